@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// BlueGreenAppNameTakenError is returned when a blue-green push needs a
+// temporary application name that is already in use.
+type BlueGreenAppNameTakenError struct {
+	AppName string
+	Name    string
+}
+
+func (e BlueGreenAppNameTakenError) Error() string {
+	return fmt.Sprintf("cannot blue-green push app %s: app %s already exists", e.AppName, e.Name)
+}
@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildpackExecutableMissingError is returned when a buildpack directory
+// does not contain one of the executables the stager runs. When more than one
+// executable is listed, any of them satisfies the requirement.
+type BuildpackExecutableMissingError struct {
+	Executables []string
+}
+
+func (e BuildpackExecutableMissingError) Error() string {
+	return fmt.Sprintf("buildpack is missing %s", strings.Join(e.Executables, " or "))
+}
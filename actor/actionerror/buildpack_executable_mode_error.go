@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"os"
+)
+
+// BuildpackExecutableModeError is returned when a buildpack executable is not
+// executable by its owner.
+type BuildpackExecutableModeError struct {
+	Executable string
+	Mode       os.FileMode
+}
+
+func (e BuildpackExecutableModeError) Error() string {
+	return fmt.Sprintf("%s is not executable (mode %s)", e.Executable, e.Mode)
+}
@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// BuildpackNotFoundError is returned when a buildpack cannot be found.
+type BuildpackNotFoundError struct {
+	Name  string
+	Stack string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.Stack != "" {
+		return fmt.Sprintf("Buildpack '%s' with stack '%s' not found", e.Name, e.Stack)
+	}
+	return fmt.Sprintf("Buildpack '%s' not found", e.Name)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// ChecksumMismatchError is returned when downloaded app bits do not match the
+// expected checksum.
+type ChecksumMismatchError struct {
+	Source   string
+	Expected string
+	Actual   string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum of %s is %s, expected %s", e.Source, e.Actual, e.Expected)
+}
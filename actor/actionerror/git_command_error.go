@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// GitCommandError is returned when checking out a git repository fails.
+type GitCommandError struct {
+	Command string
+	Output  string
+}
+
+func (e GitCommandError) Error() string {
+	return fmt.Sprintf("git %s failed: %s", e.Command, e.Output)
+}
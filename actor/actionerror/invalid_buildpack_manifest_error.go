@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidBuildpackManifestError is returned when a buildpack's manifest.yml
+// cannot be parsed or is missing required settings.
+type InvalidBuildpackManifestError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidBuildpackManifestError) Error() string {
+	return fmt.Sprintf("invalid buildpack manifest %s: %s", e.Path, e.Reason)
+}
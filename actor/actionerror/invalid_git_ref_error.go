@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidGitRefError is returned when the ref of a git app source cannot be
+// safely passed to git.
+type InvalidGitRefError struct {
+	Ref string
+}
+
+func (e InvalidGitRefError) Error() string {
+	return fmt.Sprintf("invalid git ref: %s", e.Ref)
+}
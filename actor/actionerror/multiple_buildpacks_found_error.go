@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultipleBuildpacksFoundError is returned when a buildpack name matches
+// buildpacks for more than one stack.
+type MultipleBuildpacksFoundError struct {
+	Name   string
+	Stacks []string
+}
+
+func (e MultipleBuildpacksFoundError) Error() string {
+	return fmt.Sprintf("Buildpack '%s' exists for multiple stacks: %s", e.Name, strings.Join(e.Stacks, ", "))
+}
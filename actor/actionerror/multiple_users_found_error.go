@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultipleUsersFoundError is returned when a username matches users from
+// more than one origin.
+type MultipleUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUsersFoundError) Error() string {
+	return fmt.Sprintf("User '%s' exists in multiple origins: %s", e.Username, strings.Join(e.Origins, ", "))
+}
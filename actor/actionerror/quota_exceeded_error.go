@@ -0,0 +1,27 @@
+package actionerror
+
+import "fmt"
+
+// QuotaResource is a limit of an organization or space quota.
+type QuotaResource string
+
+const (
+	QuotaResourceMemory         QuotaResource = "memory"
+	QuotaResourceInstanceMemory QuotaResource = "instance memory"
+	QuotaResourceAppInstances   QuotaResource = "app instances"
+)
+
+// QuotaExceededError is returned when starting app instances would exceed a
+// limit of an organization or space quota. Memory is in megabytes.
+type QuotaExceededError struct {
+	// Scope is either "org" or "space".
+	Scope     string
+	QuotaName string
+	Resource  QuotaResource
+	Requested uint64
+	Available uint64
+}
+
+func (e QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota '%s' %s limit exceeded: %d requested, %d available", e.Scope, e.QuotaName, e.Resource, e.Requested, e.Available)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// SmokeTestFailedError is returned when the smoke test of a blue-green push
+// fails against the new version of an application.
+type SmokeTestFailedError struct {
+	AppName string
+	Reason  string
+}
+
+func (e SmokeTestFailedError) Error() string {
+	return fmt.Sprintf("smoke test of app %s failed: %s", e.AppName, e.Reason)
+}
@@ -4,8 +4,12 @@ import "fmt"
 
 type SpaceQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e SpaceQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Space quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Space quota with GUID '%s' not found.", e.GUID)
 }
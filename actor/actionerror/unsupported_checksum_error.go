@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// UnsupportedChecksumError is returned when a checksum is not a SHA1 or
+// SHA256 hex digest.
+type UnsupportedChecksumError struct {
+	Checksum string
+}
+
+func (e UnsupportedChecksumError) Error() string {
+	return fmt.Sprintf("unsupported checksum: %s", e.Checksum)
+}
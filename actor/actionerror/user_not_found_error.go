@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// UserNotFoundError is returned when a user cannot be found.
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	if e.Origin != "" {
+		return fmt.Sprintf("User '%s' with origin '%s' not found.", e.Username, e.Origin)
+	}
+	return fmt.Sprintf("User '%s' not found.", e.Username)
+}
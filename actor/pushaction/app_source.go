@@ -0,0 +1,103 @@
+package pushaction
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . Downloader
+
+type Downloader interface {
+	Download(url string, tmpDirPath string) (string, error)
+}
+
+// IsRemoteAppSource returns true if the app path is a git repository
+// (git+URL[#REF]) or an archive URL instead of a local path.
+func IsRemoteAppSource(appPath string) bool {
+	return util.IsGitScheme(appPath) || util.IsHTTPScheme(appPath)
+}
+
+// FetchRemoteAppSource checks out the git repository or downloads the archive
+// at sourceURL into tmpDir, and returns the local path of the app bits. When
+// a checksum is provided, the downloaded archive must match it; checksums are
+// hex SHA1 or SHA256 digests, optionally prefixed with 'sha1:' or 'sha256:'.
+func (actor Actor) FetchRemoteAppSource(sourceURL string, checksum string, tmpDir string, downloader Downloader) (string, error) {
+	if util.IsGitScheme(sourceURL) {
+		return actor.checkoutGitSource(sourceURL, tmpDir)
+	}
+
+	var checksumToMatch *sharedaction.Checksum
+	if checksum != "" {
+		parsed, err := sharedaction.ParseChecksum(checksum)
+		if err != nil {
+			return "", err
+		}
+		checksumToMatch = &parsed
+	}
+
+	log.WithField("url", sourceURL).Info("downloading app source")
+	archivePath, err := downloader.Download(sourceURL, tmpDir)
+	if err != nil {
+		return "", err
+	}
+
+	if checksumToMatch != nil {
+		err = checksumToMatch.Verify(archivePath, sourceURL)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return archivePath, nil
+}
+
+func (Actor) checkoutGitSource(sourceURL string, tmpDir string) (string, error) {
+	repoURL := strings.TrimPrefix(sourceURL, "git+")
+	var ref string
+	if i := strings.LastIndex(repoURL, "#"); i >= 0 {
+		repoURL, ref = repoURL[:i], repoURL[i+1:]
+	}
+	if strings.HasPrefix(ref, "-") {
+		return "", actionerror.InvalidGitRefError{Ref: ref}
+	}
+
+	sourceDir := filepath.Join(tmpDir, "source")
+
+	log.WithFields(log.Fields{"repository": repoURL, "ref": ref}).Info("checking out app source")
+	err := runGit(tmpDir, "clone", "--quiet", "--", repoURL, sourceDir)
+	if err != nil {
+		return "", err
+	}
+
+	if ref != "" {
+		err = runGit(sourceDir, "checkout", "--quiet", ref)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return sourceDir, nil
+}
+
+func runGit(dir string, args ...string) error {
+	command := exec.Command("git", args...)
+	command.Dir = dir
+	command.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	output, err := command.CombinedOutput()
+	if err != nil {
+		message := strings.TrimSpace(string(output))
+		if message == "" {
+			message = err.Error()
+		}
+		return actionerror.GitCommandError{Command: args[0], Output: message}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package pushaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/util/download"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Source Actions", func() {
+	var (
+		actor  *Actor
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		actor, _, _, _ = getTestPushActor()
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "push-app-source")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	DescribeTable("IsRemoteAppSource",
+		func(appPath string, isRemote bool) {
+			Expect(IsRemoteAppSource(appPath)).To(Equal(isRemote))
+		},
+
+		Entry("git repository", "git+file:///some/repo#v1.2.3", true),
+		Entry("archive URL", "https://example.com/app.zip", true),
+		Entry("local directory", "/some/app", false),
+		Entry("local archive", "app.zip", false),
+	)
+
+	Describe("FetchRemoteAppSource", func() {
+		Context("when the source is a git repository", func() {
+			var repoDir string
+
+			git := func(args ...string) {
+				command := exec.Command("git", append([]string{"-c", "user.name=some-user", "-c", "user.email=some-user@example.com"}, args...)...)
+				command.Dir = repoDir
+				output, err := command.CombinedOutput()
+				Expect(err).ToNot(HaveOccurred(), string(output))
+			}
+
+			BeforeEach(func() {
+				var err error
+				repoDir, err = ioutil.TempDir("", "push-app-source-repo")
+				Expect(err).ToNot(HaveOccurred())
+
+				git("init", "--quiet")
+				Expect(ioutil.WriteFile(filepath.Join(repoDir, "version"), []byte("1"), 0644)).To(Succeed())
+				git("add", ".")
+				git("commit", "--quiet", "-m", "first")
+				git("tag", "v1")
+				Expect(ioutil.WriteFile(filepath.Join(repoDir, "version"), []byte("2"), 0644)).To(Succeed())
+				git("commit", "--quiet", "-am", "second")
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(repoDir)).To(Succeed())
+			})
+
+			Context("when no ref is provided", func() {
+				It("checks out the default branch", func() {
+					sourceDir, err := actor.FetchRemoteAppSource("git+file://"+repoDir, "", tmpDir, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(sourceDir).To(HavePrefix(tmpDir))
+					Expect(ioutil.ReadFile(filepath.Join(sourceDir, "version"))).To(Equal([]byte("2")))
+				})
+			})
+
+			Context("when a ref is provided", func() {
+				It("checks out the ref", func() {
+					sourceDir, err := actor.FetchRemoteAppSource("git+file://"+repoDir+"#v1", "", tmpDir, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(sourceDir, "version"))).To(Equal([]byte("1")))
+				})
+			})
+
+			Context("when the ref does not exist", func() {
+				It("returns a GitCommandError", func() {
+					_, err := actor.FetchRemoteAppSource("git+file://"+repoDir+"#v2", "", tmpDir, nil)
+					Expect(err).To(BeAssignableToTypeOf(actionerror.GitCommandError{}))
+					Expect(err.(actionerror.GitCommandError).Command).To(Equal("checkout"))
+				})
+			})
+
+			Context("when the ref looks like an option", func() {
+				It("returns an InvalidGitRefError", func() {
+					_, err := actor.FetchRemoteAppSource("git+file://"+repoDir+"#--orphan", "", tmpDir, nil)
+					Expect(err).To(MatchError(actionerror.InvalidGitRefError{Ref: "--orphan"}))
+				})
+			})
+
+			Context("when the repository does not exist", func() {
+				It("returns a GitCommandError", func() {
+					_, err := actor.FetchRemoteAppSource("git+file://"+filepath.Join(repoDir, "missing"), "", tmpDir, nil)
+					Expect(err).To(BeAssignableToTypeOf(actionerror.GitCommandError{}))
+					Expect(err.(actionerror.GitCommandError).Command).To(Equal("clone"))
+				})
+			})
+		})
+
+		Context("when the source is an archive URL", func() {
+			const (
+				archiveContent = "some-archive"
+				archiveSHA1    = "b2803fafd68ed39654ad9c262ebfbb18323ed6b9"
+				archiveSHA256  = "4bd51f0fb046e4b390a4f4e8a85880b287dd1265c8d5e4534399ae10258ccbc4"
+				otherSHA256    = "0000000000000000000000000000000000000000000000000000000000000000"
+			)
+
+			var (
+				server     *httptest.Server
+				downloader Downloader
+			)
+
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/app.zip" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Write([]byte(archiveContent))
+				}))
+				downloader = download.NewDownloader(time.Second)
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			Context("when no checksum is provided", func() {
+				It("downloads the archive", func() {
+					archivePath, err := actor.FetchRemoteAppSource(server.URL+"/app.zip", "", tmpDir, downloader)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(archivePath).To(Equal(filepath.Join(tmpDir, "app.zip")))
+					Expect(ioutil.ReadFile(archivePath)).To(Equal([]byte(archiveContent)))
+				})
+			})
+
+			DescribeTable("when the archive matches the checksum",
+				func(checksum string) {
+					archivePath, err := actor.FetchRemoteAppSource(server.URL+"/app.zip", checksum, tmpDir, downloader)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(archivePath).To(Equal(filepath.Join(tmpDir, "app.zip")))
+				},
+
+				Entry("SHA256", archiveSHA256),
+				Entry("prefixed SHA256", "sha256:"+archiveSHA256),
+				Entry("SHA1", archiveSHA1),
+				Entry("prefixed SHA1", "sha1:"+archiveSHA1),
+				Entry("prefixed uppercase SHA1", "SHA1:B2803FAFD68ED39654AD9C262EBFBB18323ED6B9"),
+			)
+
+			Context("when the archive does not match the checksum", func() {
+				It("returns a ChecksumMismatchError", func() {
+					_, err := actor.FetchRemoteAppSource(server.URL+"/app.zip", "sha256:"+otherSHA256, tmpDir, downloader)
+					Expect(err).To(MatchError(actionerror.ChecksumMismatchError{
+						Source:   server.URL + "/app.zip",
+						Expected: otherSHA256,
+						Actual:   archiveSHA256,
+					}))
+				})
+			})
+
+			Context("when the checksum is not supported", func() {
+				It("returns an UnsupportedChecksumError without downloading", func() {
+					fakeDownloader := new(pushactionfakes.FakeDownloader)
+					_, err := actor.FetchRemoteAppSource(server.URL+"/app.zip", "md5:abc", tmpDir, fakeDownloader)
+					Expect(err).To(MatchError(actionerror.UnsupportedChecksumError{Checksum: "md5:abc"}))
+					Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the download fails", func() {
+				It("returns the error", func() {
+					fakeDownloader := new(pushactionfakes.FakeDownloader)
+					fakeDownloader.DownloadReturns("", errors.New("some-download-error"))
+					_, err := actor.FetchRemoteAppSource(server.URL+"/app.zip", "", tmpDir, fakeDownloader)
+					Expect(err).To(MatchError("some-download-error"))
+				})
+			})
+
+			Context("when the archive does not exist", func() {
+				It("returns the HTTP error", func() {
+					_, err := actor.FetchRemoteAppSource(server.URL+"/missing.zip", "", tmpDir, downloader)
+					Expect(err).To(BeAssignableToTypeOf(download.RawHTTPStatusError{}))
+				})
+			})
+		})
+	})
+})
@@ -119,47 +119,17 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 				return
 			}
 		} else if config.DesiredApplication.DockerImage == "" {
-			eventStream <- ResourceMatching
-			config, warnings = actor.SetMatchedResources(config)
-			warningsStream <- warnings
-
-			if len(config.UnmatchedResources) > 0 {
-				var archivePath string
-				archivePath, err = actor.CreateArchive(config)
-				if err != nil {
-					errorStream <- err
-					os.RemoveAll(archivePath)
-					return
-				}
-				eventStream <- CreatingArchive
-				defer os.RemoveAll(archivePath)
-
-				for count := 0; count < PushRetries; count++ {
-					warnings, err = actor.UploadPackageWithArchive(config, archivePath, progressBar, eventStream)
-					warningsStream <- warnings
-					if _, ok := err.(ccerror.PipeSeekError); ok {
-						eventStream <- RetryUpload
-					} else {
-						break
-					}
-				}
+			config, err = actor.matchAndUploadResources(config, progressBar, eventStream, warningsStream)
+			if _, ok := err.(ccerror.JobFailedError); ok && len(config.MatchedResources) > 0 {
+				log.WithError(err).Warn("upload failed with matched resources, retrying without the resource match cache")
+				actor.V2Actor.ClearResourceMatchCache()
+				eventStream <- RetryUpload
+				config, err = actor.matchAndUploadResources(config, progressBar, eventStream, warningsStream)
+			}
 
-				if err != nil {
-					if e, ok := err.(ccerror.PipeSeekError); ok {
-						errorStream <- actionerror.UploadFailedError{Err: e.Err}
-					} else {
-						errorStream <- err
-					}
-					return
-				}
-			} else {
-				eventStream <- UploadingApplication
-				warnings, err = actor.UploadPackage(config)
-				warningsStream <- warnings
-				if err != nil {
-					errorStream <- err
-					return
-				}
+			if err != nil {
+				errorStream <- err
+				return
 			}
 		} else {
 			log.WithField("docker_image", config.DesiredApplication.DockerImage).Debug("skipping file upload")
@@ -173,3 +143,45 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 
 	return configStream, eventStream, warningsStream, errorStream
 }
+
+// matchAndUploadResources uploads the resources of the application that the
+// Cloud Controller does not already have.
+func (actor Actor) matchAndUploadResources(config ApplicationConfig, progressBar ProgressBar, eventStream chan<- Event, warningsStream chan<- Warnings) (ApplicationConfig, error) {
+	var warnings Warnings
+	var err error
+
+	eventStream <- ResourceMatching
+	config, warnings = actor.SetMatchedResources(config)
+	warningsStream <- warnings
+
+	if len(config.UnmatchedResources) == 0 {
+		eventStream <- UploadingApplication
+		warnings, err = actor.UploadPackage(config)
+		warningsStream <- warnings
+		return config, err
+	}
+
+	var archivePath string
+	archivePath, err = actor.CreateArchive(config)
+	if err != nil {
+		os.RemoveAll(archivePath)
+		return config, err
+	}
+	eventStream <- CreatingArchive
+	defer os.RemoveAll(archivePath)
+
+	for count := 0; count < PushRetries; count++ {
+		warnings, err = actor.UploadPackageWithArchive(config, archivePath, progressBar, eventStream)
+		warningsStream <- warnings
+		if _, ok := err.(ccerror.PipeSeekError); ok {
+			eventStream <- RetryUpload
+		} else {
+			break
+		}
+	}
+
+	if e, ok := err.(ccerror.PipeSeekError); ok {
+		return config, actionerror.UploadFailedError{Err: e.Err}
+	}
+	return config, err
+}
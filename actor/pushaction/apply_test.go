@@ -738,6 +738,33 @@ var _ = Describe("Apply", func() {
 						Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
 						Expect(nextEvent()).To(Equal(Complete))
 					})
+
+					It("does not clear the resource match cache", func() {
+						Eventually(nextEvent).Should(Equal(Complete))
+						Expect(fakeV2Actor.ClearResourceMatchCacheCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the upload job fails after resources were matched", func() {
+					BeforeEach(func() {
+						fakeV2Actor.ResourceMatchReturns([]v2action.Resource{{Filename: "some-file", SHA1: "some-sha", Size: 10}}, nil, v2action.Warnings{"resource-warnings-1", "resource-warnings-2"}, nil)
+						fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil)
+						fakeV2Actor.PollJobReturnsOnCall(0, v2action.Warnings{"poll-warning"}, ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "missing resources"})
+						fakeV2Actor.PollJobReturnsOnCall(1, nil, nil)
+					})
+
+					It("clears the resource match cache and matches the resources again", func() {
+						Eventually(nextEvent).Should(Equal(UploadingApplication))
+						Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2", "poll-warning")))
+						Expect(nextEvent()).To(Equal(RetryUpload))
+						Expect(nextEvent()).To(Equal(ResourceMatching))
+						Expect(nextEvent()).To(Equal(UploadingApplication))
+						Expect(nextEvent()).To(Equal(Complete))
+
+						Expect(fakeV2Actor.ClearResourceMatchCacheCallCount()).To(Equal(1))
+						Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(2))
+						Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(2))
+					})
 				})
 
 				Context("when the upload fails", func() {
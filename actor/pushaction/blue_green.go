@@ -0,0 +1,407 @@
+package pushaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	log "github.com/sirupsen/logrus"
+)
+
+type BlueGreenPhase string
+
+const (
+	// BlueGreenPushing means the new version of the app is being pushed with
+	// only the temporary route mapped.
+	BlueGreenPushing BlueGreenPhase = "pushing"
+	// BlueGreenTesting means the new version of the app is running and is
+	// being smoke tested on the temporary route.
+	BlueGreenTesting BlueGreenPhase = "testing"
+	// BlueGreenSwapping means the routes are being moved from the old version
+	// of the app to the new one.
+	BlueGreenSwapping BlueGreenPhase = "swapping"
+	// BlueGreenRetiring means the new version of the app is serving traffic
+	// and the old version is being deleted or stopped.
+	BlueGreenRetiring BlueGreenPhase = "retiring"
+)
+
+// BlueGreenState records the progress of a blue-green push so that an
+// interrupted push can be resumed or rolled back.
+type BlueGreenState struct {
+	Phase     BlueGreenPhase `json:"phase"`
+	AppName   string         `json:"app_name"`
+	SpaceGUID string         `json:"space_guid"`
+
+	OldAppGUID string `json:"old_app_guid,omitempty"`
+	OldAppName string `json:"old_app_name"`
+	NewAppGUID string `json:"new_app_guid,omitempty"`
+	NewAppName string `json:"new_app_name"`
+	KeepOld    bool   `json:"keep_old,omitempty"`
+
+	RouteGUIDs        []string `json:"route_guids,omitempty"`
+	CreatedRouteGUIDs []string `json:"created_route_guids,omitempty"`
+	OldRouteGUIDs     []string `json:"old_route_guids,omitempty"`
+	TempRouteGUID     string   `json:"temp_route_guid,omitempty"`
+	TempURL           string   `json:"temp_url,omitempty"`
+
+	path string
+}
+
+// Resumable returns true if the new version of the app has passed its smoke
+// test, in which case an interrupted push should be finished rather than
+// rolled back.
+func (state BlueGreenState) Resumable() bool {
+	return state.Phase == BlueGreenSwapping || state.Phase == BlueGreenRetiring
+}
+
+// LoadBlueGreenState returns the state of an interrupted blue-green push of
+// the app, if any.
+func (Actor) LoadBlueGreenState(stateDir string, spaceGUID string, appName string) (BlueGreenState, bool, error) {
+	path := blueGreenStatePath(stateDir, spaceGUID, appName)
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return BlueGreenState{}, false, nil
+	} else if err != nil {
+		return BlueGreenState{}, false, err
+	}
+
+	var state BlueGreenState
+	err = json.Unmarshal(raw, &state)
+	if err != nil {
+		return BlueGreenState{}, false, err
+	}
+	state.path = path
+	return state, true, nil
+}
+
+// PrepareBlueGreenPush creates the routes of the app and a temporary route
+// for the new version of the app. The returned config pushes the new version
+// under a temporary name, with only the temporary route mapped.
+func (actor Actor) PrepareBlueGreenPush(config ApplicationConfig, orgGUID string, stateDir string, keepOld bool) (ApplicationConfig, BlueGreenState, Warnings, error) {
+	log.WithField("app", config.DesiredApplication.Name).Info("preparing blue-green push")
+
+	appName := config.DesiredApplication.Name
+	spaceGUID := config.DesiredApplication.SpaceGUID
+	state := BlueGreenState{
+		Phase:      BlueGreenPushing,
+		AppName:    appName,
+		SpaceGUID:  spaceGUID,
+		OldAppGUID: config.CurrentApplication.GUID,
+		OldAppName: appName + "-old",
+		NewAppName: appName + "-new",
+		KeepOld:    keepOld,
+		path:       blueGreenStatePath(stateDir, spaceGUID, appName),
+	}
+
+	names := []string{state.NewAppName}
+	if keepOld {
+		names = append(names, state.OldAppName)
+	}
+
+	var allWarnings Warnings
+	for _, name := range names {
+		_, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			return ApplicationConfig{}, BlueGreenState{}, allWarnings, actionerror.BlueGreenAppNameTakenError{AppName: appName, Name: name}
+		} else if _, ok := err.(actionerror.ApplicationNotFoundError); !ok {
+			return ApplicationConfig{}, BlueGreenState{}, allWarnings, err
+		}
+	}
+
+	for _, route := range config.CurrentRoutes {
+		state.OldRouteGUIDs = append(state.OldRouteGUIDs, route.GUID)
+	}
+
+	if !config.NoRoute {
+		existingRoutes := map[int]bool{}
+		for i, route := range config.DesiredRoutes {
+			existingRoutes[i] = route.GUID != ""
+		}
+
+		var warnings Warnings
+		var err error
+		config, _, warnings, err = actor.CreateRoutes(config)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationConfig{}, BlueGreenState{}, allWarnings, err
+		}
+		for i, route := range config.DesiredRoutes {
+			state.RouteGUIDs = append(state.RouteGUIDs, route.GUID)
+			if !existingRoutes[i] {
+				state.CreatedRouteGUIDs = append(state.CreatedRouteGUIDs, route.GUID)
+			}
+		}
+	}
+
+	tempRoute, warnings, err := actor.createTemporaryRoute(config, orgGUID, state.NewAppName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		deleteWarnings, _ := actor.deleteRoutes(state.CreatedRouteGUIDs)
+		return ApplicationConfig{}, BlueGreenState{}, append(allWarnings, deleteWarnings...), err
+	}
+	state.TempRouteGUID = tempRoute.GUID
+	state.TempURL = "http://" + tempRoute.String()
+
+	err = state.save()
+	if err != nil {
+		deleteWarnings, _ := actor.deleteRoutes(append(state.CreatedRouteGUIDs, state.TempRouteGUID))
+		return ApplicationConfig{}, BlueGreenState{}, append(allWarnings, deleteWarnings...), err
+	}
+
+	tempConfig := config
+	tempConfig.CurrentApplication = Application{}
+	tempConfig.DesiredApplication.GUID = ""
+	tempConfig.DesiredApplication.Name = state.NewAppName
+	tempConfig.DesiredApplication.State = ""
+	tempConfig.CurrentRoutes = nil
+	tempConfig.DesiredRoutes = []v2action.Route{tempRoute}
+	tempConfig.NoRoute = false
+	tempConfig.CurrentServices = nil
+
+	return tempConfig, state, allWarnings, nil
+}
+
+// MarkBlueGreenAppPushed records the GUID of the new version of the app once
+// it has been pushed and started.
+func (Actor) MarkBlueGreenAppPushed(state BlueGreenState, appGUID string) (BlueGreenState, error) {
+	state.NewAppGUID = appGUID
+	state.Phase = BlueGreenTesting
+	return state, state.save()
+}
+
+// SwapBlueGreenRoutes maps the routes of the app to the new version of the
+// app, unmaps them from the old version, and deletes the temporary route.
+func (actor Actor) SwapBlueGreenRoutes(state BlueGreenState) (BlueGreenState, Warnings, error) {
+	log.WithField("app", state.AppName).Info("swapping blue-green routes")
+
+	state.Phase = BlueGreenSwapping
+	err := state.save()
+	if err != nil {
+		return state, nil, err
+	}
+
+	var allWarnings Warnings
+	for _, routeGUID := range state.RouteGUIDs {
+		warnings, err := actor.V2Actor.MapRouteToApplication(routeGUID, state.NewAppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return state, allWarnings, err
+		}
+	}
+
+	if state.OldAppGUID != "" {
+		for _, routeGUID := range state.OldRouteGUIDs {
+			warnings, err := actor.V2Actor.UnmapRouteFromApplication(routeGUID, state.OldAppGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil && !isNotFound(err) {
+				return state, allWarnings, err
+			}
+		}
+	}
+
+	warnings, err := actor.deleteTemporaryRoute(state)
+	allWarnings = append(allWarnings, warnings...)
+	return state, allWarnings, err
+}
+
+// RetireBlueGreenApp deletes the old version of the app, or renames and
+// stops it when it should be kept, and gives the new version of the app its
+// final name.
+func (actor Actor) RetireBlueGreenApp(state BlueGreenState) (Warnings, error) {
+	log.WithField("app", state.AppName).Info("retiring old blue-green app")
+
+	state.Phase = BlueGreenRetiring
+	err := state.save()
+	if err != nil {
+		return nil, err
+	}
+
+	var allWarnings Warnings
+	if state.OldAppGUID != "" {
+		var warnings v2action.Warnings
+		if state.KeepOld {
+			_, warnings, err = actor.V2Actor.UpdateApplication(v2action.Application{
+				GUID:  state.OldAppGUID,
+				Name:  state.OldAppName,
+				State: constant.ApplicationStopped,
+			})
+		} else {
+			warnings, err = actor.V2Actor.DeleteApplication(state.OldAppGUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotFound(err) {
+			return allWarnings, err
+		}
+	}
+
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID: state.NewAppGUID,
+		Name: state.AppName,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	return allWarnings, state.remove()
+}
+
+// RollbackBlueGreenPush restores the routes of the old version of the app
+// and deletes the new version of the app, the routes created for it and the
+// temporary route.
+func (actor Actor) RollbackBlueGreenPush(state BlueGreenState) (Warnings, error) {
+	log.WithField("app", state.AppName).Info("rolling back blue-green push")
+
+	var allWarnings Warnings
+	if state.Phase == BlueGreenSwapping {
+		if state.OldAppGUID != "" {
+			for _, routeGUID := range state.OldRouteGUIDs {
+				warnings, err := actor.V2Actor.MapRouteToApplication(routeGUID, state.OldAppGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+			}
+		}
+
+		if state.NewAppGUID != "" {
+			for _, routeGUID := range state.RouteGUIDs {
+				warnings, err := actor.V2Actor.UnmapRouteFromApplication(routeGUID, state.NewAppGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil && !isNotFound(err) {
+					return allWarnings, err
+				}
+			}
+		}
+	}
+
+	newAppGUID := state.NewAppGUID
+	if newAppGUID == "" {
+		app, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(state.NewAppName, state.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			newAppGUID = app.GUID
+		} else if _, ok := err.(actionerror.ApplicationNotFoundError); !ok {
+			return allWarnings, err
+		}
+	}
+
+	if newAppGUID != "" {
+		warnings, err := actor.V2Actor.DeleteApplication(newAppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotFound(err) {
+			return allWarnings, err
+		}
+	}
+
+	warnings, err := actor.deleteRoutes(append(state.CreatedRouteGUIDs, state.TempRouteGUID))
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	return allWarnings, state.remove()
+}
+
+func (actor Actor) createTemporaryRoute(config ApplicationConfig, orgGUID string, appName string) (v2action.Route, Warnings, error) {
+	var (
+		domain   v2action.Domain
+		warnings Warnings
+		err      error
+	)
+
+	for _, route := range config.DesiredRoutes {
+		if route.Domain.IsHTTP() {
+			domain = route.Domain
+			break
+		}
+	}
+
+	if domain.GUID == "" {
+		domain, warnings, err = actor.DefaultDomain(orgGUID)
+		if err != nil {
+			return v2action.Route{}, warnings, err
+		}
+	}
+
+	route := v2action.Route{
+		Domain:    domain,
+		SpaceGUID: config.DesiredApplication.SpaceGUID,
+	}
+	if domain.IsHTTP() {
+		route.Host = fmt.Sprintf("%s-%s-%s", actor.sanitize(appName), actor.WordGenerator.RandomAdjective(), actor.WordGenerator.RandomNoun())
+	}
+
+	createdRoute, createWarnings, err := actor.V2Actor.CreateRoute(route, route.RandomTCPPort())
+	return createdRoute, append(warnings, createWarnings...), err
+}
+
+func (actor Actor) deleteTemporaryRoute(state BlueGreenState) (Warnings, error) {
+	return actor.deleteRoutes([]string{state.TempRouteGUID})
+}
+
+// deleteRoutes deletes the routes, ignoring routes that no longer exist.
+func (actor Actor) deleteRoutes(routeGUIDs []string) (Warnings, error) {
+	var allWarnings Warnings
+	for _, routeGUID := range routeGUIDs {
+		if routeGUID == "" {
+			continue
+		}
+
+		warnings, err := actor.V2Actor.DeleteRoute(routeGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil && !isNotFound(err) {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+func (state BlueGreenState) save() error {
+	if state.path == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(state.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(state.path, raw, 0600)
+}
+
+func (state BlueGreenState) remove() error {
+	if state.path == "" {
+		return nil
+	}
+
+	err := os.Remove(state.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func blueGreenStatePath(stateDir string, spaceGUID string, appName string) string {
+	return filepath.Join(stateDir, fmt.Sprintf("%s_%s.json", spaceGUID, url.PathEscape(appName)))
+}
+
+func isNotFound(err error) bool {
+	switch err.(type) {
+	case actionerror.ApplicationNotFoundError, actionerror.RouteNotFoundError, ccerror.ResourceNotFoundError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,406 @@
+package pushaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Blue-Green Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		stateDir    string
+	)
+
+	BeforeEach(func() {
+		actor, fakeV2Actor, _, _ = getTestPushActor()
+
+		fakeRandomWordGenerator := new(pushactionfakes.FakeRandomWordGenerator)
+		fakeRandomWordGenerator.RandomAdjectiveReturns("striped")
+		fakeRandomWordGenerator.RandomNounReturns("apple")
+		actor.WordGenerator = fakeRandomWordGenerator
+
+		var err error
+		stateDir, err = ioutil.TempDir("", "push-blue-green")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(stateDir)).To(Succeed())
+	})
+
+	loadState := func() (BlueGreenState, bool) {
+		state, found, err := actor.LoadBlueGreenState(stateDir, "some-space-guid", "some-app")
+		Expect(err).ToNot(HaveOccurred())
+		return state, found
+	}
+
+	Describe("PrepareBlueGreenPush", func() {
+		var (
+			config  ApplicationConfig
+			keepOld bool
+
+			tempConfig ApplicationConfig
+			state      BlueGreenState
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			keepOld = false
+			httpDomain := v2action.Domain{GUID: "some-domain-guid", Name: "some-domain.com"}
+			config = ApplicationConfig{
+				CurrentApplication: Application{Application: v2action.Application{
+					GUID:      "some-old-app-guid",
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+					State:     constant.ApplicationStarted,
+				}},
+				DesiredApplication: Application{Application: v2action.Application{
+					GUID:      "some-old-app-guid",
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+					State:     constant.ApplicationStarted,
+				}},
+				CurrentRoutes: []v2action.Route{
+					{GUID: "some-old-route-guid", Host: "some-app", Domain: httpDomain},
+				},
+				DesiredRoutes: []v2action.Route{
+					{GUID: "some-old-route-guid", Host: "some-app", Domain: httpDomain},
+					{Host: "some-other-host", Domain: httpDomain, SpaceGUID: "some-space-guid"},
+				},
+				CurrentServices: map[string]v2action.ServiceInstance{
+					"some-service": {GUID: "some-service-guid"},
+				},
+				DesiredServices: map[string]v2action.ServiceInstance{
+					"some-service": {GUID: "some-service-guid"},
+				},
+			}
+
+			fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{})
+			fakeV2Actor.CreateRouteStub = func(route v2action.Route, _ bool) (v2action.Route, v2action.Warnings, error) {
+				route.GUID = route.Host + "-guid"
+				return route, v2action.Warnings{"create-route-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			tempConfig, state, warnings, executeErr = actor.PrepareBlueGreenPush(config, "some-org-guid", stateDir, keepOld)
+		})
+
+		It("creates the routes and a temporary route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "create-route-warning", "create-route-warning"))
+
+			Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(2))
+			route, _ := fakeV2Actor.CreateRouteArgsForCall(0)
+			Expect(route.Host).To(Equal("some-other-host"))
+			route, _ = fakeV2Actor.CreateRouteArgsForCall(1)
+			Expect(route.Host).To(Equal("some-app-new-striped-apple"))
+			Expect(route.Domain.GUID).To(Equal("some-domain-guid"))
+			Expect(route.SpaceGUID).To(Equal("some-space-guid"))
+		})
+
+		It("returns a config that pushes a new app with only the temporary route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(tempConfig.CreatingApplication()).To(BeTrue())
+			Expect(tempConfig.DesiredApplication.Name).To(Equal("some-app-new"))
+			Expect(tempConfig.DesiredApplication.GUID).To(BeEmpty())
+			Expect(tempConfig.DesiredApplication.State).To(BeEmpty())
+			Expect(tempConfig.CurrentRoutes).To(BeEmpty())
+			Expect(tempConfig.DesiredRoutes).To(HaveLen(1))
+			Expect(tempConfig.DesiredRoutes[0].GUID).To(Equal("some-app-new-striped-apple-guid"))
+			Expect(tempConfig.CurrentServices).To(BeEmpty())
+			Expect(tempConfig.DesiredServices).To(HaveKey("some-service"))
+		})
+
+		It("saves the state of the push", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(state.Phase).To(Equal(BlueGreenPushing))
+			Expect(state.OldAppGUID).To(Equal("some-old-app-guid"))
+			Expect(state.NewAppName).To(Equal("some-app-new"))
+			Expect(state.RouteGUIDs).To(Equal([]string{"some-old-route-guid", "some-other-host-guid"}))
+			Expect(state.CreatedRouteGUIDs).To(Equal([]string{"some-other-host-guid"}))
+			Expect(state.OldRouteGUIDs).To(Equal([]string{"some-old-route-guid"}))
+			Expect(state.TempRouteGUID).To(Equal("some-app-new-striped-apple-guid"))
+			Expect(state.TempURL).To(Equal("http://some-app-new-striped-apple.some-domain.com"))
+
+			savedState, found := loadState()
+			Expect(found).To(BeTrue())
+			Expect(savedState.Phase).To(Equal(BlueGreenPushing))
+			Expect(savedState.RouteGUIDs).To(Equal(state.RouteGUIDs))
+		})
+
+		Context("when the temporary app name is taken", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-guid"}, nil, nil)
+			})
+
+			It("returns a BlueGreenAppNameTakenError without creating routes", func() {
+				Expect(executeErr).To(MatchError(actionerror.BlueGreenAppNameTakenError{AppName: "some-app", Name: "some-app-new"}))
+				Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(0))
+
+				_, found := loadState()
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the old app is kept", func() {
+			BeforeEach(func() {
+				keepOld = true
+				fakeV2Actor.GetApplicationByNameAndSpaceReturnsOnCall(1, v2action.Application{GUID: "some-guid"}, nil, nil)
+			})
+
+			It("checks that the name for the old app is free", func() {
+				Expect(executeErr).To(MatchError(actionerror.BlueGreenAppNameTakenError{AppName: "some-app", Name: "some-app-old"}))
+			})
+		})
+
+		Context("when the app has no HTTP routes", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.DesiredRoutes = nil
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{GUID: "default-domain-guid", Name: "default-domain.com"}}, nil, nil)
+			})
+
+			It("creates the temporary route on the default domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(1))
+				route, _ := fakeV2Actor.CreateRouteArgsForCall(0)
+				Expect(route.Domain.GUID).To(Equal("default-domain-guid"))
+				Expect(state.RouteGUIDs).To(BeEmpty())
+				Expect(tempConfig.NoRoute).To(BeFalse())
+			})
+		})
+
+		Context("when creating a route fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateRouteStub = nil
+				fakeV2Actor.CreateRouteReturns(v2action.Route{}, nil, errors.New("some-route-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-route-error"))
+			})
+		})
+
+		Context("when creating the temporary route fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateRouteStub = func(route v2action.Route, _ bool) (v2action.Route, v2action.Warnings, error) {
+					if route.Host == "some-other-host" {
+						route.GUID = "some-other-host-guid"
+						return route, nil, nil
+					}
+					return v2action.Route{}, nil, errors.New("some-route-error")
+				}
+				fakeV2Actor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+			})
+
+			It("deletes the routes it created and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-route-error"))
+				Expect(warnings).To(ContainElement("delete-route-warning"))
+				Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("some-other-host-guid"))
+
+				_, found := loadState()
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Context("with a pushed app", func() {
+		var state BlueGreenState
+
+		BeforeEach(func() {
+			var err error
+			config := ApplicationConfig{
+				CurrentApplication: Application{Application: v2action.Application{GUID: "some-old-app-guid", Name: "some-app", SpaceGUID: "some-space-guid"}},
+				DesiredApplication: Application{Application: v2action.Application{GUID: "some-old-app-guid", Name: "some-app", SpaceGUID: "some-space-guid"}},
+				CurrentRoutes:      []v2action.Route{{GUID: "some-route-guid", Host: "some-app", Domain: v2action.Domain{GUID: "some-domain-guid", Name: "some-domain.com"}}},
+				DesiredRoutes:      []v2action.Route{{GUID: "some-route-guid", Host: "some-app", Domain: v2action.Domain{GUID: "some-domain-guid", Name: "some-domain.com"}}},
+			}
+			fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{})
+			fakeV2Actor.CreateRouteReturns(v2action.Route{GUID: "some-temp-route-guid"}, nil, nil)
+			_, state, _, err = actor.PrepareBlueGreenPush(config, "some-org-guid", stateDir, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			state, err = actor.MarkBlueGreenAppPushed(state, "some-new-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Phase).To(Equal(BlueGreenTesting))
+		})
+
+		Describe("SwapBlueGreenRoutes", func() {
+			It("moves the routes to the new app and deletes the temporary route", func() {
+				fakeV2Actor.MapRouteToApplicationReturns(v2action.Warnings{"map-warning"}, nil)
+				fakeV2Actor.UnmapRouteFromApplicationReturns(v2action.Warnings{"unmap-warning"}, nil)
+				fakeV2Actor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+
+				newState, warnings, err := actor.SwapBlueGreenRoutes(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("map-warning", "unmap-warning", "delete-route-warning"))
+				Expect(newState.Phase).To(Equal(BlueGreenSwapping))
+
+				routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-new-app-guid"))
+				routeGUID, appGUID = fakeV2Actor.UnmapRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-old-app-guid"))
+				Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("some-temp-route-guid"))
+
+				savedState, _ := loadState()
+				Expect(savedState.Resumable()).To(BeTrue())
+			})
+
+			Context("when the temporary route is already gone", func() {
+				It("ignores the error", func() {
+					fakeV2Actor.DeleteRouteReturns(nil, ccerror.ResourceNotFoundError{})
+					_, _, err := actor.SwapBlueGreenRoutes(state)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Describe("RetireBlueGreenApp", func() {
+			It("deletes the old app, renames the new app and removes the state", func() {
+				fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-app-warning"}, nil)
+				fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-app-warning"}, nil)
+
+				warnings, err := actor.RetireBlueGreenApp(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-app-warning", "update-app-warning"))
+
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("some-old-app-guid"))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{GUID: "some-new-app-guid", Name: "some-app"}))
+
+				_, found := loadState()
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when the old app is kept", func() {
+				It("renames and stops the old app", func() {
+					state.KeepOld = true
+					_, err := actor.RetireBlueGreenApp(state)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+						GUID:  "some-old-app-guid",
+						Name:  "some-app-old",
+						State: constant.ApplicationStopped,
+					}))
+				})
+			})
+
+			Context("when renaming the new app fails", func() {
+				It("keeps the state so the push can be resumed", func() {
+					fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, nil, errors.New("some-update-error"))
+					_, err := actor.RetireBlueGreenApp(state)
+					Expect(err).To(MatchError("some-update-error"))
+
+					savedState, found := loadState()
+					Expect(found).To(BeTrue())
+					Expect(savedState.Phase).To(Equal(BlueGreenRetiring))
+				})
+			})
+		})
+
+		Describe("RollbackBlueGreenPush", func() {
+			It("deletes the new app and the temporary route and removes the state", func() {
+				warnings, err := actor.RollbackBlueGreenPush(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+
+				Expect(fakeV2Actor.MapRouteToApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("some-new-app-guid"))
+				Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("some-temp-route-guid"))
+
+				_, found := loadState()
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when routes were created for the new app", func() {
+				It("deletes them along with the temporary route", func() {
+					state.CreatedRouteGUIDs = []string{"some-created-route-guid"}
+					fakeV2Actor.DeleteRouteReturnsOnCall(0, nil, ccerror.ResourceNotFoundError{})
+					_, err := actor.RollbackBlueGreenPush(state)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(2))
+					Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("some-created-route-guid"))
+					Expect(fakeV2Actor.DeleteRouteArgsForCall(1)).To(Equal("some-temp-route-guid"))
+				})
+			})
+
+			Context("when the routes were being swapped", func() {
+				It("maps the routes back to the old app", func() {
+					state.Phase = BlueGreenSwapping
+					_, err := actor.RollbackBlueGreenPush(state)
+					Expect(err).ToNot(HaveOccurred())
+
+					routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("some-route-guid"))
+					Expect(appGUID).To(Equal("some-old-app-guid"))
+					routeGUID, appGUID = fakeV2Actor.UnmapRouteFromApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("some-route-guid"))
+					Expect(appGUID).To(Equal("some-new-app-guid"))
+				})
+			})
+
+			Context("when the push was interrupted before the new app GUID was recorded", func() {
+				It("looks up the new app by name", func() {
+					state.NewAppGUID = ""
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-found-guid"}, nil, nil)
+					_, err := actor.RollbackBlueGreenPush(state)
+					Expect(err).ToNot(HaveOccurred())
+
+					name, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(fakeV2Actor.GetApplicationByNameAndSpaceCallCount() - 1)
+					Expect(name).To(Equal("some-app-new"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("some-found-guid"))
+				})
+			})
+
+			Context("when deleting the new app fails", func() {
+				It("returns the error and keeps the state", func() {
+					fakeV2Actor.DeleteApplicationReturns(nil, errors.New("some-delete-error"))
+					_, err := actor.RollbackBlueGreenPush(state)
+					Expect(err).To(MatchError("some-delete-error"))
+
+					_, found := loadState()
+					Expect(found).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("LoadBlueGreenState", func() {
+		Context("when there is no state", func() {
+			It("returns not found", func() {
+				_, found := loadState()
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the state is corrupt", func() {
+			It("returns an error", func() {
+				Expect(ioutil.WriteFile(filepath.Join(stateDir, "some-space-guid_some-app.json"), []byte("{"), 0600)).To(Succeed())
+				_, _, err := actor.LoadBlueGreenState(stateDir, "some-space-guid", "some-app")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -38,6 +38,24 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	CheckQuotaHeadroomStub        func(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
+	checkQuotaHeadroomMutex       sync.RWMutex
+	checkQuotaHeadroomArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		requests  []v2action.QuotaRequest
+	}
+	checkQuotaHeadroomReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	checkQuotaHeadroomReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	ClearResourceMatchCacheStub          func()
+	clearResourceMatchCacheMutex         sync.RWMutex
+	clearResourceMatchCacheArgsForCall   []struct{}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -78,6 +96,32 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(guid string) (v2action.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteRouteStub        func(routeGUID string) (v2action.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		routeGUID string
+	}
+	deleteRouteReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	FindRouteBoundToSpaceWithSettingsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	findRouteBoundToSpaceWithSettingsMutex       sync.RWMutex
 	findRouteBoundToSpaceWithSettingsArgsForCall []struct {
@@ -418,6 +462,80 @@ func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error) {
+	var requestsCopy []v2action.QuotaRequest
+	if requests != nil {
+		requestsCopy = make([]v2action.QuotaRequest, len(requests))
+		copy(requestsCopy, requests)
+	}
+	fake.checkQuotaHeadroomMutex.Lock()
+	ret, specificReturn := fake.checkQuotaHeadroomReturnsOnCall[len(fake.checkQuotaHeadroomArgsForCall)]
+	fake.checkQuotaHeadroomArgsForCall = append(fake.checkQuotaHeadroomArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		requests  []v2action.QuotaRequest
+	}{orgGUID, spaceGUID, requestsCopy})
+	fake.recordInvocation("CheckQuotaHeadroom", []interface{}{orgGUID, spaceGUID, requestsCopy})
+	fake.checkQuotaHeadroomMutex.Unlock()
+	if fake.CheckQuotaHeadroomStub != nil {
+		return fake.CheckQuotaHeadroomStub(orgGUID, spaceGUID, requests)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.checkQuotaHeadroomReturns.result1, fake.checkQuotaHeadroomReturns.result2
+}
+
+func (fake *FakeV2Actor) CheckQuotaHeadroomCallCount() int {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return len(fake.checkQuotaHeadroomArgsForCall)
+}
+
+func (fake *FakeV2Actor) CheckQuotaHeadroomArgsForCall(i int) (string, string, []v2action.QuotaRequest) {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return fake.checkQuotaHeadroomArgsForCall[i].orgGUID, fake.checkQuotaHeadroomArgsForCall[i].spaceGUID, fake.checkQuotaHeadroomArgsForCall[i].requests
+}
+
+func (fake *FakeV2Actor) CheckQuotaHeadroomReturns(result1 v2action.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	fake.checkQuotaHeadroomReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) CheckQuotaHeadroomReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	if fake.checkQuotaHeadroomReturnsOnCall == nil {
+		fake.checkQuotaHeadroomReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.checkQuotaHeadroomReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) ClearResourceMatchCache() {
+	fake.clearResourceMatchCacheMutex.Lock()
+	fake.clearResourceMatchCacheArgsForCall = append(fake.clearResourceMatchCacheArgsForCall, struct{}{})
+	fake.recordInvocation("ClearResourceMatchCache", []interface{}{})
+	fake.clearResourceMatchCacheMutex.Unlock()
+	if fake.ClearResourceMatchCacheStub != nil {
+		fake.ClearResourceMatchCacheStub()
+	}
+}
+
+func (fake *FakeV2Actor) ClearResourceMatchCacheCallCount() int {
+	fake.clearResourceMatchCacheMutex.RLock()
+	defer fake.clearResourceMatchCacheMutex.RUnlock()
+	return len(fake.clearResourceMatchCacheArgsForCall)
+}
+
 func (fake *FakeV2Actor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -567,6 +685,108 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) DeleteApplication(guid string) (v2action.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) DeleteApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteRoute(routeGUID string) (v2action.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
 	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
 	ret, specificReturn := fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)]
@@ -1405,12 +1625,20 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.mapRouteToApplicationMutex.RUnlock()
 	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
 	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	fake.clearResourceMatchCacheMutex.RLock()
+	defer fake.clearResourceMatchCacheMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
 	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
 	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
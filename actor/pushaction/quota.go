@@ -0,0 +1,35 @@
+package pushaction
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+// CheckQuotaHeadroom returns a QuotaExceededError when starting the apps
+// described by configs would exceed a limit of the org or space quota. New
+// apps without a memory setting are checked with the Cloud Controller's
+// default app memory.
+func (actor Actor) CheckQuotaHeadroom(orgGUID string, spaceGUID string, configs []ApplicationConfig) (Warnings, error) {
+	var requests []v2action.QuotaRequest
+	for _, config := range configs {
+		instances := 1
+		if config.DesiredApplication.Instances.IsSet {
+			instances = config.DesiredApplication.Instances.Value
+		}
+
+		memory := config.DesiredApplication.Memory.Value
+		if !config.DesiredApplication.Memory.IsSet {
+			if config.CreatingApplication() {
+				memory = v2action.DefaultApplicationMemoryInMB
+			} else {
+				memory = config.CurrentApplication.Memory.Value
+			}
+		}
+
+		requests = append(requests, v2action.QuotaRequest{
+			ApplicationGUID: config.CurrentApplication.GUID,
+			Instances:       instances,
+			MemoryInMB:      memory,
+		})
+	}
+
+	warnings, err := actor.V2Actor.CheckQuotaHeadroom(orgGUID, spaceGUID, requests)
+	return Warnings(warnings), err
+}
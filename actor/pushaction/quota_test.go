@@ -0,0 +1,87 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		actor, fakeV2Actor, _, _ = getTestPushActor()
+	})
+
+	Describe("CheckQuotaHeadroom", func() {
+		var (
+			configs    []ApplicationConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			existingApp := ApplicationConfig{}
+			existingApp.CurrentApplication.GUID = "some-app-guid"
+			existingApp.DesiredApplication.Instances = types.NullInt{IsSet: true, Value: 3}
+			existingApp.DesiredApplication.Memory = types.NullByteSizeInMb{IsSet: true, Value: 256}
+
+			newApp := ApplicationConfig{}
+			newApp.DesiredApplication.Name = "some-new-app"
+
+			configs = []ApplicationConfig{existingApp, newApp}
+
+			fakeV2Actor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CheckQuotaHeadroom("some-org-guid", "some-space-guid", configs)
+		})
+
+		It("checks the desired instances and memory of every app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("quota-warning"))
+
+			Expect(fakeV2Actor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+			orgGUID, spaceGUID, requests := fakeV2Actor.CheckQuotaHeadroomArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(requests).To(Equal([]v2action.QuotaRequest{
+				{ApplicationGUID: "some-app-guid", Instances: 3, MemoryInMB: 256},
+				{Instances: 1, MemoryInMB: v2action.DefaultApplicationMemoryInMB},
+			}))
+		})
+
+		Context("when an existing app has no memory setting", func() {
+			BeforeEach(func() {
+				configs[0].DesiredApplication.Memory = types.NullByteSizeInMb{}
+				configs[0].CurrentApplication.Memory = types.NullByteSizeInMb{IsSet: true, Value: 512}
+			})
+
+			It("checks the current memory of the app", func() {
+				_, _, requests := fakeV2Actor.CheckQuotaHeadroomArgsForCall(0)
+				Expect(requests[0].MemoryInMB).To(Equal(uint64(512)))
+			})
+		})
+
+		Context("when the check fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, errors.New("quota-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("quota-error"))
+				Expect(warnings).To(ConsistOf("quota-warning"))
+			})
+		})
+	})
+})
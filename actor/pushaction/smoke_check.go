@@ -0,0 +1,120 @@
+package pushaction
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	log "github.com/sirupsen/logrus"
+)
+
+// SmokeTest describes how the new version of an app is checked during a
+// blue-green push. When Command is set it is run through the shell with
+// APP_URL and APP_NAME set in its environment; otherwise HTTPPath is polled
+// on the temporary route until it returns a 2xx status.
+type SmokeTest struct {
+	Command           string
+	HTTPPath          string
+	Timeout           time.Duration
+	SkipSSLValidation bool
+	Output            io.Writer
+}
+
+const smokeTestPollInterval = time.Second
+
+// RunSmokeTest runs the smoke test against the new version of the app on its
+// temporary route.
+func (Actor) RunSmokeTest(state BlueGreenState, smokeTest SmokeTest) error {
+	log.WithField("app", state.NewAppName).Info("running smoke test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), smokeTest.Timeout)
+	defer cancel()
+
+	if smokeTest.Command != "" {
+		return runSmokeTestCommand(ctx, state, smokeTest)
+	}
+	return pollSmokeTestURL(ctx, state, smokeTest)
+}
+
+func runSmokeTestCommand(ctx context.Context, state BlueGreenState, smokeTest SmokeTest) error {
+	var command *exec.Cmd
+	if runtime.GOOS == "windows" {
+		command = exec.CommandContext(ctx, "cmd", "/C", smokeTest.Command)
+	} else {
+		command = exec.CommandContext(ctx, "sh", "-c", smokeTest.Command)
+	}
+	command.Env = append(os.Environ(), "APP_URL="+state.TempURL, "APP_NAME="+state.NewAppName)
+
+	output := smokeTest.Output
+	if output == nil {
+		output = ioutil.Discard
+	}
+	command.Stdout = output
+	command.Stderr = output
+
+	err := command.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return actionerror.SmokeTestFailedError{
+			AppName: state.NewAppName,
+			Reason:  fmt.Sprintf("command did not finish within %s", smokeTest.Timeout),
+		}
+	}
+	if err != nil {
+		return actionerror.SmokeTestFailedError{
+			AppName: state.NewAppName,
+			Reason:  fmt.Sprintf("command '%s' failed: %s", smokeTest.Command, err),
+		}
+	}
+	return nil
+}
+
+func pollSmokeTestURL(ctx context.Context, state BlueGreenState, smokeTest SmokeTest) error {
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: smokeTest.SkipSSLValidation,
+			},
+		},
+	}
+
+	url := strings.TrimSuffix(state.TempURL, "/") + "/" + strings.TrimPrefix(smokeTest.HTTPPath, "/")
+
+	var lastFailure string
+	for {
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Do(request.WithContext(ctx))
+		if err == nil {
+			response.Body.Close()
+			if response.StatusCode >= 200 && response.StatusCode < 300 {
+				return nil
+			}
+			lastFailure = fmt.Sprintf("GET %s returned %s", url, response.Status)
+		} else {
+			lastFailure = fmt.Sprintf("GET %s failed: %s", url, err)
+		}
+		log.WithField("url", url).Debug(lastFailure)
+
+		select {
+		case <-ctx.Done():
+			return actionerror.SmokeTestFailedError{
+				AppName: state.NewAppName,
+				Reason:  fmt.Sprintf("%s (gave up after %s)", lastFailure, smokeTest.Timeout),
+			}
+		case <-time.After(smokeTestPollInterval):
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package pushaction_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Smoke Test Actions", func() {
+	var (
+		actor *Actor
+		state BlueGreenState
+	)
+
+	BeforeEach(func() {
+		actor, _, _, _ = getTestPushActor()
+	})
+
+	Describe("RunSmokeTest", func() {
+		Context("when checking an HTTP path", func() {
+			var (
+				server   *httptest.Server
+				requests int
+			)
+
+			BeforeEach(func() {
+				requests = 0
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					switch {
+					case r.URL.Path == "/health" && requests > 1:
+						w.WriteHeader(http.StatusOK)
+					default:
+						w.WriteHeader(http.StatusServiceUnavailable)
+					}
+				}))
+				state = BlueGreenState{NewAppName: "some-app-new", TempURL: server.URL}
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("polls until the path returns a 2xx status", func() {
+				err := actor.RunSmokeTest(state, SmokeTest{HTTPPath: "/health", Timeout: 5 * time.Second})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(requests).To(Equal(2))
+			})
+
+			Context("when the path never succeeds", func() {
+				It("returns a SmokeTestFailedError", func() {
+					err := actor.RunSmokeTest(state, SmokeTest{HTTPPath: "/broken", Timeout: 1500 * time.Millisecond})
+					Expect(err).To(BeAssignableToTypeOf(actionerror.SmokeTestFailedError{}))
+					Expect(err.Error()).To(ContainSubstring("503 Service Unavailable"))
+				})
+			})
+		})
+	})
+})
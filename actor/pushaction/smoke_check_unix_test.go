@@ -0,0 +1,58 @@
+// +build !windows
+
+package pushaction_test
+
+import (
+	"bytes"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Smoke Test Actions", func() {
+	var (
+		actor  *Actor
+		state  BlueGreenState
+		output *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		actor, _, _, _ = getTestPushActor()
+		state = BlueGreenState{NewAppName: "some-app-new", TempURL: "http://some-app-new.some-domain.com"}
+		output = new(bytes.Buffer)
+	})
+
+	Describe("RunSmokeTest", func() {
+		Context("when running a command", func() {
+			It("runs the command with the app URL and name in its environment", func() {
+				err := actor.RunSmokeTest(state, SmokeTest{Command: `echo "$APP_NAME $APP_URL"`, Timeout: 5 * time.Second, Output: output})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output.String()).To(Equal("some-app-new http://some-app-new.some-domain.com\n"))
+			})
+
+			Context("when the command fails", func() {
+				It("returns a SmokeTestFailedError", func() {
+					err := actor.RunSmokeTest(state, SmokeTest{Command: "exit 3", Timeout: 5 * time.Second})
+					Expect(err).To(MatchError(actionerror.SmokeTestFailedError{
+						AppName: "some-app-new",
+						Reason:  "command 'exit 3' failed: exit status 3",
+					}))
+				})
+			})
+
+			Context("when the command times out", func() {
+				It("returns a SmokeTestFailedError", func() {
+					err := actor.RunSmokeTest(state, SmokeTest{Command: "exec sleep 5", Timeout: 100 * time.Millisecond})
+					Expect(err).To(MatchError(actionerror.SmokeTestFailedError{
+						AppName: "some-app-new",
+						Reason:  "command did not finish within 100ms",
+					}))
+				})
+			})
+		})
+	})
+})
@@ -11,9 +11,13 @@ import (
 type V2Actor interface {
 	MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
 	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
+	ClearResourceMatchCache()
 	CloudControllerAPIVersion() string
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	DeleteApplication(guid string) (v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
 	FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
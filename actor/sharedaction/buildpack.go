@@ -0,0 +1,367 @@
+package sharedaction
+
+import (
+	"archive/zip"
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/cfignore"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const buildpackManifestName = "manifest.yml"
+
+// buildpackIgnoreLines are the paths left out of a packaged buildpack. Unlike
+// DefaultIgnoreLines they keep manifest.yml, which the buildpack reads while
+// staging.
+var buildpackIgnoreLines = []string{
+	".DS_Store",
+	".git",
+	".gitignore",
+	".hg",
+	".svn",
+	"_darcs",
+}
+
+// requiredBuildpackExecutables are the executables the stager runs. Any
+// executable of a group satisfies that group.
+var requiredBuildpackExecutables = [][]string{
+	{"bin/detect"},
+	{"bin/compile", "bin/supply"},
+	{"bin/release"},
+}
+
+// BuildpackDependency is a dependency listed in a buildpack's manifest.yml.
+type BuildpackDependency struct {
+	Name    string   `yaml:"name"`
+	Version string   `yaml:"version"`
+	URI     string   `yaml:"uri"`
+	SHA256  string   `yaml:"sha256"`
+	Stacks  []string `yaml:"cf_stacks"`
+}
+
+// BuildpackManifest is the manifest.yml of a buildpack.
+type BuildpackManifest struct {
+	Language     string                `yaml:"language"`
+	Dependencies []BuildpackDependency `yaml:"dependencies"`
+	IncludeFiles []string              `yaml:"include_files"`
+}
+
+// BuildpackPackage describes a buildpack zip created by PackageBuildpack.
+type BuildpackPackage struct {
+	Path string
+	Size int64
+
+	// Language is the language from the buildpack's manifest.yml, or empty
+	// when the buildpack has no manifest.
+	Language string
+
+	// EmbeddedDependencies are the dependencies that were copied into the zip.
+	EmbeddedDependencies []BuildpackDependency
+
+	// RemoteDependencies are the dependencies that were not embedded because
+	// their URI is not a local path.
+	RemoteDependencies []BuildpackDependency
+}
+
+// PackageBuildpack validates that sourceDir contains a buildpack and zips it
+// to outputPath. When includeDependencies is true, the dependencies listed in
+// the buildpack's manifest.yml with a local URI are embedded in the zip.
+func (actor Actor) PackageBuildpack(sourceDir string, outputPath string, includeDependencies bool) (BuildpackPackage, error) {
+	err := validateBuildpackExecutables(sourceDir)
+	if err != nil {
+		return BuildpackPackage{}, err
+	}
+
+	manifest, err := readBuildpackManifest(sourceDir)
+	if err != nil {
+		return BuildpackPackage{}, err
+	}
+
+	pkg := BuildpackPackage{
+		Path:     outputPath,
+		Language: manifest.Language,
+	}
+
+	var localPaths []string
+	if includeDependencies {
+		for _, dependency := range manifest.Dependencies {
+			localPath, isLocal := dependency.localPath(sourceDir)
+			if !isLocal {
+				pkg.RemoteDependencies = append(pkg.RemoteDependencies, dependency)
+				continue
+			}
+
+			if dependency.SHA256 != "" {
+				checksum, err := ParseChecksum("sha256:" + dependency.SHA256)
+				if err != nil {
+					return BuildpackPackage{}, err
+				}
+
+				err = checksum.Verify(localPath, dependency.URI)
+				if err != nil {
+					return BuildpackPackage{}, err
+				}
+			}
+
+			localPaths = append(localPaths, localPath)
+			pkg.EmbeddedDependencies = append(pkg.EmbeddedDependencies, dependency)
+		}
+	}
+
+	resources, err := actor.gatherBuildpackResources(sourceDir, outputPath)
+	if err != nil {
+		return BuildpackPackage{}, err
+	}
+
+	err = actor.writeBuildpackZip(sourceDir, resources, pkg.EmbeddedDependencies, localPaths, outputPath)
+	if err != nil {
+		return BuildpackPackage{}, err
+	}
+
+	info, err := os.Stat(outputPath)
+	if err != nil {
+		return BuildpackPackage{}, err
+	}
+	pkg.Size = info.Size()
+
+	return pkg, nil
+}
+
+// localPath returns the path of the dependency on disk, or false when its URI
+// points to a remote location. Relative paths are relative to sourceDir.
+func (dependency BuildpackDependency) localPath(sourceDir string) (string, bool) {
+	switch {
+	case strings.HasPrefix(dependency.URI, "file://"):
+		return filepath.FromSlash(strings.TrimPrefix(dependency.URI, "file://")), true
+	case strings.Contains(dependency.URI, "://"):
+		return "", false
+	case filepath.IsAbs(dependency.URI):
+		return dependency.URI, true
+	default:
+		return filepath.Join(sourceDir, filepath.FromSlash(dependency.URI)), true
+	}
+}
+
+// zipPath returns where the dependency is stored in the zip. This is the
+// location buildpacks look up cached dependencies in.
+func (dependency BuildpackDependency) zipPath() string {
+	return path.Join(
+		"dependencies",
+		fmt.Sprintf("%x", md5.Sum([]byte(dependency.URI))),
+		path.Base(filepath.ToSlash(dependency.URI)),
+	)
+}
+
+func validateBuildpackExecutables(sourceDir string) error {
+	for _, executables := range requiredBuildpackExecutables {
+		found := false
+		for _, executable := range executables {
+			info, err := os.Stat(filepath.Join(sourceDir, filepath.FromSlash(executable)))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				continue
+			}
+
+			if fixMode(info.Mode())&0100 == 0 {
+				return actionerror.BuildpackExecutableModeError{Executable: executable, Mode: info.Mode()}
+			}
+			found = true
+		}
+
+		if !found {
+			return actionerror.BuildpackExecutableMissingError{Executables: executables}
+		}
+	}
+
+	return nil
+}
+
+// readBuildpackManifest reads and validates the manifest.yml of the
+// buildpack. An empty manifest is returned when the buildpack has none.
+func readBuildpackManifest(sourceDir string) (BuildpackManifest, error) {
+	var manifest BuildpackManifest
+
+	raw, err := ioutil.ReadFile(filepath.Join(sourceDir, buildpackManifestName))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return manifest, err
+	}
+
+	invalid := func(reason string, args ...interface{}) error {
+		return actionerror.InvalidBuildpackManifestError{Path: buildpackManifestName, Reason: fmt.Sprintf(reason, args...)}
+	}
+
+	err = yaml.Unmarshal(raw, &manifest)
+	if err != nil {
+		return manifest, invalid("%s", err)
+	}
+
+	if manifest.Language == "" {
+		return manifest, invalid("language is required")
+	}
+
+	for i, dependency := range manifest.Dependencies {
+		switch {
+		case dependency.Name == "":
+			return manifest, invalid("dependency %d is missing a name", i+1)
+		case dependency.Version == "":
+			return manifest, invalid("dependency %s is missing a version", dependency.Name)
+		case dependency.URI == "":
+			return manifest, invalid("dependency %s %s is missing a uri", dependency.Name, dependency.Version)
+		}
+
+		if dependency.SHA256 != "" {
+			if _, err := ParseChecksum("sha256:" + dependency.SHA256); err != nil {
+				return manifest, invalid("dependency %s %s has an invalid sha256", dependency.Name, dependency.Version)
+			}
+		}
+	}
+
+	for _, includeFile := range manifest.IncludeFiles {
+		_, err := os.Stat(filepath.Join(sourceDir, filepath.FromSlash(includeFile)))
+		if os.IsNotExist(err) {
+			return manifest, invalid("include_files entry %s does not exist", includeFile)
+		}
+		if err != nil {
+			return manifest, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// gatherBuildpackResources returns the resources of the buildpack directory,
+// leaving out outputPath when it is inside the directory.
+func (actor Actor) gatherBuildpackResources(sourceDir string, outputPath string) ([]Resource, error) {
+	matcher, err := cfignore.NewMatcher(buildpackIgnoreLines...)
+	if err != nil {
+		return nil, err
+	}
+
+	absOutputPath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	walkErr := filepath.Walk(sourceDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(sourceDir, fullPath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		if absPath, err := filepath.Abs(fullPath); err == nil && absPath == absOutputPath {
+			return nil
+		}
+
+		if ignored, _ := matcher.Match(filepath.ToSlash(relPath), info.IsDir()); ignored {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		resource := Resource{
+			Filename: filepath.ToSlash(relPath),
+		}
+
+		switch {
+		case info.IsDir():
+			resource.Mode = DefaultFolderPermissions
+		case info.Mode()&os.ModeSymlink == os.ModeSymlink:
+			resource.Mode = fixMode(info.Mode())
+		default:
+			sha, err := actor.fileSHA1(fullPath, info, nil)
+			if err != nil {
+				return err
+			}
+
+			resource.Mode = fixMode(info.Mode())
+			resource.SHA1 = sha
+			resource.Size = info.Size()
+		}
+
+		resources = append(resources, resource)
+		return nil
+	})
+
+	return resources, walkErr
+}
+
+// writeBuildpackZip zips the buildpack resources and the embedded
+// dependencies, read from localPaths, to outputPath.
+func (actor Actor) writeBuildpackZip(sourceDir string, resources []Resource, dependencies []BuildpackDependency, localPaths []string, outputPath string) error {
+	log.WithField("outputPath", outputPath).Info("zipping buildpack")
+	zipFile, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+
+	writer := zip.NewWriter(zipFile)
+	err = actor.addDirectoryResourcesToZip(sourceDir, resources, writer)
+	for i := 0; i < len(dependencies) && err == nil; i++ {
+		err = actor.addDependencyToZip(localPaths[i], dependencies[i], writer)
+	}
+
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := zipFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(outputPath)
+		return err
+	}
+	return nil
+}
+
+func (actor Actor) addDependencyToZip(localPath string, dependency BuildpackDependency, writer *zip.Writer) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	sha, err := actor.fileSHA1(localPath, info, nil)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	resource := Resource{
+		Filename: dependency.zipPath(),
+		Mode:     fixMode(info.Mode()),
+		SHA1:     sha,
+		Size:     info.Size(),
+	}
+
+	return actor.addFileToZipFromFileSystem(localPath, file, info, resource, writer)
+}
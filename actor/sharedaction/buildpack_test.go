@@ -0,0 +1,225 @@
+package sharedaction_test
+
+import (
+	"archive/zip"
+	"crypto/md5"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor      *Actor
+		srcDir     string
+		outputDir  string
+		outputPath string
+	)
+
+	writeFile := func(relPath string, contents string, mode os.FileMode) {
+		fullPath := filepath.Join(srcDir, filepath.FromSlash(relPath))
+		Expect(os.MkdirAll(filepath.Dir(fullPath), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(fullPath, []byte(contents), mode)).To(Succeed())
+	}
+
+	zipContents := func(zipPath string) map[string]string {
+		reader, err := zip.OpenReader(zipPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		contents := map[string]string{}
+		for _, file := range reader.File {
+			if file.FileInfo().IsDir() {
+				continue
+			}
+			fileReader, err := file.Open()
+			Expect(err).ToNot(HaveOccurred())
+			raw, err := ioutil.ReadAll(fileReader)
+			Expect(err).ToNot(HaveOccurred())
+			fileReader.Close()
+			contents[file.Name] = string(raw)
+		}
+		return contents
+	}
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		var err error
+		srcDir, err = ioutil.TempDir("", "buildpack-actions-test")
+		Expect(err).ToNot(HaveOccurred())
+		outputDir, err = ioutil.TempDir("", "buildpack-actions-output")
+		Expect(err).ToNot(HaveOccurred())
+		outputPath = filepath.Join(outputDir, "buildpack.zip")
+
+		writeFile("bin/detect", "detect", 0755)
+		writeFile("bin/compile", "compile", 0755)
+		writeFile("bin/release", "release", 0755)
+		writeFile("lib/helper.sh", "helper", 0644)
+		writeFile(".git/HEAD", "ref", 0644)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+		Expect(os.RemoveAll(outputDir)).To(Succeed())
+	})
+
+	Describe("PackageBuildpack", func() {
+		var (
+			includeDependencies bool
+			pkg                 BuildpackPackage
+			executeErr          error
+		)
+
+		BeforeEach(func() {
+			includeDependencies = false
+		})
+
+		JustBeforeEach(func() {
+			pkg, executeErr = actor.PackageBuildpack(srcDir, outputPath, includeDependencies)
+		})
+
+		Context("when the buildpack has no manifest", func() {
+			It("zips the buildpack without the VCS files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pkg.Path).To(Equal(outputPath))
+				Expect(pkg.Language).To(BeEmpty())
+
+				info, err := os.Stat(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pkg.Size).To(Equal(info.Size()))
+
+				Expect(zipContents(outputPath)).To(Equal(map[string]string{
+					"bin/detect":    "detect",
+					"bin/compile":   "compile",
+					"bin/release":   "release",
+					"lib/helper.sh": "helper",
+				}))
+			})
+		})
+
+		Context("when the buildpack uses bin/supply instead of bin/compile", func() {
+			BeforeEach(func() {
+				Expect(os.Remove(filepath.Join(srcDir, "bin", "compile"))).To(Succeed())
+				writeFile("bin/supply", "supply", 0755)
+			})
+
+			It("zips the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(zipContents(outputPath)).To(HaveKey("bin/supply"))
+			})
+		})
+
+		Context("when a required executable is missing", func() {
+			BeforeEach(func() {
+				Expect(os.Remove(filepath.Join(srcDir, "bin", "compile"))).To(Succeed())
+			})
+
+			It("returns a BuildpackExecutableMissingError without creating the zip", func() {
+				Expect(executeErr).To(MatchError(actionerror.BuildpackExecutableMissingError{
+					Executables: []string{"bin/compile", "bin/supply"},
+				}))
+				_, err := os.Stat(outputPath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the output path is inside the buildpack", func() {
+			BeforeEach(func() {
+				outputPath = filepath.Join(srcDir, "buildpack.zip")
+				writeFile("buildpack.zip", "previous package", 0644)
+			})
+
+			It("leaves the output out of the zip", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(zipContents(outputPath)).ToNot(HaveKey("buildpack.zip"))
+			})
+		})
+
+		Context("when the buildpack has a manifest", func() {
+			var dependencySHA string
+
+			BeforeEach(func() {
+				writeFile("deps/ruby-2.4.1.tgz", "ruby", 0644)
+				dependencySHA = fmt.Sprintf("%x", sha256.Sum256([]byte("ruby")))
+
+				writeFile("manifest.yml", `---
+language: ruby
+include_files:
+- bin/detect
+dependencies:
+- name: ruby
+  version: 2.4.1
+  uri: deps/ruby-2.4.1.tgz
+  sha256: `+dependencySHA+`
+- name: bundler
+  version: 1.15.1
+  uri: https://example.com/bundler-1.15.1.tgz
+`, 0644)
+			})
+
+			It("keeps the manifest and returns the language", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pkg.Language).To(Equal("ruby"))
+				Expect(pkg.EmbeddedDependencies).To(BeEmpty())
+				Expect(zipContents(outputPath)).To(HaveKey("manifest.yml"))
+			})
+
+			Context("when dependencies are included", func() {
+				BeforeEach(func() {
+					includeDependencies = true
+				})
+
+				It("embeds the local dependencies where buildpacks look them up", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(pkg.EmbeddedDependencies).To(ConsistOf(
+						BuildpackDependency{Name: "ruby", Version: "2.4.1", URI: "deps/ruby-2.4.1.tgz", SHA256: dependencySHA},
+					))
+					Expect(pkg.RemoteDependencies).To(ConsistOf(
+						BuildpackDependency{Name: "bundler", Version: "1.15.1", URI: "https://example.com/bundler-1.15.1.tgz"},
+					))
+
+					dependencyPath := fmt.Sprintf("dependencies/%x/ruby-2.4.1.tgz", md5.Sum([]byte("deps/ruby-2.4.1.tgz")))
+					Expect(zipContents(outputPath)).To(HaveKeyWithValue(dependencyPath, "ruby"))
+				})
+
+				Context("when a local dependency does not match its sha256", func() {
+					BeforeEach(func() {
+						writeFile("deps/ruby-2.4.1.tgz", "tampered", 0644)
+					})
+
+					It("returns a ChecksumMismatchError", func() {
+						Expect(executeErr).To(MatchError(actionerror.ChecksumMismatchError{
+							Source:   "deps/ruby-2.4.1.tgz",
+							Expected: dependencySHA,
+							Actual:   fmt.Sprintf("%x", sha256.Sum256([]byte("tampered"))),
+						}))
+					})
+				})
+			})
+		})
+
+		DescribeTable("when the manifest is invalid",
+			func(manifest string, reason string) {
+				writeFile("manifest.yml", manifest, 0644)
+				_, err := actor.PackageBuildpack(srcDir, outputPath, false)
+				Expect(err).To(MatchError(actionerror.InvalidBuildpackManifestError{Path: "manifest.yml", Reason: reason}))
+			},
+			Entry("no language", "dependencies: []\n", "language is required"),
+			Entry("dependency without a name", "language: ruby\ndependencies:\n- version: 1.0.0\n", "dependency 1 is missing a name"),
+			Entry("dependency without a version", "language: ruby\ndependencies:\n- name: ruby\n", "dependency ruby is missing a version"),
+			Entry("dependency without a uri", "language: ruby\ndependencies:\n- name: ruby\n  version: 1.0.0\n", "dependency ruby 1.0.0 is missing a uri"),
+			Entry("invalid sha256", "language: ruby\ndependencies:\n- name: ruby\n  version: 1.0.0\n  uri: ruby.tgz\n  sha256: nope\n", "dependency ruby 1.0.0 has an invalid sha256"),
+			Entry("missing include file", "language: ruby\ninclude_files:\n- VERSION\n", "include_files entry VERSION does not exist"),
+		)
+	})
+})
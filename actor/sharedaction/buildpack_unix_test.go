@@ -0,0 +1,52 @@
+// +build !windows
+
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor  *Actor
+		srcDir string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		var err error
+		srcDir, err = ioutil.TempDir("", "buildpack-actions-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.Mkdir(filepath.Join(srcDir, "bin"), 0755)).To(Succeed())
+		for _, executable := range []string{"detect", "compile", "release"} {
+			Expect(ioutil.WriteFile(filepath.Join(srcDir, "bin", executable), nil, 0755)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+	})
+
+	Describe("PackageBuildpack", func() {
+		Context("when an executable is not executable", func() {
+			BeforeEach(func() {
+				Expect(os.Chmod(filepath.Join(srcDir, "bin", "release"), 0644)).To(Succeed())
+			})
+
+			It("returns a BuildpackExecutableModeError", func() {
+				_, err := actor.PackageBuildpack(srcDir, filepath.Join(srcDir, "buildpack.zip"), false)
+				Expect(err).To(MatchError(actionerror.BuildpackExecutableModeError{Executable: "bin/release", Mode: 0644}))
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package sharedaction
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// Checksum is a digest that a downloaded or uploaded file is expected to
+// match.
+type Checksum struct {
+	newHash func() hash.Hash
+	digest  string
+}
+
+// ParseChecksum parses a hex SHA1 or SHA256 digest, optionally prefixed with
+// 'sha1:' or 'sha256:'.
+func ParseChecksum(checksum string) (Checksum, error) {
+	algorithm, digest := "", strings.ToLower(checksum)
+	if i := strings.Index(digest, ":"); i >= 0 {
+		algorithm, digest = digest[:i], digest[i+1:]
+	}
+
+	if _, err := hex.DecodeString(digest); err == nil {
+		switch {
+		case (algorithm == "" || algorithm == "sha256") && len(digest) == sha256.Size*2:
+			return Checksum{newHash: sha256.New, digest: digest}, nil
+		case (algorithm == "" || algorithm == "sha1") && len(digest) == sha1.Size*2:
+			return Checksum{newHash: sha1.New, digest: digest}, nil
+		}
+	}
+
+	return Checksum{}, actionerror.UnsupportedChecksumError{Checksum: checksum}
+}
+
+// Verify returns a ChecksumMismatchError when the file at path does not match
+// the checksum. source is used to name the file in the error.
+func (checksum Checksum) Verify(path string, source string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	sum := checksum.newHash()
+	_, err = io.Copy(sum, file)
+	if err != nil {
+		return err
+	}
+
+	actual := fmt.Sprintf("%x", sum.Sum(nil))
+	if actual != checksum.digest {
+		return actionerror.ChecksumMismatchError{Source: source, Expected: checksum.digest, Actual: actual}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checksum", func() {
+	const (
+		contentSHA1   = "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed"
+		contentSHA256 = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+		otherSHA256   = "0000000000000000000000000000000000000000000000000000000000000000"
+	)
+
+	var (
+		tmpDir   string
+		filePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "checksum-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		filePath = filepath.Join(tmpDir, "some-file")
+		Expect(ioutil.WriteFile(filePath, []byte("hello world"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	DescribeTable("when the file matches the checksum",
+		func(rawChecksum string) {
+			checksum, err := ParseChecksum(rawChecksum)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checksum.Verify(filePath, "some-source")).To(Succeed())
+		},
+
+		Entry("SHA256", contentSHA256),
+		Entry("prefixed SHA256", "sha256:"+contentSHA256),
+		Entry("SHA1", contentSHA1),
+		Entry("prefixed uppercase SHA1", "SHA1:2AAE6C35C94FCFB415DBE95F408B9CE91EE846ED"),
+	)
+
+	Context("when the file does not match the checksum", func() {
+		It("returns a ChecksumMismatchError", func() {
+			checksum, err := ParseChecksum("sha256:" + otherSHA256)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checksum.Verify(filePath, "some-source")).To(MatchError(actionerror.ChecksumMismatchError{
+				Source:   "some-source",
+				Expected: otherSHA256,
+				Actual:   contentSHA256,
+			}))
+		})
+	})
+
+	DescribeTable("when the checksum is not supported",
+		func(rawChecksum string) {
+			_, err := ParseChecksum(rawChecksum)
+			Expect(err).To(MatchError(actionerror.UnsupportedChecksumError{Checksum: rawChecksum}))
+		},
+
+		Entry("unknown algorithm", "md5:5eb63bbbe01eeed093cb22bb8f5acdc3"),
+		Entry("SHA1 digest with a SHA256 prefix", "sha256:"+contentSHA1),
+		Entry("not hex", "sha1:"+"zzae6c35c94fcfb415dbe95f408b9ce91ee846ed"),
+	)
+})
@@ -7,12 +7,15 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/cfignore"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 	"code.cloudfoundry.org/ykk"
-	ignore "github.com/sabhiram/go-gitignore"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -56,7 +59,7 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 		return nil, err
 	}
 
-	gitIgnore, err := actor.generateArchiveCFIgnoreMatcher(reader.File)
+	matcher, err := actor.generateArchiveCFIgnoreMatcher(reader.File)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
 		return nil, err
@@ -64,7 +67,7 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 	for _, archivedFile := range reader.File {
 		filename := filepath.ToSlash(archivedFile.Name)
-		if gitIgnore.MatchesPath(filename) {
+		if ignored, _ := matcher.Match(filename, archivedFile.FileInfo().IsDir()); ignored {
 			continue
 		}
 
@@ -100,41 +103,33 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
-// GatherDirectoryResources returns a list of resources for a directory.
+// IgnoreStatus describes whether a path of an application directory is
+// uploaded, and the .cfignore rule that decided it.
+type IgnoreStatus struct {
+	Filename string
+	IsDir    bool
+	Ignored  bool
+
+	// Rule is the rule that matched the path, or empty if no rule did.
+	Rule string
+}
+
+// GatherDirectoryResources returns a list of resources for a directory. The
+// SHA1s of files that have not changed since a previous push are read from
+// the resource cache instead of being recomputed.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
 	var (
-		resources []Resource
-		gitIgnore *ignore.GitIgnore
+		resources  []Resource
+		fileHashes *resourcecache.FileHashes
 	)
 
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
-	if err != nil {
-		log.Errorln("reading .cfignore file:", err)
-		return nil, err
+	if cacheDir := actor.Config.ResourceCacheDir(); cacheDir != "" {
+		fileHashes = resourcecache.LoadFileHashes(cacheDir)
 	}
 
-	evalDir, err := filepath.EvalSymlinks(sourceDir)
-	if err != nil {
-		log.Errorln("evaluating symlink:", err)
-		return nil, err
-	}
-
-	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		relPath, err := filepath.Rel(evalDir, fullPath)
-		if err != nil {
-			return err
-		}
-
+	walkErr := actor.walkDirectory(sourceDir, func(fullPath string, relPath string, info os.FileInfo, ignored bool, _ *cfignore.Rule) error {
 		// if file ignored contine to the next file
-		if gitIgnore.MatchesPath(relPath) {
-			return nil
-		}
-
-		if relPath == "." {
+		if ignored {
 			return nil
 		}
 
@@ -154,20 +149,13 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		default:
 			// If the file is regular we want to open
 			// and calculate the sha of the file
-			file, err := os.Open(fullPath)
-			if err != nil {
-				return err
-			}
-			defer file.Close()
-
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
+			sha, err := actor.fileSHA1(fullPath, info, fileHashes)
 			if err != nil {
 				return err
 			}
 
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
+			resource.SHA1 = sha
 			resource.Size = info.Size()
 		}
 
@@ -175,13 +163,130 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil
 	})
 
-	if len(resources) == 0 {
+	if len(resources) == 0 && walkErr == nil {
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if fileHashes != nil && walkErr == nil {
+		log.WithField("cached_hashes", fileHashes.Hits()).Debug("reused file hashes")
+		if err := fileHashes.Save(); err != nil {
+			log.WithError(err).Warn("unable to save file hash cache")
+		}
+	}
+
 	return resources, walkErr
 }
 
+// GetDirectoryIgnoreStatuses returns whether each path of a directory would
+// be uploaded. The contents of ignored directories are not listed.
+func (actor Actor) GetDirectoryIgnoreStatuses(sourceDir string) ([]IgnoreStatus, error) {
+	var statuses []IgnoreStatus
+
+	err := actor.walkDirectory(sourceDir, func(_ string, relPath string, info os.FileInfo, ignored bool, rule *cfignore.Rule) error {
+		status := IgnoreStatus{
+			Filename: filepath.ToSlash(relPath),
+			IsDir:    info.IsDir(),
+			Ignored:  ignored,
+		}
+		if rule != nil {
+			status.Rule = rule.String()
+		}
+
+		statuses = append(statuses, status)
+		return nil
+	})
+
+	return statuses, err
+}
+
+type walkFunc func(fullPath string, relPath string, info os.FileInfo, ignored bool, rule *cfignore.Rule) error
+
+// walkDirectory calls walkFn for every path of the directory, applying the
+// .cfignore files of each directory it visits. The contents of ignored
+// directories are skipped.
+func (actor Actor) walkDirectory(sourceDir string, walkFn walkFunc) error {
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		log.Errorln("evaluating symlink:", err)
+		return err
+	}
+
+	readFile := func(slashPath string) ([]byte, error) {
+		return ioutil.ReadFile(filepath.Join(evalDir, filepath.FromSlash(slashPath)))
+	}
+
+	matcher, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir, readFile)
+	if err != nil {
+		log.Errorln("reading .cfignore file:", err)
+		return err
+	}
+
+	return filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." {
+			return nil
+		}
+
+		slashPath := filepath.ToSlash(relPath)
+		ignored, rule := matcher.Match(slashPath, info.IsDir())
+
+		err = walkFn(fullPath, relPath, info, ignored, rule)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if ignored {
+				return filepath.SkipDir
+			}
+
+			err = matcher.AddIgnoreFile(path.Join(slashPath, ".cfignore"), readFile)
+			if err != nil && !os.IsNotExist(err) {
+				log.WithField("dir", relPath).Errorln("reading .cfignore file:", err)
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// fileSHA1 returns the SHA1 of the file, using and updating fileHashes when
+// it is not nil.
+func (Actor) fileSHA1(path string, info os.FileInfo, fileHashes *resourcecache.FileHashes) (string, error) {
+	if fileHashes != nil {
+		if sha, ok := fileHashes.Get(path, info); ok {
+			return sha, nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	sum := sha1.New()
+	_, err = io.Copy(sum, file)
+	if err != nil {
+		return "", err
+	}
+
+	sha := fmt.Sprintf("%x", sum.Sum(nil))
+	if fileHashes != nil {
+		fileHashes.Set(path, info, sha)
+	}
+	return sha, nil
+}
+
 // ZipArchiveResources zips an archive and a sorted (based on full
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
@@ -257,6 +362,21 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	writer := zip.NewWriter(zipFile)
 	defer writer.Close()
 
+	err = actor.addDirectoryResourcesToZip(sourceDir, filesToInclude, writer)
+	if err != nil {
+		return zipPath, err
+	}
+
+	log.WithFields(log.Fields{
+		"zip_file_location": zipFile.Name(),
+		"zipped_file_count": len(filesToInclude),
+	}).Info("zip file created")
+	return zipPath, nil
+}
+
+// addDirectoryResourcesToZip adds the resources of sourceDir to the zip
+// writer.
+func (actor Actor) addDirectoryResourcesToZip(sourceDir string, filesToInclude []Resource, writer *zip.Writer) error {
 	for _, resource := range filesToInclude {
 		fullPath := filepath.Join(sourceDir, resource.Filename)
 		log.WithField("fullPath", fullPath).Debug("zipping file")
@@ -264,7 +384,7 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 		fileInfo, err := os.Lstat(fullPath)
 		if err != nil {
 			log.WithField("fullPath", fullPath).Errorln("stat error in dir:", err)
-			return zipPath, err
+			return err
 		}
 
 		log.WithField("file-mode", fileInfo.Mode().String()).Debug("resource file info")
@@ -273,14 +393,14 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 			err = actor.addLinkToZipFromFileSystem(fullPath, fileInfo, resource, writer)
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("zipping file:", err)
-				return zipPath, err
+				return err
 			}
 		} else {
 			srcFile, err := os.Open(fullPath)
 			defer srcFile.Close()
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("opening path in dir:", err)
-				return zipPath, err
+				return err
 			}
 
 			err = actor.addFileToZipFromFileSystem(
@@ -290,16 +410,12 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 			srcFile.Close()
 			if err != nil {
 				log.WithField("fullPath", fullPath).Errorln("zipping file:", err)
-				return zipPath, err
+				return err
 			}
 		}
 	}
 
-	log.WithFields(log.Fields{
-		"zip_file_location": zipFile.Name(),
-		"zipped_file_count": len(filesToInclude),
-	}).Info("zip file created")
-	return zipPath, nil
+	return nil
 }
 
 func (Actor) addLinkToZipFromFileSystem(srcPath string,
@@ -395,44 +511,78 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	return nil
 }
 
-func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
+// generateArchiveCFIgnoreMatcher returns a matcher for the default ignore
+// rules and every .cfignore file in the archive.
+func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*cfignore.Matcher, error) {
+	matcher, err := cfignore.NewMatcher(DefaultIgnoreLines...)
+	if err != nil {
+		return nil, err
+	}
+
+	filesByName := map[string]*zip.File{}
+	var ignoreFiles []string
 	for _, item := range files {
-		if strings.HasSuffix(item.Name, ".cfignore") {
-			fileReader, err := item.Open()
-			if err != nil {
-				return nil, err
-			}
-			defer fileReader.Close()
+		name := strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(item.Name)), "/")
+		filesByName[name] = item
+		if path.Base(name) == ".cfignore" {
+			ignoreFiles = append(ignoreFiles, name)
+		}
+	}
 
-			raw, err := ioutil.ReadAll(fileReader)
-			if err != nil {
-				return nil, err
-			}
-			s := append(DefaultIgnoreLines, strings.Split(string(raw), "\n")...)
-			return ignore.CompileIgnoreLines(s...)
+	readFile := func(slashPath string) ([]byte, error) {
+		item, ok := filesByName[slashPath]
+		if !ok {
+			return nil, &os.PathError{Op: "open", Path: slashPath, Err: os.ErrNotExist}
+		}
+
+		fileReader, err := item.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer fileReader.Close()
+
+		return ioutil.ReadAll(fileReader)
+	}
+
+	// Outer ignore files must be added before the ones nested below them.
+	sort.Slice(ignoreFiles, func(i int, j int) bool {
+		return strings.Count(ignoreFiles[i], "/") < strings.Count(ignoreFiles[j], "/")
+	})
+	for _, ignoreFile := range ignoreFiles {
+		err = matcher.AddIgnoreFile(ignoreFile, readFile)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
-}
 
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
-	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
+	return matcher, nil
+}
 
+// generateDirectoryCFIgnoreMatcher returns a matcher for the default ignore
+// rules, the trace files in the directory and the top-level .cfignore file.
+// Nested .cfignore files are added while walking the directory.
+func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string, readFile cfignore.ReadFileFunc) (*cfignore.Matcher, error) {
 	additionalIgnoreLines := DefaultIgnoreLines
 
 	// If verbose logging has files in the current dir, ignore them
 	_, traceFiles := actor.Config.Verbose()
 	for _, traceFilePath := range traceFiles {
-		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
-			additionalIgnoreLines = append(additionalIgnoreLines, relPath)
+		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil && !strings.HasPrefix(relPath, "..") {
+			additionalIgnoreLines = append(additionalIgnoreLines, "/"+filepath.ToSlash(relPath))
 		}
 	}
 
-	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
-	} else {
-		return ignore.CompileIgnoreLines(additionalIgnoreLines...)
+	matcher, err := cfignore.NewMatcher(additionalIgnoreLines...)
+	if err != nil {
+		return nil, err
 	}
+
+	err = matcher.AddIgnoreFile(".cfignore", readFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return matcher, nil
 }
 
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
@@ -253,6 +254,47 @@ var _ = Describe("Resource Actions", func() {
 				})
 			})
 
+			Context("when .cfignore files exist in nested directories", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("tmpFile*\n"), 0655)
+					Expect(err).ToNot(HaveOccurred())
+					err = ioutil.WriteFile(filepath.Join(srcDir, "level1", ".cfignore"), []byte("!tmpFile1\n"), 0655)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("applies each .cfignore file to its own directory", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(gatheredResources).To(Equal(
+						[]Resource{
+							{Filename: "level1", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+						}))
+				})
+			})
+
+			Context("when the .cfignore file includes the .gitignore file", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("#include .gitignore\n"), 0655)
+					Expect(err).ToNot(HaveOccurred())
+					err = ioutil.WriteFile(filepath.Join(srcDir, ".gitignore"), []byte("/tmpFile3\n"), 0655)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("excludes the patterns of the included file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(gatheredResources).To(Equal(
+						[]Resource{
+							{Filename: "level1", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+							{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+						}))
+				})
+			})
+
 			Context("when default ignored files exist in the app dir", func() {
 				BeforeEach(func() {
 					for _, filename := range DefaultIgnoreLines {
@@ -301,6 +343,60 @@ var _ = Describe("Resource Actions", func() {
 						}))
 				})
 			})
+			Context("when the resource cache is enabled", func() {
+				var cacheDir string
+
+				BeforeEach(func() {
+					var err error
+					cacheDir, err = ioutil.TempDir("", "v2-resource-actions-cache")
+					Expect(err).ToNot(HaveOccurred())
+					fakeConfig.ResourceCacheDirReturns(cacheDir)
+
+					_, err = actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(cacheDir)).ToNot(HaveOccurred())
+				})
+
+				Context("when a file is changed without changing its size or modification time", func() {
+					BeforeEach(func() {
+						path := filepath.Join(srcDir, "tmpFile3")
+						info, err := os.Stat(path)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(ioutil.WriteFile(path, []byte("Bananarame"), 0655)).To(Succeed())
+						Expect(os.Chtimes(path, info.ModTime(), info.ModTime())).To(Succeed())
+					})
+
+					It("reuses the previously computed SHA1", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(gatheredResources).To(ContainElement(
+							Resource{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+						))
+					})
+				})
+
+				Context("when a file is modified", func() {
+					BeforeEach(func() {
+						path := filepath.Join(srcDir, "tmpFile3")
+						info, err := os.Stat(path)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(ioutil.WriteFile(path, []byte("Bananarame"), 0655)).To(Succeed())
+						later := info.ModTime().Add(time.Minute)
+						Expect(os.Chtimes(path, later, later)).To(Succeed())
+					})
+
+					It("rehashes the file", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(gatheredResources).ToNot(ContainElement(
+							Resource{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+						))
+					})
+				})
+			})
 		})
 
 		Context("when the directory is empty", func() {
@@ -323,6 +419,26 @@ var _ = Describe("Resource Actions", func() {
 		})
 	})
 
+	Describe("GetDirectoryIgnoreStatuses", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("level2/\n"), 0655)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns whether each path is uploaded and the rule that decided it", func() {
+			statuses, err := actor.GetDirectoryIgnoreStatuses(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(statuses).To(Equal([]IgnoreStatus{
+				{Filename: ".cfignore", Ignored: true, Rule: "(default) .cfignore"},
+				{Filename: "level1", IsDir: true},
+				{Filename: "level1/level2", IsDir: true, Ignored: true, Rule: ".cfignore:1 level2/"},
+				{Filename: "tmpFile2"},
+				{Filename: "tmpFile3"},
+			}))
+		})
+	})
+
 	Describe("ZipDirectoryResources", func() {
 		var (
 			resultZip  string
@@ -53,6 +53,15 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceCacheDirStub        func() string
+	resourceCacheDirMutex       sync.RWMutex
+	resourceCacheDirArgsForCall []struct{}
+	resourceCacheDirReturns     struct {
+		result1 string
+	}
+	resourceCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct{}
@@ -268,6 +277,46 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheDir() string {
+	fake.resourceCacheDirMutex.Lock()
+	ret, specificReturn := fake.resourceCacheDirReturnsOnCall[len(fake.resourceCacheDirArgsForCall)]
+	fake.resourceCacheDirArgsForCall = append(fake.resourceCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheDir", []interface{}{})
+	fake.resourceCacheDirMutex.Unlock()
+	if fake.ResourceCacheDirStub != nil {
+		return fake.ResourceCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheDirCallCount() int {
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
+	return len(fake.resourceCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturns(result1 string) {
+	fake.ResourceCacheDirStub = nil
+	fake.resourceCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheDirStub = nil
+	if fake.resourceCacheDirReturnsOnCall == nil {
+		fake.resourceCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -324,6 +373,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedSpaceMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -10,6 +10,11 @@ type Actor struct {
 	Config                Config
 	UAAClient             UAAClient
 
+	// RouterClient is only needed by the actions that read from the routing
+	// API. It is nil when the Cloud Controller does not advertise a routing
+	// endpoint.
+	RouterClient RouterClient
+
 	domainCache map[string]Domain
 }
 
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type ApplicationInstanceWithStats struct {
@@ -44,6 +45,51 @@ type ApplicationInstanceWithStats struct {
 	State ApplicationInstanceState
 }
 
+type ApplicationInstanceChangeType string
+
+const (
+	InstanceCrashed   ApplicationInstanceChangeType = "crashed"
+	InstanceRestarted ApplicationInstanceChangeType = "restarted"
+)
+
+// ApplicationInstanceChange describes an instance that crashed or restarted
+// between two polls of the same application.
+type ApplicationInstanceChange struct {
+	ID   int
+	Type ApplicationInstanceChangeType
+}
+
+// ApplicationInstanceChangesSince returns the instances in current that
+// crashed or restarted since previous was fetched. An instance has restarted
+// when it was created after the previous poll's instance or it is no longer
+// crashed.
+func ApplicationInstanceChangesSince(current []ApplicationInstanceWithStats, previous []ApplicationInstanceWithStats) []ApplicationInstanceChange {
+	previousInstances := map[int]ApplicationInstanceWithStats{}
+	for _, instance := range previous {
+		previousInstances[instance.ID] = instance
+	}
+
+	crashed := ApplicationInstanceState(constant.ApplicationInstanceCrashed)
+
+	var changes []ApplicationInstanceChange
+	for _, instance := range current {
+		previousInstance, ok := previousInstances[instance.ID]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case instance.State == crashed && previousInstance.State != crashed:
+			changes = append(changes, ApplicationInstanceChange{ID: instance.ID, Type: InstanceCrashed})
+		case instance.State == crashed:
+		case previousInstance.State == crashed || instance.Since > previousInstance.Since:
+			changes = append(changes, ApplicationInstanceChange{ID: instance.ID, Type: InstanceRestarted})
+		}
+	}
+
+	return changes
+}
+
 // newApplicationInstanceWithStats returns a pointer to a new
 // ApplicationInstance.
 func newApplicationInstanceWithStats(id int) ApplicationInstanceWithStats {
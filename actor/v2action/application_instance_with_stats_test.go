@@ -218,4 +218,40 @@ var _ = Describe("Application Instance With Stats Actions", func() {
 			})
 		})
 	})
+
+	Describe("ApplicationInstanceChangesSince", func() {
+		var previous, current []ApplicationInstanceWithStats
+
+		BeforeEach(func() {
+			previous = []ApplicationInstanceWithStats{
+				{ID: 0, State: ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+				{ID: 1, State: ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+				{ID: 2, State: ApplicationInstanceState(constant.ApplicationInstanceCrashed)},
+				{ID: 3, State: ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+				{ID: 4, State: ApplicationInstanceState(constant.ApplicationInstanceCrashed)},
+			}
+			current = []ApplicationInstanceWithStats{
+				{ID: 0, State: ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+				{ID: 1, State: ApplicationInstanceState(constant.ApplicationInstanceCrashed)},
+				{ID: 2, State: ApplicationInstanceState(constant.ApplicationInstanceStarting)},
+				{ID: 3, State: ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 200},
+				{ID: 4, State: ApplicationInstanceState(constant.ApplicationInstanceCrashed)},
+				{ID: 5, State: ApplicationInstanceState(constant.ApplicationInstanceStarting)},
+			}
+		})
+
+		It("returns the instances that crashed or restarted", func() {
+			Expect(ApplicationInstanceChangesSince(current, previous)).To(Equal([]ApplicationInstanceChange{
+				{ID: 1, Type: InstanceCrashed},
+				{ID: 2, Type: InstanceRestarted},
+				{ID: 3, Type: InstanceRestarted},
+			}))
+		})
+
+		Context("when there is no previous poll", func() {
+			It("returns no changes", func() {
+				Expect(ApplicationInstanceChangesSince(current, nil)).To(BeEmpty())
+			})
+		})
+	})
 })
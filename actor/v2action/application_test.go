@@ -1238,6 +1238,49 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the delete is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"some-app-warning-1"}, nil)
+			})
+
+			It("deletes the application and returns all warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning-1"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"some-app-warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("some-app-warning-1"))
+			})
+		})
+
+		Context("when the client returns back an error", func() {
+			var expectedErr error
+			BeforeEach(func() {
+				expectedErr = errors.New("some delete app error")
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"some-app-warning-1"}, expectedErr)
+			})
+
+			It("returns warnings and an error", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-app-warning-1"))
+			})
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		Context("when the update is successful", func() {
 			var expectedApp ccv2.Application
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -35,3 +36,31 @@ func (actor Actor) Authenticate(ID string, secret string, grantType constant.Gra
 
 	return nil
 }
+
+// BrowserLoginTimeout is how long AuthenticateWithBrowser waits for the user to
+// complete the login in their browser.
+const BrowserLoginTimeout = 5 * time.Minute
+
+// AuthenticateWithBrowser authenticates the user in UAA through the login page
+// opened by openURL and sets the returned tokens in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithBrowser(openURL func(loginURL string) error) error {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithBrowser(openURL, BrowserLoginTimeout)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	actor.Config.SetTokenInformation(accessToken, refreshToken, "")
+
+	return nil
+}
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -119,4 +120,92 @@ var _ = Describe("Auth Actions", func() {
 			})
 		})
 	})
+
+	Describe("AuthenticateWithBrowser", func() {
+		var (
+			openURL   func(string) error
+			actualErr error
+		)
+
+		BeforeEach(func() {
+			openURL = func(string) error { return nil }
+		})
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithBrowser(openURL)
+		})
+
+		Context("when no API errors occur", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithBrowserStub = func(open func(string) error, _ time.Duration) (string, string, error) {
+					Expect(open("some-login-url")).To(Succeed())
+					return "some-access-token", "some-refresh-token", nil
+				}
+			})
+
+			It("authenticates the user and stores the access and refresh tokens", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithBrowserCallCount()).To(Equal(1))
+				_, timeout := fakeUAAClient.AuthenticateWithBrowserArgsForCall(0)
+				Expect(timeout).To(Equal(BrowserLoginTimeout))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+
+			Context("when the URL opener is called", func() {
+				var openedURL string
+
+				BeforeEach(func() {
+					openURL = func(loginURL string) error {
+						openedURL = loginURL
+						return nil
+					}
+				})
+
+				It("passes the opener through to the UAA client", func() {
+					Expect(openedURL).To(Equal("some-login-url"))
+				})
+			})
+
+			Context("when a previous user authenticated with a client grant type", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAGrantTypeReturns("client_credentials")
+				})
+
+				It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+					Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+					Expect(fakeUAAClient.AuthenticateWithBrowserCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when an API error occurs", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeUAAClient.AuthenticateWithBrowserReturns("", "", expectedErr)
+			})
+
+			It("returns the error and clears the tokens", func() {
+				Expect(actualErr).To(MatchError(expectedErr))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
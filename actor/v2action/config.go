@@ -8,6 +8,7 @@ type Config interface {
 	AccessToken() string
 	PollingInterval() time.Duration
 	RefreshToken() string
+	ResourceCacheDir() string
 	SetAccessToken(accessToken string)
 	SetRefreshToken(refreshToken string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
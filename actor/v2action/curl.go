@@ -0,0 +1,173 @@
+package v2action
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var curlAppPlaceholderRegexp = regexp.MustCompile(`\{app:([^}]+)\}`)
+
+// CurlRequest represents an arbitrary request to the Cloud Controller API.
+type CurlRequest struct {
+	Method  string
+	Path    string
+	Headers http.Header
+	Body    []byte
+
+	// Paginate follows the pagination links of list responses and merges the
+	// resources of every page into the first page.
+	Paginate bool
+}
+
+// CurlResponse represents the Cloud Controller's response to a CurlRequest.
+type CurlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// curlPage is a decoded page of a V2 or V3 list response.
+type curlPage map[string]interface{}
+
+// Curl performs the provided request against the Cloud Controller. When
+// pagination is requested, the resources of every page are merged into the
+// body of the first page. Unsuccessful responses are returned as is.
+func (actor Actor) Curl(request CurlRequest) (CurlResponse, Warnings, error) {
+	body, httpResponse, ccWarnings, err := actor.CloudControllerClient.MakeRawRequest(request.Method, request.Path, request.Headers, request.Body)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return CurlResponse{}, allWarnings, err
+	}
+
+	response := CurlResponse{Body: body, HTTPResponse: httpResponse}
+	if !request.Paginate || !isSuccessfulCurlResponse(httpResponse) {
+		return response, allWarnings, nil
+	}
+
+	firstPage, ok := decodeCurlPage(body)
+	if !ok {
+		return response, allWarnings, nil
+	}
+
+	resources := firstPage.resources()
+	visited := map[string]bool{request.Path: true}
+	for next := firstPage.nextPath(); next != "" && !visited[next]; {
+		visited[next] = true
+
+		body, httpResponse, ccWarnings, err = actor.CloudControllerClient.MakeRawRequest(request.Method, next, request.Headers, nil)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return CurlResponse{}, allWarnings, err
+		}
+		if !isSuccessfulCurlResponse(httpResponse) {
+			return CurlResponse{Body: body, HTTPResponse: httpResponse}, allWarnings, nil
+		}
+
+		page, ok := decodeCurlPage(body)
+		if !ok {
+			return CurlResponse{Body: body, HTTPResponse: httpResponse}, allWarnings, nil
+		}
+		resources = append(resources, page.resources()...)
+		next = page.nextPath()
+	}
+
+	firstPage.mergeResources(resources)
+	response.Body, err = firstPage.encode()
+	return response, allWarnings, err
+}
+
+// CurlPathRequiresTargetedSpace returns true if the path contains
+// placeholders that are resolved from the targeted space.
+func (Actor) CurlPathRequiresTargetedSpace(path string) bool {
+	return strings.Contains(path, "{space_guid}") || curlAppPlaceholderRegexp.MatchString(path)
+}
+
+// CurlPathRequiresTargetedOrganization returns true if the path contains
+// placeholders that are resolved from the targeted organization or space.
+func (actor Actor) CurlPathRequiresTargetedOrganization(path string) bool {
+	return strings.Contains(path, "{org_guid}") || actor.CurlPathRequiresTargetedSpace(path)
+}
+
+// ResolveCurlPath replaces the {org_guid} and {space_guid} placeholders in
+// the path with the provided GUIDs, and every {app:NAME} placeholder with the
+// GUID of the named application in the provided space.
+func (actor Actor) ResolveCurlPath(path string, orgGUID string, spaceGUID string) (string, Warnings, error) {
+	path = strings.Replace(path, "{org_guid}", orgGUID, -1)
+	path = strings.Replace(path, "{space_guid}", spaceGUID, -1)
+
+	var allWarnings Warnings
+	for _, match := range curlAppPlaceholderRegexp.FindAllStringSubmatch(path, -1) {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(match[1], spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+		path = strings.Replace(path, match[0], app.GUID, -1)
+	}
+
+	return path, allWarnings, nil
+}
+
+func isSuccessfulCurlResponse(response *http.Response) bool {
+	return response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices
+}
+
+func decodeCurlPage(body []byte) (curlPage, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var page curlPage
+	if err := decoder.Decode(&page); err != nil || page == nil {
+		return nil, false
+	}
+	if _, ok := page["resources"].([]interface{}); !ok {
+		return nil, false
+	}
+	return page, true
+}
+
+func (page curlPage) resources() []interface{} {
+	resources, _ := page["resources"].([]interface{})
+	return resources
+}
+
+// nextPath returns the path of the next page, using the V3 pagination.next
+// link or the V2 next_url.
+func (page curlPage) nextPath() string {
+	if pagination, ok := page["pagination"].(map[string]interface{}); ok {
+		next, _ := pagination["next"].(map[string]interface{})
+		href, _ := next["href"].(string)
+		nextURL, err := url.Parse(href)
+		if href == "" || err != nil {
+			return ""
+		}
+		return nextURL.RequestURI()
+	}
+
+	nextURL, _ := page["next_url"].(string)
+	return nextURL
+}
+
+func (page curlPage) mergeResources(resources []interface{}) {
+	page["resources"] = resources
+
+	if pagination, ok := page["pagination"].(map[string]interface{}); ok {
+		pagination["next"] = nil
+	}
+	if _, ok := page["next_url"]; ok {
+		page["next_url"] = nil
+	}
+}
+
+func (page curlPage) encode() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(page); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,289 @@
+package v2action_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Curl Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("Curl", func() {
+		var (
+			request    CurlRequest
+			response   CurlResponse
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			request = CurlRequest{
+				Method:  http.MethodGet,
+				Path:    "/v3/apps",
+				Headers: http.Header{"Some-Header": {"some-value"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			response, warnings, executeErr = actor.Curl(request)
+		})
+
+		Context("when the request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("request error")
+				fakeCloudControllerClient.MakeRawRequestReturns(nil, nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when pagination is not requested", func() {
+			BeforeEach(func() {
+				request.Body = []byte("some-body")
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					[]byte(`{"pagination":{"next":{"href":"https://api.example.com/v3/apps?page=2"}},"resources":[]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the response of the single request", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(response.Body).To(MatchJSON(`{"pagination":{"next":{"href":"https://api.example.com/v3/apps?page=2"}},"resources":[]}`))
+				Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				method, path, headers, body := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+				Expect(method).To(Equal(http.MethodGet))
+				Expect(path).To(Equal("/v3/apps"))
+				Expect(headers).To(Equal(http.Header{"Some-Header": {"some-value"}}))
+				Expect(body).To(Equal([]byte("some-body")))
+			})
+		})
+
+		Context("when pagination is requested", func() {
+			BeforeEach(func() {
+				request.Paginate = true
+			})
+
+			Context("when the response is a V3 list", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+						[]byte(`{"pagination":{"total_pages":2,"next":{"href":"https://api.example.com/v3/apps?page=2"}},"resources":[{"name":"app-1"}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						ccv2.Warnings{"warning-1"},
+						nil,
+					)
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+						[]byte(`{"pagination":{"total_pages":2,"next":null},"resources":[{"name":"app-2"}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						ccv2.Warnings{"warning-2"},
+						nil,
+					)
+				})
+
+				It("merges the resources of every page into the first page", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+					Expect(response.Body).To(MatchJSON(`{"pagination":{"total_pages":2,"next":null},"resources":[{"name":"app-1"},{"name":"app-2"}]}`))
+
+					Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(2))
+					method, path, headers, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(1)
+					Expect(method).To(Equal(http.MethodGet))
+					Expect(path).To(Equal("/v3/apps?page=2"))
+					Expect(headers).To(Equal(http.Header{"Some-Header": {"some-value"}}))
+				})
+			})
+
+			Context("when the response is a V2 list", func() {
+				BeforeEach(func() {
+					request.Path = "/v2/apps"
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+						[]byte(`{"total_pages":2,"next_url":"/v2/apps?page=2","resources":[{"entity":{"name":"app-1"}}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						nil,
+						nil,
+					)
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+						[]byte(`{"total_pages":2,"next_url":null,"resources":[{"entity":{"name":"app-2"}}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						nil,
+						nil,
+					)
+				})
+
+				It("follows next_url and merges the resources", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(response.Body).To(MatchJSON(`{"total_pages":2,"next_url":null,"resources":[{"entity":{"name":"app-1"}},{"entity":{"name":"app-2"}}]}`))
+
+					Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(2))
+					_, path, _, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(1)
+					Expect(path).To(Equal("/v2/apps?page=2"))
+				})
+			})
+
+			Context("when a subsequent page is unsuccessful", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+						[]byte(`{"pagination":{"next":{"href":"https://api.example.com/v3/apps?page=2"}},"resources":[{"name":"app-1"}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						nil,
+						nil,
+					)
+					fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+						[]byte(`{"errors":[]}`),
+						&http.Response{StatusCode: http.StatusBadGateway},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns the unsuccessful response", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(response.Body).To(MatchJSON(`{"errors":[]}`))
+					Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusBadGateway))
+				})
+			})
+
+			Context("when the next page links back to a visited page", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.MakeRawRequestReturns(
+						[]byte(`{"next_url":"/v2/apps","resources":[{"name":"app-1"}]}`),
+						&http.Response{StatusCode: http.StatusOK},
+						nil,
+						nil,
+					)
+					request.Path = "/v2/apps"
+				})
+
+				It("stops following links", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the response is not a list", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.MakeRawRequestReturns(
+						[]byte("some-text"),
+						&http.Response{StatusCode: http.StatusOK},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns the response as is", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(response.Body).To(Equal([]byte("some-text")))
+					Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("CurlPathRequiresTargetedOrganization and CurlPathRequiresTargetedSpace", func() {
+		It("detects placeholders resolved from the target", func() {
+			Expect(actor.CurlPathRequiresTargetedOrganization("/v2/apps")).To(BeFalse())
+			Expect(actor.CurlPathRequiresTargetedSpace("/v2/apps")).To(BeFalse())
+
+			Expect(actor.CurlPathRequiresTargetedOrganization("/v3/spaces?organization_guids={org_guid}")).To(BeTrue())
+			Expect(actor.CurlPathRequiresTargetedSpace("/v3/spaces?organization_guids={org_guid}")).To(BeFalse())
+
+			Expect(actor.CurlPathRequiresTargetedOrganization("/v2/spaces/{space_guid}")).To(BeTrue())
+			Expect(actor.CurlPathRequiresTargetedSpace("/v2/spaces/{space_guid}")).To(BeTrue())
+
+			Expect(actor.CurlPathRequiresTargetedSpace("/v3/apps/{app:some-app}/env")).To(BeTrue())
+		})
+	})
+
+	Describe("ResolveCurlPath", func() {
+		var (
+			path       string
+			warnings   Warnings
+			executeErr error
+		)
+
+		Context("when the path contains org and space placeholders", func() {
+			BeforeEach(func() {
+				path, warnings, executeErr = actor.ResolveCurlPath("/v3/spaces/{space_guid}?organization_guids={org_guid}", "some-org-guid", "some-space-guid")
+			})
+
+			It("replaces them with the provided GUIDs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(path).To(Equal("/v3/spaces/some-space-guid?organization_guids=some-org-guid"))
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the path contains app placeholders", func() {
+			Context("when the app exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]ccv2.Application{{GUID: "some-app-guid"}},
+						ccv2.Warnings{"warning-1"},
+						nil,
+					)
+
+					path, warnings, executeErr = actor.ResolveCurlPath("/v3/apps/{app:some-app}/env", "some-org-guid", "some-space-guid")
+				})
+
+				It("replaces them with the app's GUID", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(path).To(Equal("/v3/apps/some-app-guid/env"))
+					Expect(warnings).To(ConsistOf("warning-1"))
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv2.Filter{
+							Type:     constant.NameFilter,
+							Operator: constant.EqualOperator,
+							Values:   []string{"some-app"},
+						},
+						ccv2.Filter{
+							Type:     constant.SpaceGUIDFilter,
+							Operator: constant.EqualOperator,
+							Values:   []string{"some-space-guid"},
+						},
+					))
+				})
+			})
+
+			Context("when the app does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+
+					path, warnings, executeErr = actor.ResolveCurlPath("/v3/apps/{app:some-app}", "some-org-guid", "some-space-guid")
+				})
+
+				It("returns an ApplicationNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+		})
+	})
+})
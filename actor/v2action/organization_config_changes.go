@@ -0,0 +1,459 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationUserRole is a role given to a user in an organization.
+type OrganizationUserRole struct {
+	Username string
+	Role     constant.OrgRole
+}
+
+// SpaceUserRole is a role given to a user in a space.
+type SpaceUserRole struct {
+	Username string
+	Role     constant.SpaceRole
+}
+
+// SpaceConfigChanges are the changes required to bring a space in line with
+// its declarative description.
+type SpaceConfigChanges struct {
+	Name string
+	GUID string
+
+	// Create is true when the space does not exist yet.
+	Create bool
+
+	CurrentQuota string
+	Quota        string
+	QuotaGUID    string
+
+	RolesToAdd []SpaceUserRole
+}
+
+// QuotaChanged returns true when a space quota is configured and it is not
+// the one currently assigned to the space.
+func (changes SpaceConfigChanges) QuotaChanged() bool {
+	return changes.Quota != "" && changes.Quota != changes.CurrentQuota
+}
+
+// HasChanges returns true when applying the changes would modify the space.
+func (changes SpaceConfigChanges) HasChanges() bool {
+	return changes.Create || changes.QuotaChanged() || len(changes.RolesToAdd) > 0
+}
+
+// OrganizationConfigChanges are the changes required to bring an
+// organization and its spaces in line with their declarative description.
+// Configuration is additive: spaces and roles that are not described are left
+// unchanged.
+type OrganizationConfigChanges struct {
+	Name string
+	GUID string
+
+	// Create is true when the organization does not exist yet.
+	Create bool
+
+	CurrentQuota string
+	Quota        string
+	QuotaGUID    string
+
+	RolesToAdd []OrganizationUserRole
+
+	Spaces []SpaceConfigChanges
+}
+
+// QuotaChanged returns true when a quota is configured and it is not the one
+// currently assigned to the organization.
+func (changes OrganizationConfigChanges) QuotaChanged() bool {
+	return changes.Quota != "" && changes.Quota != changes.CurrentQuota
+}
+
+// HasChanges returns true when applying the changes would modify the
+// organization or any of its spaces.
+func (changes OrganizationConfigChanges) HasChanges() bool {
+	if changes.Create || changes.QuotaChanged() || len(changes.RolesToAdd) > 0 {
+		return true
+	}
+
+	for _, space := range changes.Spaces {
+		if space.HasChanges() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOrgConfigFile reads the declarative org config file at the provided
+// path.
+func (Actor) ReadOrgConfigFile(pathToFile string) ([]orgconfig.Organization, error) {
+	// Cover method to make testing easier
+	return orgconfig.ReadOrganizations(pathToFile)
+}
+
+// GetOrganizationConfigChanges compares the desired organizations with the
+// ones on the Cloud Controller and returns the changes required for each of
+// them. Quotas are referenced by name and are never created. Space quotas of
+// organizations that do not exist yet cannot exist either, so they are only
+// checked when the changes are applied.
+func (actor Actor) GetOrganizationConfigChanges(desiredOrgs []orgconfig.Organization) ([]OrganizationConfigChanges, Warnings, error) {
+	var (
+		allWarnings Warnings
+		allChanges  []OrganizationConfigChanges
+	)
+
+	orgQuotas := map[string]ccv2.OrganizationQuota{}
+	for _, desiredOrg := range desiredOrgs {
+		changes, warnings, err := actor.getOrganizationConfigChanges(desiredOrg, orgQuotas)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		allChanges = append(allChanges, changes)
+	}
+
+	return allChanges, allWarnings, nil
+}
+
+// ApplyOrganizationConfigChanges creates the organization and its spaces when
+// needed, assigns quotas and adds user roles. It returns the changes with the
+// GUIDs of any created organization and spaces filled in.
+func (actor Actor) ApplyOrganizationConfigChanges(changes OrganizationConfigChanges) (OrganizationConfigChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	if changes.Create {
+		log.WithField("org", changes.Name).Debug("creating organization")
+		org, warnings, err := actor.CloudControllerClient.CreateOrganization(changes.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+		changes.GUID = org.GUID
+	}
+
+	if changes.QuotaChanged() {
+		_, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(changes.GUID, changes.QuotaGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+	}
+
+	for _, role := range changes.RolesToAdd {
+		warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(role.Role, changes.GUID, role.Username)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+	}
+
+	appliedSpaces := make([]SpaceConfigChanges, len(changes.Spaces))
+	copy(appliedSpaces, changes.Spaces)
+	changes.Spaces = appliedSpaces
+
+	for i := range changes.Spaces {
+		warnings, err := actor.applySpaceConfigChanges(changes.GUID, &changes.Spaces[i])
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+	}
+
+	// Space quotas are assigned last, once the organization and all of its
+	// spaces exist, so that a missing space quota does not prevent the rest
+	// of the configuration from being applied.
+	for i := range changes.Spaces {
+		warnings, err := actor.applySpaceQuota(changes.GUID, &changes.Spaces[i])
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) applySpaceConfigChanges(orgGUID string, changes *SpaceConfigChanges) (Warnings, error) {
+	var allWarnings Warnings
+
+	if changes.Create {
+		log.WithField("space", changes.Name).Debug("creating space")
+		space, warnings, err := actor.CloudControllerClient.CreateSpace(changes.Name, orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		changes.GUID = space.GUID
+	}
+
+	for _, role := range changes.RolesToAdd {
+		warnings, err := actor.CloudControllerClient.UpdateSpaceUserByRole(role.Role, changes.GUID, role.Username)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// applySpaceQuota assigns the configured space quota to the space. Space
+// quotas of organizations that did not exist when the changes were planned
+// are looked up by name in the organization first.
+func (actor Actor) applySpaceQuota(orgGUID string, changes *SpaceConfigChanges) (Warnings, error) {
+	if !changes.QuotaChanged() {
+		return nil, nil
+	}
+
+	var allWarnings Warnings
+	if changes.QuotaGUID == "" {
+		quotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		for _, quota := range quotas {
+			if quota.Name == changes.Quota {
+				changes.QuotaGUID = quota.GUID
+			}
+		}
+		if changes.QuotaGUID == "" {
+			return allWarnings, actionerror.SpaceQuotaNotFoundError{Name: changes.Quota}
+		}
+	}
+
+	warnings, err := actor.CloudControllerClient.SetSpaceQuota(changes.GUID, changes.QuotaGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getOrganizationConfigChanges(desiredOrg orgconfig.Organization, orgQuotas map[string]ccv2.OrganizationQuota) (OrganizationConfigChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	changes := OrganizationConfigChanges{Name: desiredOrg.Name}
+
+	org, warnings, err := actor.GetOrganizationByName(desiredOrg.Name)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		changes.GUID = org.GUID
+	case actionerror.OrganizationNotFoundError:
+		log.WithField("org", desiredOrg.Name).Debug("organization does not exist")
+		changes.Create = true
+	default:
+		return OrganizationConfigChanges{}, allWarnings, err
+	}
+
+	if desiredOrg.Quota != "" {
+		quota, warnings, err := actor.getOrganizationQuotaByName(desiredOrg.Quota, orgQuotas)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationConfigChanges{}, allWarnings, err
+		}
+		changes.Quota = quota.Name
+		changes.QuotaGUID = quota.GUID
+
+		if org.QuotaDefinitionGUID == quota.GUID {
+			changes.CurrentQuota = quota.Name
+		} else if org.QuotaDefinitionGUID != "" {
+			currentQuota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return OrganizationConfigChanges{}, allWarnings, err
+			}
+			changes.CurrentQuota = currentQuota.Name
+		}
+	}
+
+	desiredRoles := desiredOrganizationRoles(desiredOrg)
+	for _, role := range []constant.OrgRole{constant.OrgUserRole, constant.OrgManagerRole, constant.OrgBillingManagerRole, constant.OrgAuditorRole} {
+		if len(desiredRoles[role]) == 0 {
+			continue
+		}
+
+		var currentUsers []ccv2.User
+		if !changes.Create {
+			users, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, changes.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return OrganizationConfigChanges{}, allWarnings, err
+			}
+			currentUsers = users
+		}
+
+		for _, username := range missingUsernames(desiredRoles[role], currentUsers) {
+			changes.RolesToAdd = append(changes.RolesToAdd, OrganizationUserRole{Username: username, Role: role})
+		}
+	}
+
+	spaceChanges, warnings, err := actor.getSpaceConfigChanges(changes, desiredOrg.Spaces)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationConfigChanges{}, allWarnings, err
+	}
+	changes.Spaces = spaceChanges
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) getSpaceConfigChanges(orgChanges OrganizationConfigChanges, desiredSpaces []orgconfig.Space) ([]SpaceConfigChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	existingSpaces := map[string]Space{}
+	spaceQuotas := map[string]ccv2.SpaceQuota{}
+	if !orgChanges.Create && len(desiredSpaces) > 0 {
+		spaces, warnings, err := actor.GetOrganizationSpaces(orgChanges.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, space := range spaces {
+			existingSpaces[space.Name] = space
+		}
+
+		quotas, ccWarnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgChanges.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, quota := range quotas {
+			spaceQuotas[quota.Name] = quota
+		}
+	}
+
+	var allChanges []SpaceConfigChanges
+	for _, desiredSpace := range desiredSpaces {
+		changes := SpaceConfigChanges{Name: desiredSpace.Name}
+
+		space, exists := existingSpaces[desiredSpace.Name]
+		if exists {
+			changes.GUID = space.GUID
+		} else {
+			changes.Create = true
+		}
+
+		if desiredSpace.Quota != "" && orgChanges.Create {
+			// Space quotas belong to an organization, so the quota of a
+			// space in a new organization is looked up when the changes are
+			// applied.
+			changes.Quota = desiredSpace.Quota
+		} else if desiredSpace.Quota != "" {
+			quota, found := spaceQuotas[desiredSpace.Quota]
+			if !found {
+				return nil, allWarnings, actionerror.SpaceQuotaNotFoundError{Name: desiredSpace.Quota}
+			}
+			changes.Quota = quota.Name
+			changes.QuotaGUID = quota.GUID
+
+			for _, currentQuota := range spaceQuotas {
+				if currentQuota.GUID == space.SpaceQuotaDefinitionGUID {
+					changes.CurrentQuota = currentQuota.Name
+				}
+			}
+		}
+
+		desiredRoles := desiredSpaceRoles(desiredSpace)
+		for _, role := range []constant.SpaceRole{constant.SpaceManagerRole, constant.SpaceDeveloperRole, constant.SpaceAuditorRole} {
+			if len(desiredRoles[role]) == 0 {
+				continue
+			}
+
+			var currentUsers []ccv2.User
+			if !changes.Create {
+				users, ccWarnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, changes.GUID)
+				allWarnings = append(allWarnings, ccWarnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				currentUsers = users
+			}
+
+			for _, username := range missingUsernames(desiredRoles[role], currentUsers) {
+				changes.RolesToAdd = append(changes.RolesToAdd, SpaceUserRole{Username: username, Role: role})
+			}
+		}
+
+		allChanges = append(allChanges, changes)
+	}
+
+	return allChanges, allWarnings, nil
+}
+
+func (actor Actor) getOrganizationQuotaByName(name string, cache map[string]ccv2.OrganizationQuota) (ccv2.OrganizationQuota, Warnings, error) {
+	if quota, found := cache[name]; found {
+		return quota, nil, nil
+	}
+
+	quotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(ccv2.Filter{
+		Type:     constant.NameFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{name},
+	})
+	if err != nil {
+		return ccv2.OrganizationQuota{}, Warnings(warnings), err
+	}
+
+	if len(quotas) == 0 {
+		return ccv2.OrganizationQuota{}, Warnings(warnings), actionerror.OrganizationQuotaNotFoundError{Name: name}
+	}
+
+	cache[name] = quotas[0]
+	return quotas[0], Warnings(warnings), nil
+}
+
+// desiredOrganizationRoles returns the users of each organization role. Every
+// user with an organization or space role must also be an organization user.
+func desiredOrganizationRoles(org orgconfig.Organization) map[constant.OrgRole][]string {
+	roles := map[constant.OrgRole][]string{
+		constant.OrgManagerRole:        org.Managers,
+		constant.OrgBillingManagerRole: org.BillingManagers,
+		constant.OrgAuditorRole:        org.Auditors,
+	}
+
+	members := append(append(append([]string{}, org.Managers...), org.BillingManagers...), org.Auditors...)
+	for _, space := range org.Spaces {
+		members = append(append(append(members, space.Managers...), space.Developers...), space.Auditors...)
+	}
+	roles[constant.OrgUserRole] = uniqueUsernames(members)
+
+	return roles
+}
+
+func desiredSpaceRoles(space orgconfig.Space) map[constant.SpaceRole][]string {
+	return map[constant.SpaceRole][]string{
+		constant.SpaceManagerRole:   space.Managers,
+		constant.SpaceDeveloperRole: space.Developers,
+		constant.SpaceAuditorRole:   space.Auditors,
+	}
+}
+
+func missingUsernames(desired []string, current []ccv2.User) []string {
+	existing := map[string]bool{}
+	for _, user := range current {
+		existing[user.Username] = true
+	}
+
+	var missing []string
+	for _, username := range uniqueUsernames(desired) {
+		if !existing[username] {
+			missing = append(missing, username)
+		}
+	}
+	return missing
+}
+
+func uniqueUsernames(usernames []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, username := range usernames {
+		if !seen[username] {
+			seen[username] = true
+			unique = append(unique, username)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,429 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization Config Changes Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("OrganizationConfigChanges", func() {
+		It("has changes when one of its spaces has changes", func() {
+			changes := OrganizationConfigChanges{
+				Quota:        "default",
+				CurrentQuota: "default",
+				Spaces: []SpaceConfigChanges{
+					{Name: "space-1"},
+					{Name: "space-2", Quota: "small", CurrentQuota: "large"},
+				},
+			}
+			Expect(changes.QuotaChanged()).To(BeFalse())
+			Expect(changes.Spaces[0].HasChanges()).To(BeFalse())
+			Expect(changes.Spaces[1].QuotaChanged()).To(BeTrue())
+			Expect(changes.HasChanges()).To(BeTrue())
+		})
+	})
+
+	Describe("GetOrganizationConfigChanges", func() {
+		var (
+			desiredOrgs []orgconfig.Organization
+			changes     []OrganizationConfigChanges
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			desiredOrgs = []orgconfig.Organization{
+				{
+					Name:     "org-1",
+					Quota:    "large",
+					Managers: []string{"alice"},
+					Auditors: []string{"carol"},
+					Spaces: []orgconfig.Space{
+						{Name: "existing-space", Quota: "small", Developers: []string{"dave", "alice"}},
+						{Name: "new-space", Managers: []string{"erin"}},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-1-guid", Name: "org-1", QuotaDefinitionGUID: "default-quota-guid"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]ccv2.OrganizationQuota{{GUID: "large-quota-guid", Name: "large"}},
+				ccv2.Warnings{"get-quotas-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{GUID: "default-quota-guid", Name: "default"},
+				ccv2.Warnings{"get-quota-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role constant.OrgRole, guid string) ([]ccv2.User, ccv2.Warnings, error) {
+				switch role {
+				case constant.OrgUserRole:
+					return []ccv2.User{{Username: "alice"}, {Username: "dave"}}, ccv2.Warnings{"get-org-users-warning"}, nil
+				case constant.OrgManagerRole:
+					return []ccv2.User{{Username: "alice"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "existing-space-guid", Name: "existing-space", SpaceQuotaDefinitionGUID: "medium-quota-guid"}},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]ccv2.SpaceQuota{{GUID: "small-quota-guid", Name: "small"}, {GUID: "medium-quota-guid", Name: "medium"}},
+				ccv2.Warnings{"get-space-quotas-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+				[]ccv2.User{{Username: "dave"}},
+				ccv2.Warnings{"get-space-users-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetOrganizationConfigChanges(desiredOrgs)
+		})
+
+		Context("when the organization exists", func() {
+			It("returns the missing quotas, spaces and roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-org-warning",
+					"get-quotas-warning",
+					"get-quota-warning",
+					"get-org-users-warning",
+					"get-spaces-warning",
+					"get-space-quotas-warning",
+					"get-space-users-warning",
+				))
+
+				Expect(changes).To(Equal([]OrganizationConfigChanges{
+					{
+						Name:         "org-1",
+						GUID:         "org-1-guid",
+						CurrentQuota: "default",
+						Quota:        "large",
+						QuotaGUID:    "large-quota-guid",
+						RolesToAdd: []OrganizationUserRole{
+							{Username: "carol", Role: constant.OrgUserRole},
+							{Username: "erin", Role: constant.OrgUserRole},
+							{Username: "carol", Role: constant.OrgAuditorRole},
+						},
+						Spaces: []SpaceConfigChanges{
+							{
+								Name:         "existing-space",
+								GUID:         "existing-space-guid",
+								CurrentQuota: "medium",
+								Quota:        "small",
+								QuotaGUID:    "small-quota-guid",
+								RolesToAdd: []SpaceUserRole{
+									{Username: "alice", Role: constant.SpaceDeveloperRole},
+								},
+							},
+							{
+								Name:   "new-space",
+								Create: true,
+								RolesToAdd: []SpaceUserRole{
+									{Username: "erin", Role: constant.SpaceManagerRole},
+								},
+							},
+						},
+					},
+				}))
+
+				quotaFilters := fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)
+				Expect(quotaFilters).To(ConsistOf(ccv2.Filter{
+					Type:     constant.NameFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"large"},
+				}))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("default-quota-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("org-1-guid"))
+
+				Expect(fakeCloudControllerClient.GetSpaceUsersByRoleCallCount()).To(Equal(1))
+				role, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceDeveloperRole))
+				Expect(spaceGUID).To(Equal("existing-space-guid"))
+			})
+
+			Context("when the organization is already up to date", func() {
+				BeforeEach(func() {
+					desiredOrgs = []orgconfig.Organization{{Name: "org-1", Quota: "large", Managers: []string{"alice"}}}
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]ccv2.Organization{{GUID: "org-1-guid", Name: "org-1", QuotaDefinitionGUID: "large-quota-guid"}},
+						nil,
+						nil)
+				})
+
+				It("returns no changes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(changes).To(HaveLen(1))
+					Expect(changes[0].HasChanges()).To(BeFalse())
+					Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the space quota does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceQuotasReturns(nil, nil, nil)
+				})
+
+				It("returns a SpaceQuotaNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceQuotaNotFoundError{Name: "small"}))
+				})
+			})
+
+			Context("when getting the organization users fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationUsersByRoleStub = nil
+					fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(nil, ccv2.Warnings{"get-org-users-warning"}, errors.New("get-users-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("get-users-error"))
+					Expect(warnings).To(ContainElement("get-org-users-warning"))
+				})
+			})
+		})
+
+		Context("when the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("plans to create the organization, its spaces and all roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]OrganizationConfigChanges{
+					{
+						Name:      "org-1",
+						Create:    true,
+						Quota:     "large",
+						QuotaGUID: "large-quota-guid",
+						RolesToAdd: []OrganizationUserRole{
+							{Username: "alice", Role: constant.OrgUserRole},
+							{Username: "carol", Role: constant.OrgUserRole},
+							{Username: "dave", Role: constant.OrgUserRole},
+							{Username: "erin", Role: constant.OrgUserRole},
+							{Username: "alice", Role: constant.OrgManagerRole},
+							{Username: "carol", Role: constant.OrgAuditorRole},
+						},
+						Spaces: []SpaceConfigChanges{
+							{
+								Name:   "existing-space",
+								Create: true,
+								Quota:  "small",
+								RolesToAdd: []SpaceUserRole{
+									{Username: "dave", Role: constant.SpaceDeveloperRole},
+									{Username: "alice", Role: constant.SpaceDeveloperRole},
+								},
+							},
+							{
+								Name:       "new-space",
+								Create:     true,
+								RolesToAdd: []SpaceUserRole{{Username: "erin", Role: constant.SpaceManagerRole}},
+							},
+						},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationUsersByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpaceUsersByRoleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the organization quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"get-quotas-warning"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationQuotaNotFoundError{Name: "large"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-quotas-warning"))
+			})
+		})
+
+		Context("when getting the organization fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, errors.New("get-org-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-org-error"))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyOrganizationConfigChanges", func() {
+		var (
+			changes        OrganizationConfigChanges
+			appliedChanges OrganizationConfigChanges
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			changes = OrganizationConfigChanges{
+				Name:      "org-1",
+				Create:    true,
+				Quota:     "large",
+				QuotaGUID: "large-quota-guid",
+				RolesToAdd: []OrganizationUserRole{
+					{Username: "alice", Role: constant.OrgUserRole},
+					{Username: "alice", Role: constant.OrgManagerRole},
+				},
+				Spaces: []SpaceConfigChanges{
+					{Name: "unchanged-space", GUID: "unchanged-space-guid"},
+					{
+						Name:       "new-space",
+						Create:     true,
+						Quota:      "small",
+						QuotaGUID:  "small-quota-guid",
+						RolesToAdd: []SpaceUserRole{{Username: "alice", Role: constant.SpaceDeveloperRole}},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.CreateOrganizationReturns(ccv2.Organization{GUID: "org-1-guid"}, ccv2.Warnings{"create-org-warning"}, nil)
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.Organization{}, ccv2.Warnings{"update-quota-warning"}, nil)
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"org-role-warning"}, nil)
+			fakeCloudControllerClient.CreateSpaceReturns(ccv2.Space{GUID: "new-space-guid"}, ccv2.Warnings{"create-space-warning"}, nil)
+			fakeCloudControllerClient.SetSpaceQuotaReturns(ccv2.Warnings{"set-space-quota-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"space-role-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			appliedChanges, warnings, executeErr = actor.ApplyOrganizationConfigChanges(changes)
+		})
+
+		It("creates the organization and spaces, assigns quotas and adds roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"create-org-warning",
+				"update-quota-warning",
+				"org-role-warning",
+				"org-role-warning",
+				"create-space-warning",
+				"set-space-quota-warning",
+				"space-role-warning",
+			))
+
+			Expect(fakeCloudControllerClient.CreateOrganizationArgsForCall(0)).To(Equal("org-1"))
+
+			orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("org-1-guid"))
+			Expect(quotaGUID).To(Equal("large-quota-guid"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(2))
+			orgRole, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(constant.OrgUserRole))
+			Expect(orgGUID).To(Equal("org-1-guid"))
+			Expect(username).To(Equal("alice"))
+
+			Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+			spaceName, orgGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+			Expect(spaceName).To(Equal("new-space"))
+			Expect(orgGUID).To(Equal("org-1-guid"))
+
+			spaceGUID, quotaGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceGUID).To(Equal("new-space-guid"))
+			Expect(quotaGUID).To(Equal("small-quota-guid"))
+
+			spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("new-space-guid"))
+			Expect(username).To(Equal("alice"))
+
+			Expect(appliedChanges.GUID).To(Equal("org-1-guid"))
+			Expect(appliedChanges.Spaces[0].GUID).To(Equal("unchanged-space-guid"))
+			Expect(appliedChanges.Spaces[1].GUID).To(Equal("new-space-guid"))
+			Expect(changes.Spaces[1].GUID).To(BeEmpty())
+		})
+
+		Context("when creating the organization fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"create-org-warning"}, errors.New("create-org-error"))
+			})
+
+			It("returns the error and makes no further changes", func() {
+				Expect(executeErr).To(MatchError("create-org-error"))
+				Expect(warnings).To(ConsistOf("create-org-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when adding a space role fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"space-role-warning"}, errors.New("space-role-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("space-role-error"))
+				Expect(warnings).To(ContainElement("space-role-warning"))
+				Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space quota was not looked up because the organization is new", func() {
+			BeforeEach(func() {
+				changes.Spaces[1].QuotaGUID = ""
+			})
+
+			Context("when the space quota exists in the created organization", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceQuotasReturns(
+						[]ccv2.SpaceQuota{{GUID: "small-quota-guid", Name: "small"}},
+						ccv2.Warnings{"get-space-quotas-warning"},
+						nil)
+				})
+
+				It("looks up the space quota in the created organization and assigns it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("get-space-quotas-warning"))
+					Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("org-1-guid"))
+
+					spaceGUID, quotaGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+					Expect(spaceGUID).To(Equal("new-space-guid"))
+					Expect(quotaGUID).To(Equal("small-quota-guid"))
+				})
+			})
+
+			Context("when the space quota does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv2.Warnings{"get-space-quotas-warning"}, nil)
+				})
+
+				It("applies the rest of the configuration and returns a SpaceQuotaNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceQuotaNotFoundError{Name: "small"}))
+					Expect(warnings).To(ContainElement("get-space-quotas-warning"))
+					Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
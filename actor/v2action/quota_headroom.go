@@ -0,0 +1,185 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// DefaultApplicationMemoryInMB is the memory the Cloud Controller gives each
+// instance of an app created without a memory setting. The Cloud Controller
+// does not expose its configured default, so this is its stock value.
+const DefaultApplicationMemoryInMB uint64 = 1024
+
+// QuotaRequest is the capacity an app is allocated once it is started.
+type QuotaRequest struct {
+	// ApplicationGUID is the app whose current allocation the request
+	// replaces. It is empty for apps that do not exist yet.
+	ApplicationGUID string
+	Instances       int
+	// MemoryInMB is the memory of each instance. Memory limits are not
+	// checked when it is 0.
+	MemoryInMB uint64
+}
+
+// quotaAllocation is the memory, in megabytes, and instances allocated to
+// started apps.
+type quotaAllocation struct {
+	memory    uint64
+	instances uint64
+}
+
+// CheckQuotaHeadroom returns a QuotaExceededError when starting the
+// requested apps in the space would exceed a limit of the space quota or the
+// organization quota, given the apps already started in them.
+func (actor Actor) CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []QuotaRequest) (Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganization(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var orgQuota OrganizationQuota
+	if org.QuotaDefinitionGUID != "" {
+		orgQuota, warnings, err = actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var spaceQuota SpaceQuota
+	for _, space := range spaces {
+		if space.GUID == spaceGUID && space.SpaceQuotaDefinitionGUID != "" {
+			spaceQuota, warnings, err = actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+
+	apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(ccv2.Filter{
+		Type:     constant.OrganizationGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	replaced := map[string]bool{}
+	var requested quotaAllocation
+	var largestInstanceMemory uint64
+	for _, request := range requests {
+		replaced[request.ApplicationGUID] = true
+		requested.instances += uint64(request.Instances)
+		requested.memory += uint64(request.Instances) * request.MemoryInMB
+		if request.MemoryInMB > largestInstanceMemory {
+			largestInstanceMemory = request.MemoryInMB
+		}
+	}
+
+	var orgAllocation, spaceAllocation quotaAllocation
+	for _, app := range apps {
+		if replaced[app.GUID] || app.State != constant.ApplicationStarted {
+			continue
+		}
+
+		allocation := quotaAllocation{
+			memory:    uint64(app.Instances.Value) * app.Memory.Value,
+			instances: uint64(app.Instances.Value),
+		}
+		orgAllocation.memory += allocation.memory
+		orgAllocation.instances += allocation.instances
+		if app.SpaceGUID == spaceGUID {
+			spaceAllocation.memory += allocation.memory
+			spaceAllocation.instances += allocation.instances
+		}
+	}
+
+	spaceLimits := quotaLimits{
+		scope:          "space",
+		name:           spaceQuota.Name,
+		memory:         spaceQuota.MemoryLimit,
+		instanceMemory: spaceQuota.InstanceMemoryLimit,
+		appInstances:   spaceQuota.AppInstanceLimit,
+	}
+	err = spaceLimits.check(spaceAllocation, requested, largestInstanceMemory)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	orgLimits := quotaLimits{
+		scope:          "org",
+		name:           orgQuota.Name,
+		memory:         orgQuota.MemoryLimit,
+		instanceMemory: orgQuota.InstanceMemoryLimit,
+		appInstances:   orgQuota.AppInstanceLimit,
+	}
+	return allWarnings, orgLimits.check(orgAllocation, requested, largestInstanceMemory)
+}
+
+// quotaLimits are the limits of an organization or space quota.
+type quotaLimits struct {
+	scope          string
+	name           string
+	memory         types.NullByteSizeInMb
+	instanceMemory types.NullByteSizeInMb
+	appInstances   types.NullInt
+}
+
+func (limits quotaLimits) check(allocated quotaAllocation, requested quotaAllocation, largestInstanceMemory uint64) error {
+	if limits.name == "" {
+		return nil
+	}
+
+	if limits.instanceMemory.IsSet && largestInstanceMemory > limits.instanceMemory.Value {
+		return actionerror.QuotaExceededError{
+			Scope:     limits.scope,
+			QuotaName: limits.name,
+			Resource:  actionerror.QuotaResourceInstanceMemory,
+			Requested: largestInstanceMemory,
+			Available: limits.instanceMemory.Value,
+		}
+	}
+
+	if limits.memory.IsSet && allocated.memory+requested.memory > limits.memory.Value {
+		return actionerror.QuotaExceededError{
+			Scope:     limits.scope,
+			QuotaName: limits.name,
+			Resource:  actionerror.QuotaResourceMemory,
+			Requested: requested.memory,
+			Available: remaining(limits.memory.Value, allocated.memory),
+		}
+	}
+
+	if limits.appInstances.IsSet && allocated.instances+requested.instances > uint64(limits.appInstances.Value) {
+		return actionerror.QuotaExceededError{
+			Scope:     limits.scope,
+			QuotaName: limits.name,
+			Resource:  actionerror.QuotaResourceAppInstances,
+			Requested: requested.instances,
+			Available: remaining(uint64(limits.appInstances.Value), allocated.instances),
+		}
+	}
+
+	return nil
+}
+
+func remaining(limit uint64, allocated uint64) uint64 {
+	if allocated > limit {
+		return 0
+	}
+	return limit - allocated
+}
@@ -0,0 +1,191 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Headroom Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CheckQuotaHeadroom", func() {
+		var (
+			requests []QuotaRequest
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			requests = []QuotaRequest{{ApplicationGUID: "some-app-guid", Instances: 2, MemoryInMB: 256}}
+
+			fakeCloudControllerClient.GetOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", QuotaDefinitionGUID: "some-org-quota-guid"},
+				ccv2.Warnings{"org-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{
+					Name:                "some-org-quota",
+					MemoryLimit:         types.NullByteSizeInMb{IsSet: true, Value: 2048},
+					InstanceMemoryLimit: types.NullByteSizeInMb{IsSet: true, Value: 1024},
+					AppInstanceLimit:    types.NullInt{IsSet: true, Value: 10},
+				},
+				ccv2.Warnings{"org-quota-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "other-space-guid"},
+					{GUID: "some-space-guid", SpaceQuotaDefinitionGUID: "some-space-quota-guid"},
+				},
+				ccv2.Warnings{"spaces-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceQuotaDefinitionReturns(
+				ccv2.SpaceQuota{
+					Name:        "some-space-quota",
+					MemoryLimit: types.NullByteSizeInMb{IsSet: true, Value: 1024},
+				},
+				ccv2.Warnings{"space-quota-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{
+						GUID:      "some-app-guid",
+						SpaceGUID: "some-space-guid",
+						State:     constant.ApplicationStarted,
+						Instances: types.NullInt{IsSet: true, Value: 1},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+					},
+					{
+						GUID:      "space-app-guid",
+						SpaceGUID: "some-space-guid",
+						State:     constant.ApplicationStarted,
+						Instances: types.NullInt{IsSet: true, Value: 2},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 128},
+					},
+					{
+						GUID:      "other-space-app-guid",
+						SpaceGUID: "other-space-guid",
+						State:     constant.ApplicationStarted,
+						Instances: types.NullInt{IsSet: true, Value: 4},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+					},
+					{
+						GUID:      "stopped-app-guid",
+						SpaceGUID: "some-space-guid",
+						State:     constant.ApplicationStopped,
+						Instances: types.NullInt{IsSet: true, Value: 10},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 1024},
+					},
+				},
+				ccv2.Warnings{"apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.CheckQuotaHeadroom("some-org-guid", "some-space-guid", requests)
+		})
+
+		Context("when the requests fit in both quotas", func() {
+			It("returns the warnings and no error", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning", "spaces-warning", "space-quota-warning", "apps-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-org-quota-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaDefinitionArgsForCall(0)).To(Equal("some-space-quota-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.OrganizationGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org-guid"},
+				}))
+			})
+		})
+
+		Context("when the requests exceed the space memory limit", func() {
+			BeforeEach(func() {
+				requests = []QuotaRequest{{ApplicationGUID: "some-app-guid", Instances: 4, MemoryInMB: 256}}
+			})
+
+			It("returns a QuotaExceededError for the space", func() {
+				Expect(err).To(MatchError(actionerror.QuotaExceededError{
+					Scope:     "space",
+					QuotaName: "some-space-quota",
+					Resource:  actionerror.QuotaResourceMemory,
+					Requested: 1024,
+					Available: 768,
+				}))
+				Expect(warnings).To(ContainElement("apps-warning"))
+			})
+		})
+
+		Context("when a request exceeds the org instance memory limit", func() {
+			BeforeEach(func() {
+				requests = []QuotaRequest{{Instances: 1, MemoryInMB: 2048}}
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid"}}, nil, nil)
+			})
+
+			It("returns a QuotaExceededError for the org", func() {
+				Expect(err).To(MatchError(actionerror.QuotaExceededError{
+					Scope:     "org",
+					QuotaName: "some-org-quota",
+					Resource:  actionerror.QuotaResourceInstanceMemory,
+					Requested: 2048,
+					Available: 1024,
+				}))
+			})
+		})
+
+		Context("when the requests exceed the org app instance limit", func() {
+			BeforeEach(func() {
+				requests = []QuotaRequest{
+					{ApplicationGUID: "some-app-guid", Instances: 2},
+					{Instances: 3},
+				}
+			})
+
+			It("counts every request and the other started apps in the org", func() {
+				Expect(err).To(MatchError(actionerror.QuotaExceededError{
+					Scope:     "org",
+					QuotaName: "some-org-quota",
+					Resource:  actionerror.QuotaResourceAppInstances,
+					Requested: 5,
+					Available: 4,
+				}))
+			})
+		})
+
+		Context("when neither the org nor the space has a quota", func() {
+			BeforeEach(func() {
+				requests = []QuotaRequest{{Instances: 100, MemoryInMB: 100000}}
+				fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "some-org-guid"}, nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid"}}, nil, nil)
+			})
+
+			It("does not return an error", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaDefinitionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("apps-error"))
+				Expect(warnings).To(ContainElement("apps-warning"))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/resourcecache"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -26,16 +27,27 @@ var DefaultIgnoreLines = []string{
 type Resource ccv2.Resource
 
 // ResourceMatch returns a set of matched resources and unmatched resources in
-// the order they were given in allResources.
+// the order they were given in allResources. Resources the Cloud Controller
+// matched during previous pushes are matched without asking the Cloud
+// Controller again. Only resources the Cloud Controller matched are
+// remembered, since uploaded files are only added to its resource pool when
+// they are within the pool's size limits.
 func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resource, Warnings, error) {
+	remoteResources := actor.remoteResourceCache()
+
 	resourcesToSend := [][]ccv2.Resource{{}}
-	var currentList, sendCount int
+	var currentList, sendCount, cachedCount int
 	for _, resource := range allResources {
 		// Skip if resource is a directory, symlink, or empty file.
 		if resource.Size == 0 {
 			continue
 		}
 
+		if remoteResources != nil && remoteResources.Contains(resource.SHA1) {
+			cachedCount++
+			continue
+		}
+
 		resourcesToSend[currentList] = append(
 			resourcesToSend[currentList],
 			ccv2.Resource(resource),
@@ -50,6 +62,7 @@ func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resourc
 
 	log.WithFields(log.Fields{
 		"total_resources":    len(allResources),
+		"cached_resources":   cachedCount,
 		"resources_to_match": sendCount,
 		"chunks":             len(resourcesToSend),
 	}).Debug("sending resource match stats")
@@ -80,14 +93,50 @@ func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resourc
 	for _, resource := range allResources {
 		if _, ok := matchedCCResources[resource.SHA1]; ok {
 			matchedResources = append(matchedResources, resource)
+		} else if resource.Size > 0 && remoteResources != nil && remoteResources.Contains(resource.SHA1) {
+			matchedResources = append(matchedResources, resource)
 		} else {
 			unmatchedResources = append(unmatchedResources, resource)
 		}
 	}
 
+	if remoteResources != nil && len(matchedCCResources) > 0 {
+		for sha1 := range matchedCCResources {
+			remoteResources.Add(sha1)
+		}
+		actor.saveRemoteResourceCache(remoteResources)
+	}
+
 	return matchedResources, unmatchedResources, allWarnings, nil
 }
 
+// ClearResourceMatchCache forgets every resource the Cloud Controller is
+// remembered to have.
+func (actor Actor) ClearResourceMatchCache() {
+	remoteResources := actor.remoteResourceCache()
+	if remoteResources == nil {
+		return
+	}
+
+	remoteResources.Clear()
+	actor.saveRemoteResourceCache(remoteResources)
+}
+
+func (actor Actor) remoteResourceCache() *resourcecache.RemoteResources {
+	cacheDir := actor.Config.ResourceCacheDir()
+	if cacheDir == "" {
+		return nil
+	}
+
+	return resourcecache.LoadRemoteResources(cacheDir, actor.CloudControllerClient.API())
+}
+
+func (Actor) saveRemoteResourceCache(remoteResources *resourcecache.RemoteResources) {
+	if err := remoteResources.Save(); err != nil {
+		log.WithError(err).Warn("unable to save resource match cache")
+	}
+}
+
 func (Actor) actorToCCResources(resources []Resource) []ccv2.Resource {
 	apiResources := make([]ccv2.Resource, 0, len(resources)) // Explicitly done to prevent nils
 
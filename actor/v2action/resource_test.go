@@ -17,12 +17,14 @@ var _ = Describe("Resource Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
 		srcDir                    string
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 
 		var err error
 		srcDir, err = ioutil.TempDir("", "resource-actions-test")
@@ -144,6 +146,79 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 
+		Context("when the resource match cache is enabled", func() {
+			var cacheDir string
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "resource-match-cache")
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeConfig.ResourceCacheDirReturns(cacheDir)
+				fakeCloudControllerClient.APIReturns("https://api.example.com")
+
+				allResources = []Resource{
+					{Filename: "file-1", Mode: 0744, Size: 11, SHA1: "some-sha-1"},
+					{Filename: "file-2", Mode: 0744, Size: 0, SHA1: "some-sha-2"},
+					{Filename: "file-3", Mode: 0744, Size: 13, SHA1: "some-sha-3"},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cacheDir)).To(Succeed())
+			})
+
+			Context("when resources were previously matched", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateResourceMatchReturns(
+						[]ccv2.Resource{{Size: 13, SHA1: "some-sha-3"}},
+						nil,
+						nil,
+					)
+					_, _, _, err := actor.ResourceMatch(allResources)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("matches them without asking the Cloud Controller", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateResourceMatchCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.UpdateResourceMatchArgsForCall(1)).To(ConsistOf(
+						ccv2.Resource{Filename: "file-1", Mode: 0744, Size: 11, SHA1: "some-sha-1"},
+					))
+
+					Expect(matchedResources).To(ConsistOf(
+						Resource{Filename: "file-3", Mode: 0744, Size: 13, SHA1: "some-sha-3"},
+					))
+					Expect(unmatchedResources).To(ConsistOf(
+						Resource{Filename: "file-1", Mode: 0744, Size: 11, SHA1: "some-sha-1"},
+						Resource{Filename: "file-2", Mode: 0744, Size: 0, SHA1: "some-sha-2"},
+					))
+				})
+
+				Context("when the cache is cleared", func() {
+					BeforeEach(func() {
+						actor.ClearResourceMatchCache()
+					})
+
+					It("asks the Cloud Controller again", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.UpdateResourceMatchArgsForCall(1)).To(HaveLen(2))
+					})
+				})
+
+				Context("when a different Cloud Controller is targeted", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.APIReturns("https://api.other.example.com")
+					})
+
+					It("does not use the resources matched by the other Cloud Controller", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.UpdateResourceMatchArgsForCall(1)).To(HaveLen(2))
+					})
+				})
+			})
+		})
+
 		Context("when sending a large number of files/folders", func() {
 			BeforeEach(func() {
 				fakeCloudControllerClient.UpdateResourceMatchReturnsOnCall(
@@ -32,6 +32,10 @@ type Route struct {
 	Path      string
 	Port      types.NullInt
 	SpaceGUID string
+
+	// ServiceInstanceGUID is the GUID of the route service bound to the
+	// route, if any.
+	ServiceInstanceGUID string
 }
 
 func (r Route) RandomTCPPort() bool {
@@ -344,12 +348,13 @@ func ActorToCCRoute(route Route) ccv2.Route {
 
 func CCToActorRoute(ccv2Route ccv2.Route, domain Domain) Route {
 	return Route{
-		Domain:    domain,
-		GUID:      ccv2Route.GUID,
-		Host:      ccv2Route.Host,
-		Path:      ccv2Route.Path,
-		Port:      ccv2Route.Port,
-		SpaceGUID: ccv2Route.SpaceGUID,
+		Domain:              domain,
+		GUID:                ccv2Route.GUID,
+		Host:                ccv2Route.Host,
+		Path:                ccv2Route.Path,
+		Port:                ccv2Route.Port,
+		SpaceGUID:           ccv2Route.SpaceGUID,
+		ServiceInstanceGUID: ccv2Route.ServiceInstanceGUID,
 	}
 }
 
@@ -0,0 +1,199 @@
+package v2action
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// RouterGroup is a group of routers that share a set of domains.
+type RouterGroup router.RouterGroup
+
+// RouteDestination is an app a route is mapped to.
+type RouteDestination struct {
+	Application Application
+
+	// Port is the port the app receives the route's traffic on. It is not set
+	// when the app uses its default port.
+	Port types.NullInt
+
+	// RunningInstances is the number of instances of the app that are running.
+	RunningInstances int
+}
+
+// RouteDiagnosis is everything involved in routing traffic to a route.
+type RouteDiagnosis struct {
+	Route        Route
+	Destinations []RouteDestination
+
+	// RouteService is the service instance bound to the route, or empty when
+	// the route does not have a route service.
+	RouteService ServiceInstance
+
+	// RouterGroup is empty when the route's domain does not belong to a router
+	// group, or when the router group could not be read from the routing API.
+	RouterGroup RouterGroup
+}
+
+// HasRunningInstances returns true when at least one of the mapped apps has a
+// running instance.
+func (diagnosis RouteDiagnosis) HasRunningInstances() bool {
+	for _, destination := range diagnosis.Destinations {
+		if destination.RunningInstances > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteProbe is the result of connecting to a route from the local machine.
+type RouteProbe struct {
+	// Address is the URL or host:port that was probed.
+	Address string
+
+	// StatusCode is the status code of the HTTP response. It is 0 for TCP
+	// routes and when no response was received.
+	StatusCode int
+
+	// Duration is how long the request or connection took.
+	Duration time.Duration
+}
+
+// GetRouteDiagnosis returns the apps the route is mapped to, along with their
+// ports and running instances, the route service bound to the route and the
+// router group of the route's domain. Failing to read the router group is
+// returned as a warning since the routing API is usually restricted to
+// admins.
+func (actor Actor) GetRouteDiagnosis(route Route) (RouteDiagnosis, Warnings, error) {
+	var allWarnings Warnings
+	diagnosis := RouteDiagnosis{Route: route}
+
+	mappings, warnings, err := actor.CloudControllerClient.GetRouteMappings(ccv2.Filter{
+		Type:     constant.RouteGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{route.GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RouteDiagnosis{}, allWarnings, err
+	}
+
+	for _, mapping := range mappings {
+		destination, destinationWarnings, err := actor.getRouteDestination(mapping)
+		allWarnings = append(allWarnings, destinationWarnings...)
+		if err != nil {
+			return RouteDiagnosis{}, allWarnings, err
+		}
+		diagnosis.Destinations = append(diagnosis.Destinations, destination)
+	}
+
+	sort.Slice(diagnosis.Destinations, func(i int, j int) bool {
+		return diagnosis.Destinations[i].Application.Name < diagnosis.Destinations[j].Application.Name
+	})
+
+	if route.ServiceInstanceGUID != "" {
+		serviceInstance, serviceWarnings, err := actor.GetServiceInstance(route.ServiceInstanceGUID)
+		allWarnings = append(allWarnings, serviceWarnings...)
+		if err != nil {
+			return RouteDiagnosis{}, allWarnings, err
+		}
+		diagnosis.RouteService = serviceInstance
+	}
+
+	if route.Domain.RouterGroupGUID != "" && actor.RouterClient != nil {
+		routerGroups, err := actor.RouterClient.GetRouterGroups()
+		if err != nil {
+			allWarnings = append(allWarnings, fmt.Sprintf("Unable to get router group %s: %s", route.Domain.RouterGroupGUID, err))
+		}
+
+		for _, routerGroup := range routerGroups {
+			if routerGroup.GUID == route.Domain.RouterGroupGUID {
+				diagnosis.RouterGroup = RouterGroup(routerGroup)
+				break
+			}
+		}
+	}
+
+	return diagnosis, allWarnings, nil
+}
+
+// ProbeRoute connects to the route from the local machine. HTTP routes are
+// requested over plain HTTP without following redirects, TCP routes are only
+// connected to. The error is returned when no response was received within
+// timeout.
+func (actor Actor) ProbeRoute(route Route, timeout time.Duration) (RouteProbe, error) {
+	if route.Domain.IsTCP() {
+		probe := RouteProbe{Address: net.JoinHostPort(route.Domain.Name, strconv.Itoa(route.Port.Value))}
+
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", probe.Address, timeout)
+		probe.Duration = time.Since(start)
+		if err != nil {
+			return probe, err
+		}
+
+		return probe, conn.Close()
+	}
+
+	probe := RouteProbe{Address: "http://" + route.String()}
+	client := &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	response, err := client.Get(probe.Address)
+	probe.Duration = time.Since(start)
+	if err != nil {
+		return probe, err
+	}
+	defer response.Body.Close()
+
+	probe.StatusCode = response.StatusCode
+	return probe, nil
+}
+
+func (actor Actor) getRouteDestination(mapping ccv2.RouteMapping) (RouteDestination, Warnings, error) {
+	app, allWarnings, err := actor.GetApplication(mapping.AppGUID)
+	if err != nil {
+		return RouteDestination{}, allWarnings, err
+	}
+
+	destination := RouteDestination{
+		Application: app,
+		Port:        mapping.AppPort,
+	}
+
+	if !app.Started() {
+		return destination, allWarnings, nil
+	}
+
+	instances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+	case actionerror.ApplicationInstancesNotFoundError:
+		return destination, allWarnings, nil
+	default:
+		return RouteDestination{}, allWarnings, err
+	}
+
+	for _, instance := range instances {
+		if instance.Running() {
+			destination.RunningInstances++
+		}
+	}
+
+	return destination, allWarnings, nil
+}
@@ -0,0 +1,246 @@
+package v2action_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Diagnosis Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeRouterClient          *v2actionfakes.FakeRouterClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeRouterClient = new(v2actionfakes.FakeRouterClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		actor.RouterClient = fakeRouterClient
+	})
+
+	Describe("GetRouteDiagnosis", func() {
+		var (
+			route     Route
+			diagnosis RouteDiagnosis
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				GUID: "some-route-guid",
+				Host: "some-host",
+				Domain: Domain{
+					Name:            "some-domain.com",
+					RouterGroupGUID: "http-group-guid",
+					RouterGroupType: constant.HTTPRouterGroup,
+				},
+				ServiceInstanceGUID: "some-route-service-guid",
+			}
+
+			fakeCloudControllerClient.GetRouteMappingsReturns(
+				[]ccv2.RouteMapping{
+					{AppGUID: "stopped-app-guid", RouteGUID: "some-route-guid"},
+					{AppGUID: "started-app-guid", RouteGUID: "some-route-guid", AppPort: types.NullInt{IsSet: true, Value: 9090}},
+				},
+				ccv2.Warnings{"mappings-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+				if guid == "started-app-guid" {
+					return ccv2.Application{GUID: guid, Name: "b-app", State: constant.ApplicationStarted}, ccv2.Warnings{"app-warning"}, nil
+				}
+				return ccv2.Application{GUID: guid, Name: "a-app", State: constant.ApplicationStopped}, ccv2.Warnings{"app-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(
+				map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: constant.ApplicationInstanceRunning},
+					1: {ID: 1, State: constant.ApplicationInstanceCrashed},
+				},
+				ccv2.Warnings{"instances-warning"}, nil)
+			fakeCloudControllerClient.GetServiceInstanceReturns(
+				ccv2.ServiceInstance{GUID: "some-route-service-guid", Name: "some-route-service"},
+				ccv2.Warnings{"service-warning"}, nil)
+			fakeRouterClient.GetRouterGroupsReturns(
+				[]router.RouterGroup{
+					{GUID: "tcp-group-guid", Name: "default-tcp", Type: "tcp", ReservedPorts: "1024-1033"},
+					{GUID: "http-group-guid", Name: "default-http", Type: "http"},
+				}, nil)
+		})
+
+		JustBeforeEach(func() {
+			diagnosis, warnings, err = actor.GetRouteDiagnosis(route)
+		})
+
+		It("returns the mapped apps, route service and router group", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("mappings-warning", "app-warning", "app-warning", "instances-warning", "service-warning"))
+
+			Expect(diagnosis.Route).To(Equal(route))
+			Expect(diagnosis.Destinations).To(Equal([]RouteDestination{
+				{
+					Application: Application{GUID: "stopped-app-guid", Name: "a-app", State: constant.ApplicationStopped},
+				},
+				{
+					Application:      Application{GUID: "started-app-guid", Name: "b-app", State: constant.ApplicationStarted},
+					Port:             types.NullInt{IsSet: true, Value: 9090},
+					RunningInstances: 1,
+				},
+			}))
+			Expect(diagnosis.HasRunningInstances()).To(BeTrue())
+			Expect(diagnosis.RouteService.Name).To(Equal("some-route-service"))
+			Expect(diagnosis.RouterGroup).To(Equal(RouterGroup{GUID: "http-group-guid", Name: "default-http", Type: "http"}))
+
+			Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.RouteGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-route-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesArgsForCall(0)).To(Equal("started-app-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-route-service-guid"))
+		})
+
+		Context("when the started app has no instances yet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(nil, nil, ccerror.NotStagedError{})
+			})
+
+			It("reports no running instances", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(diagnosis.HasRunningInstances()).To(BeFalse())
+			})
+		})
+
+		Context("when the route has no route service and no router group", func() {
+			BeforeEach(func() {
+				route.ServiceInstanceGUID = ""
+				route.Domain.RouterGroupGUID = ""
+			})
+
+			It("does not look them up", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(diagnosis.RouteService).To(Equal(ServiceInstance{}))
+				Expect(diagnosis.RouterGroup).To(Equal(RouterGroup{}))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+				Expect(fakeRouterClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there is no routing API", func() {
+			BeforeEach(func() {
+				actor.RouterClient = nil
+			})
+
+			It("leaves the router group empty", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(diagnosis.RouterGroup).To(Equal(RouterGroup{}))
+			})
+		})
+
+		Context("when the routing API returns an error", func() {
+			BeforeEach(func() {
+				fakeRouterClient.GetRouterGroupsReturns(nil, errors.New("forbidden"))
+			})
+
+			It("returns the error as a warning", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("Unable to get router group http-group-guid: forbidden"))
+				Expect(diagnosis.RouterGroup).To(Equal(RouterGroup{}))
+			})
+		})
+
+		Context("when getting the route mappings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"mappings-warning"}, errors.New("mappings error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("mappings error"))
+				Expect(warnings).To(ConsistOf("mappings-warning"))
+			})
+		})
+	})
+
+	Describe("ProbeRoute", func() {
+		Context("when the route is an HTTP route", func() {
+			var server *httptest.Server
+
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == "/some-path" {
+						http.Redirect(w, r, "/login", http.StatusFound)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				}))
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("returns the status code without following redirects", func() {
+				address := strings.TrimPrefix(server.URL, "http://")
+				probe, err := actor.ProbeRoute(Route{Domain: Domain{Name: address}, Path: "/some-path"}, time.Second)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(probe.Address).To(Equal(server.URL + "/some-path"))
+				Expect(probe.StatusCode).To(Equal(http.StatusFound))
+				Expect(probe.Duration).To(BeNumerically(">", 0))
+			})
+		})
+
+		Context("when the route is a TCP route", func() {
+			var listener net.Listener
+
+			BeforeEach(func() {
+				var err error
+				listener, err = net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				listener.Close()
+			})
+
+			It("connects to the route's port", func() {
+				port := listener.Addr().(*net.TCPAddr).Port
+				route := Route{
+					Domain: Domain{Name: "127.0.0.1", RouterGroupType: constant.TCPRouterGroup},
+					Port:   types.NullInt{IsSet: true, Value: port},
+				}
+
+				probe, err := actor.ProbeRoute(route, time.Second)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(probe.Address).To(Equal(listener.Addr().String()))
+				Expect(probe.StatusCode).To(Equal(0))
+			})
+
+			Context("when nothing is listening", func() {
+				It("returns the error", func() {
+					port := listener.Addr().(*net.TCPAddr).Port
+					listener.Close()
+
+					_, err := actor.ProbeRoute(Route{
+						Domain: Domain{Name: "127.0.0.1", RouterGroupType: constant.TCPRouterGroup},
+						Port:   types.NullInt{IsSet: true, Value: port},
+					}, time.Second)
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/router"
+
+//go:generate counterfeiter . RouterClient
+
+// RouterClient is the client used to talk to the routing API.
+type RouterClient interface {
+	GetRouterGroups() ([]router.RouterGroup, error)
+}
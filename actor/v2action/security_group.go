@@ -112,6 +112,7 @@ func (actor Actor) GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeSta
 		securityGroup := SecurityGroup{
 			GUID:           s.GUID,
 			Name:           s.Name,
+			Rules:          s.Rules,
 			RunningDefault: s.RunningDefault,
 			StagingDefault: s.StagingDefault,
 		}
@@ -0,0 +1,319 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+	log "github.com/sirupsen/logrus"
+)
+
+// SecurityGroupSpaceBinding is a binding of a security group to a space for a
+// single lifecycle phase.
+type SecurityGroupSpaceBinding struct {
+	OrganizationName string
+	SpaceName        string
+	SpaceGUID        string
+	Lifecycle        constant.SecurityGroupLifecycle
+}
+
+// SecurityGroupChanges are the changes required to bring an existing security
+// group in line with its declarative description.
+type SecurityGroupChanges struct {
+	Name string
+	GUID string
+
+	// Create is true when the security group does not exist yet.
+	Create bool
+
+	CurrentRules []ccv2.SecurityGroupRule
+	Rules        []ccv2.SecurityGroupRule
+
+	CurrentBindings []SecurityGroupSpaceBinding
+	Bindings        []SecurityGroupSpaceBinding
+}
+
+// BindingsToAdd returns the desired space bindings that do not exist yet.
+func (changes SecurityGroupChanges) BindingsToAdd() []SecurityGroupSpaceBinding {
+	return subtractSecurityGroupSpaceBindings(changes.Bindings, changes.CurrentBindings)
+}
+
+// BindingsToRemove returns the existing space bindings that are no longer
+// desired.
+func (changes SecurityGroupChanges) BindingsToRemove() []SecurityGroupSpaceBinding {
+	return subtractSecurityGroupSpaceBindings(changes.CurrentBindings, changes.Bindings)
+}
+
+// RulesChanged returns true when the desired rules differ from the current
+// rules, ignoring their order.
+func (changes SecurityGroupChanges) RulesChanged() bool {
+	if len(changes.CurrentRules) != len(changes.Rules) {
+		return true
+	}
+
+	current := sortedSecurityGroupRules(changes.CurrentRules)
+	desired := sortedSecurityGroupRules(changes.Rules)
+	for i := range current {
+		if !securityGroupRulesEqual(current[i], desired[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasChanges returns true when applying the changes would modify the
+// security group.
+func (changes SecurityGroupChanges) HasChanges() bool {
+	return changes.Create ||
+		changes.RulesChanged() ||
+		len(changes.BindingsToAdd()) > 0 ||
+		len(changes.BindingsToRemove()) > 0
+}
+
+// ReadSecurityGroupsFile reads the declarative security groups file at the
+// provided path.
+func (Actor) ReadSecurityGroupsFile(pathToFile string) ([]securitygroups.SecurityGroup, error) {
+	// Cover method to make testing easier
+	return securitygroups.ReadSecurityGroups(pathToFile)
+}
+
+// GetSecurityGroupsChanges compares the provided security groups against the
+// security groups, rules and space bindings that currently exist. Security
+// groups that are not described are left untouched.
+func (actor Actor) GetSecurityGroupsChanges(desiredSecurityGroups []securitygroups.SecurityGroup) ([]SecurityGroupChanges, Warnings, error) {
+	existing, allWarnings, err := actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(true)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	existingGroups := map[string]SecurityGroup{}
+	existingBindings := map[string][]SecurityGroupSpaceBinding{}
+	for _, secGroupOrgSpace := range existing {
+		existingGroups[secGroupOrgSpace.SecurityGroup.Name] = *secGroupOrgSpace.SecurityGroup
+		if secGroupOrgSpace.Space.GUID == "" {
+			continue
+		}
+		existingBindings[secGroupOrgSpace.SecurityGroup.Name] = append(existingBindings[secGroupOrgSpace.SecurityGroup.Name], SecurityGroupSpaceBinding{
+			OrganizationName: secGroupOrgSpace.Organization.Name,
+			SpaceName:        secGroupOrgSpace.Space.Name,
+			SpaceGUID:        secGroupOrgSpace.Space.GUID,
+			Lifecycle:        secGroupOrgSpace.Lifecycle,
+		})
+	}
+
+	resolver := spaceResolver{actor: actor, orgs: map[string]Organization{}, spaces: map[string]Space{}}
+
+	var allChanges []SecurityGroupChanges
+	for _, desired := range desiredSecurityGroups {
+		changes := SecurityGroupChanges{
+			Name:  desired.Name,
+			Rules: []ccv2.SecurityGroupRule{},
+		}
+		for _, rule := range desired.Rules {
+			ccRule := ccv2.SecurityGroupRule{
+				Description: rule.Description,
+				Destination: rule.Destination,
+				Ports:       rule.Ports,
+				Protocol:    rule.Protocol,
+				Log:         types.NullBool{IsSet: rule.Log, Value: rule.Log},
+			}
+			ccRule.Type.ParseIntValue(rule.Type)
+			ccRule.Code.ParseIntValue(rule.Code)
+			changes.Rules = append(changes.Rules, ccRule)
+		}
+
+		if current, ok := existingGroups[desired.Name]; ok {
+			changes.GUID = current.GUID
+			changes.CurrentRules = current.Rules
+		} else {
+			log.WithField("security_group", desired.Name).Debug("security group does not exist")
+			changes.Create = true
+		}
+
+		for _, lifecycleSpaces := range []struct {
+			lifecycle constant.SecurityGroupLifecycle
+			spaces    []securitygroups.Space
+		}{
+			{constant.SecurityGroupLifecycleRunning, desired.RunningSpaces},
+			{constant.SecurityGroupLifecycleStaging, desired.StagingSpaces},
+		} {
+			for _, desiredSpace := range lifecycleSpaces.spaces {
+				space, warnings, spaceErr := resolver.resolve(desiredSpace.Org, desiredSpace.Space)
+				allWarnings = append(allWarnings, warnings...)
+				if spaceErr != nil {
+					return nil, allWarnings, spaceErr
+				}
+
+				changes.Bindings = append(changes.Bindings, SecurityGroupSpaceBinding{
+					OrganizationName: desiredSpace.Org,
+					SpaceName:        space.Name,
+					SpaceGUID:        space.GUID,
+					Lifecycle:        lifecycleSpaces.lifecycle,
+				})
+			}
+		}
+
+		changes.CurrentBindings = existingBindings[desired.Name]
+
+		allChanges = append(allChanges, changes)
+	}
+
+	return allChanges, allWarnings, nil
+}
+
+// ApplySecurityGroupChanges creates or updates the security group and binds
+// and unbinds it from spaces as described by the provided changes.
+func (actor Actor) ApplySecurityGroupChanges(changes SecurityGroupChanges) (Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroup := ccv2.SecurityGroup{
+		GUID:  changes.GUID,
+		Name:  changes.Name,
+		Rules: changes.Rules,
+	}
+
+	switch {
+	case changes.Create:
+		createdSecurityGroup, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(securityGroup)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		securityGroup.GUID = createdSecurityGroup.GUID
+	case changes.RulesChanged():
+		_, warnings, err := actor.CloudControllerClient.UpdateSecurityGroup(securityGroup)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, binding := range changes.BindingsToAdd() {
+		warnings, err := actor.BindSecurityGroupToSpace(securityGroup.GUID, binding.SpaceGUID, binding.Lifecycle)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, binding := range changes.BindingsToRemove() {
+		var (
+			warnings ccv2.Warnings
+			err      error
+		)
+		if binding.Lifecycle == constant.SecurityGroupLifecycleStaging {
+			warnings, err = actor.CloudControllerClient.DeleteSecurityGroupStagingSpace(securityGroup.GUID, binding.SpaceGUID)
+		} else {
+			warnings, err = actor.CloudControllerClient.DeleteSecurityGroupSpace(securityGroup.GUID, binding.SpaceGUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// spaceResolver looks up spaces by org and space name, caching the results
+// so that every org and space is only requested once.
+type spaceResolver struct {
+	actor  Actor
+	orgs   map[string]Organization
+	spaces map[string]Space
+}
+
+func (resolver spaceResolver) resolve(orgName string, spaceName string) (Space, Warnings, error) {
+	var allWarnings Warnings
+
+	org, ok := resolver.orgs[orgName]
+	if !ok {
+		var (
+			warnings Warnings
+			err      error
+		)
+		org, warnings, err = resolver.actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Space{}, allWarnings, err
+		}
+		resolver.orgs[orgName] = org
+	}
+
+	key := org.GUID + "/" + spaceName
+	if space, ok := resolver.spaces[key]; ok {
+		return space, allWarnings, nil
+	}
+
+	space, warnings, err := resolver.actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Space{}, allWarnings, err
+	}
+	resolver.spaces[key] = space
+
+	return space, allWarnings, nil
+}
+
+func subtractSecurityGroupSpaceBindings(bindings []SecurityGroupSpaceBinding, bindingsToSubtract []SecurityGroupSpaceBinding) []SecurityGroupSpaceBinding {
+	var difference []SecurityGroupSpaceBinding
+	for _, binding := range bindings {
+		found := false
+		for _, bindingToSubtract := range bindingsToSubtract {
+			if binding.SpaceGUID == bindingToSubtract.SpaceGUID && binding.Lifecycle == bindingToSubtract.Lifecycle {
+				found = true
+				break
+			}
+		}
+		if !found {
+			difference = append(difference, binding)
+		}
+	}
+	return difference
+}
+
+func sortedSecurityGroupRules(rules []ccv2.SecurityGroupRule) []ccv2.SecurityGroupRule {
+	sorted := append([]ccv2.SecurityGroupRule{}, rules...)
+	sort.Slice(sorted, func(i, j int) bool {
+		switch {
+		case sorted[i].Protocol != sorted[j].Protocol:
+			return sorted[i].Protocol < sorted[j].Protocol
+		case sorted[i].Destination != sorted[j].Destination:
+			return sorted[i].Destination < sorted[j].Destination
+		case sorted[i].Ports != sorted[j].Ports:
+			return sorted[i].Ports < sorted[j].Ports
+		case icmpValue(sorted[i].Type) != icmpValue(sorted[j].Type):
+			return icmpValue(sorted[i].Type) < icmpValue(sorted[j].Type)
+		case icmpValue(sorted[i].Code) != icmpValue(sorted[j].Code):
+			return icmpValue(sorted[i].Code) < icmpValue(sorted[j].Code)
+		case sorted[i].Log.Value != sorted[j].Log.Value:
+			return !sorted[i].Log.Value
+		}
+		return sorted[i].Description < sorted[j].Description
+	})
+	return sorted
+}
+
+// securityGroupRulesEqual compares every field of the two rules. A rule that
+// does not set log is the same as a rule that disables it, and a rule that
+// does not set the ICMP type or code is the same as one that sets it to -1.
+func securityGroupRulesEqual(rule ccv2.SecurityGroupRule, other ccv2.SecurityGroupRule) bool {
+	return rule.Protocol == other.Protocol &&
+		rule.Destination == other.Destination &&
+		rule.Ports == other.Ports &&
+		icmpValue(rule.Type) == icmpValue(other.Type) &&
+		icmpValue(rule.Code) == icmpValue(other.Code) &&
+		rule.Log.Value == other.Log.Value &&
+		rule.Description == other.Description
+}
+
+// icmpValue returns the ICMP type or code, treating an unset value as -1,
+// which matches every type or code.
+func icmpValue(value types.NullInt) int {
+	if !value.IsSet {
+		return -1
+	}
+	return value.Value
+}
@@ -0,0 +1,347 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Changes Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("SecurityGroupChanges", func() {
+		Describe("RulesChanged", func() {
+			It("ignores the order of the rules", func() {
+				changes := SecurityGroupChanges{
+					CurrentRules: []ccv2.SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8"},
+						{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+					},
+					Rules: []ccv2.SecurityGroupRule{
+						{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						{Protocol: "tcp", Destination: "10.0.0.0/8"},
+					},
+				}
+				Expect(changes.RulesChanged()).To(BeFalse())
+				Expect(changes.HasChanges()).To(BeFalse())
+			})
+
+			It("detects modified rules", func() {
+				changes := SecurityGroupChanges{
+					CurrentRules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8"}},
+					Rules:        []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/16"}},
+				}
+				Expect(changes.RulesChanged()).To(BeTrue())
+				Expect(changes.HasChanges()).To(BeTrue())
+			})
+
+			It("detects modified icmp types, codes and logging", func() {
+				current := ccv2.SecurityGroupRule{
+					Protocol:    "icmp",
+					Destination: "10.0.0.0/8",
+					Type:        types.NullInt{IsSet: true, Value: 0},
+					Code:        types.NullInt{IsSet: true, Value: 0},
+				}
+
+				changedType := current
+				changedType.Type = types.NullInt{IsSet: true, Value: 8}
+				changedCode := current
+				changedCode.Code = types.NullInt{IsSet: true, Value: 1}
+				changedLog := current
+				changedLog.Log = types.NullBool{IsSet: true, Value: true}
+
+				for _, desired := range []ccv2.SecurityGroupRule{changedType, changedCode, changedLog} {
+					changes := SecurityGroupChanges{
+						CurrentRules: []ccv2.SecurityGroupRule{current},
+						Rules:        []ccv2.SecurityGroupRule{desired},
+					}
+					Expect(changes.RulesChanged()).To(BeTrue())
+				}
+			})
+
+			It("treats an unset log the same as a disabled log", func() {
+				changes := SecurityGroupChanges{
+					CurrentRules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8", Log: types.NullBool{IsSet: true, Value: false}}},
+					Rules:        []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}},
+				}
+				Expect(changes.RulesChanged()).To(BeFalse())
+			})
+
+			It("treats an unset icmp type or code the same as -1", func() {
+				changes := SecurityGroupChanges{
+					CurrentRules: []ccv2.SecurityGroupRule{
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{IsSet: true, Value: 0}},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{IsSet: true, Value: -1}, Code: types.NullInt{IsSet: true, Value: -1}},
+					},
+					Rules: []ccv2.SecurityGroupRule{
+						{Protocol: "icmp", Destination: "10.0.0.0/8"},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+					},
+				}
+				Expect(changes.RulesChanged()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("GetSecurityGroupsChanges", func() {
+		var (
+			desiredSecurityGroups []securitygroups.SecurityGroup
+			changes               []SecurityGroupChanges
+			warnings              Warnings
+			executeErr            error
+		)
+
+		BeforeEach(func() {
+			icmpType, icmpCode := 8, 0
+			desiredSecurityGroups = []securitygroups.SecurityGroup{
+				{
+					Name:          "existing-group",
+					Rules:         []securitygroups.Rule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}},
+					RunningSpaces: []securitygroups.Space{{Org: "org-1", Space: "space-1"}},
+					StagingSpaces: []securitygroups.Space{{Org: "org-1", Space: "space-2"}},
+				},
+				{
+					Name: "new-group",
+					Rules: []securitygroups.Rule{
+						{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: &icmpType, Code: &icmpCode, Log: true},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID:  "existing-group-guid",
+						Name:  "existing-group",
+						Rules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "80"}},
+					},
+					{
+						GUID: "other-group-guid",
+						Name: "other-group",
+					},
+				},
+				ccv2.Warnings{"get-security-groups-warning"},
+				nil)
+			fakeCloudControllerClient.GetSecurityGroupSpacesStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+				if securityGroupGUID == "existing-group-guid" {
+					return []ccv2.Space{
+						{GUID: "space-1-guid", Name: "space-1", OrganizationGUID: "org-1-guid"},
+						{GUID: "space-3-guid", Name: "space-3", OrganizationGUID: "org-1-guid"},
+					}, nil, nil
+				}
+				return []ccv2.Space{{GUID: "space-3-guid", Name: "space-3", OrganizationGUID: "org-1-guid"}}, nil, nil
+			}
+			fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-1-guid", Name: "org-1"}, nil, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-1-guid", Name: "org-1"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesStub = func(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
+				name := filters[0].Values[0]
+				return []ccv2.Space{{GUID: name + "-guid", Name: name}}, ccv2.Warnings{"get-space-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetSecurityGroupsChanges(desiredSecurityGroups)
+		})
+
+		It("returns the changes for the described security groups only", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]SecurityGroupChanges{
+				{
+					Name:         "existing-group",
+					GUID:         "existing-group-guid",
+					CurrentRules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "80"}},
+					Rules:        []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}},
+					CurrentBindings: []SecurityGroupSpaceBinding{
+						{OrganizationName: "org-1", SpaceName: "space-1", SpaceGUID: "space-1-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{OrganizationName: "org-1", SpaceName: "space-3", SpaceGUID: "space-3-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+					},
+					Bindings: []SecurityGroupSpaceBinding{
+						{OrganizationName: "org-1", SpaceName: "space-1", SpaceGUID: "space-1-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{OrganizationName: "org-1", SpaceName: "space-2", SpaceGUID: "space-2-guid", Lifecycle: constant.SecurityGroupLifecycleStaging},
+					},
+				},
+				{
+					Name:   "new-group",
+					Create: true,
+					Rules: []ccv2.SecurityGroupRule{
+						{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						{
+							Protocol:    "icmp",
+							Destination: "10.0.0.0/8",
+							Type:        types.NullInt{IsSet: true, Value: 8},
+							Code:        types.NullInt{IsSet: true, Value: 0},
+							Log:         types.NullBool{IsSet: true, Value: true},
+						},
+					},
+				},
+			}))
+			Expect(changes[0].BindingsToAdd()).To(Equal([]SecurityGroupSpaceBinding{
+				{OrganizationName: "org-1", SpaceName: "space-2", SpaceGUID: "space-2-guid", Lifecycle: constant.SecurityGroupLifecycleStaging},
+			}))
+			Expect(changes[0].BindingsToRemove()).To(Equal([]SecurityGroupSpaceBinding{
+				{OrganizationName: "org-1", SpaceName: "space-3", SpaceGUID: "space-3-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+			}))
+			Expect(warnings).To(ContainElement("get-security-groups-warning"))
+			Expect(warnings).To(ContainElement("get-org-warning"))
+			Expect(warnings).To(ContainElement("get-space-warning"))
+
+			Expect(fakeCloudControllerClient.GetSecurityGroupStagingSpacesCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+		})
+
+		Context("when a described space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesStub = nil
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "space-1"}))
+				Expect(warnings).To(ContainElement("get-space-warning"))
+			})
+		})
+
+		Context("when getting the existing security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-security-groups-warning"}, errors.New("get-security-groups-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-security-groups-error"))
+				Expect(warnings).To(ConsistOf("get-security-groups-warning"))
+			})
+		})
+	})
+
+	Describe("ApplySecurityGroupChanges", func() {
+		var (
+			changes    SecurityGroupChanges
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySecurityGroupChanges(changes)
+		})
+
+		Context("when the security group needs to be created", func() {
+			BeforeEach(func() {
+				changes = SecurityGroupChanges{
+					Name:   "new-group",
+					Create: true,
+					Rules:  []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}},
+					Bindings: []SecurityGroupSpaceBinding{
+						{SpaceGUID: "space-1-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{SpaceGUID: "space-2-guid", Lifecycle: constant.SecurityGroupLifecycleStaging},
+					},
+				}
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{GUID: "new-group-guid"}, ccv2.Warnings{"create-warning"}, nil)
+				fakeCloudControllerClient.UpdateSecurityGroupSpaceReturns(ccv2.Warnings{"bind-running-warning"}, nil)
+				fakeCloudControllerClient.UpdateSecurityGroupStagingSpaceReturns(ccv2.Warnings{"bind-staging-warning"}, nil)
+			})
+
+			It("creates the security group and binds it to the spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "bind-running-warning", "bind-staging-warning"))
+
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)).To(Equal(ccv2.SecurityGroup{
+					Name:  "new-group",
+					Rules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}},
+				}))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(0))
+
+				securityGroupGUID, spaceGUID := fakeCloudControllerClient.UpdateSecurityGroupSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("new-group-guid"))
+				Expect(spaceGUID).To(Equal("space-1-guid"))
+				securityGroupGUID, spaceGUID = fakeCloudControllerClient.UpdateSecurityGroupStagingSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("new-group-guid"))
+				Expect(spaceGUID).To(Equal("space-2-guid"))
+			})
+
+			Context("when creating the security group fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, errors.New("create-error"))
+				})
+
+				It("returns the error and does not bind any spaces", func() {
+					Expect(executeErr).To(MatchError("create-error"))
+					Expect(warnings).To(ConsistOf("create-warning"))
+					Expect(fakeCloudControllerClient.UpdateSecurityGroupSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the rules changed and spaces need to be unbound", func() {
+			BeforeEach(func() {
+				changes = SecurityGroupChanges{
+					Name:         "existing-group",
+					GUID:         "existing-group-guid",
+					CurrentRules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}},
+					Rules:        []ccv2.SecurityGroupRule{},
+					CurrentBindings: []SecurityGroupSpaceBinding{
+						{SpaceGUID: "space-1-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+						{SpaceGUID: "space-2-guid", Lifecycle: constant.SecurityGroupLifecycleStaging},
+					},
+				}
+				fakeCloudControllerClient.UpdateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, nil)
+				fakeCloudControllerClient.DeleteSecurityGroupSpaceReturns(ccv2.Warnings{"unbind-running-warning"}, nil)
+				fakeCloudControllerClient.DeleteSecurityGroupStagingSpaceReturns(ccv2.Warnings{"unbind-staging-warning"}, nil)
+			})
+
+			It("updates the rules and unbinds the spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning", "unbind-running-warning", "unbind-staging-warning"))
+
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)).To(Equal(ccv2.SecurityGroup{
+					GUID:  "existing-group-guid",
+					Name:  "existing-group",
+					Rules: []ccv2.SecurityGroupRule{},
+				}))
+
+				securityGroupGUID, spaceGUID := fakeCloudControllerClient.DeleteSecurityGroupSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("existing-group-guid"))
+				Expect(spaceGUID).To(Equal("space-1-guid"))
+				securityGroupGUID, spaceGUID = fakeCloudControllerClient.DeleteSecurityGroupStagingSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("existing-group-guid"))
+				Expect(spaceGUID).To(Equal("space-2-guid"))
+			})
+
+			Context("when unbinding a space fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteSecurityGroupSpaceReturns(ccv2.Warnings{"unbind-running-warning"}, errors.New("unbind-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("unbind-error"))
+					Expect(warnings).To(ConsistOf("update-warning", "unbind-running-warning"))
+					Expect(fakeCloudControllerClient.DeleteSecurityGroupStagingSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+)
+
+// SecurityGroupEgressRule is a rule of a running security group and the name
+// of that group.
+type SecurityGroupEgressRule struct {
+	SecurityGroupName string
+	Rule              securitygroups.Rule
+}
+
+// GetSpaceRunningSecurityGroupRules returns the rules of the running security
+// groups that apply to the provided space, including the platform wide running
+// security groups.
+func (actor Actor) GetSpaceRunningSecurityGroupRules(spaceGUID string) ([]SecurityGroupEgressRule, Warnings, error) {
+	defaultSecurityGroups, allWarnings, err := actor.CloudControllerClient.GetRunningSecurityGroups()
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	spaceSecurityGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	var securityGroups []SecurityGroup
+	for _, securityGroup := range defaultSecurityGroups {
+		securityGroups = append(securityGroups, SecurityGroup(securityGroup))
+	}
+	securityGroups = append(securityGroups, spaceSecurityGroups...)
+
+	var (
+		rules []SecurityGroupEgressRule
+		seen  = map[string]bool{}
+	)
+	for _, securityGroup := range securityGroups {
+		if seen[securityGroup.GUID] {
+			continue
+		}
+		seen[securityGroup.GUID] = true
+
+		for _, ccRule := range securityGroup.Rules {
+			rules = append(rules, SecurityGroupEgressRule{
+				SecurityGroupName: securityGroup.Name,
+				Rule: securitygroups.Rule{
+					Protocol:    ccRule.Protocol,
+					Destination: ccRule.Destination,
+					Ports:       ccRule.Ports,
+					Type:        nullIntToPointer(ccRule.Type),
+					Code:        nullIntToPointer(ccRule.Code),
+					Log:         ccRule.Log.Value,
+					Description: ccRule.Description,
+				},
+			})
+		}
+	}
+
+	return rules, Warnings(allWarnings), nil
+}
+
+func nullIntToPointer(value types.NullInt) *int {
+	if !value.IsSet {
+		return nil
+	}
+	return &value.Value
+}
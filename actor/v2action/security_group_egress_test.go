@@ -0,0 +1,112 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Egress Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSpaceRunningSecurityGroupRules", func() {
+		var (
+			rules      []SecurityGroupEgressRule
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			rules, warnings, executeErr = actor.GetSpaceRunningSecurityGroupRules("some-space-guid")
+		})
+
+		Context("when the security groups are retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "default-guid",
+							Name: "default",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "all", Destination: "10.0.0.0/8"},
+								{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "443"},
+							},
+						},
+					},
+					ccv2.Warnings{"get-running-warning"},
+					nil)
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "default-guid",
+							Name: "default",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "all", Destination: "10.0.0.0/8"},
+							},
+						},
+						{
+							GUID: "space-guid",
+							Name: "space-group",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "tcp", Destination: "10.0.0.1-10.0.0.9", Ports: "80,443", Description: "web"},
+								{Protocol: "tcp", Destination: "10.0.0.1-10.0.0.9", Ports: "8080"},
+							},
+						},
+					},
+					ccv2.Warnings{"get-space-warning"},
+					nil)
+			})
+
+			It("returns the rules of each security group once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal([]SecurityGroupEgressRule{
+					{SecurityGroupName: "default", Rule: securitygroups.Rule{Protocol: "all", Destination: "10.0.0.0/8"}},
+					{SecurityGroupName: "default", Rule: securitygroups.Rule{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "443"}},
+					{SecurityGroupName: "space-group", Rule: securitygroups.Rule{Protocol: "tcp", Destination: "10.0.0.1-10.0.0.9", Ports: "80,443", Description: "web"}},
+					{SecurityGroupName: "space-group", Rule: securitygroups.Rule{Protocol: "tcp", Destination: "10.0.0.1-10.0.0.9", Ports: "8080"}},
+				}))
+				Expect(warnings).To(ConsistOf("get-running-warning", "get-space-warning"))
+
+				spaceGUID, _ := fakeCloudControllerClient.GetSpaceSecurityGroupsArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when getting the running security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns(nil, ccv2.Warnings{"get-running-warning"}, errors.New("get-running-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-running-error"))
+				Expect(warnings).To(ConsistOf("get-running-warning"))
+				Expect(fakeCloudControllerClient.GetSpaceSecurityGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the space security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(nil, ccv2.Warnings{"get-space-warning"}, errors.New("get-space-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-space-error"))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+			})
+		})
+	})
+})
@@ -18,6 +18,18 @@ func (instance ServiceInstance) IsUserProvided() bool {
 	return ccv2.ServiceInstance(instance).UserProvided()
 }
 
+// DeleteServiceInstance deletes the service instance, using the user provided
+// service endpoint when necessary.
+func (actor Actor) DeleteServiceInstance(serviceInstance ServiceInstance) (Warnings, error) {
+	if serviceInstance.IsUserProvided() {
+		warnings, err := actor.CloudControllerClient.DeleteUserProvidedServiceInstance(serviceInstance.GUID)
+		return Warnings(warnings), err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, error) {
 	instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
 	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
@@ -0,0 +1,77 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/sorting"
+	log "github.com/sirupsen/logrus"
+)
+
+// ServiceParameters are the configuration parameters a service broker was
+// given when a service instance or service binding was created or updated.
+type ServiceParameters map[string]interface{}
+
+// ServiceBindingParameters are the parameters of a single service binding,
+// along with the application the service instance is bound to.
+type ServiceBindingParameters struct {
+	AppName            string
+	ServiceBindingName string
+	Parameters         ServiceParameters
+
+	// FetchNotSupported is true when the service broker does not support
+	// retrieving the parameters of the service binding.
+	FetchNotSupported bool
+}
+
+// GetServiceInstanceParameters returns the parameters of the provided
+// service instance.
+func (actor Actor) GetServiceInstanceParameters(serviceInstanceGUID string) (ServiceParameters, Warnings, error) {
+	parameters, warnings, err := actor.CloudControllerClient.GetServiceInstanceParameters(serviceInstanceGUID)
+	return ServiceParameters(parameters), Warnings(warnings), err
+}
+
+// GetServiceBindingsParametersByServiceInstance returns the parameters of
+// every binding of the provided managed service instance, sorted by
+// application name. Bindings whose service broker does not support fetching
+// parameters are returned with FetchNotSupported set.
+func (actor Actor) GetServiceBindingsParametersByServiceInstance(serviceInstanceGUID string) ([]ServiceBindingParameters, Warnings, error) {
+	serviceBindings, allWarnings, err := actor.GetServiceBindingsByServiceInstance(serviceInstanceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var allParameters []ServiceBindingParameters
+	for _, serviceBinding := range serviceBindings {
+		app, appWarnings, err := actor.GetApplication(serviceBinding.AppGUID)
+		allWarnings = append(allWarnings, appWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		bindingParameters := ServiceBindingParameters{
+			AppName:            app.Name,
+			ServiceBindingName: serviceBinding.Name,
+		}
+
+		parameters, parametersWarnings, err := actor.CloudControllerClient.GetServiceBindingParameters(serviceBinding.GUID)
+		allWarnings = append(allWarnings, parametersWarnings...)
+		switch err.(type) {
+		case nil:
+			bindingParameters.Parameters = ServiceParameters(parameters)
+		case ccerror.ServiceParametersFetchNotSupportedError:
+			log.WithField("service_binding_guid", serviceBinding.GUID).Debug("service broker does not support fetching binding parameters")
+			bindingParameters.FetchNotSupported = true
+		default:
+			return nil, allWarnings, err
+		}
+
+		allParameters = append(allParameters, bindingParameters)
+	}
+
+	sort.Slice(allParameters, func(i, j int) bool {
+		return sorting.LessIgnoreCase(allParameters[i].AppName, allParameters[j].AppName)
+	})
+
+	return allParameters, allWarnings, nil
+}
@@ -0,0 +1,201 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Parameters Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstanceParameters", func() {
+		var (
+			parameters ServiceParameters
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			parameters, warnings, executeErr = actor.GetServiceInstanceParameters("some-service-instance-guid")
+		})
+
+		Context("when getting the parameters succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					map[string]interface{}{"ram_gb": "4"},
+					ccv2.Warnings{"get-parameters-warning"},
+					nil)
+			})
+
+			It("returns the parameters and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parameters).To(Equal(ServiceParameters{"ram_gb": "4"}))
+				Expect(warnings).To(ConsistOf("get-parameters-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceParametersCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when getting the parameters fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.ServiceParametersFetchNotSupportedError{Message: "not supported"}
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					nil,
+					ccv2.Warnings{"get-parameters-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-parameters-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceBindingsParametersByServiceInstance", func() {
+		var (
+			bindingsParameters []ServiceBindingParameters
+			warnings           Warnings
+			executeErr         error
+		)
+
+		JustBeforeEach(func() {
+			bindingsParameters, warnings, executeErr = actor.GetServiceBindingsParametersByServiceInstance("some-service-instance-guid")
+		})
+
+		Context("when the service instance has bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "binding-guid-1", AppGUID: "app-guid-1", Name: "binding-1"},
+						{GUID: "binding-guid-2", AppGUID: "app-guid-2"},
+					},
+					ccv2.Warnings{"get-bindings-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetApplicationReturnsOnCall(0, ccv2.Application{Name: "zoo-app"}, ccv2.Warnings{"get-app-warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationReturnsOnCall(1, ccv2.Application{Name: "app"}, ccv2.Warnings{"get-app-warning-2"}, nil)
+			})
+
+			Context("when the service broker supports fetching binding parameters", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceBindingParametersReturnsOnCall(0,
+						map[string]interface{}{"permissions": "read-only"},
+						ccv2.Warnings{"get-parameters-warning-1"},
+						nil)
+					fakeCloudControllerClient.GetServiceBindingParametersReturnsOnCall(1,
+						map[string]interface{}{},
+						ccv2.Warnings{"get-parameters-warning-2"},
+						nil)
+				})
+
+				It("returns the parameters of each binding sorted by app name, and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(bindingsParameters).To(Equal([]ServiceBindingParameters{
+						{AppName: "app", Parameters: ServiceParameters{}},
+						{AppName: "zoo-app", ServiceBindingName: "binding-1", Parameters: ServiceParameters{"permissions": "read-only"}},
+					}))
+					Expect(warnings).To(ConsistOf(
+						"get-bindings-warning",
+						"get-app-warning-1",
+						"get-parameters-warning-1",
+						"get-app-warning-2",
+						"get-parameters-warning-2",
+					))
+
+					Expect(fakeCloudControllerClient.GetServiceInstanceServiceBindingsArgsForCall(0)).To(Equal("some-service-instance-guid"))
+					Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("app-guid-1"))
+					Expect(fakeCloudControllerClient.GetApplicationArgsForCall(1)).To(Equal("app-guid-2"))
+					Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(0)).To(Equal("binding-guid-1"))
+					Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(1)).To(Equal("binding-guid-2"))
+				})
+			})
+
+			Context("when the service broker does not support fetching binding parameters", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceBindingParametersReturns(
+						nil,
+						ccv2.Warnings{"get-parameters-warning"},
+						ccerror.ServiceParametersFetchNotSupportedError{Message: "not supported"})
+				})
+
+				It("marks the bindings as not supporting parameters", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(bindingsParameters).To(Equal([]ServiceBindingParameters{
+						{AppName: "app", FetchNotSupported: true},
+						{AppName: "zoo-app", ServiceBindingName: "binding-1", FetchNotSupported: true},
+					}))
+				})
+			})
+
+			Context("when getting the binding parameters fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-parameters-error")
+					fakeCloudControllerClient.GetServiceBindingParametersReturns(
+						nil,
+						ccv2.Warnings{"get-parameters-warning"},
+						expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-bindings-warning", "get-app-warning-1", "get-parameters-warning"))
+				})
+			})
+
+			Context("when getting an application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-app-error")
+					fakeCloudControllerClient.GetApplicationReturnsOnCall(0, ccv2.Application{}, ccv2.Warnings{"get-app-warning-1"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-bindings-warning", "get-app-warning-1"))
+					Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when getting the service bindings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-bindings-error")
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+					nil,
+					ccv2.Warnings{"get-bindings-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-bindings-warning"))
+			})
+		})
+	})
+})
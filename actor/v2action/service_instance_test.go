@@ -46,6 +46,36 @@ var _ = Describe("Service Instance Actions", func() {
 		)
 	})
 
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service instance is managed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the managed service instance", func() {
+				warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-guid", Type: constant.ServiceInstanceTypeManagedService})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete error"))
+			})
+
+			It("deletes the user provided service instance", func() {
+				warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-guid", Type: constant.ServiceInstanceTypeUserProvidedService})
+				Expect(err).To(MatchError("delete error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		var (
 			serviceInstanceGUID string
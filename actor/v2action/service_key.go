@@ -0,0 +1,13 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceKey represents a set of credentials for a service instance that is
+// not tied to an application.
+type ServiceKey ccv2.ServiceKey
+
+// DeleteServiceKey deletes the service key with the given GUID.
+func (actor Actor) DeleteServiceKey(guid string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(guid)
+	return Warnings(warnings), err
+}
@@ -0,0 +1,36 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete error"))
+		})
+
+		It("deletes the service key and returns the error and warnings", func() {
+			warnings, err := actor.DeleteServiceKey("some-key-guid")
+			Expect(err).To(MatchError("delete error"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package v2action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// StaleServiceKey is a service key that was created before the cleanup
+// cutoff, along with the name of its service instance.
+type StaleServiceKey struct {
+	ServiceKey
+	ServiceInstanceName string
+}
+
+// SpaceCleanup contains the resources in a space that are likely no longer
+// needed.
+type SpaceCleanup struct {
+	// UnboundServiceInstances are service instances owned by the space that
+	// have no app bindings, service keys or bound routes, and are not in the
+	// middle of an operation.
+	UnboundServiceInstances []ServiceInstance
+
+	// StaleServiceKeys are service keys created before the cutoff.
+	StaleServiceKeys []StaleServiceKey
+
+	// StaleStoppedApplications are stopped apps that were last updated before
+	// the cutoff.
+	StaleStoppedApplications []Application
+}
+
+// GetSpaceCleanup returns the unbound service instances of the space, and
+// the service keys and stopped applications that have not changed since
+// cutoff.
+func (actor Actor) GetSpaceCleanup(spaceGUID string, cutoff time.Time) (SpaceCleanup, Warnings, error) {
+	var cleanup SpaceCleanup
+
+	ccServiceInstances, warnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(spaceGUID, true)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return SpaceCleanup{}, allWarnings, err
+	}
+
+	var (
+		serviceInstances     []ServiceInstance
+		managedInstanceGUIDs []string
+	)
+	for _, ccServiceInstance := range ccServiceInstances {
+		// instances shared into this space belong to another space
+		if ccServiceInstance.SpaceGUID != spaceGUID {
+			continue
+		}
+
+		serviceInstances = append(serviceInstances, ServiceInstance(ccServiceInstance))
+		if ccServiceInstance.Managed() {
+			managedInstanceGUIDs = append(managedInstanceGUIDs, ccServiceInstance.GUID)
+		}
+	}
+
+	var ccServiceKeys []ccv2.ServiceKey
+	if len(managedInstanceGUIDs) > 0 {
+		ccServiceKeys, warnings, err = actor.CloudControllerClient.GetServiceKeys(ccv2.Filter{
+			Type:     constant.ServiceInstanceGUIDFilter,
+			Operator: constant.InOperator,
+			Values:   managedInstanceGUIDs,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceCleanup{}, allWarnings, err
+		}
+	}
+
+	ccRoutes, warnings, err := actor.CloudControllerClient.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceCleanup{}, allWarnings, err
+	}
+
+	inUse := map[string]bool{}
+	for _, ccServiceKey := range ccServiceKeys {
+		inUse[ccServiceKey.ServiceInstanceGUID] = true
+	}
+	for _, ccRoute := range ccRoutes {
+		if ccRoute.ServiceInstanceGUID != "" {
+			inUse[ccRoute.ServiceInstanceGUID] = true
+		}
+	}
+
+	serviceInstanceNames := map[string]string{}
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceNames[serviceInstance.GUID] = serviceInstance.Name
+
+		if inUse[serviceInstance.GUID] || serviceInstance.LastOperation.State == constant.LastOperationInProgress {
+			continue
+		}
+
+		var (
+			bindings        []ServiceBinding
+			bindingWarnings Warnings
+		)
+		if serviceInstance.IsUserProvided() {
+			bindings, bindingWarnings, err = actor.GetServiceBindingsByUserProvidedServiceInstance(serviceInstance.GUID)
+		} else {
+			bindings, bindingWarnings, err = actor.GetServiceBindingsByServiceInstance(serviceInstance.GUID)
+		}
+		allWarnings = append(allWarnings, bindingWarnings...)
+		if err != nil {
+			return SpaceCleanup{}, allWarnings, err
+		}
+
+		if len(bindings) == 0 {
+			cleanup.UnboundServiceInstances = append(cleanup.UnboundServiceInstances, serviceInstance)
+		}
+	}
+
+	for _, ccServiceKey := range ccServiceKeys {
+		if ccServiceKey.CreatedAt.Before(cutoff) {
+			cleanup.StaleServiceKeys = append(cleanup.StaleServiceKeys, StaleServiceKey{
+				ServiceKey:          ServiceKey(ccServiceKey),
+				ServiceInstanceName: serviceInstanceNames[ccServiceKey.ServiceInstanceGUID],
+			})
+		}
+	}
+
+	ccApplications, warnings, err := actor.CloudControllerClient.GetApplications(ccv2.Filter{
+		Type:     constant.SpaceGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{spaceGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceCleanup{}, allWarnings, err
+	}
+
+	for _, ccApplication := range ccApplications {
+		if ccApplication.State == constant.ApplicationStopped && ccApplication.UpdatedAt.Before(cutoff) {
+			cleanup.StaleStoppedApplications = append(cleanup.StaleStoppedApplications, Application(ccApplication))
+		}
+	}
+
+	sort.Slice(cleanup.UnboundServiceInstances, func(i int, j int) bool {
+		return cleanup.UnboundServiceInstances[i].Name < cleanup.UnboundServiceInstances[j].Name
+	})
+	sort.Slice(cleanup.StaleServiceKeys, func(i int, j int) bool {
+		if cleanup.StaleServiceKeys[i].ServiceInstanceName != cleanup.StaleServiceKeys[j].ServiceInstanceName {
+			return cleanup.StaleServiceKeys[i].ServiceInstanceName < cleanup.StaleServiceKeys[j].ServiceInstanceName
+		}
+		return cleanup.StaleServiceKeys[i].Name < cleanup.StaleServiceKeys[j].Name
+	})
+	sort.Slice(cleanup.StaleStoppedApplications, func(i int, j int) bool {
+		return cleanup.StaleStoppedApplications[i].Name < cleanup.StaleStoppedApplications[j].Name
+	})
+
+	return cleanup, allWarnings, nil
+}
@@ -0,0 +1,162 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Cleanup Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSpaceCleanup", func() {
+		var (
+			cutoff   time.Time
+			old      time.Time
+			recent   time.Time
+			cleanup  SpaceCleanup
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			cutoff = time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+			old = cutoff.Add(-time.Hour)
+			recent = cutoff.Add(time.Hour)
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "unbound-managed-guid", Name: "z-unbound", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeManagedService},
+					{GUID: "unbound-ups-guid", Name: "a-unbound", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeUserProvidedService},
+					{GUID: "bound-guid", Name: "bound", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeManagedService},
+					{GUID: "keyed-guid", Name: "keyed", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeManagedService},
+					{GUID: "route-service-guid", Name: "route-service", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeUserProvidedService},
+					{
+						GUID: "creating-guid", Name: "creating", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeManagedService,
+						LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress},
+					},
+					{GUID: "shared-guid", Name: "shared", SpaceGUID: "other-space-guid", Type: constant.ServiceInstanceTypeManagedService},
+				},
+				ccv2.Warnings{"instances-warning"}, nil)
+			fakeCloudControllerClient.GetServiceKeysReturns(
+				[]ccv2.ServiceKey{
+					{GUID: "new-key-guid", Name: "new-key", ServiceInstanceGUID: "keyed-guid", CreatedAt: recent},
+					{GUID: "old-key-guid", Name: "old-key", ServiceInstanceGUID: "keyed-guid", CreatedAt: old},
+				},
+				ccv2.Warnings{"keys-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceRoutesReturns(
+				[]ccv2.Route{
+					{GUID: "route-guid", ServiceInstanceGUID: "route-service-guid"},
+					{GUID: "other-route-guid"},
+				},
+				ccv2.Warnings{"routes-warning"}, nil)
+			fakeCloudControllerClient.GetServiceInstanceServiceBindingsStub = func(guid string) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if guid == "bound-guid" {
+					return []ccv2.ServiceBinding{{GUID: "binding-guid"}}, ccv2.Warnings{"bindings-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"bindings-warning"}, nil
+			}
+			fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"ups-bindings-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{GUID: "old-stopped-guid", Name: "old-stopped", State: constant.ApplicationStopped, UpdatedAt: old},
+					{GUID: "new-stopped-guid", Name: "new-stopped", State: constant.ApplicationStopped, UpdatedAt: recent},
+					{GUID: "old-started-guid", Name: "old-started", State: constant.ApplicationStarted, UpdatedAt: old},
+				},
+				ccv2.Warnings{"apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			cleanup, warnings, err = actor.GetSpaceCleanup("some-space-guid", cutoff)
+		})
+
+		It("returns the resources that are no longer needed", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"instances-warning",
+				"keys-warning",
+				"routes-warning",
+				"bindings-warning",
+				"bindings-warning",
+				"ups-bindings-warning",
+				"apps-warning",
+			))
+
+			Expect(cleanup.UnboundServiceInstances).To(HaveLen(2))
+			Expect(cleanup.UnboundServiceInstances[0].Name).To(Equal("a-unbound"))
+			Expect(cleanup.UnboundServiceInstances[1].Name).To(Equal("z-unbound"))
+
+			Expect(cleanup.StaleServiceKeys).To(Equal([]StaleServiceKey{
+				{
+					ServiceKey:          ServiceKey{GUID: "old-key-guid", Name: "old-key", ServiceInstanceGUID: "keyed-guid", CreatedAt: old},
+					ServiceInstanceName: "keyed",
+				},
+			}))
+
+			Expect(cleanup.StaleStoppedApplications).To(HaveLen(1))
+			Expect(cleanup.StaleStoppedApplications[0].Name).To(Equal("old-stopped"))
+
+			spaceGUID, includeUserProvided, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(includeUserProvided).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.ServiceInstanceGUIDFilter,
+				Operator: constant.InOperator,
+				Values:   []string{"unbound-managed-guid", "bound-guid", "keyed-guid", "creating-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.SpaceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-space-guid"},
+			}))
+		})
+
+		Context("when the space has no managed service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, nil, nil)
+			})
+
+			It("does not look up service keys", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the service keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, errors.New("keys error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("keys error"))
+				Expect(warnings).To(ConsistOf("instances-warning", "keys-warning"))
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("apps error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("apps error"))
+				Expect(warnings).To(ContainElement("apps-warning"))
+			})
+		})
+	})
+})
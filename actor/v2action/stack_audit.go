@@ -0,0 +1,114 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// StackApplication is an app along with the org and space it is in.
+type StackApplication struct {
+	GUID             string
+	Name             string
+	OrganizationName string
+	SpaceName        string
+	State            constant.ApplicationState
+}
+
+// StackAudit is a stack and the apps running on it. The apps on stacks that no
+// longer exist are audited under an empty Stack.
+type StackAudit struct {
+	Stack        Stack
+	Applications []StackApplication
+}
+
+// GetStackAudit returns every stack along with the apps on it, across all
+// the orgs and spaces visible to the current user. Stacks are sorted by name
+// and apps by org, space and app name. Apps on stacks that no longer exist are
+// returned in a final audit with an empty Stack.
+func (actor Actor) GetStackAudit() ([]StackAudit, Warnings, error) {
+	var allWarnings Warnings
+
+	ccStacks, warnings, err := actor.CloudControllerClient.GetStacks()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	spacesByGUID := map[string]Space{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = Space(space)
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	audits := make([]StackAudit, len(ccStacks))
+	auditIndex := map[string]int{}
+	for i, stack := range ccStacks {
+		audits[i] = StackAudit{Stack: Stack(stack)}
+		auditIndex[stack.GUID] = i
+	}
+
+	var unknownStackAudit StackAudit
+	for _, app := range apps {
+		space := spacesByGUID[app.SpaceGUID]
+		stackApp := StackApplication{
+			GUID:             app.GUID,
+			Name:             app.Name,
+			OrganizationName: orgNames[space.OrganizationGUID],
+			SpaceName:        space.Name,
+			State:            app.State,
+		}
+
+		i, ok := auditIndex[app.StackGUID]
+		if !ok {
+			// the stack was deleted after the app was staged
+			unknownStackAudit.Applications = append(unknownStackAudit.Applications, stackApp)
+			continue
+		}
+		audits[i].Applications = append(audits[i].Applications, stackApp)
+	}
+
+	sort.Slice(audits, func(i int, j int) bool {
+		return audits[i].Stack.Name < audits[j].Stack.Name
+	})
+	if len(unknownStackAudit.Applications) > 0 {
+		audits = append(audits, unknownStackAudit)
+	}
+	for _, audit := range audits {
+		sortStackApplications(audit.Applications)
+	}
+
+	return audits, allWarnings, nil
+}
+
+func sortStackApplications(apps []StackApplication) {
+	sort.Slice(apps, func(i int, j int) bool {
+		if apps[i].OrganizationName != apps[j].OrganizationName {
+			return apps[i].OrganizationName < apps[j].OrganizationName
+		}
+		if apps[i].SpaceName != apps[j].SpaceName {
+			return apps[i].SpaceName < apps[j].SpaceName
+		}
+		return apps[i].Name < apps[j].Name
+	})
+}
@@ -0,0 +1,126 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stack Audit Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetStackAudit", func() {
+		var (
+			audits   []StackAudit
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetStacksReturns(
+				[]ccv2.Stack{
+					{GUID: "stack-2-guid", Name: "stack-2"},
+					{GUID: "stack-1-guid", Name: "stack-1"},
+					{GUID: "stack-3-guid", Name: "stack-3"},
+				},
+				ccv2.Warnings{"stacks-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{
+					{GUID: "org-1-guid", Name: "org-1"},
+					{GUID: "org-2-guid", Name: "org-2"},
+				},
+				ccv2.Warnings{"orgs-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-1-guid", Name: "space-1", OrganizationGUID: "org-1-guid"},
+					{GUID: "space-2-guid", Name: "space-2", OrganizationGUID: "org-2-guid"},
+				},
+				ccv2.Warnings{"spaces-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{GUID: "app-b-guid", Name: "app-b", SpaceGUID: "space-2-guid", StackGUID: "stack-1-guid", State: constant.ApplicationStarted},
+					{GUID: "app-c-guid", Name: "app-c", SpaceGUID: "space-1-guid", StackGUID: "stack-1-guid", State: constant.ApplicationStopped},
+					{GUID: "app-a-guid", Name: "app-a", SpaceGUID: "space-1-guid", StackGUID: "stack-1-guid", State: constant.ApplicationStarted},
+					{GUID: "app-d-guid", Name: "app-d", SpaceGUID: "space-1-guid", StackGUID: "stack-2-guid", State: constant.ApplicationStarted},
+					{GUID: "app-e-guid", Name: "app-e", SpaceGUID: "space-1-guid", StackGUID: "deleted-stack-guid", State: constant.ApplicationStarted},
+				},
+				ccv2.Warnings{"apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			audits, warnings, err = actor.GetStackAudit()
+		})
+
+		It("returns the apps on each stack, sorted by stack, org, space and app name, followed by the apps on deleted stacks", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("stacks-warning", "orgs-warning", "spaces-warning", "apps-warning"))
+			Expect(audits).To(Equal([]StackAudit{
+				{
+					Stack: Stack{GUID: "stack-1-guid", Name: "stack-1"},
+					Applications: []StackApplication{
+						{GUID: "app-a-guid", Name: "app-a", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStarted},
+						{GUID: "app-c-guid", Name: "app-c", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStopped},
+						{GUID: "app-b-guid", Name: "app-b", OrganizationName: "org-2", SpaceName: "space-2", State: constant.ApplicationStarted},
+					},
+				},
+				{
+					Stack: Stack{GUID: "stack-2-guid", Name: "stack-2"},
+					Applications: []StackApplication{
+						{GUID: "app-d-guid", Name: "app-d", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStarted},
+					},
+				},
+				{
+					Stack: Stack{GUID: "stack-3-guid", Name: "stack-3"},
+				},
+				{
+					Applications: []StackApplication{
+						{GUID: "app-e-guid", Name: "app-e", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStarted},
+					},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(BeEmpty())
+		})
+
+		Context("when getting the stacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"stacks-warning"}, errors.New("stacks error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("stacks error"))
+				Expect(warnings).To(ConsistOf("stacks-warning"))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("apps error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError("apps error"))
+				Expect(warnings).To(ConsistOf("stacks-warning", "orgs-warning", "spaces-warning", "apps-warning"))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
@@ -9,6 +11,7 @@ import (
 
 type UAAClient interface {
 	Authenticate(ID string, secret string, grantType constant.GrantType) (string, string, error)
+	AuthenticateWithBrowser(openURL func(loginURL string) error, timeout time.Duration) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
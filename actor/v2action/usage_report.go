@@ -0,0 +1,185 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// Usage is the allocated and used capacity of one or more apps. Memory and
+// disk are in bytes. Only started apps are allocated capacity.
+type Usage struct {
+	Instances        int
+	RunningInstances int
+	AllocatedMemory  uint64
+	UsedMemory       uint64
+	AllocatedDisk    uint64
+	UsedDisk         uint64
+}
+
+func (usage *Usage) add(other Usage) {
+	usage.Instances += other.Instances
+	usage.RunningInstances += other.RunningInstances
+	usage.AllocatedMemory += other.AllocatedMemory
+	usage.UsedMemory += other.UsedMemory
+	usage.AllocatedDisk += other.AllocatedDisk
+	usage.UsedDisk += other.UsedDisk
+}
+
+type ApplicationUsage struct {
+	Usage
+	Name  string
+	State constant.ApplicationState
+}
+
+type SpaceUsage struct {
+	Name string
+	// Quota is empty when the space does not have a space quota.
+	Quota        SpaceQuota
+	Applications []ApplicationUsage
+}
+
+// Total returns the summed usage of all the apps in the space.
+func (space SpaceUsage) Total() Usage {
+	var total Usage
+	for _, app := range space.Applications {
+		total.add(app.Usage)
+	}
+	return total
+}
+
+type OrganizationUsage struct {
+	Name   string
+	Quota  OrganizationQuota
+	Spaces []SpaceUsage
+}
+
+// Total returns the summed usage of all the reported spaces.
+func (org OrganizationUsage) Total() Usage {
+	var total Usage
+	for _, space := range org.Spaces {
+		total.add(space.Total())
+	}
+	return total
+}
+
+// GetOrganizationUsageReport returns the usage of every app in the
+// organization, grouped by space, along with the organization and space
+// quotas. When spaceName is provided, only that space is reported.
+func (actor Actor) GetOrganizationUsageReport(orgGUID string, spaceName string) (OrganizationUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganization(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	report := OrganizationUsage{Name: org.Name}
+
+	if org.QuotaDefinitionGUID != "" {
+		report.Quota, warnings, err = actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationUsage{}, allWarnings, err
+		}
+	}
+
+	var spaces []Space
+	if spaceName != "" {
+		var space Space
+		space, warnings, err = actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+		spaces = []Space{space}
+	} else {
+		spaces, warnings, err = actor.GetOrganizationSpaces(org.GUID)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		spaceUsage, warnings, err := actor.getSpaceUsage(space)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationUsage{}, allWarnings, err
+		}
+		report.Spaces = append(report.Spaces, spaceUsage)
+	}
+
+	sort.Slice(report.Spaces, func(i int, j int) bool {
+		return report.Spaces[i].Name < report.Spaces[j].Name
+	})
+
+	return report, allWarnings, nil
+}
+
+func (actor Actor) getSpaceUsage(space Space) (SpaceUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	spaceUsage := SpaceUsage{Name: space.Name}
+
+	if space.SpaceQuotaDefinitionGUID != "" {
+		quota, warnings, err := actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceUsage{}, allWarnings, err
+		}
+		spaceUsage.Quota = quota
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		appUsage, warnings, err := actor.getApplicationUsage(app)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceUsage{}, allWarnings, err
+		}
+		spaceUsage.Applications = append(spaceUsage.Applications, appUsage)
+	}
+
+	sort.Slice(spaceUsage.Applications, func(i int, j int) bool {
+		return spaceUsage.Applications[i].Name < spaceUsage.Applications[j].Name
+	})
+
+	return spaceUsage, allWarnings, nil
+}
+
+func (actor Actor) getApplicationUsage(app Application) (ApplicationUsage, Warnings, error) {
+	appUsage := ApplicationUsage{Name: app.Name, State: app.State}
+	if !app.Started() {
+		return appUsage, nil, nil
+	}
+
+	appUsage.Instances = app.Instances.Value
+	appUsage.AllocatedMemory = uint64(app.Instances.Value) * app.Memory.Value * bytefmt.MEGABYTE
+	appUsage.AllocatedDisk = uint64(app.Instances.Value) * app.DiskQuota.Value * bytefmt.MEGABYTE
+
+	// cloud controller calls the instance reporter only when the desired
+	// application state is STARTED
+	instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+	switch err.(type) {
+	case nil:
+	case actionerror.ApplicationInstancesNotFoundError:
+		return appUsage, warnings, nil
+	default:
+		return ApplicationUsage{}, warnings, err
+	}
+
+	for _, instance := range instances {
+		if instance.State == ApplicationInstanceState(constant.ApplicationInstanceRunning) {
+			appUsage.RunningInstances++
+		}
+		appUsage.UsedMemory += uint64(instance.Memory)
+		appUsage.UsedDisk += uint64(instance.Disk)
+	}
+
+	return appUsage, warnings, nil
+}
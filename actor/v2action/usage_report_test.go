@@ -0,0 +1,198 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/bytefmt"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Report Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationUsageReport", func() {
+		var (
+			spaceName string
+			report    OrganizationUsage
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			spaceName = ""
+
+			fakeCloudControllerClient.GetOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-org-quota-guid"},
+				ccv2.Warnings{"org-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{Name: "some-org-quota", MemoryLimit: types.NullByteSizeInMb{IsSet: true, Value: 4096}},
+				ccv2.Warnings{"org-quota-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-2-guid", Name: "space-2"},
+					{GUID: "space-1-guid", Name: "space-1", SpaceQuotaDefinitionGUID: "some-space-quota-guid"},
+				},
+				ccv2.Warnings{"spaces-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceQuotaDefinitionReturns(
+				ccv2.SpaceQuota{Name: "some-space-quota"},
+				ccv2.Warnings{"space-quota-warning"}, nil)
+
+			fakeCloudControllerClient.GetApplicationsStub = func(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+				if filters[0].Values[0] == "space-2-guid" {
+					return nil, ccv2.Warnings{"apps-warning"}, nil
+				}
+				return []ccv2.Application{
+					{
+						GUID:      "started-app-guid",
+						Name:      "started-app",
+						State:     constant.ApplicationStarted,
+						Instances: types.NullInt{IsSet: true, Value: 2},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+						DiskQuota: types.NullByteSizeInMb{IsSet: true, Value: 1024},
+					},
+					{
+						GUID:      "stopped-app-guid",
+						Name:      "stopped-app",
+						State:     constant.ApplicationStopped,
+						Instances: types.NullInt{IsSet: true, Value: 3},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+					},
+				}, ccv2.Warnings{"apps-warning"}, nil
+			}
+
+			fakeCloudControllerClient.GetApplicationApplicationInstanceStatusesReturns(
+				map[int]ccv2.ApplicationInstanceStatus{
+					0: {ID: 0, Memory: 100 * bytefmt.MEGABYTE, Disk: 200 * bytefmt.MEGABYTE},
+					1: {ID: 1, Memory: 50 * bytefmt.MEGABYTE, Disk: 200 * bytefmt.MEGABYTE},
+				},
+				ccv2.Warnings{"stats-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(
+				map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: constant.ApplicationInstanceRunning},
+					1: {ID: 1, State: constant.ApplicationInstanceCrashed},
+				},
+				ccv2.Warnings{"instances-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			report, warnings, err = actor.GetOrganizationUsageReport("some-org-guid", spaceName)
+		})
+
+		It("returns the usage of every app grouped by space along with the quotas", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("org-warning"))
+			Expect(warnings).To(ContainElement("space-quota-warning"))
+			Expect(warnings).To(ContainElement("instances-warning"))
+
+			Expect(report.Name).To(Equal("some-org"))
+			Expect(report.Quota.Name).To(Equal("some-org-quota"))
+			Expect(report.Spaces).To(Equal([]SpaceUsage{
+				{
+					Name:  "space-1",
+					Quota: SpaceQuota{Name: "some-space-quota"},
+					Applications: []ApplicationUsage{
+						{
+							Name:  "started-app",
+							State: constant.ApplicationStarted,
+							Usage: Usage{
+								Instances:        2,
+								RunningInstances: 1,
+								AllocatedMemory:  512 * bytefmt.MEGABYTE,
+								UsedMemory:       150 * bytefmt.MEGABYTE,
+								AllocatedDisk:    2048 * bytefmt.MEGABYTE,
+								UsedDisk:         400 * bytefmt.MEGABYTE,
+							},
+						},
+						{
+							Name:  "stopped-app",
+							State: constant.ApplicationStopped,
+						},
+					},
+				},
+				{Name: "space-2"},
+			}))
+
+			Expect(report.Total()).To(Equal(report.Spaces[0].Total()))
+			Expect(report.Total().AllocatedMemory).To(BeEquivalentTo(512 * bytefmt.MEGABYTE))
+
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-org-quota-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceQuotaDefinitionCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationApplicationInstanceStatusesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationApplicationInstanceStatusesArgsForCall(0)).To(Equal("started-app-guid"))
+		})
+
+		Context("when a space name is provided", func() {
+			BeforeEach(func() {
+				spaceName = "space-1"
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "space-1-guid", Name: "space-1"}},
+					ccv2.Warnings{"spaces-warning"}, nil)
+			})
+
+			It("only reports that space", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(report.Spaces).To(HaveLen(1))
+				Expect(report.Spaces[0].Name).To(Equal("space-1"))
+
+				filters := fakeCloudControllerClient.GetSpacesArgsForCall(0)
+				Expect(filters).To(ContainElement(ccv2.Filter{
+					Type:     constant.NameFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"space-1"},
+				}))
+			})
+		})
+
+		Context("when a started app has no instance stats", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationApplicationInstanceStatusesReturns(nil, nil, ccerror.ApplicationStoppedStatsError{})
+			})
+
+			It("reports only the allocated usage", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(report.Spaces[0].Applications[0].Usage).To(Equal(Usage{
+					Instances:       2,
+					AllocatedMemory: 512 * bytefmt.MEGABYTE,
+					AllocatedDisk:   2048 * bytefmt.MEGABYTE,
+				}))
+			})
+		})
+
+		Context("when getting the organization quota fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"org-quota-warning"}, errors.New("quota-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("quota-error"))
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning"))
+			})
+		})
+
+		Context("when getting the instance stats fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationApplicationInstanceStatusesReturns(nil, ccv2.Warnings{"stats-warning"}, errors.New("stats-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("stats-error"))
+				Expect(warnings).To(ContainElement("stats-warning"))
+			})
+		})
+	})
+})
@@ -3,10 +3,12 @@ package v2actionfakes
 
 import (
 	"io"
+	"net/http"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -86,6 +88,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSecurityGroupStub        func(securityGroup ccv2.SecurityGroup) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		securityGroup ccv2.SecurityGroup
+	}
+	createSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateServiceBindingStub        func(appGUID string, serviceBindingGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	createServiceBindingMutex       sync.RWMutex
 	createServiceBindingArgsForCall []struct {
@@ -105,6 +122,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -120,6 +153,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(guid string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationJobStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationJobMutex       sync.RWMutex
 	deleteOrganizationJobArgsForCall []struct {
@@ -206,6 +252,32 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteSpaceJobStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteSpaceJobMutex       sync.RWMutex
 	deleteSpaceJobArgsForCall []struct {
@@ -221,6 +293,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteUserProvidedServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.Warnings, error)
+	deleteUserProvidedServiceInstanceMutex       sync.RWMutex
+	deleteUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -371,6 +456,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(filters ...ccv2.Filter) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -386,6 +486,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationUsersByRoleStub        func(role constant.OrgRole, guid string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role constant.OrgRole
+		guid string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetPrivateDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getPrivateDomainMutex       sync.RWMutex
 	getPrivateDomainArgsForCall []struct {
@@ -417,6 +533,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouteMappingsStub        func(filters ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error)
+	getRouteMappingsMutex       sync.RWMutex
+	getRouteMappingsArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getRouteMappingsReturns struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteMappingsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -432,6 +563,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRunningSecurityGroupsStub        func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	getRunningSecurityGroupsMutex       sync.RWMutex
+	getRunningSecurityGroupsArgsForCall []struct{}
+	getRunningSecurityGroupsReturns     struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRunningSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSecurityGroupsStub        func(filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSecurityGroupsMutex       sync.RWMutex
 	getSecurityGroupsArgsForCall []struct {
@@ -492,6 +636,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBindingParametersStub        func(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -537,6 +696,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceInstanceParametersStub        func(serviceInstanceGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceInstanceParametersMutex       sync.RWMutex
+	getServiceInstanceParametersArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -597,6 +771,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeysStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	getServicePlanMutex       sync.RWMutex
 	getServicePlanArgsForCall []struct {
@@ -702,6 +891,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceRoutesStub        func(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	getSpaceRoutesMutex       sync.RWMutex
 	getSpaceRoutesArgsForCall []struct {
@@ -782,6 +986,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role constant.SpaceRole, guid string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role constant.SpaceRole
+		guid string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetStackStub        func(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	getStackMutex       sync.RWMutex
 	getStackArgsForCall []struct {
@@ -827,6 +1047,26 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	MakeRawRequestStub        func(method string, path string, headers http.Header, body []byte) ([]byte, *http.Response, ccv2.Warnings, error)
+	makeRawRequestMutex       sync.RWMutex
+	makeRawRequestArgsForCall []struct {
+		method  string
+		path    string
+		headers http.Header
+		body    []byte
+	}
+	makeRawRequestReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}
+	makeRawRequestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -855,6 +1095,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	SetSpaceQuotaStub        func(spaceGUID string, quotaGUID string) (ccv2.Warnings, error)
+	setSpaceQuotaMutex       sync.RWMutex
+	setSpaceQuotaArgsForCall []struct {
+		spaceGUID string
+		quotaGUID string
+	}
+	setSpaceQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	setSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	TargetCFStub        func(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	targetCFMutex       sync.RWMutex
 	targetCFArgsForCall []struct {
@@ -883,6 +1137,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserByRoleStub        func(role constant.OrgRole, guid string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		role     constant.OrgRole
+		guid     string
+		username string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateResourceMatchStub        func(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	updateResourceMatchMutex       sync.RWMutex
 	updateResourceMatchArgsForCall []struct {
@@ -914,6 +1199,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateSecurityGroupStub        func(securityGroup ccv2.SecurityGroup) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	updateSecurityGroupMutex       sync.RWMutex
+	updateSecurityGroupArgsForCall []struct {
+		securityGroup ccv2.SecurityGroup
+	}
+	updateSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	UpdateSecurityGroupSpaceStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	updateSecurityGroupSpaceMutex       sync.RWMutex
 	updateSecurityGroupSpaceArgsForCall []struct {
@@ -942,6 +1242,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateSpaceUserByRoleStub        func(role constant.SpaceRole, guid string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		role     constant.SpaceRole
+		guid     string
+		username string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -1331,6 +1646,60 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSecurityGroup(securityGroup ccv2.SecurityGroup) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		securityGroup ccv2.SecurityGroup
+	}{securityGroup})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{securityGroup})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(securityGroup)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2, fake.createSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupArgsForCall(i int) ccv2.SecurityGroup {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].securityGroup
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceBindingGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.createServiceBindingMutex.Lock()
 	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
@@ -1389,6 +1758,61 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1443,6 +1867,57 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplication(guid string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationJobMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationJobReturnsOnCall[len(fake.deleteOrganizationJobArgsForCall)]
@@ -1759,36 +2234,138 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteSpaceJobMutex.Lock()
-	ret, specificReturn := fake.deleteSpaceJobReturnsOnCall[len(fake.deleteSpaceJobArgsForCall)]
-	fake.deleteSpaceJobArgsForCall = append(fake.deleteSpaceJobArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("DeleteSpaceJob", []interface{}{spaceGUID})
-	fake.deleteSpaceJobMutex.Unlock()
-	if fake.DeleteSpaceJobStub != nil {
-		return fake.DeleteSpaceJobStub(spaceGUID)
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.deleteSpaceJobReturns.result1, fake.deleteSpaceJobReturns.result2, fake.deleteSpaceJobReturns.result3
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceJobCallCount() int {
-	fake.deleteSpaceJobMutex.RLock()
-	defer fake.deleteSpaceJobMutex.RUnlock()
-	return len(fake.deleteSpaceJobArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceJobArgsForCall(i int) string {
-	fake.deleteSpaceJobMutex.RLock()
-	defer fake.deleteSpaceJobMutex.RUnlock()
-	return fake.deleteSpaceJobArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
 }
 
-func (fake *FakeCloudControllerClient) DeleteSpaceJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteSpaceJobMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceJobReturnsOnCall[len(fake.deleteSpaceJobArgsForCall)]
+	fake.deleteSpaceJobArgsForCall = append(fake.deleteSpaceJobArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("DeleteSpaceJob", []interface{}{spaceGUID})
+	fake.deleteSpaceJobMutex.Unlock()
+	if fake.DeleteSpaceJobStub != nil {
+		return fake.DeleteSpaceJobStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteSpaceJobReturns.result1, fake.deleteSpaceJobReturns.result2, fake.deleteSpaceJobReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceJobCallCount() int {
+	fake.deleteSpaceJobMutex.RLock()
+	defer fake.deleteSpaceJobMutex.RUnlock()
+	return len(fake.deleteSpaceJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceJobArgsForCall(i int) string {
+	fake.deleteSpaceJobMutex.RLock()
+	defer fake.deleteSpaceJobMutex.RUnlock()
+	return fake.deleteSpaceJobArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
 	fake.DeleteSpaceJobStub = nil
 	fake.deleteSpaceJobReturns = struct {
 		result1 ccv2.Job
@@ -1813,6 +2390,57 @@ func (fake *FakeCloudControllerClient) DeleteSpaceJobReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.deleteUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteUserProvidedServiceInstanceReturnsOnCall[len(fake.deleteUserProvidedServiceInstanceArgsForCall)]
+	fake.deleteUserProvidedServiceInstanceArgsForCall = append(fake.deleteUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteUserProvidedServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteUserProvidedServiceInstanceMutex.Unlock()
+	if fake.DeleteUserProvidedServiceInstanceStub != nil {
+		return fake.DeleteUserProvidedServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteUserProvidedServiceInstanceReturns.result1, fake.deleteUserProvidedServiceInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceCallCount() int {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.deleteUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceArgsForCall(i int) string {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.deleteUserProvidedServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	fake.deleteUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	if fake.deleteUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.deleteUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
 	fake.getApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
@@ -2347,6 +2975,60 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(filters ...ccv2.Filter) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{filters})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Filter {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -2401,6 +3083,61 @@ func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role constant.OrgRole, guid string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role constant.OrgRole
+		guid string
+	}{role, guid})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, guid})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (constant.OrgRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
 	fake.getPrivateDomainMutex.Lock()
 	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
@@ -2510,6 +3247,60 @@ func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouteMappings(filters ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error) {
+	fake.getRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.getRouteMappingsReturnsOnCall[len(fake.getRouteMappingsArgsForCall)]
+	fake.getRouteMappingsArgsForCall = append(fake.getRouteMappingsArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetRouteMappings", []interface{}{filters})
+	fake.getRouteMappingsMutex.Unlock()
+	if fake.GetRouteMappingsStub != nil {
+		return fake.GetRouteMappingsStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteMappingsReturns.result1, fake.getRouteMappingsReturns.result2, fake.getRouteMappingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCallCount() int {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return len(fake.getRouteMappingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsArgsForCall(i int) []ccv2.Filter {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return fake.getRouteMappingsArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturns(result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	fake.getRouteMappingsReturns = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturnsOnCall(i int, result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	if fake.getRouteMappingsReturnsOnCall == nil {
+		fake.getRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouteMapping
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteMappingsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoutes(filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
 	fake.getRoutesMutex.Lock()
 	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
@@ -2564,6 +3355,52 @@ func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getRunningSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getRunningSecurityGroupsReturnsOnCall[len(fake.getRunningSecurityGroupsArgsForCall)]
+	fake.getRunningSecurityGroupsArgsForCall = append(fake.getRunningSecurityGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetRunningSecurityGroups", []interface{}{})
+	fake.getRunningSecurityGroupsMutex.Unlock()
+	if fake.GetRunningSecurityGroupsStub != nil {
+		return fake.GetRunningSecurityGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningSecurityGroupsReturns.result1, fake.getRunningSecurityGroupsReturns.result2, fake.getRunningSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsCallCount() int {
+	fake.getRunningSecurityGroupsMutex.RLock()
+	defer fake.getRunningSecurityGroupsMutex.RUnlock()
+	return len(fake.getRunningSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSecurityGroupsStub = nil
+	fake.getRunningSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSecurityGroupsStub = nil
+	if fake.getRunningSecurityGroupsReturnsOnCall == nil {
+		fake.getRunningSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSecurityGroups(filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
 	fake.getSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupsReturnsOnCall[len(fake.getSecurityGroupsArgsForCall)]
@@ -2780,6 +3617,60 @@ func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingParametersReturns.result1, fake.getServiceBindingParametersReturns.result2, fake.getServiceBindingParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.getServiceBindingParametersArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceBindings(filters ...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.getServiceBindingsMutex.Lock()
 	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
@@ -2942,6 +3833,60 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceParameters(serviceInstanceGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceInstanceParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceParametersReturnsOnCall[len(fake.getServiceInstanceParametersArgsForCall)]
+	fake.getServiceInstanceParametersArgsForCall = append(fake.getServiceInstanceParametersArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceParameters", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceParametersMutex.Unlock()
+	if fake.GetServiceInstanceParametersStub != nil {
+		return fake.GetServiceInstanceParametersStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceParametersReturns.result1, fake.getServiceInstanceParametersReturns.result2, fake.getServiceInstanceParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersCallCount() int {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return len(fake.getServiceInstanceParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersArgsForCall(i int) string {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return fake.getServiceInstanceParametersArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	fake.getServiceInstanceParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	if fake.getServiceInstanceParametersReturnsOnCall == nil {
+		fake.getServiceInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstances(filters ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
 	fake.getServiceInstancesMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
@@ -3158,6 +4103,60 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceKeys(filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServiceKeys", []interface{}{filters})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Filter {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
 	fake.getServicePlanMutex.Lock()
 	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
@@ -3536,6 +4535,60 @@ func (fake *FakeCloudControllerClient) GetSpaceQuotaDefinitionReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{orgGUID})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotasReturns.result1, fake.getSpaceQuotasReturns.result2, fake.getSpaceQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) string {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return fake.getSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
 	fake.getSpaceRoutesMutex.Lock()
 	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
@@ -3811,6 +4864,61 @@ func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role constant.SpaceRole, guid string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role constant.SpaceRole
+		guid string
+	}{role, guid})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, guid})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (constant.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
 	fake.getStackMutex.Lock()
 	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
@@ -3973,6 +5081,71 @@ func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceServiceBind
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) MakeRawRequest(method string, path string, headers http.Header, body []byte) ([]byte, *http.Response, ccv2.Warnings, error) {
+	var bodyCopy []byte
+	if body != nil {
+		bodyCopy = make([]byte, len(body))
+		copy(bodyCopy, body)
+	}
+	fake.makeRawRequestMutex.Lock()
+	ret, specificReturn := fake.makeRawRequestReturnsOnCall[len(fake.makeRawRequestArgsForCall)]
+	fake.makeRawRequestArgsForCall = append(fake.makeRawRequestArgsForCall, struct {
+		method  string
+		path    string
+		headers http.Header
+		body    []byte
+	}{method, path, headers, bodyCopy})
+	fake.recordInvocation("MakeRawRequest", []interface{}{method, path, headers, bodyCopy})
+	fake.makeRawRequestMutex.Unlock()
+	if fake.MakeRawRequestStub != nil {
+		return fake.MakeRawRequestStub(method, path, headers, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.makeRawRequestReturns.result1, fake.makeRawRequestReturns.result2, fake.makeRawRequestReturns.result3, fake.makeRawRequestReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestCallCount() int {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return len(fake.makeRawRequestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestArgsForCall(i int) (string, string, http.Header, []byte) {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return fake.makeRawRequestArgsForCall[i].method, fake.makeRawRequestArgsForCall[i].path, fake.makeRawRequestArgsForCall[i].headers, fake.makeRawRequestArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturns(result1 []byte, result2 *http.Response, result3 ccv2.Warnings, result4 error) {
+	fake.MakeRawRequestStub = nil
+	fake.makeRawRequestReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 ccv2.Warnings, result4 error) {
+	fake.MakeRawRequestStub = nil
+	if fake.makeRawRequestReturnsOnCall == nil {
+		fake.makeRawRequestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 ccv2.Warnings
+			result4 error
+		})
+	}
+	fake.makeRawRequestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -4078,6 +5251,58 @@ func (fake *FakeCloudControllerClient) RestageApplicationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) SetSpaceQuota(spaceGUID string, quotaGUID string) (ccv2.Warnings, error) {
+	fake.setSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
+	fake.setSpaceQuotaArgsForCall = append(fake.setSpaceQuotaArgsForCall, struct {
+		spaceGUID string
+		quotaGUID string
+	}{spaceGUID, quotaGUID})
+	fake.recordInvocation("SetSpaceQuota", []interface{}{spaceGUID, quotaGUID})
+	fake.setSpaceQuotaMutex.Unlock()
+	if fake.SetSpaceQuotaStub != nil {
+		return fake.SetSpaceQuotaStub(spaceGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceQuotaReturns.result1, fake.setSpaceQuotaReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaCallCount() int {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return len(fake.setSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return fake.setSpaceQuotaArgsForCall[i].spaceGUID, fake.setSpaceQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	fake.setSpaceQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	if fake.setSpaceQuotaReturnsOnCall == nil {
+		fake.setSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
 	fake.targetCFMutex.Lock()
 	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
@@ -4183,6 +5408,114 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2, fake.updateOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(role constant.OrgRole, guid string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		role     constant.OrgRole
+		guid     string
+		username string
+	}{role, guid, username})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{role, guid, username})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(role, guid, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleReturns.result1, fake.updateOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (constant.OrgRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleArgsForCall[i].role, fake.updateOrganizationUserByRoleArgsForCall[i].guid, fake.updateOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
 	var resourcesToMatchCopy []ccv2.Resource
 	if resourcesToMatch != nil {
@@ -4297,6 +5630,60 @@ func (fake *FakeCloudControllerClient) UpdateRouteApplicationReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSecurityGroup(securityGroup ccv2.SecurityGroup) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.updateSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
+	fake.updateSecurityGroupArgsForCall = append(fake.updateSecurityGroupArgsForCall, struct {
+		securityGroup ccv2.SecurityGroup
+	}{securityGroup})
+	fake.recordInvocation("UpdateSecurityGroup", []interface{}{securityGroup})
+	fake.updateSecurityGroupMutex.Unlock()
+	if fake.UpdateSecurityGroupStub != nil {
+		return fake.UpdateSecurityGroupStub(securityGroup)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSecurityGroupReturns.result1, fake.updateSecurityGroupReturns.result2, fake.updateSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupCallCount() int {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return len(fake.updateSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupArgsForCall(i int) ccv2.SecurityGroup {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return fake.updateSecurityGroupArgsForCall[i].securityGroup
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	fake.updateSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	if fake.updateSecurityGroupReturnsOnCall == nil {
+		fake.updateSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
 	fake.updateSecurityGroupSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSecurityGroupSpaceReturnsOnCall[len(fake.updateSecurityGroupSpaceArgsForCall)]
@@ -4401,6 +5788,59 @@ func (fake *FakeCloudControllerClient) UpdateSecurityGroupStagingSpaceReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(role constant.SpaceRole, guid string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		role     constant.SpaceRole
+		guid     string
+		username string
+	}{role, guid, username})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{role, guid, username})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(role, guid, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleReturns.result1, fake.updateSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (constant.SpaceRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return fake.updateSpaceUserByRoleArgsForCall[i].role, fake.updateSpaceUserByRoleArgsForCall[i].guid, fake.updateSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error) {
 	var existingResourcesCopy []ccv2.Resource
 	if existingResources != nil {
@@ -4866,10 +6306,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createOrganizationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteOrganizationJobMutex.RLock()
 	defer fake.deleteOrganizationJobMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -4882,8 +6328,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteSecurityGroupStagingSpaceMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteSpaceJobMutex.RLock()
 	defer fake.deleteSpaceJobMutex.RUnlock()
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationApplicationInstancesMutex.RLock()
@@ -4904,14 +6356,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
+	fake.getRunningSecurityGroupsMutex.RLock()
+	defer fake.getRunningSecurityGroupsMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getSecurityGroupSpacesMutex.RLock()
@@ -4920,12 +6380,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSecurityGroupStagingSpacesMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
 	defer fake.getServiceBrokersMutex.RUnlock()
 	fake.getServiceInstanceMutex.RLock()
 	defer fake.getServiceInstanceMutex.RUnlock()
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
 	fake.getServiceInstanceServiceBindingsMutex.RLock()
@@ -4934,6 +6398,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
 	fake.getServiceInstanceSharedTosMutex.RLock()
 	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
 	fake.getServicePlanMutex.RLock()
 	defer fake.getServicePlanMutex.RUnlock()
 	fake.getServicePlansMutex.RLock()
@@ -4948,6 +6414,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSharedDomainsMutex.RUnlock()
 	fake.getSpaceQuotaDefinitionMutex.RLock()
 	defer fake.getSpaceQuotaDefinitionMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
 	defer fake.getSpaceRoutesMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
@@ -4958,28 +6426,42 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
 	fake.getUserProvidedServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getUserProvidedServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
 	defer fake.restageApplicationMutex.RUnlock()
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
 	fake.updateResourceMatchMutex.RLock()
 	defer fake.updateResourceMatchMutex.RUnlock()
 	fake.updateRouteApplicationMutex.RLock()
 	defer fake.updateRouteApplicationMutex.RUnlock()
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
 	fake.updateSecurityGroupSpaceMutex.RLock()
 	defer fake.updateSecurityGroupSpaceMutex.RUnlock()
 	fake.updateSecurityGroupStagingSpaceMutex.RLock()
 	defer fake.updateSecurityGroupStagingSpaceMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.uploadBuildpackMutex.RLock()
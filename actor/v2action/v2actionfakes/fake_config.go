@@ -36,6 +36,15 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceCacheDirStub        func() string
+	resourceCacheDirMutex       sync.RWMutex
+	resourceCacheDirArgsForCall []struct{}
+	resourceCacheDirReturns     struct {
+		result1 string
+	}
+	resourceCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SetAccessTokenStub        func(accessToken string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -270,6 +279,46 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheDir() string {
+	fake.resourceCacheDirMutex.Lock()
+	ret, specificReturn := fake.resourceCacheDirReturnsOnCall[len(fake.resourceCacheDirArgsForCall)]
+	fake.resourceCacheDirArgsForCall = append(fake.resourceCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheDir", []interface{}{})
+	fake.resourceCacheDirMutex.Unlock()
+	if fake.ResourceCacheDirStub != nil {
+		return fake.ResourceCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheDirCallCount() int {
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
+	return len(fake.resourceCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturns(result1 string) {
+	fake.ResourceCacheDirStub = nil
+	fake.resourceCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheDirStub = nil
+	if fake.resourceCacheDirReturnsOnCall == nil {
+		fake.resourceCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(accessToken string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -747,6 +796,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
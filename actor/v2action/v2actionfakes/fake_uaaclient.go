@@ -3,6 +3,7 @@ package v2actionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -27,6 +28,22 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateWithBrowserStub        func(openURL func(loginURL string) error, timeout time.Duration) (string, string, error)
+	authenticateWithBrowserMutex       sync.RWMutex
+	authenticateWithBrowserArgsForCall []struct {
+		openURL func(loginURL string) error
+		timeout time.Duration
+	}
+	authenticateWithBrowserReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithBrowserReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -129,6 +146,61 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateWithBrowser(openURL func(loginURL string) error, timeout time.Duration) (string, string, error) {
+	fake.authenticateWithBrowserMutex.Lock()
+	ret, specificReturn := fake.authenticateWithBrowserReturnsOnCall[len(fake.authenticateWithBrowserArgsForCall)]
+	fake.authenticateWithBrowserArgsForCall = append(fake.authenticateWithBrowserArgsForCall, struct {
+		openURL func(loginURL string) error
+		timeout time.Duration
+	}{openURL, timeout})
+	fake.recordInvocation("AuthenticateWithBrowser", []interface{}{openURL, timeout})
+	fake.authenticateWithBrowserMutex.Unlock()
+	if fake.AuthenticateWithBrowserStub != nil {
+		return fake.AuthenticateWithBrowserStub(openURL, timeout)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.authenticateWithBrowserReturns.result1, fake.authenticateWithBrowserReturns.result2, fake.authenticateWithBrowserReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithBrowserCallCount() int {
+	fake.authenticateWithBrowserMutex.RLock()
+	defer fake.authenticateWithBrowserMutex.RUnlock()
+	return len(fake.authenticateWithBrowserArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithBrowserArgsForCall(i int) (func(loginURL string) error, time.Duration) {
+	fake.authenticateWithBrowserMutex.RLock()
+	defer fake.authenticateWithBrowserMutex.RUnlock()
+	return fake.authenticateWithBrowserArgsForCall[i].openURL, fake.authenticateWithBrowserArgsForCall[i].timeout
+}
+
+func (fake *FakeUAAClient) AuthenticateWithBrowserReturns(result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithBrowserStub = nil
+	fake.authenticateWithBrowserReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithBrowserReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithBrowserStub = nil
+	if fake.authenticateWithBrowserReturnsOnCall == nil {
+		fake.authenticateWithBrowserReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithBrowserReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -290,6 +362,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithBrowserMutex.RLock()
+	defer fake.authenticateWithBrowserMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
@@ -0,0 +1,151 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// UnusedApplicationArtifacts are the droplets and packages of an application
+// that it no longer needs.
+type UnusedApplicationArtifacts struct {
+	Application Application
+
+	// UnusedDroplets are the staged, failed or expired droplets that are not
+	// the application's current droplet.
+	UnusedDroplets []Droplet
+
+	// UnusedPackages are the ready or failed packages other than the
+	// application's most recent ready package.
+	UnusedPackages []Package
+
+	// ExpiredPackages are the packages whose bits are no longer available.
+	ExpiredPackages []Package
+}
+
+// IsEmpty returns true if the application has no unused droplets or
+// packages.
+func (artifacts UnusedApplicationArtifacts) IsEmpty() bool {
+	return len(artifacts.UnusedDroplets) == 0 &&
+		len(artifacts.UnusedPackages) == 0 &&
+		len(artifacts.ExpiredPackages) == 0
+}
+
+// GetUnusedApplicationArtifactsBySpace returns the unused droplets and
+// packages of every application in the space. Applications without any are
+// omitted. Droplets and packages that are still being uploaded, processed or
+// copied are never considered unused.
+func (actor Actor) GetUnusedApplicationArtifactsBySpace(spaceGUID string) ([]UnusedApplicationArtifacts, Warnings, error) {
+	applications, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var allArtifacts []UnusedApplicationArtifacts
+	for _, application := range applications {
+		artifacts, warnings, err := actor.getUnusedApplicationArtifacts(application)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if !artifacts.IsEmpty() {
+			allArtifacts = append(allArtifacts, artifacts)
+		}
+	}
+
+	sort.Slice(allArtifacts, func(i int, j int) bool {
+		return allArtifacts[i].Application.Name < allArtifacts[j].Application.Name
+	})
+
+	return allArtifacts, allWarnings, nil
+}
+
+// DeleteDroplet deletes the droplet with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeleteDroplet(dropletGUID string) (Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteDroplet(dropletGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// DeletePackage deletes the package with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeletePackage(packageGUID string) (Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.DeletePackage(packageGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getUnusedApplicationArtifacts(application Application) (UnusedApplicationArtifacts, Warnings, error) {
+	artifacts := UnusedApplicationArtifacts{Application: application}
+
+	currentDroplet, allWarnings, err := actor.GetCurrentDropletByApplication(application.GUID)
+	if _, ok := err.(actionerror.DropletNotFoundError); !ok && err != nil {
+		return UnusedApplicationArtifacts{}, allWarnings, err
+	}
+
+	ccDroplets, warnings, err := actor.CloudControllerClient.GetDroplets(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{application.GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UnusedApplicationArtifacts{}, allWarnings, err
+	}
+
+	for _, ccDroplet := range ccDroplets {
+		if ccDroplet.GUID == currentDroplet.GUID {
+			continue
+		}
+
+		switch ccDroplet.State {
+		case constant.DropletStaged, constant.DropletFailed, constant.DropletExpired:
+			artifacts.UnusedDroplets = append(artifacts.UnusedDroplets, actor.convertCCToActorDroplet(ccDroplet))
+		}
+	}
+
+	ccPackages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{application.GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UnusedApplicationArtifacts{}, allWarnings, err
+	}
+
+	// Newest first, so the first ready package is the one to keep.
+	sort.SliceStable(ccPackages, func(i int, j int) bool {
+		return ccPackages[i].CreatedAt > ccPackages[j].CreatedAt
+	})
+
+	keptReadyPackage := false
+	for _, ccPackage := range ccPackages {
+		switch ccPackage.State {
+		case constant.PackageExpired:
+			artifacts.ExpiredPackages = append(artifacts.ExpiredPackages, Package(ccPackage))
+		case constant.PackageReady:
+			if !keptReadyPackage {
+				keptReadyPackage = true
+				continue
+			}
+			artifacts.UnusedPackages = append(artifacts.UnusedPackages, Package(ccPackage))
+		case constant.PackageFailed:
+			artifacts.UnusedPackages = append(artifacts.UnusedPackages, Package(ccPackage))
+		}
+	}
+
+	return artifacts, allWarnings, nil
+}
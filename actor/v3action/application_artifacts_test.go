@@ -0,0 +1,182 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Artifact Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetUnusedApplicationArtifactsBySpace", func() {
+		var (
+			artifacts []UnusedApplicationArtifacts
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{GUID: "b-app-guid", Name: "b-app"},
+					{GUID: "a-app-guid", Name: "a-app"},
+					{GUID: "clean-app-guid", Name: "clean-app"},
+				},
+				ccv3.Warnings{"apps-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationDropletCurrentStub = func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+				if appGUID == "b-app-guid" {
+					return ccv3.Droplet{}, ccv3.Warnings{"current-droplet-warning"}, ccerror.DropletNotFoundError{}
+				}
+				return ccv3.Droplet{GUID: appGUID + "-current-droplet"}, ccv3.Warnings{"current-droplet-warning"}, nil
+			}
+			fakeCloudControllerClient.GetDropletsStub = func(query ...ccv3.Query) ([]ccv3.Droplet, ccv3.Warnings, error) {
+				appGUID := query[0].Values[0]
+				switch appGUID {
+				case "a-app-guid":
+					return []ccv3.Droplet{
+						{GUID: "a-app-guid-current-droplet", State: constant.DropletStaged},
+						{GUID: "old-droplet", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "failed-droplet", State: constant.DropletFailed},
+						{GUID: "copying-droplet", State: constant.DropletCopying},
+					}, ccv3.Warnings{"droplets-warning"}, nil
+				case "b-app-guid":
+					return []ccv3.Droplet{
+						{GUID: "expired-droplet", State: constant.DropletExpired},
+					}, ccv3.Warnings{"droplets-warning"}, nil
+				}
+				return []ccv3.Droplet{{GUID: appGUID + "-current-droplet", State: constant.DropletStaged}}, ccv3.Warnings{"droplets-warning"}, nil
+			}
+			fakeCloudControllerClient.GetPackagesStub = func(query ...ccv3.Query) ([]ccv3.Package, ccv3.Warnings, error) {
+				if query[0].Values[0] == "a-app-guid" {
+					return []ccv3.Package{
+						{GUID: "older-package", State: constant.PackageReady, CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "newest-package", State: constant.PackageReady, CreatedAt: "2017-03-01T00:00:00Z"},
+						{GUID: "failed-package", State: constant.PackageFailed, CreatedAt: "2017-02-01T00:00:00Z"},
+						{GUID: "expired-package", State: constant.PackageExpired, CreatedAt: "2016-01-01T00:00:00Z"},
+						{GUID: "uploading-package", State: constant.PackageProcessingUpload, CreatedAt: "2017-04-01T00:00:00Z"},
+					}, ccv3.Warnings{"packages-warning"}, nil
+				}
+				return []ccv3.Package{{GUID: "only-package", State: constant.PackageReady}}, ccv3.Warnings{"packages-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			artifacts, warnings, err = actor.GetUnusedApplicationArtifactsBySpace("some-space-guid")
+		})
+
+		It("returns the unused droplets and packages of each app, sorted by app name", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(artifacts).To(HaveLen(2))
+
+			Expect(artifacts[0].Application.Name).To(Equal("a-app"))
+			Expect(artifacts[0].UnusedDroplets).To(Equal([]Droplet{
+				{GUID: "old-droplet", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				{GUID: "failed-droplet", State: constant.DropletFailed},
+			}))
+			Expect(artifacts[0].UnusedPackages).To(Equal([]Package{
+				{GUID: "failed-package", State: constant.PackageFailed, CreatedAt: "2017-02-01T00:00:00Z"},
+				{GUID: "older-package", State: constant.PackageReady, CreatedAt: "2017-01-01T00:00:00Z"},
+			}))
+			Expect(artifacts[0].ExpiredPackages).To(Equal([]Package{
+				{GUID: "expired-package", State: constant.PackageExpired, CreatedAt: "2016-01-01T00:00:00Z"},
+			}))
+
+			Expect(artifacts[1].Application.Name).To(Equal("b-app"))
+			Expect(artifacts[1].UnusedDroplets).To(Equal([]Droplet{
+				{GUID: "expired-droplet", State: constant.DropletExpired},
+			}))
+			Expect(artifacts[1].UnusedPackages).To(BeEmpty())
+
+			Expect(warnings).To(ContainElement("apps-warning"))
+			Expect(warnings).To(ContainElement("current-droplet-warning"))
+			Expect(warnings).To(ContainElement("droplets-warning"))
+			Expect(warnings).To(ContainElement("packages-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+
+		Context("when getting the current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentStub = nil
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"current-droplet-warning"}, errors.New("droplet error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("droplet error"))
+				Expect(warnings).To(ConsistOf("apps-warning", "current-droplet-warning"))
+			})
+		})
+
+		Context("when getting the packages fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesStub = nil
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, errors.New("packages error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("packages error"))
+				Expect(warnings).To(ConsistOf("apps-warning", "current-droplet-warning", "droplets-warning", "packages-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteDroplet", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteDropletReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		It("deletes the droplet and waits for the job", func() {
+			warnings, err := actor.DeleteDroplet("some-droplet-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+
+		Context("when the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"delete-warning"}, errors.New("delete error"))
+			})
+
+			It("returns the error without polling", func() {
+				warnings, err := actor.DeleteDroplet("some-droplet-guid")
+				Expect(err).To(MatchError("delete error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeletePackageReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, errors.New("poll error"))
+		})
+
+		It("deletes the package and returns the job's error", func() {
+			warnings, err := actor.DeletePackage("some-package-guid")
+			Expect(err).To(MatchError("poll error"))
+			Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("some-package-guid"))
+		})
+	})
+})
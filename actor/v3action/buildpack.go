@@ -0,0 +1,128 @@
+package v3action
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Buildpack represents a V3 actor buildpack.
+type Buildpack ccv3.Buildpack
+
+// GetBuildpacks returns the buildpacks in the order they are checked during
+// buildpack auto-detection. When stack is not empty, only the buildpacks for
+// that stack are returned.
+func (actor Actor) GetBuildpacks(stack string) ([]Buildpack, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.PositionOrder}},
+	}
+	if stack != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.StackFilter, Values: []string{stack}})
+	}
+
+	ccBuildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	buildpacks := make([]Buildpack, 0, len(ccBuildpacks))
+	for _, ccBuildpack := range ccBuildpacks {
+		buildpacks = append(buildpacks, Buildpack(ccBuildpack))
+	}
+
+	return buildpacks, Warnings(warnings), nil
+}
+
+// GetBuildpackByNameAndStack returns the buildpack with the given name. When
+// stack is empty the name must only be used by a single buildpack.
+func (actor Actor) GetBuildpackByNameAndStack(buildpackName string, stack string) (Buildpack, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.NameFilter, Values: []string{buildpackName}},
+	}
+	if stack != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.StackFilter, Values: []string{stack}})
+	}
+
+	ccBuildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(queries...)
+	if err != nil {
+		return Buildpack{}, Warnings(warnings), err
+	}
+
+	switch len(ccBuildpacks) {
+	case 0:
+		return Buildpack{}, Warnings(warnings), actionerror.BuildpackNotFoundError{Name: buildpackName, Stack: stack}
+	case 1:
+		return Buildpack(ccBuildpacks[0]), Warnings(warnings), nil
+	}
+
+	var stacks []string
+	for _, ccBuildpack := range ccBuildpacks {
+		stacks = append(stacks, ccBuildpack.Stack)
+	}
+	return Buildpack{}, Warnings(warnings), actionerror.MultipleBuildpacksFoundError{Name: buildpackName, Stacks: stacks}
+}
+
+// CreateBuildpack creates a buildpack without any bits.
+func (actor Actor) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	ccBuildpack, warnings, err := actor.CloudControllerClient.CreateBuildpack(ccv3.Buildpack(buildpack))
+	return Buildpack(ccBuildpack), Warnings(warnings), err
+}
+
+// UpdateBuildpack updates the settings of the buildpack with the given GUID.
+// Only the settings that are set on buildpack are changed.
+func (actor Actor) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	ccBuildpack, warnings, err := actor.CloudControllerClient.UpdateBuildpack(ccv3.Buildpack(buildpack))
+	return Buildpack(ccBuildpack), Warnings(warnings), err
+}
+
+// UploadBuildpack uploads the zipped buildpack at pathToBuildpackBits and
+// waits for the Cloud Controller to finish processing it. When checksum is
+// not empty, the bits are verified against it before they are uploaded. The
+// SHA256 of the uploaded bits is returned.
+func (actor Actor) UploadBuildpack(buildpackGUID string, pathToBuildpackBits string, checksum string, progressBar ProgressBar) (string, Warnings, error) {
+	if checksum != "" {
+		expected, err := sharedaction.ParseChecksum(checksum)
+		if err != nil {
+			return "", nil, err
+		}
+
+		err = expected.Verify(pathToBuildpackBits, filepath.Base(pathToBuildpackBits))
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	file, err := os.Open(pathToBuildpackBits)
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return "", nil, err
+	}
+
+	sum := sha256.New()
+	reader := progressBar.NewProgressBarWrapper(io.TeeReader(file, sum), fileInfo.Size())
+
+	jobURL, warnings, err := actor.CloudControllerClient.UploadBuildpack(buildpackGUID, pathToBuildpackBits, reader, fileInfo.Size())
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	return fmt.Sprintf("%x", sum.Sum(nil)), allWarnings, nil
+}
@@ -0,0 +1,353 @@
+package v3action_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetBuildpacks", func() {
+		var (
+			stack      string
+			buildpacks []Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			stack = ""
+			fakeCloudControllerClient.GetBuildpacksReturns(
+				[]ccv3.Buildpack{
+					{GUID: "buildpack-guid-1", Name: "buildpack-1", Position: types.NullInt{IsSet: true, Value: 1}},
+					{GUID: "buildpack-guid-2", Name: "buildpack-2", Position: types.NullInt{IsSet: true, Value: 2}},
+				},
+				ccv3.Warnings{"get-buildpacks-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			buildpacks, warnings, executeErr = actor.GetBuildpacks(stack)
+		})
+
+		It("returns all buildpacks ordered by position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(buildpacks).To(Equal([]Buildpack{
+				{GUID: "buildpack-guid-1", Name: "buildpack-1", Position: types.NullInt{IsSet: true, Value: 1}},
+				{GUID: "buildpack-guid-2", Name: "buildpack-2", Position: types.NullInt{IsSet: true, Value: 2}},
+			}))
+			Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.PositionOrder}},
+			))
+		})
+
+		Context("when a stack is provided", func() {
+			BeforeEach(func() {
+				stack = "cflinuxfs2"
+			})
+
+			It("filters the buildpacks by stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.PositionOrder}},
+					ccv3.Query{Key: ccv3.StackFilter, Values: []string{"cflinuxfs2"}},
+				))
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv3.Warnings{"get-buildpacks-warning"}, errors.New("get-buildpacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-buildpacks-error"))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+	})
+
+	Describe("GetBuildpackByNameAndStack", func() {
+		var (
+			stack      string
+			buildpack  Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			stack = ""
+		})
+
+		JustBeforeEach(func() {
+			buildpack, warnings, executeErr = actor.GetBuildpackByNameAndStack("some-buildpack", stack)
+		})
+
+		Context("when a single buildpack is found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv3.Buildpack{{GUID: "buildpack-guid", Name: "some-buildpack", Stack: "cflinuxfs2"}},
+					ccv3.Warnings{"get-buildpacks-warning"},
+					nil)
+			})
+
+			It("returns the buildpack and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(buildpack).To(Equal(Buildpack{GUID: "buildpack-guid", Name: "some-buildpack", Stack: "cflinuxfs2"}))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-buildpack"}},
+				))
+			})
+
+			Context("when a stack is provided", func() {
+				BeforeEach(func() {
+					stack = "cflinuxfs2"
+				})
+
+				It("filters the buildpacks by stack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-buildpack"}},
+						ccv3.Query{Key: ccv3.StackFilter, Values: []string{"cflinuxfs2"}},
+					))
+				})
+			})
+		})
+
+		Context("when no buildpack is found", func() {
+			BeforeEach(func() {
+				stack = "cflinuxfs2"
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv3.Warnings{"get-buildpacks-warning"}, nil)
+			})
+
+			It("returns a BuildpackNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{Name: "some-buildpack", Stack: "cflinuxfs2"}))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+
+		Context("when buildpacks are found for multiple stacks", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv3.Buildpack{
+						{GUID: "buildpack-guid-1", Name: "some-buildpack", Stack: "cflinuxfs2"},
+						{GUID: "buildpack-guid-2", Name: "some-buildpack", Stack: "cflinuxfs3"},
+					},
+					ccv3.Warnings{"get-buildpacks-warning"},
+					nil)
+			})
+
+			It("returns a MultipleBuildpacksFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.MultipleBuildpacksFoundError{
+					Name:   "some-buildpack",
+					Stacks: []string{"cflinuxfs2", "cflinuxfs3"},
+				}))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv3.Warnings{"get-buildpacks-warning"}, errors.New("get-buildpacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-buildpacks-error"))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+	})
+
+	Describe("CreateBuildpack", func() {
+		It("creates the buildpack and returns all warnings", func() {
+			fakeCloudControllerClient.CreateBuildpackReturns(
+				ccv3.Buildpack{GUID: "buildpack-guid", Name: "some-buildpack"},
+				ccv3.Warnings{"create-buildpack-warning"},
+				nil)
+
+			buildpack, warnings, err := actor.CreateBuildpack(Buildpack{Name: "some-buildpack", Position: types.NullInt{IsSet: true, Value: 1}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildpack).To(Equal(Buildpack{GUID: "buildpack-guid", Name: "some-buildpack"}))
+			Expect(warnings).To(ConsistOf("create-buildpack-warning"))
+			Expect(fakeCloudControllerClient.CreateBuildpackArgsForCall(0)).To(Equal(
+				ccv3.Buildpack{Name: "some-buildpack", Position: types.NullInt{IsSet: true, Value: 1}},
+			))
+		})
+	})
+
+	Describe("UpdateBuildpack", func() {
+		It("updates the buildpack and returns all warnings", func() {
+			fakeCloudControllerClient.UpdateBuildpackReturns(
+				ccv3.Buildpack{GUID: "buildpack-guid", Locked: types.NullBool{IsSet: true, Value: true}},
+				ccv3.Warnings{"update-buildpack-warning"},
+				errors.New("update-buildpack-error"))
+
+			buildpack, warnings, err := actor.UpdateBuildpack(Buildpack{GUID: "buildpack-guid", Locked: types.NullBool{IsSet: true, Value: true}})
+			Expect(err).To(MatchError("update-buildpack-error"))
+			Expect(buildpack).To(Equal(Buildpack{GUID: "buildpack-guid", Locked: types.NullBool{IsSet: true, Value: true}}))
+			Expect(warnings).To(ConsistOf("update-buildpack-warning"))
+			Expect(fakeCloudControllerClient.UpdateBuildpackArgsForCall(0)).To(Equal(
+				ccv3.Buildpack{GUID: "buildpack-guid", Locked: types.NullBool{IsSet: true, Value: true}},
+			))
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		var (
+			tmpDir          string
+			buildpackPath   string
+			checksum        string
+			fakeProgressBar *v3actionfakes.FakeProgressBar
+			uploadedBits    []byte
+
+			sha        string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "upload-buildpack-test")
+			Expect(err).ToNot(HaveOccurred())
+
+			buildpackPath = filepath.Join(tmpDir, "buildpack.zip")
+			err = ioutil.WriteFile(buildpackPath, []byte("some-buildpack-bits"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			checksum = ""
+			uploadedBits = nil
+
+			fakeProgressBar = new(v3actionfakes.FakeProgressBar)
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+
+			fakeCloudControllerClient.UploadBuildpackStub = func(_ string, _ string, reader io.Reader, _ int64) (ccv3.JobURL, ccv3.Warnings, error) {
+				var err error
+				uploadedBits, err = ioutil.ReadAll(reader)
+				Expect(err).ToNot(HaveOccurred())
+				return "some-job-url", ccv3.Warnings{"upload-buildpack-warning"}, nil
+			}
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			sha, warnings, executeErr = actor.UploadBuildpack("buildpack-guid", buildpackPath, checksum, fakeProgressBar)
+		})
+
+		It("uploads the bits through the progress bar and waits for the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("upload-buildpack-warning", "poll-job-warning"))
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(len("some-buildpack-bits")))
+
+			Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(1))
+			guid, path, _, length := fakeCloudControllerClient.UploadBuildpackArgsForCall(0)
+			Expect(guid).To(Equal("buildpack-guid"))
+			Expect(path).To(Equal(buildpackPath))
+			Expect(length).To(BeEquivalentTo(len("some-buildpack-bits")))
+			Expect(string(uploadedBits)).To(Equal("some-buildpack-bits"))
+
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+
+		It("returns the SHA256 of the uploaded bits", func() {
+			Expect(sha).To(Equal(sha256Of("some-buildpack-bits")))
+		})
+
+		Context("when the checksum matches", func() {
+			BeforeEach(func() {
+				checksum = "sha256:" + sha256Of("some-buildpack-bits")
+			})
+
+			It("uploads the bits", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				checksum = sha256Of("some-other-bits")
+			})
+
+			It("returns a ChecksumMismatchError without uploading", func() {
+				Expect(executeErr).To(MatchError(actionerror.ChecksumMismatchError{
+					Source:   "buildpack.zip",
+					Expected: sha256Of("some-other-bits"),
+					Actual:   sha256Of("some-buildpack-bits"),
+				}))
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the checksum is invalid", func() {
+			BeforeEach(func() {
+				checksum = "md5:abc"
+			})
+
+			It("returns an UnsupportedChecksumError", func() {
+				Expect(executeErr).To(MatchError(actionerror.UnsupportedChecksumError{Checksum: "md5:abc"}))
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the upload fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadBuildpackStub = nil
+				fakeCloudControllerClient.UploadBuildpackReturns("", ccv3.Warnings{"upload-buildpack-warning"}, errors.New("upload-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("upload-error"))
+				Expect(warnings).To(ConsistOf("upload-buildpack-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when polling the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, errors.New("poll-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("poll-error"))
+				Expect(warnings).To(ConsistOf("upload-buildpack-warning", "poll-job-warning"))
+			})
+		})
+	})
+})
+
+func sha256Of(content string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+}
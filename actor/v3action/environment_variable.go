@@ -3,6 +3,7 @@ package v3action
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // EnvironmentVariableGroups represents all environment variables for application
@@ -46,6 +47,22 @@ func (actor *Actor) SetEnvironmentVariableByApplicationNameAndSpace(appName stri
 	return warnings, apiErr
 }
 
+// SetEnvironmentVariablesByApplicationNameAndSpace adds all envPairs to an
+// application in a single request. It must be restarted for changes to take
+// effect.
+func (actor *Actor) SetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string, envPairs []EnvironmentVariablePair) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, v3Warnings, apiErr := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(
+		app.GUID,
+		environmentVariablesFromPairs(envPairs))
+	warnings = append(warnings, v3Warnings...)
+	return warnings, apiErr
+}
+
 // UnsetEnvironmentVariableByApplicationNameAndSpace removes an enviornment
 // variable from an application. It must be restarted for changes to take
 // effect.
@@ -72,3 +89,11 @@ func (actor *Actor) UnsetEnvironmentVariableByApplicationNameAndSpace(appName st
 	warnings = append(warnings, patchWarnings...)
 	return warnings, patchErr
 }
+
+func environmentVariablesFromPairs(envPairs []EnvironmentVariablePair) ccv3.EnvironmentVariables {
+	envVars := ccv3.EnvironmentVariables{}
+	for _, envPair := range envPairs {
+		envVars[envPair.Key] = types.FilteredString{Value: envPair.Value, IsSet: true}
+	}
+	return envVars
+}
@@ -0,0 +1,34 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/util/envfile"
+)
+
+// ReadEnvironmentVariablesFile returns the variables of a dotenv or JSON file,
+// sorted by name.
+func (*Actor) ReadEnvironmentVariablesFile(path string) ([]EnvironmentVariablePair, error) {
+	vars, err := envfile.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var envPairs []EnvironmentVariablePair
+	for key, value := range vars {
+		envPairs = append(envPairs, EnvironmentVariablePair{Key: key, Value: value})
+	}
+	sort.Slice(envPairs, func(i int, j int) bool {
+		return envPairs[i].Key < envPairs[j].Key
+	})
+
+	return envPairs, nil
+}
+
+// WriteEnvironmentVariablesFile writes envVars to path, as JSON if path has a
+// .json extension and as a dotenv file otherwise. Numbers and booleans stay
+// JSON values in JSON files; other values that are not strings are written as
+// JSON.
+func (*Actor) WriteEnvironmentVariablesFile(path string, envVars map[string]interface{}) error {
+	return envfile.WriteValues(path, envVars)
+}
@@ -0,0 +1,86 @@
+package v3action_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/util/envfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable File Actions", func() {
+	var (
+		actor *Actor
+		dir   string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil, nil, nil)
+
+		var err error
+		dir, err = ioutil.TempDir("", "v3action-envfile")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("ReadEnvironmentVariablesFile", func() {
+		It("returns the variables of the file sorted by name", func() {
+			path := filepath.Join(dir, ".env")
+			Expect(ioutil.WriteFile(path, []byte("# comment\nZ_VAR=z\nA_VAR='a'\n"), 0600)).To(Succeed())
+
+			envPairs, err := actor.ReadEnvironmentVariablesFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envPairs).To(Equal([]EnvironmentVariablePair{
+				{Key: "A_VAR", Value: "a"},
+				{Key: "Z_VAR", Value: "z"},
+			}))
+		})
+
+		It("returns parse errors", func() {
+			path := filepath.Join(dir, ".env")
+			Expect(ioutil.WriteFile(path, []byte("not an assignment"), 0600)).To(Succeed())
+
+			_, err := actor.ReadEnvironmentVariablesFile(path)
+			Expect(err).To(MatchError(envfile.InvalidLineError{Source: path, Line: 1}))
+		})
+	})
+
+	Describe("WriteEnvironmentVariablesFile", func() {
+		var envVars map[string]interface{}
+
+		BeforeEach(func() {
+			envVars = map[string]interface{}{
+				"STRING": "some-value",
+				"NUMBER": float64(1),
+				"BOOL":   true,
+				"OBJECT": map[string]interface{}{"a": "b"},
+			}
+		})
+
+		It("keeps numbers and booleans as JSON values in JSON files", func() {
+			path := filepath.Join(dir, "vars.json")
+			err := actor.WriteEnvironmentVariablesFile(path, envVars)
+			Expect(err).ToNot(HaveOccurred())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{"STRING": "some-value", "NUMBER": 1, "BOOL": true, "OBJECT": "{\"a\":\"b\"}"}`))
+		})
+
+		It("writes values that are not strings as JSON in dotenv files", func() {
+			path := filepath.Join(dir, ".env")
+			err := actor.WriteEnvironmentVariablesFile(path, envVars)
+			Expect(err).ToNot(HaveOccurred())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal("BOOL=true\nNUMBER=1\nOBJECT=\"{\\\"a\\\":\\\"b\\\"}\"\nSTRING=some-value\n"))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// GetEnvironmentVariableGroup returns the variables of the running or staging
+// environment variable group, sorted by name.
+func (actor *Actor) GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) ([]EnvironmentVariablePair, Warnings, error) {
+	ccEnvVars, warnings, err := actor.CloudControllerClient.GetEnvironmentVariableGroup(group)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var envPairs []EnvironmentVariablePair
+	for key, value := range ccEnvVars {
+		envPairs = append(envPairs, EnvironmentVariablePair{Key: key, Value: value.Value})
+	}
+	sort.Slice(envPairs, func(i int, j int) bool {
+		return envPairs[i].Key < envPairs[j].Key
+	})
+
+	return envPairs, Warnings(warnings), nil
+}
+
+// SetEnvironmentVariableGroup adds envPairs to the running or staging
+// environment variable group. Variables of the group that are not in envPairs
+// are left unchanged. Applications must be restarted or restaged for changes
+// to take effect.
+func (actor *Actor) SetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envPairs []EnvironmentVariablePair) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateEnvironmentVariableGroup(group, environmentVariablesFromPairs(envPairs))
+	return Warnings(warnings), err
+}
@@ -0,0 +1,81 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		Context("when getting the group succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(
+					ccv3.EnvironmentVariables{
+						"z-var": {Value: "z-val", IsSet: true},
+						"a-var": {Value: "a-val", IsSet: true},
+					},
+					ccv3.Warnings{"group-warning"}, nil)
+			})
+
+			It("returns the variables sorted by name", func() {
+				envPairs, warnings, err := actor.GetEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("group-warning"))
+				Expect(envPairs).To(Equal([]EnvironmentVariablePair{
+					{Key: "a-var", Value: "a-val"},
+					{Key: "z-var", Value: "z-val"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.RunningEnvironmentVariableGroup))
+			})
+		})
+
+		Context("when getting the group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEnvironmentVariableGroupReturns(nil, ccv3.Warnings{"group-warning"}, errors.New("group-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup)
+				Expect(err).To(MatchError("group-error"))
+				Expect(warnings).To(ConsistOf("group-warning"))
+			})
+		})
+	})
+
+	Describe("SetEnvironmentVariableGroup", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateEnvironmentVariableGroupReturns(nil, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+		})
+
+		It("updates the group with the variables", func() {
+			warnings, err := actor.SetEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, []EnvironmentVariablePair{
+				{Key: "my-var", Value: "my-val"},
+			})
+			Expect(err).To(MatchError("update-error"))
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			group, envVars := fakeCloudControllerClient.UpdateEnvironmentVariableGroupArgsForCall(0)
+			Expect(group).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(envVars).To(Equal(ccv3.EnvironmentVariables{
+				"my-var": {Value: "my-val", IsSet: true},
+			}))
+		})
+	})
+})
@@ -178,6 +178,70 @@ var _ = Describe("Environment Variable Actions", func() {
 		})
 	})
 
+	Describe("SetEnvironmentVariablesByApplicationNameAndSpace", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+			executeErr                error
+			warnings                  Warnings
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-application-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetEnvironmentVariablesByApplicationNameAndSpace("some-app", "space-guid", []EnvironmentVariablePair{
+				{Key: "my-var", Value: "my-val"},
+				{Key: "empty-var", Value: ""},
+			})
+		})
+
+		Context("when finding the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, errors.New("get-application-error"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("get-application-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when updating the app environment variables fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(ccv3.EnvironmentVariables{}, ccv3.Warnings{"some-env-var-warnings"}, errors.New("some-env-var-error"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("some-env-var-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning", "some-env-var-warnings"))
+			})
+		})
+
+		Context("when updating the app environment variables succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(ccv3.EnvironmentVariables{}, ccv3.Warnings{"some-env-var-warnings"}, nil)
+			})
+
+			It("sets all the variables in a single request", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-application-warning", "some-env-var-warnings"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+				appGUIDArg, envVarsArg := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(appGUIDArg).To(Equal("some-app-guid"))
+				Expect(envVarsArg).To(Equal(ccv3.EnvironmentVariables{
+					"my-var":    {Value: "my-val", IsSet: true},
+					"empty-var": {Value: "", IsSet: true},
+				}))
+			})
+		})
+	})
+
 	Describe("UnsetEnvironmentVariableByApplicationNameAndSpace", func() {
 		var (
 			actor                     *Actor
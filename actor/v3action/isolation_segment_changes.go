@@ -0,0 +1,217 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+// SpaceIsolationSegmentChange is a change of the isolation segment assigned
+// to a space.
+type SpaceIsolationSegmentChange struct {
+	SpaceName string
+	Current   string
+	Desired   string
+}
+
+// OrganizationIsolationSegmentChanges are the isolation segment changes
+// required to bring an organization and its spaces in line with their
+// declarative description. Entitlements that are not described are left
+// unchanged.
+type OrganizationIsolationSegmentChanges struct {
+	OrganizationName string
+
+	// Entitle lists the isolation segments the organization is not entitled
+	// to yet.
+	Entitle []string
+
+	CurrentDefault string
+	Default        string
+
+	Spaces []SpaceIsolationSegmentChange
+}
+
+// DefaultChanged returns true when a default isolation segment is configured
+// and it is not the organization's current default.
+func (changes OrganizationIsolationSegmentChanges) DefaultChanged() bool {
+	return changes.Default != "" && changes.Default != changes.CurrentDefault
+}
+
+// HasChanges returns true when applying the changes would modify the
+// organization or any of its spaces.
+func (changes OrganizationIsolationSegmentChanges) HasChanges() bool {
+	return len(changes.Entitle) > 0 || changes.DefaultChanged() || len(changes.Spaces) > 0
+}
+
+// GetOrganizationIsolationSegmentChanges compares the isolation segments of
+// the desired organization with the current entitlements and assignments.
+// orgGUID is empty when the organization does not exist yet, and
+// spaceGUIDs maps the names of existing spaces to their GUIDs.
+func (actor Actor) GetOrganizationIsolationSegmentChanges(orgGUID string, desiredOrg orgconfig.Organization, spaceGUIDs map[string]string) (OrganizationIsolationSegmentChanges, Warnings, error) {
+	changes := OrganizationIsolationSegmentChanges{
+		OrganizationName: desiredOrg.Name,
+		Default:          desiredOrg.DefaultIsolationSegment,
+	}
+
+	if len(desiredOrg.IsolationSegments) == 0 {
+		return changes, nil, nil
+	}
+
+	isolationSegments, warnings, err := actor.CloudControllerClient.GetIsolationSegments(
+		ccv3.Query{Key: ccv3.NameFilter, Values: desiredOrg.IsolationSegments},
+	)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return OrganizationIsolationSegmentChanges{}, allWarnings, err
+	}
+
+	names := map[string]string{}
+	found := map[string]bool{}
+	for _, isolationSegment := range isolationSegments {
+		names[isolationSegment.GUID] = isolationSegment.Name
+		found[isolationSegment.Name] = true
+	}
+	for _, name := range desiredOrg.IsolationSegments {
+		if !found[name] {
+			return OrganizationIsolationSegmentChanges{}, allWarnings, actionerror.IsolationSegmentNotFoundError{Name: name}
+		}
+	}
+
+	if orgGUID == "" {
+		changes.Entitle = desiredOrg.IsolationSegments
+		for _, space := range desiredOrg.Spaces {
+			if space.IsolationSegment != "" {
+				changes.Spaces = append(changes.Spaces, SpaceIsolationSegmentChange{SpaceName: space.Name, Desired: space.IsolationSegment})
+			}
+		}
+		return changes, allWarnings, nil
+	}
+
+	entitled, entitledWarnings, err := actor.GetIsolationSegmentsByOrganization(orgGUID)
+	allWarnings = append(allWarnings, entitledWarnings...)
+	if err != nil {
+		return OrganizationIsolationSegmentChanges{}, allWarnings, err
+	}
+	entitledNames := map[string]bool{}
+	for _, isolationSegment := range entitled {
+		entitledNames[isolationSegment.Name] = true
+	}
+	for _, name := range desiredOrg.IsolationSegments {
+		if !entitledNames[name] {
+			changes.Entitle = append(changes.Entitle, name)
+		}
+	}
+
+	isolationSegmentName := func(guid string) (string, error) {
+		if name, found := names[guid]; found || guid == "" {
+			return name, nil
+		}
+		isolationSegment, ccWarnings, err := actor.CloudControllerClient.GetIsolationSegment(guid)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return "", err
+		}
+		names[guid] = isolationSegment.Name
+		return isolationSegment.Name, nil
+	}
+
+	if changes.Default != "" {
+		relationship, ccWarnings, err := actor.CloudControllerClient.GetOrganizationDefaultIsolationSegment(orgGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return OrganizationIsolationSegmentChanges{}, allWarnings, err
+		}
+		changes.CurrentDefault, err = isolationSegmentName(relationship.GUID)
+		if err != nil {
+			return OrganizationIsolationSegmentChanges{}, allWarnings, err
+		}
+	}
+
+	for _, space := range desiredOrg.Spaces {
+		if space.IsolationSegment == "" {
+			continue
+		}
+
+		change := SpaceIsolationSegmentChange{SpaceName: space.Name, Desired: space.IsolationSegment}
+		if spaceGUID, exists := spaceGUIDs[space.Name]; exists {
+			relationship, ccWarnings, err := actor.CloudControllerClient.GetSpaceIsolationSegment(spaceGUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return OrganizationIsolationSegmentChanges{}, allWarnings, err
+			}
+			change.Current, err = isolationSegmentName(relationship.GUID)
+			if err != nil {
+				return OrganizationIsolationSegmentChanges{}, allWarnings, err
+			}
+		}
+
+		if change.Current != change.Desired {
+			changes.Spaces = append(changes.Spaces, change)
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+// ApplyOrganizationIsolationSegmentChanges entitles the organization to
+// isolation segments, sets its default isolation segment and assigns
+// isolation segments to its spaces. spaceGUIDs maps space names to their
+// GUIDs.
+func (actor Actor) ApplyOrganizationIsolationSegmentChanges(orgGUID string, changes OrganizationIsolationSegmentChanges, spaceGUIDs map[string]string) (Warnings, error) {
+	var allWarnings Warnings
+
+	isolationSegmentGUIDs := map[string]string{}
+	getIsolationSegmentGUID := func(name string) (string, error) {
+		if guid, found := isolationSegmentGUIDs[name]; found {
+			return guid, nil
+		}
+		isolationSegment, warnings, err := actor.GetIsolationSegmentByName(name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", err
+		}
+		isolationSegmentGUIDs[name] = isolationSegment.GUID
+		return isolationSegment.GUID, nil
+	}
+
+	for _, name := range changes.Entitle {
+		isolationSegmentGUID, err := getIsolationSegmentGUID(name)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		_, warnings, err := actor.CloudControllerClient.EntitleIsolationSegmentToOrganizations(isolationSegmentGUID, []string{orgGUID})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if changes.DefaultChanged() {
+		isolationSegmentGUID, err := getIsolationSegmentGUID(changes.Default)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err := actor.SetOrganizationDefaultIsolationSegment(orgGUID, isolationSegmentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, space := range changes.Spaces {
+		isolationSegmentGUID, err := getIsolationSegmentGUID(space.Desired)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		_, warnings, err := actor.CloudControllerClient.UpdateSpaceIsolationSegmentRelationship(spaceGUIDs[space.SpaceName], isolationSegmentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
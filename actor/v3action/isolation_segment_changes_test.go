@@ -0,0 +1,222 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Isolation Segment Changes Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationIsolationSegmentChanges", func() {
+		var (
+			orgGUID    string
+			desiredOrg orgconfig.Organization
+			spaceGUIDs map[string]string
+			changes    OrganizationIsolationSegmentChanges
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			orgGUID = "some-org-guid"
+			desiredOrg = orgconfig.Organization{
+				Name:                    "some-org",
+				IsolationSegments:       []string{"iso-1", "iso-2"},
+				DefaultIsolationSegment: "iso-1",
+				Spaces: []orgconfig.Space{
+					{Name: "existing-space", IsolationSegment: "iso-2"},
+					{Name: "up-to-date-space", IsolationSegment: "iso-1"},
+					{Name: "new-space", IsolationSegment: "iso-1"},
+					{Name: "shared-space"},
+				},
+			}
+			spaceGUIDs = map[string]string{
+				"existing-space":   "existing-space-guid",
+				"up-to-date-space": "up-to-date-space-guid",
+			}
+
+			fakeCloudControllerClient.GetIsolationSegmentsStub = func(queries ...ccv3.Query) ([]ccv3.IsolationSegment, ccv3.Warnings, error) {
+				if queries[0].Key == ccv3.OrganizationGUIDFilter {
+					return []ccv3.IsolationSegment{{GUID: "iso-2-guid", Name: "iso-2"}}, ccv3.Warnings{"get-entitled-warning"}, nil
+				}
+				return []ccv3.IsolationSegment{{GUID: "iso-1-guid", Name: "iso-1"}, {GUID: "iso-2-guid", Name: "iso-2"}}, ccv3.Warnings{"get-isolation-segments-warning"}, nil
+			}
+			fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(ccv3.Relationship{GUID: "other-guid"}, ccv3.Warnings{"get-default-warning"}, nil)
+			fakeCloudControllerClient.GetIsolationSegmentReturns(ccv3.IsolationSegment{GUID: "other-guid", Name: "other"}, ccv3.Warnings{"get-other-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceIsolationSegmentStub = func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+				if spaceGUID == "up-to-date-space-guid" {
+					return ccv3.Relationship{GUID: "iso-1-guid"}, ccv3.Warnings{"get-space-warning"}, nil
+				}
+				return ccv3.Relationship{}, ccv3.Warnings{"get-space-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetOrganizationIsolationSegmentChanges(orgGUID, desiredOrg, spaceGUIDs)
+		})
+
+		Context("when the organization exists", func() {
+			It("returns the missing entitlements and assignments", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal(OrganizationIsolationSegmentChanges{
+					OrganizationName: "some-org",
+					Entitle:          []string{"iso-1"},
+					CurrentDefault:   "other",
+					Default:          "iso-1",
+					Spaces: []SpaceIsolationSegmentChange{
+						{SpaceName: "existing-space", Desired: "iso-2"},
+						{SpaceName: "new-space", Desired: "iso-1"},
+					},
+				}))
+				Expect(changes.HasChanges()).To(BeTrue())
+				Expect(warnings).To(ConsistOf(
+					"get-isolation-segments-warning",
+					"get-entitled-warning",
+					"get-default-warning",
+					"get-other-warning",
+					"get-space-warning",
+					"get-space-warning",
+				))
+
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"iso-1", "iso-2"}},
+				))
+				Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentArgsForCall(0)).To(Equal("other-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the organization does not exist yet", func() {
+			BeforeEach(func() {
+				orgGUID = ""
+				spaceGUIDs = nil
+			})
+
+			It("entitles every isolation segment and assigns every space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal(OrganizationIsolationSegmentChanges{
+					OrganizationName: "some-org",
+					Entitle:          []string{"iso-1", "iso-2"},
+					Default:          "iso-1",
+					Spaces: []SpaceIsolationSegmentChange{
+						{SpaceName: "existing-space", Desired: "iso-2"},
+						{SpaceName: "up-to-date-space", Desired: "iso-1"},
+						{SpaceName: "new-space", Desired: "iso-1"},
+					},
+				}))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no isolation segments are configured", func() {
+			BeforeEach(func() {
+				desiredOrg = orgconfig.Organization{Name: "some-org"}
+			})
+
+			It("returns no changes without calling the API", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes.HasChanges()).To(BeFalse())
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentsStub = nil
+				fakeCloudControllerClient.GetIsolationSegmentsReturns([]ccv3.IsolationSegment{{GUID: "iso-1-guid", Name: "iso-1"}}, ccv3.Warnings{"get-isolation-segments-warning"}, nil)
+			})
+
+			It("returns an IsolationSegmentNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.IsolationSegmentNotFoundError{Name: "iso-2"}))
+				Expect(warnings).To(ConsistOf("get-isolation-segments-warning"))
+			})
+		})
+
+		Context("when getting the space isolation segment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentStub = nil
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(ccv3.Relationship{}, ccv3.Warnings{"get-space-warning"}, errors.New("get-space-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-space-error"))
+				Expect(warnings).To(ContainElement("get-space-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyOrganizationIsolationSegmentChanges", func() {
+		var (
+			changes    OrganizationIsolationSegmentChanges
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			changes = OrganizationIsolationSegmentChanges{
+				OrganizationName: "some-org",
+				Entitle:          []string{"iso-1"},
+				Default:          "iso-1",
+				Spaces:           []SpaceIsolationSegmentChange{{SpaceName: "some-space", Desired: "iso-1"}},
+			}
+
+			fakeCloudControllerClient.GetIsolationSegmentsReturns([]ccv3.IsolationSegment{{GUID: "iso-1-guid", Name: "iso-1"}}, ccv3.Warnings{"get-isolation-segment-warning"}, nil)
+			fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsReturns(ccv3.RelationshipList{}, ccv3.Warnings{"entitle-warning"}, nil)
+			fakeCloudControllerClient.UpdateOrganizationDefaultIsolationSegmentRelationshipReturns(ccv3.Relationship{}, ccv3.Warnings{"set-default-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceIsolationSegmentRelationshipReturns(ccv3.Relationship{}, ccv3.Warnings{"assign-space-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyOrganizationIsolationSegmentChanges("some-org-guid", changes, map[string]string{"some-space": "some-space-guid"})
+		})
+
+		It("entitles the organization, sets the default and assigns the spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-isolation-segment-warning", "entitle-warning", "set-default-warning", "assign-space-warning"))
+
+			Expect(fakeCloudControllerClient.GetIsolationSegmentsCallCount()).To(Equal(1))
+
+			isolationSegmentGUID, orgGUIDs := fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsArgsForCall(0)
+			Expect(isolationSegmentGUID).To(Equal("iso-1-guid"))
+			Expect(orgGUIDs).To(Equal([]string{"some-org-guid"}))
+
+			orgGUID, isolationSegmentGUID := fakeCloudControllerClient.UpdateOrganizationDefaultIsolationSegmentRelationshipArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(isolationSegmentGUID).To(Equal("iso-1-guid"))
+
+			spaceGUID, isolationSegmentGUID := fakeCloudControllerClient.UpdateSpaceIsolationSegmentRelationshipArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(isolationSegmentGUID).To(Equal("iso-1-guid"))
+		})
+
+		Context("when entitling the organization fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsReturns(ccv3.RelationshipList{}, ccv3.Warnings{"entitle-warning"}, errors.New("entitle-error"))
+			})
+
+			It("returns the error and makes no further changes", func() {
+				Expect(executeErr).To(MatchError("entitle-error"))
+				Expect(warnings).To(ConsistOf("get-isolation-segment-warning", "entitle-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationDefaultIsolationSegmentRelationshipCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -21,3 +21,13 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// GetProcessByTypeAndApplication returns the process of the given type for
+// the application.
+func (actor Actor) GetProcessByTypeAndApplication(processType string, appGUID string) (Process, Warnings, error) {
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+		return Process{}, Warnings(warnings), actionerror.ProcessNotFoundError{ProcessType: processType}
+	}
+	return Process(process), Warnings(warnings), err
+}
@@ -18,6 +18,21 @@ type ProcessSummary struct {
 
 type ProcessSummaries []ProcessSummary
 
+type ProcessInstanceChangeType string
+
+const (
+	InstanceCrashed   ProcessInstanceChangeType = "crashed"
+	InstanceRestarted ProcessInstanceChangeType = "restarted"
+)
+
+// ProcessInstanceChange describes an instance that crashed or restarted
+// between two polls of the same processes.
+type ProcessInstanceChange struct {
+	ProcessType string
+	Index       int
+	Type        ProcessInstanceChangeType
+}
+
 func (p ProcessSummary) TotalInstanceCount() int {
 	return len(p.InstanceDetails)
 }
@@ -69,6 +84,45 @@ func (ps ProcessSummaries) String() string {
 	return strings.Join(summaries, ", ")
 }
 
+// InstanceChangesSince returns the instances that crashed or restarted since
+// the previous summaries were fetched. An instance has restarted when its
+// uptime went down or it is no longer crashed.
+func (ps ProcessSummaries) InstanceChangesSince(previous ProcessSummaries) []ProcessInstanceChange {
+	var changes []ProcessInstanceChange
+	for _, process := range ps {
+		previousInstances := map[int]ProcessInstance{}
+		for _, previousProcess := range previous {
+			if previousProcess.Type == process.Type {
+				for _, instance := range previousProcess.InstanceDetails {
+					previousInstances[instance.Index] = instance
+				}
+			}
+		}
+
+		for _, instance := range process.InstanceDetails {
+			previousInstance, ok := previousInstances[instance.Index]
+			if !ok {
+				continue
+			}
+
+			change := ProcessInstanceChange{ProcessType: process.Type, Index: instance.Index}
+			switch {
+			case instance.State == constant.ProcessInstanceCrashed && previousInstance.State != constant.ProcessInstanceCrashed:
+				change.Type = InstanceCrashed
+			case instance.State == constant.ProcessInstanceCrashed:
+				continue
+			case previousInstance.State == constant.ProcessInstanceCrashed || instance.Uptime < previousInstance.Uptime:
+				change.Type = InstanceRestarted
+			default:
+				continue
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
 func (actor Actor) getProcessSummariesForApp(appGUID string, withObfuscatedValues bool) (ProcessSummaries, Warnings, error) {
 	log.WithFields(log.Fields{
 		"appGUID":              appGUID,
@@ -84,4 +84,60 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("InstanceChangesSince", func() {
+		var previous, current ProcessSummaries
+
+		BeforeEach(func() {
+			previous = ProcessSummaries{
+				{
+					Process: Process{Type: "web"},
+					InstanceDetails: []ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 100},
+						{Index: 1, State: constant.ProcessInstanceRunning, Uptime: 100},
+						{Index: 2, State: constant.ProcessInstanceCrashed},
+						{Index: 3, State: constant.ProcessInstanceRunning, Uptime: 100},
+					},
+				},
+				{
+					Process: Process{Type: "worker"},
+					InstanceDetails: []ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceCrashed},
+					},
+				},
+			}
+			current = ProcessSummaries{
+				{
+					Process: Process{Type: "web"},
+					InstanceDetails: []ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 105},
+						{Index: 1, State: constant.ProcessInstanceCrashed},
+						{Index: 2, State: constant.ProcessInstanceStarting},
+						{Index: 3, State: constant.ProcessInstanceRunning, Uptime: 2},
+						{Index: 4, State: constant.ProcessInstanceStarting},
+					},
+				},
+				{
+					Process: Process{Type: "worker"},
+					InstanceDetails: []ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceCrashed},
+					},
+				},
+			}
+		})
+
+		It("returns the instances that crashed or restarted", func() {
+			Expect(current.InstanceChangesSince(previous)).To(Equal([]ProcessInstanceChange{
+				{ProcessType: "web", Index: 1, Type: InstanceCrashed},
+				{ProcessType: "web", Index: 2, Type: InstanceRestarted},
+				{ProcessType: "web", Index: 3, Type: InstanceRestarted},
+			}))
+		})
+
+		Context("when there is no previous poll", func() {
+			It("returns no changes", func() {
+				Expect(current.InstanceChangesSince(nil)).To(BeEmpty())
+			})
+		})
+	})
 })
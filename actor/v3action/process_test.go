@@ -98,4 +98,52 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessByTypeAndApplication", func() {
+		var (
+			process  Process
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			process, warnings, err = actor.GetProcessByTypeAndApplication(constant.ProcessTypeWeb, "some-app-guid")
+		})
+
+		Context("when the process exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{GUID: "some-process-guid", Instances: types.NullInt{Value: 2, IsSet: true}},
+					ccv3.Warnings{"get-process-warning"},
+					nil,
+				)
+			})
+
+			It("returns the process and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+				Expect(process).To(Equal(Process{GUID: "some-process-guid", Instances: types.NullInt{Value: 2, IsSet: true}}))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+				appGUIDArg, processTypeArg := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUIDArg).To(Equal("some-app-guid"))
+				Expect(processTypeArg).To(Equal(constant.ProcessTypeWeb))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"get-process-warning"},
+					ccerror.ProcessNotFoundError{},
+				)
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(err).To(Equal(actionerror.ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+	})
 })
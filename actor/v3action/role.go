@@ -0,0 +1,255 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// MaxGUIDFilterSize is the maximum number of GUIDs sent in a single filter,
+// which keeps request URLs within the limits of the Cloud Controller.
+const MaxGUIDFilterSize = 50
+
+// Role is a role granted to a user in an organization or space.
+type Role struct {
+	GUID     string
+	Type     constant.RoleType
+	UserGUID string
+
+	OrganizationGUID string
+	OrganizationName string
+
+	// SpaceGUID and SpaceName are empty for organization roles.
+	SpaceGUID string
+	SpaceName string
+}
+
+// IsSpaceRole returns true when the role grants access to a space.
+func (role Role) IsSpaceRole() bool {
+	return role.SpaceGUID != ""
+}
+
+// UserWithRoles is a user with all of their organization and space roles.
+type UserWithRoles struct {
+	User
+	Roles []Role
+}
+
+// roleTypeOrder orders roles within the same organization or space.
+var roleTypeOrder = map[constant.RoleType]int{
+	constant.RoleOrganizationManager:        0,
+	constant.RoleOrganizationBillingManager: 1,
+	constant.RoleOrganizationAuditor:        2,
+	constant.RoleOrganizationUser:           3,
+	constant.RoleSpaceManager:               4,
+	constant.RoleSpaceDeveloper:             5,
+	constant.RoleSpaceAuditor:               6,
+}
+
+// GetUserRoles returns the roles of the user, ordered by organization name,
+// then space name, with organization roles first.
+func (actor Actor) GetUserRoles(userGUID string) ([]Role, Warnings, error) {
+	rolesByUser, warnings, err := actor.getRolesByUser([]string{userGUID})
+	return rolesByUser[userGUID], warnings, err
+}
+
+// GetUsersWithRoles returns the users matching search, as GetUsers does,
+// together with their roles.
+func (actor Actor) GetUsersWithRoles(search string) ([]UserWithRoles, Warnings, error) {
+	users, allWarnings, err := actor.GetUsers(search)
+	if err != nil || len(users) == 0 {
+		return nil, allWarnings, err
+	}
+
+	var userGUIDs []string
+	for _, user := range users {
+		userGUIDs = append(userGUIDs, user.GUID)
+	}
+
+	rolesByUser, warnings, err := actor.getRolesByUser(userGUIDs)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	usersWithRoles := make([]UserWithRoles, 0, len(users))
+	for _, user := range users {
+		usersWithRoles = append(usersWithRoles, UserWithRoles{User: user, Roles: rolesByUser[user.GUID]})
+	}
+
+	return usersWithRoles, allWarnings, nil
+}
+
+// RevokeAllRoles removes every organization and space role of the user and
+// returns the removed roles. Space roles are removed before organization
+// roles, and the organization user role last, since the Cloud Controller
+// refuses to remove it while the user holds other roles in the organization.
+func (actor Actor) RevokeAllRoles(userGUID string) ([]Role, Warnings, error) {
+	roles, allWarnings, err := actor.GetUserRoles(userGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ordered := make([]Role, len(roles))
+	copy(ordered, roles)
+	sort.SliceStable(ordered, func(i int, j int) bool {
+		return revokeOrder(ordered[i]) < revokeOrder(ordered[j])
+	})
+
+	var revoked []Role
+	for _, role := range ordered {
+		jobURL, warnings, err := actor.CloudControllerClient.DeleteRole(role.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return revoked, allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return revoked, allWarnings, err
+		}
+
+		revoked = append(revoked, role)
+	}
+
+	return revoked, allWarnings, nil
+}
+
+func revokeOrder(role Role) int {
+	switch {
+	case role.IsSpaceRole():
+		return 0
+	case role.Type == constant.RoleOrganizationUser:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// getRolesByUser returns the sorted roles of each user, keyed by user GUID,
+// with their organization and space names filled in.
+func (actor Actor) getRolesByUser(userGUIDs []string) (map[string][]Role, Warnings, error) {
+	var (
+		ccRoles     []ccv3.Role
+		allWarnings Warnings
+	)
+	for _, guids := range guidFilterBatches(uniqueStrings(userGUIDs)) {
+		roles, ccWarnings, err := actor.CloudControllerClient.GetRoles(ccv3.Query{
+			Key:    ccv3.UserGUIDFilter,
+			Values: guids,
+		})
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		ccRoles = append(ccRoles, roles...)
+	}
+
+	var spaceGUIDs []string
+	for _, ccRole := range ccRoles {
+		if ccRole.SpaceGUID != "" {
+			spaceGUIDs = append(spaceGUIDs, ccRole.SpaceGUID)
+		}
+	}
+
+	spaces := map[string]ccv3.Space{}
+	for _, guids := range guidFilterBatches(uniqueStrings(spaceGUIDs)) {
+		ccSpaces, ccWarnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{
+			Key:    ccv3.GUIDFilter,
+			Values: guids,
+		})
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, space := range ccSpaces {
+			spaces[space.GUID] = space
+		}
+	}
+
+	var orgGUIDs []string
+	for _, ccRole := range ccRoles {
+		if ccRole.OrganizationGUID != "" {
+			orgGUIDs = append(orgGUIDs, ccRole.OrganizationGUID)
+		}
+	}
+	for _, space := range spaces {
+		orgGUIDs = append(orgGUIDs, space.Relationships[constant.RelationshipTypeOrganization].GUID)
+	}
+
+	orgNames := map[string]string{}
+	for _, guids := range guidFilterBatches(uniqueStrings(orgGUIDs)) {
+		ccOrgs, ccWarnings, err := actor.CloudControllerClient.GetOrganizations(ccv3.Query{
+			Key:    ccv3.GUIDFilter,
+			Values: guids,
+		})
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, org := range ccOrgs {
+			orgNames[org.GUID] = org.Name
+		}
+	}
+
+	rolesByUser := map[string][]Role{}
+	for _, ccRole := range ccRoles {
+		role := Role{
+			GUID:             ccRole.GUID,
+			Type:             ccRole.Type,
+			UserGUID:         ccRole.UserGUID,
+			OrganizationGUID: ccRole.OrganizationGUID,
+		}
+		if ccRole.SpaceGUID != "" {
+			space := spaces[ccRole.SpaceGUID]
+			role.SpaceGUID = ccRole.SpaceGUID
+			role.SpaceName = space.Name
+			role.OrganizationGUID = space.Relationships[constant.RelationshipTypeOrganization].GUID
+		}
+		role.OrganizationName = orgNames[role.OrganizationGUID]
+
+		rolesByUser[role.UserGUID] = append(rolesByUser[role.UserGUID], role)
+	}
+
+	for _, roles := range rolesByUser {
+		sort.Slice(roles, func(i int, j int) bool {
+			if roles[i].OrganizationName != roles[j].OrganizationName {
+				return roles[i].OrganizationName < roles[j].OrganizationName
+			}
+			if roles[i].SpaceName != roles[j].SpaceName {
+				return roles[i].SpaceName < roles[j].SpaceName
+			}
+			return roleTypeOrder[roles[i].Type] < roleTypeOrder[roles[j].Type]
+		})
+	}
+
+	return rolesByUser, allWarnings, nil
+}
+
+// guidFilterBatches splits the GUIDs into batches of at most
+// MaxGUIDFilterSize.
+func guidFilterBatches(guids []string) [][]string {
+	var batches [][]string
+	for len(guids) > MaxGUIDFilterSize {
+		batches = append(batches, guids[:MaxGUIDFilterSize])
+		guids = guids[MaxGUIDFilterSize:]
+	}
+	if len(guids) > 0 {
+		batches = append(batches, guids)
+	}
+	return batches
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,196 @@
+package v3action_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+
+		fakeCloudControllerClient.GetRolesReturns(
+			[]ccv3.Role{
+				{GUID: "space-dev-guid", Type: constant.RoleSpaceDeveloper, UserGUID: "user-guid-1", SpaceGUID: "space-guid"},
+				{GUID: "org-user-guid", Type: constant.RoleOrganizationUser, UserGUID: "user-guid-1", OrganizationGUID: "org-guid-b"},
+				{GUID: "org-manager-guid", Type: constant.RoleOrganizationManager, UserGUID: "user-guid-1", OrganizationGUID: "org-guid-b"},
+				{GUID: "org-auditor-guid", Type: constant.RoleOrganizationAuditor, UserGUID: "user-guid-2", OrganizationGUID: "org-guid-a"},
+			},
+			ccv3.Warnings{"get-roles-warning"},
+			nil)
+		fakeCloudControllerClient.GetSpacesReturns(
+			[]ccv3.Space{{
+				GUID:          "space-guid",
+				Name:          "some-space",
+				Relationships: ccv3.Relationships{constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "org-guid-b"}},
+			}},
+			ccv3.Warnings{"get-spaces-warning"},
+			nil)
+		fakeCloudControllerClient.GetOrganizationsReturns(
+			[]ccv3.Organization{{GUID: "org-guid-a", Name: "org-a"}, {GUID: "org-guid-b", Name: "org-b"}},
+			ccv3.Warnings{"get-orgs-warning"},
+			nil)
+	})
+
+	Describe("GetUserRoles", func() {
+		It("returns the sorted roles with organization and space names", func() {
+			roles, warnings, err := actor.GetUserRoles("user-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-roles-warning", "get-spaces-warning", "get-orgs-warning"))
+			Expect(roles).To(Equal([]Role{
+				{GUID: "org-manager-guid", Type: constant.RoleOrganizationManager, UserGUID: "user-guid-1", OrganizationGUID: "org-guid-b", OrganizationName: "org-b"},
+				{GUID: "org-user-guid", Type: constant.RoleOrganizationUser, UserGUID: "user-guid-1", OrganizationGUID: "org-guid-b", OrganizationName: "org-b"},
+				{GUID: "space-dev-guid", Type: constant.RoleSpaceDeveloper, UserGUID: "user-guid-1", OrganizationGUID: "org-guid-b", OrganizationName: "org-b", SpaceGUID: "space-guid", SpaceName: "some-space"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.UserGUIDFilter, Values: []string{"user-guid-1"}},
+			))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"space-guid"}},
+			))
+			orgQueries := fakeCloudControllerClient.GetOrganizationsArgsForCall(0)
+			Expect(orgQueries).To(HaveLen(1))
+			Expect(orgQueries[0].Values).To(ConsistOf("org-guid-a", "org-guid-b"))
+		})
+
+		Context("when the user has no roles", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRolesReturns(nil, ccv3.Warnings{"get-roles-warning"}, nil)
+			})
+
+			It("does not look up spaces or organizations", func() {
+				roles, warnings, err := actor.GetUserRoles("user-guid-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(roles).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("get-roles-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the roles fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRolesReturns(nil, ccv3.Warnings{"get-roles-warning"}, errors.New("get-roles-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetUserRoles("user-guid-1")
+				Expect(err).To(MatchError("get-roles-error"))
+				Expect(warnings).To(ConsistOf("get-roles-warning"))
+			})
+		})
+	})
+
+	Describe("GetUsersWithRoles", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetUsersReturns(
+				[]ccv3.User{{GUID: "user-guid-1", Username: "user-1"}, {GUID: "user-guid-2", Username: "user-2"}, {GUID: "user-guid-3", Username: "user-3"}},
+				ccv3.Warnings{"get-users-warning"},
+				nil)
+		})
+
+		It("returns each user with their roles using a single roles request", func() {
+			usersWithRoles, warnings, err := actor.GetUsersWithRoles("user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-users-warning", "get-roles-warning", "get-spaces-warning", "get-orgs-warning"))
+
+			Expect(usersWithRoles).To(HaveLen(3))
+			Expect(usersWithRoles[0].Username).To(Equal("user-1"))
+			Expect(usersWithRoles[0].Roles).To(HaveLen(3))
+			Expect(usersWithRoles[1].Roles).To(Equal([]Role{
+				{GUID: "org-auditor-guid", Type: constant.RoleOrganizationAuditor, UserGUID: "user-guid-2", OrganizationGUID: "org-guid-a", OrganizationName: "org-a"},
+			}))
+			Expect(usersWithRoles[2].Roles).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.UserGUIDFilter, Values: []string{"user-guid-1", "user-guid-2", "user-guid-3"}},
+			))
+		})
+
+		Context("when more users match than fit in a single filter", func() {
+			BeforeEach(func() {
+				var users []ccv3.User
+				for i := 0; i < MaxGUIDFilterSize+1; i++ {
+					users = append(users, ccv3.User{GUID: fmt.Sprintf("user-guid-%d", i)})
+				}
+				fakeCloudControllerClient.GetUsersReturns(users, ccv3.Warnings{"get-users-warning"}, nil)
+			})
+
+			It("gets the roles in batches", func() {
+				_, warnings, err := actor.GetUsersWithRoles("user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-users-warning", "get-roles-warning", "get-roles-warning", "get-spaces-warning", "get-orgs-warning"))
+
+				Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)[0].Values).To(HaveLen(MaxGUIDFilterSize))
+				Expect(fakeCloudControllerClient.GetRolesArgsForCall(1)[0].Values).To(Equal([]string{fmt.Sprintf("user-guid-%d", MaxGUIDFilterSize)}))
+			})
+		})
+
+		Context("when no users match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUsersReturns(nil, ccv3.Warnings{"get-users-warning"}, nil)
+			})
+
+			It("does not get any roles", func() {
+				usersWithRoles, warnings, err := actor.GetUsersWithRoles("nobody")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usersWithRoles).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("get-users-warning"))
+				Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RevokeAllRoles", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteRoleStub = func(roleGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+				return ccv3.JobURL(roleGUID + "-job"), ccv3.Warnings{"delete-" + roleGUID + "-warning"}, nil
+			}
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		It("removes space roles first and the organization user role last", func() {
+			revoked, warnings, err := actor.RevokeAllRoles("user-guid-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(HaveLen(3))
+			Expect(warnings).To(ContainElement("delete-org-user-guid-warning"))
+			Expect(warnings).To(ContainElement("poll-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteRoleCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(0)).To(Equal("space-dev-guid"))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(1)).To(Equal("org-manager-guid"))
+			Expect(fakeCloudControllerClient.DeleteRoleArgsForCall(2)).To(Equal("org-user-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(2)).To(Equal(ccv3.JobURL("org-user-guid-job")))
+		})
+
+		Context("when removing a role fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturnsOnCall(1, ccv3.Warnings{"poll-warning"}, errors.New("poll-error"))
+			})
+
+			It("returns the roles removed so far and the error", func() {
+				revoked, _, err := actor.RevokeAllRoles("user-guid-1")
+				Expect(err).To(MatchError("poll-error"))
+				Expect(revoked).To(HaveLen(1))
+				Expect(revoked[0].GUID).To(Equal("space-dev-guid"))
+				Expect(fakeCloudControllerClient.DeleteRoleCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
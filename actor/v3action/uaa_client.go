@@ -1,7 +1,10 @@
 package v3action
 
+import "code.cloudfoundry.org/cli/api/uaa"
+
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	ListUsers(userName string, origin string) ([]uaa.User, error)
 }
@@ -0,0 +1,90 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// User represents a V3 actor user.
+type User ccv3.User
+
+// GetUsers returns the users ordered by username. When search is not empty,
+// only users whose username contains search are returned.
+func (actor Actor) GetUsers(search string) ([]User, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.UsernameOrder}},
+	}
+	if search != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.PartialUsernameFilter, Values: []string{search}})
+	}
+
+	ccUsers, warnings, err := actor.CloudControllerClient.GetUsers(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	users := make([]User, 0, len(ccUsers))
+	for _, ccUser := range ccUsers {
+		users = append(users, User(ccUser))
+	}
+
+	return users, Warnings(warnings), nil
+}
+
+// GetUserByNameAndOrigin returns the user with the given username. When
+// origin is provided the user is looked up in UAA by username and origin;
+// otherwise the username must be unique across origins.
+func (actor Actor) GetUserByNameAndOrigin(username string, origin string) (User, Warnings, error) {
+	if origin != "" {
+		return actor.getUserByUAALookup(username, origin)
+	}
+
+	ccUsers, warnings, err := actor.CloudControllerClient.GetUsers(ccv3.Query{
+		Key:    ccv3.UsernameFilter,
+		Values: []string{username},
+	})
+	if err != nil {
+		return User{}, Warnings(warnings), err
+	}
+
+	switch len(ccUsers) {
+	case 0:
+		return User{}, Warnings(warnings), actionerror.UserNotFoundError{Username: username}
+	case 1:
+		return User(ccUsers[0]), Warnings(warnings), nil
+	}
+
+	var origins []string
+	for _, ccUser := range ccUsers {
+		origins = append(origins, ccUser.Origin)
+	}
+	sort.Strings(origins)
+
+	return User{}, Warnings(warnings), actionerror.MultipleUsersFoundError{
+		Username: username,
+		Origins:  origins,
+	}
+}
+
+func (actor Actor) getUserByUAALookup(username string, origin string) (User, Warnings, error) {
+	uaaUsers, err := actor.UAAClient.ListUsers(username, origin)
+	if err != nil {
+		return User{}, nil, err
+	}
+	if len(uaaUsers) == 0 {
+		return User{}, nil, actionerror.UserNotFoundError{Username: username, Origin: origin}
+	}
+
+	ccUser, warnings, err := actor.CloudControllerClient.GetUser(uaaUsers[0].ID)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return User{}, Warnings(warnings), actionerror.UserNotFoundError{Username: username, Origin: origin}
+		}
+		return User{}, Warnings(warnings), err
+	}
+
+	return User(ccUser), Warnings(warnings), nil
+}
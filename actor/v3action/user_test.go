@@ -0,0 +1,193 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeUAAClient             *v3actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeUAAClient = new(v3actionfakes.FakeUAAClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, fakeUAAClient)
+	})
+
+	Describe("GetUsers", func() {
+		var (
+			search     string
+			users      []User
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			search = ""
+			fakeCloudControllerClient.GetUsersReturns(
+				[]ccv3.User{{GUID: "user-guid-1", Username: "user-1"}, {GUID: "user-guid-2", Username: "user-2"}},
+				ccv3.Warnings{"get-users-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			users, warnings, executeErr = actor.GetUsers(search)
+		})
+
+		It("returns all users ordered by username", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(users).To(Equal([]User{{GUID: "user-guid-1", Username: "user-1"}, {GUID: "user-guid-2", Username: "user-2"}}))
+			Expect(warnings).To(ConsistOf("get-users-warning"))
+			Expect(fakeCloudControllerClient.GetUsersArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.UsernameOrder}},
+			))
+		})
+
+		Context("when a search is provided", func() {
+			BeforeEach(func() {
+				search = "user"
+			})
+
+			It("filters the users by partial username", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetUsersArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.UsernameOrder}},
+					ccv3.Query{Key: ccv3.PartialUsernameFilter, Values: []string{"user"}},
+				))
+			})
+		})
+
+		Context("when getting the users fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUsersReturns(nil, ccv3.Warnings{"get-users-warning"}, errors.New("get-users-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-users-error"))
+				Expect(warnings).To(ConsistOf("get-users-warning"))
+			})
+		})
+	})
+
+	Describe("GetUserByNameAndOrigin", func() {
+		var (
+			origin     string
+			user       User
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			origin = ""
+		})
+
+		JustBeforeEach(func() {
+			user, warnings, executeErr = actor.GetUserByNameAndOrigin("some-user", origin)
+		})
+
+		Context("when no origin is provided", func() {
+			Context("when exactly one user matches", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUsersReturns([]ccv3.User{{GUID: "some-user-guid", Username: "some-user", Origin: "uaa"}}, ccv3.Warnings{"get-users-warning"}, nil)
+				})
+
+				It("returns the user", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(user).To(Equal(User{GUID: "some-user-guid", Username: "some-user", Origin: "uaa"}))
+					Expect(warnings).To(ConsistOf("get-users-warning"))
+					Expect(fakeCloudControllerClient.GetUsersArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.UsernameFilter, Values: []string{"some-user"}},
+					))
+					Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when no user matches", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUsersReturns(nil, ccv3.Warnings{"get-users-warning"}, nil)
+				})
+
+				It("returns a UserNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "some-user"}))
+					Expect(warnings).To(ConsistOf("get-users-warning"))
+				})
+			})
+
+			Context("when users from several origins match", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUsersReturns(
+						[]ccv3.User{{GUID: "user-guid-1", Origin: "uaa"}, {GUID: "user-guid-2", Origin: "ldap"}},
+						ccv3.Warnings{"get-users-warning"},
+						nil)
+				})
+
+				It("returns a MultipleUsersFoundError listing the origins", func() {
+					Expect(executeErr).To(MatchError(actionerror.MultipleUsersFoundError{Username: "some-user", Origins: []string{"ldap", "uaa"}}))
+				})
+			})
+		})
+
+		Context("when an origin is provided", func() {
+			BeforeEach(func() {
+				origin = "ldap"
+				fakeUAAClient.ListUsersReturns([]uaa.User{{ID: "some-user-guid", Username: "some-user", Origin: "ldap"}}, nil)
+				fakeCloudControllerClient.GetUserReturns(ccv3.User{GUID: "some-user-guid", Username: "some-user", Origin: "ldap"}, ccv3.Warnings{"get-user-warning"}, nil)
+			})
+
+			It("looks the user up in UAA and returns the Cloud Controller user", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(user).To(Equal(User{GUID: "some-user-guid", Username: "some-user", Origin: "ldap"}))
+				Expect(warnings).To(ConsistOf("get-user-warning"))
+
+				userName, passedOrigin := fakeUAAClient.ListUsersArgsForCall(0)
+				Expect(userName).To(Equal("some-user"))
+				Expect(passedOrigin).To(Equal("ldap"))
+				Expect(fakeCloudControllerClient.GetUserArgsForCall(0)).To(Equal("some-user-guid"))
+			})
+
+			Context("when UAA has no such user", func() {
+				BeforeEach(func() {
+					fakeUAAClient.ListUsersReturns(nil, nil)
+				})
+
+				It("returns a UserNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"}))
+					Expect(fakeCloudControllerClient.GetUserCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the Cloud Controller has no such user", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUserReturns(ccv3.User{}, ccv3.Warnings{"get-user-warning"}, ccerror.ResourceNotFoundError{})
+				})
+
+				It("returns a UserNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"}))
+					Expect(warnings).To(ConsistOf("get-user-warning"))
+				})
+			})
+
+			Context("when the UAA lookup fails", func() {
+				BeforeEach(func() {
+					fakeUAAClient.ListUsersReturns(nil, errors.New("uaa-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("uaa-error"))
+				})
+			})
+		})
+	})
+})
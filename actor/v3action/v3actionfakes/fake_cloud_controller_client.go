@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -99,6 +100,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateBuildpackStub        func(buildpack ccv3.Buildpack) (ccv3.Buildpack, ccv3.Warnings, error)
+	createBuildpackMutex       sync.RWMutex
+	createBuildpackArgsForCall []struct {
+		buildpack ccv3.Buildpack
+	}
+	createBuildpackReturns struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createBuildpackReturnsOnCall map[int]struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateIsolationSegmentStub        func(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	createIsolationSegmentMutex       sync.RWMutex
 	createIsolationSegmentArgsForCall []struct {
@@ -159,6 +175,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDropletStub        func(guid string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		guid string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -186,6 +217,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(guid string) (ccv3.JobURL, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		guid string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DeleteRoleStub        func(roleGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteRoleMutex       sync.RWMutex
+	deleteRoleArgsForCall []struct {
+		roleGUID string
+	}
+	deleteRoleReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteRoleReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -323,6 +384,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(query ...ccv3.Query) ([]ccv3.Buildpack, ccv3.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		query []ccv3.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -353,6 +429,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetEnvironmentVariableGroupStub        func(group constant.EnvironmentVariableGroupName) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	getEnvironmentVariableGroupMutex       sync.RWMutex
+	getEnvironmentVariableGroupArgsForCall []struct {
+		group constant.EnvironmentVariableGroupName
+	}
+	getEnvironmentVariableGroupReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -473,6 +564,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRolesStub        func(query ...ccv3.Query) ([]ccv3.Role, ccv3.Warnings, error)
+	getRolesMutex       sync.RWMutex
+	getRolesArgsForCall []struct {
+		query []ccv3.Query
+	}
+	getRolesReturns struct {
+		result1 []ccv3.Role
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRolesReturnsOnCall map[int]struct {
+		result1 []ccv3.Role
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(query ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -518,6 +624,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetUserStub        func(userGUID string) (ccv3.User, ccv3.Warnings, error)
+	getUserMutex       sync.RWMutex
+	getUserArgsForCall []struct {
+		userGUID string
+	}
+	getUserReturns struct {
+		result1 ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getUserReturnsOnCall map[int]struct {
+		result1 ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetUsersStub        func(query ...ccv3.Query) ([]ccv3.User, ccv3.Warnings, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		query []ccv3.Query
+	}
+	getUsersReturns struct {
+		result1 []ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 []ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}
 	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
@@ -673,6 +809,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateBuildpackStub        func(buildpack ccv3.Buildpack) (ccv3.Buildpack, ccv3.Warnings, error)
+	updateBuildpackMutex       sync.RWMutex
+	updateBuildpackArgsForCall []struct {
+		buildpack ccv3.Buildpack
+	}
+	updateBuildpackReturns struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateBuildpackReturnsOnCall map[int]struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateEnvironmentVariableGroupStub        func(group constant.EnvironmentVariableGroupName, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	updateEnvironmentVariableGroupMutex       sync.RWMutex
+	updateEnvironmentVariableGroupArgsForCall []struct {
+		group   constant.EnvironmentVariableGroupName
+		envVars ccv3.EnvironmentVariables
+	}
+	updateEnvironmentVariableGroupReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateOrganizationDefaultIsolationSegmentRelationshipStub        func(orgGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateOrganizationDefaultIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall []struct {
@@ -738,6 +905,24 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UploadBuildpackStub        func(buildpackGUID string, buildpackPath string, buildpack io.Reader, buildpackLength int64) (ccv3.JobURL, ccv3.Warnings, error)
+	uploadBuildpackMutex       sync.RWMutex
+	uploadBuildpackArgsForCall []struct {
+		buildpackGUID   string
+		buildpackPath   string
+		buildpack       io.Reader
+		buildpackLength int64
+	}
+	uploadBuildpackReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	uploadBuildpackReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UploadPackageStub        func(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 	uploadPackageMutex       sync.RWMutex
 	uploadPackageArgsForCall []struct {
@@ -1096,6 +1281,60 @@ func (fake *FakeCloudControllerClient) CreateBuildReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateBuildpack(buildpack ccv3.Buildpack) (ccv3.Buildpack, ccv3.Warnings, error) {
+	fake.createBuildpackMutex.Lock()
+	ret, specificReturn := fake.createBuildpackReturnsOnCall[len(fake.createBuildpackArgsForCall)]
+	fake.createBuildpackArgsForCall = append(fake.createBuildpackArgsForCall, struct {
+		buildpack ccv3.Buildpack
+	}{buildpack})
+	fake.recordInvocation("CreateBuildpack", []interface{}{buildpack})
+	fake.createBuildpackMutex.Unlock()
+	if fake.CreateBuildpackStub != nil {
+		return fake.CreateBuildpackStub(buildpack)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createBuildpackReturns.result1, fake.createBuildpackReturns.result2, fake.createBuildpackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildpackCallCount() int {
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
+	return len(fake.createBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildpackArgsForCall(i int) ccv3.Buildpack {
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
+	return fake.createBuildpackArgsForCall[i].buildpack
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildpackReturns(result1 ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildpackStub = nil
+	fake.createBuildpackReturns = struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildpackReturnsOnCall(i int, result1 ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildpackStub = nil
+	if fake.createBuildpackReturnsOnCall == nil {
+		fake.createBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Buildpack
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createBuildpackReturnsOnCall[i] = struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.createIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.createIsolationSegmentReturnsOnCall[len(fake.createIsolationSegmentArgsForCall)]
@@ -1311,6 +1550,60 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(guid string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteDroplet", []interface{}{guid})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2, fake.deleteDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1414,6 +1707,114 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentOrganizationReturns
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(guid string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeletePackage", []interface{}{guid})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2, fake.deletePackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteRole(roleGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteRoleMutex.Lock()
+	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
+	fake.deleteRoleArgsForCall = append(fake.deleteRoleArgsForCall, struct {
+		roleGUID string
+	}{roleGUID})
+	fake.recordInvocation("DeleteRole", []interface{}{roleGUID})
+	fake.deleteRoleMutex.Unlock()
+	if fake.DeleteRoleStub != nil {
+		return fake.DeleteRoleStub(roleGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteRoleReturns.result1, fake.deleteRoleReturns.result2, fake.deleteRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteRoleCallCount() int {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	return len(fake.deleteRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteRoleArgsForCall(i int) string {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	return fake.deleteRoleArgsForCall[i].roleGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteRoleReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteRoleStub = nil
+	fake.deleteRoleReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteRoleReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteRoleStub = nil
+	if fake.deleteRoleReturnsOnCall == nil {
+		fake.deleteRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteRoleReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -1906,6 +2307,60 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetBuildpacks(query ...ccv3.Query) ([]ccv3.Buildpack, ccv3.Warnings, error) {
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		query []ccv3.Query
+	}{query})
+	fake.recordInvocation("GetBuildpacks", []interface{}{query})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(query...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv3.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Buildpack
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -2014,6 +2469,60 @@ func (fake *FakeCloudControllerClient) GetDropletsReturnsOnCall(i int, result1 [
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentVariableGroupReturnsOnCall[len(fake.getEnvironmentVariableGroupArgsForCall)]
+	fake.getEnvironmentVariableGroupArgsForCall = append(fake.getEnvironmentVariableGroupArgsForCall, struct {
+		group constant.EnvironmentVariableGroupName
+	}{group})
+	fake.recordInvocation("GetEnvironmentVariableGroup", []interface{}{group})
+	fake.getEnvironmentVariableGroupMutex.Unlock()
+	if fake.GetEnvironmentVariableGroupStub != nil {
+		return fake.GetEnvironmentVariableGroupStub(group)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEnvironmentVariableGroupReturns.result1, fake.getEnvironmentVariableGroupReturns.result2, fake.getEnvironmentVariableGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupCallCount() int {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.getEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupArgsForCall(i int) constant.EnvironmentVariableGroupName {
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
+	return fake.getEnvironmentVariableGroupArgsForCall[i].group
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	fake.getEnvironmentVariableGroupReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.GetEnvironmentVariableGroupStub = nil
+	if fake.getEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.getEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.getIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
@@ -2446,6 +2955,60 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRoles(query ...ccv3.Query) ([]ccv3.Role, ccv3.Warnings, error) {
+	fake.getRolesMutex.Lock()
+	ret, specificReturn := fake.getRolesReturnsOnCall[len(fake.getRolesArgsForCall)]
+	fake.getRolesArgsForCall = append(fake.getRolesArgsForCall, struct {
+		query []ccv3.Query
+	}{query})
+	fake.recordInvocation("GetRoles", []interface{}{query})
+	fake.getRolesMutex.Unlock()
+	if fake.GetRolesStub != nil {
+		return fake.GetRolesStub(query...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRolesReturns.result1, fake.getRolesReturns.result2, fake.getRolesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRolesCallCount() int {
+	fake.getRolesMutex.RLock()
+	defer fake.getRolesMutex.RUnlock()
+	return len(fake.getRolesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRolesArgsForCall(i int) []ccv3.Query {
+	fake.getRolesMutex.RLock()
+	defer fake.getRolesMutex.RUnlock()
+	return fake.getRolesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetRolesReturns(result1 []ccv3.Role, result2 ccv3.Warnings, result3 error) {
+	fake.GetRolesStub = nil
+	fake.getRolesReturns = struct {
+		result1 []ccv3.Role
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRolesReturnsOnCall(i int, result1 []ccv3.Role, result2 ccv3.Warnings, result3 error) {
+	fake.GetRolesStub = nil
+	if fake.getRolesReturnsOnCall == nil {
+		fake.getRolesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Role
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRolesReturnsOnCall[i] = struct {
+		result1 []ccv3.Role
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstances(query ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
 	fake.getServiceInstancesMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
@@ -2608,6 +3171,114 @@ func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetUser(userGUID string) (ccv3.User, ccv3.Warnings, error) {
+	fake.getUserMutex.Lock()
+	ret, specificReturn := fake.getUserReturnsOnCall[len(fake.getUserArgsForCall)]
+	fake.getUserArgsForCall = append(fake.getUserArgsForCall, struct {
+		userGUID string
+	}{userGUID})
+	fake.recordInvocation("GetUser", []interface{}{userGUID})
+	fake.getUserMutex.Unlock()
+	if fake.GetUserStub != nil {
+		return fake.GetUserStub(userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUserReturns.result1, fake.getUserReturns.result2, fake.getUserReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserCallCount() int {
+	fake.getUserMutex.RLock()
+	defer fake.getUserMutex.RUnlock()
+	return len(fake.getUserArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserArgsForCall(i int) string {
+	fake.getUserMutex.RLock()
+	defer fake.getUserMutex.RUnlock()
+	return fake.getUserArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) GetUserReturns(result1 ccv3.User, result2 ccv3.Warnings, result3 error) {
+	fake.GetUserStub = nil
+	fake.getUserReturns = struct {
+		result1 ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserReturnsOnCall(i int, result1 ccv3.User, result2 ccv3.Warnings, result3 error) {
+	fake.GetUserStub = nil
+	if fake.getUserReturnsOnCall == nil {
+		fake.getUserReturnsOnCall = make(map[int]struct {
+			result1 ccv3.User
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getUserReturnsOnCall[i] = struct {
+		result1 ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUsers(query ...ccv3.Query) ([]ccv3.User, ccv3.Warnings, error) {
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		query []ccv3.Query
+	}{query})
+	fake.recordInvocation("GetUsers", []interface{}{query})
+	fake.getUsersMutex.Unlock()
+	if fake.GetUsersStub != nil {
+		return fake.GetUsersStub(query...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUsersReturns.result1, fake.getUsersReturns.result2, fake.getUsersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUsersArgsForCall(i int) []ccv3.Query {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return fake.getUsersArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetUsersReturns(result1 []ccv3.User, result2 ccv3.Warnings, result3 error) {
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUsersReturnsOnCall(i int, result1 []ccv3.User, result2 ccv3.Warnings, result3 error) {
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.User
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 []ccv3.User
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
@@ -3162,6 +3833,115 @@ func (fake *FakeCloudControllerClient) UpdateApplicationRestartReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateBuildpack(buildpack ccv3.Buildpack) (ccv3.Buildpack, ccv3.Warnings, error) {
+	fake.updateBuildpackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackReturnsOnCall[len(fake.updateBuildpackArgsForCall)]
+	fake.updateBuildpackArgsForCall = append(fake.updateBuildpackArgsForCall, struct {
+		buildpack ccv3.Buildpack
+	}{buildpack})
+	fake.recordInvocation("UpdateBuildpack", []interface{}{buildpack})
+	fake.updateBuildpackMutex.Unlock()
+	if fake.UpdateBuildpackStub != nil {
+		return fake.UpdateBuildpackStub(buildpack)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateBuildpackReturns.result1, fake.updateBuildpackReturns.result2, fake.updateBuildpackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackCallCount() int {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return len(fake.updateBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackArgsForCall(i int) ccv3.Buildpack {
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	return fake.updateBuildpackArgsForCall[i].buildpack
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturns(result1 ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	fake.updateBuildpackReturns = struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateBuildpackReturnsOnCall(i int, result1 ccv3.Buildpack, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateBuildpackStub = nil
+	if fake.updateBuildpackReturnsOnCall == nil {
+		fake.updateBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Buildpack
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateBuildpackReturnsOnCall[i] = struct {
+		result1 ccv3.Buildpack
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.updateEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.updateEnvironmentVariableGroupReturnsOnCall[len(fake.updateEnvironmentVariableGroupArgsForCall)]
+	fake.updateEnvironmentVariableGroupArgsForCall = append(fake.updateEnvironmentVariableGroupArgsForCall, struct {
+		group   constant.EnvironmentVariableGroupName
+		envVars ccv3.EnvironmentVariables
+	}{group, envVars})
+	fake.recordInvocation("UpdateEnvironmentVariableGroup", []interface{}{group, envVars})
+	fake.updateEnvironmentVariableGroupMutex.Unlock()
+	if fake.UpdateEnvironmentVariableGroupStub != nil {
+		return fake.UpdateEnvironmentVariableGroupStub(group, envVars)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateEnvironmentVariableGroupReturns.result1, fake.updateEnvironmentVariableGroupReturns.result2, fake.updateEnvironmentVariableGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupCallCount() int {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.updateEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupArgsForCall(i int) (constant.EnvironmentVariableGroupName, ccv3.EnvironmentVariables) {
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
+	return fake.updateEnvironmentVariableGroupArgsForCall[i].group, fake.updateEnvironmentVariableGroupArgsForCall[i].envVars
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	fake.updateEnvironmentVariableGroupReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateEnvironmentVariableGroupStub = nil
+	if fake.updateEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.updateEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegmentRelationship(orgGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationDefaultIsolationSegmentRelationshipReturnsOnCall[len(fake.updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall)]
@@ -3388,6 +4168,63 @@ func (fake *FakeCloudControllerClient) UploadBitsPackageReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadBuildpack(buildpackGUID string, buildpackPath string, buildpack io.Reader, buildpackLength int64) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.uploadBuildpackMutex.Lock()
+	ret, specificReturn := fake.uploadBuildpackReturnsOnCall[len(fake.uploadBuildpackArgsForCall)]
+	fake.uploadBuildpackArgsForCall = append(fake.uploadBuildpackArgsForCall, struct {
+		buildpackGUID   string
+		buildpackPath   string
+		buildpack       io.Reader
+		buildpackLength int64
+	}{buildpackGUID, buildpackPath, buildpack, buildpackLength})
+	fake.recordInvocation("UploadBuildpack", []interface{}{buildpackGUID, buildpackPath, buildpack, buildpackLength})
+	fake.uploadBuildpackMutex.Unlock()
+	if fake.UploadBuildpackStub != nil {
+		return fake.UploadBuildpackStub(buildpackGUID, buildpackPath, buildpack, buildpackLength)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.uploadBuildpackReturns.result1, fake.uploadBuildpackReturns.result2, fake.uploadBuildpackReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackCallCount() int {
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
+	return len(fake.uploadBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackArgsForCall(i int) (string, string, io.Reader, int64) {
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
+	return fake.uploadBuildpackArgsForCall[i].buildpackGUID, fake.uploadBuildpackArgsForCall[i].buildpackPath, fake.uploadBuildpackArgsForCall[i].buildpack, fake.uploadBuildpackArgsForCall[i].buildpackLength
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.UploadBuildpackStub = nil
+	fake.uploadBuildpackReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadBuildpackReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.UploadBuildpackStub = nil
+	if fake.uploadBuildpackReturnsOnCall == nil {
+		fake.uploadBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.uploadBuildpackReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error) {
 	fake.uploadPackageMutex.Lock()
 	ret, specificReturn := fake.uploadPackageReturnsOnCall[len(fake.uploadPackageArgsForCall)]
@@ -3460,6 +4297,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildpackMutex.RLock()
+	defer fake.createBuildpackMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
@@ -3468,10 +4307,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
 	defer fake.deleteIsolationSegmentOrganizationMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
@@ -3490,10 +4335,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
 	defer fake.getDropletsMutex.RUnlock()
+	fake.getEnvironmentVariableGroupMutex.RLock()
+	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsMutex.RLock()
@@ -3510,12 +4359,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackagesMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getRolesMutex.RLock()
+	defer fake.getRolesMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.getUserMutex.RLock()
+	defer fake.getUserMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -3536,6 +4391,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStopMutex.RUnlock()
 	fake.updateApplicationRestartMutex.RLock()
 	defer fake.updateApplicationRestartMutex.RUnlock()
+	fake.updateBuildpackMutex.RLock()
+	defer fake.updateBuildpackMutex.RUnlock()
+	fake.updateEnvironmentVariableGroupMutex.RLock()
+	defer fake.updateEnvironmentVariableGroupMutex.RUnlock()
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RUnlock()
 	fake.updateSpaceIsolationSegmentRelationshipMutex.RLock()
@@ -3544,6 +4403,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateTaskCancelMutex.RUnlock()
 	fake.uploadBitsPackageMutex.RLock()
 	defer fake.uploadBitsPackageMutex.RUnlock()
+	fake.uploadBuildpackMutex.RLock()
+	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
 	defer fake.uploadPackageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
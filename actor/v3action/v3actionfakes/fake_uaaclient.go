@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/uaa"
 )
 
 type FakeUAAClient struct {
@@ -22,6 +23,20 @@ type FakeUAAClient struct {
 		result1 string
 		result2 error
 	}
+	ListUsersStub        func(userName string, origin string) ([]uaa.User, error)
+	listUsersMutex       sync.RWMutex
+	listUsersArgsForCall []struct {
+		userName string
+		origin   string
+	}
+	listUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	listUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -78,11 +93,65 @@ func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) ListUsers(userName string, origin string) ([]uaa.User, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		userName string
+		origin   string
+	}{userName, origin})
+	fake.recordInvocation("ListUsers", []interface{}{userName, origin})
+	fake.listUsersMutex.Unlock()
+	if fake.ListUsersStub != nil {
+		return fake.ListUsersStub(userName, origin)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listUsersReturns.result1, fake.listUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) ListUsersArgsForCall(i int) (string, string) {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return fake.listUsersArgsForCall[i].userName, fake.listUsersArgsForCall[i].origin
+}
+
+func (fake *FakeUAAClient) ListUsersReturns(result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,12 @@
+package ccerror
+
+// ServiceParametersFetchNotSupportedError is returned when the service broker
+// does not support retrieving the parameters of a service instance or
+// service binding.
+type ServiceParametersFetchNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceParametersFetchNotSupportedError) Error() string {
+	return e.Message
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -83,6 +84,10 @@ type Application struct {
 
 	// State is the desired state of the application.
 	State constant.ApplicationState
+
+	// UpdatedAt is the last time the application was updated, or the time it
+	// was created if it has never been updated.
+	UpdatedAt time.Time
 }
 
 // MarshalJSON converts an application into a Cloud Controller Application.
@@ -219,6 +224,11 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	if ccApp.Entity.PackageUpdatedAt != nil {
 		application.PackageUpdatedAt = *ccApp.Entity.PackageUpdatedAt
 	}
+
+	application.UpdatedAt = ccApp.Metadata.CreatedAt
+	if ccApp.Metadata.UpdatedAt != nil {
+		application.UpdatedAt = *ccApp.Metadata.UpdatedAt
+	}
 	return nil
 }
 
@@ -247,6 +257,25 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the Application associated with the provided
+// GUID, along with its service bindings and route mappings.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+		Query: url.Values{
+			"recursive": {"true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
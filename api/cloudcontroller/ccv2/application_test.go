@@ -89,12 +89,56 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the app and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
 						"metadata": {
 							"guid": "app-guid-1",
-							"updated_at": null
+							"created_at": "2015-03-01T10:00:00Z",
+							"updated_at": "2015-03-11T08:30:00Z"
 						},
 						"entity": {
 							"buildpack": "ruby 1.6.29",
@@ -142,6 +186,8 @@ var _ = Describe("Application", func() {
 
 				updatedAt, err := time.Parse(time.RFC3339, "2015-03-10T23:11:54Z")
 				Expect(err).NotTo(HaveOccurred())
+				appUpdatedAt, err := time.Parse(time.RFC3339, "2015-03-11T08:30:00Z")
+				Expect(err).NotTo(HaveOccurred())
 
 				Expect(app).To(Equal(Application{
 					Buildpack:            types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
@@ -173,6 +219,7 @@ var _ = Describe("Application", func() {
 					StagingFailedDescription: "some-staging-failed-description",
 					StagingFailedReason:      "some-reason",
 					State:                    constant.ApplicationStopped,
+					UpdatedAt:                appUpdatedAt,
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
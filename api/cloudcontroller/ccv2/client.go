@@ -109,8 +109,9 @@ type Client struct {
 	routingEndpoint           string
 	tokenEndpoint             string
 
-	jobPollingInterval time.Duration
-	jobPollingTimeout  time.Duration
+	jobPollingInterval    time.Duration
+	jobPollingTimeout     time.Duration
+	paginationConcurrency int
 
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
@@ -132,6 +133,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationConcurrency is the maximum number of pages of a list that are
+	// requested at the same time. Pages are requested one after the other when
+	// it is 1 or less.
+	PaginationConcurrency int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -140,9 +146,10 @@ type Config struct {
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
-		userAgent:          userAgent,
-		jobPollingInterval: config.JobPollingInterval,
-		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:             userAgent,
+		jobPollingInterval:    config.JobPollingInterval,
+		jobPollingTimeout:     config.JobPollingTimeout,
+		paginationConcurrency: config.PaginationConcurrency,
+		wrappers:              append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
@@ -0,0 +1,32 @@
+package constant
+
+// OrgRole represents a role a user can have in an organization.
+type OrgRole string
+
+const (
+	// OrgUserRole makes the user a member of the organization.
+	OrgUserRole OrgRole = "OrgUser"
+
+	// OrgManagerRole lets the user manage the organization.
+	OrgManagerRole OrgRole = "OrgManager"
+
+	// OrgBillingManagerRole lets the user manage billing for the organization.
+	OrgBillingManagerRole OrgRole = "BillingManager"
+
+	// OrgAuditorRole gives the user read-only access to the organization.
+	OrgAuditorRole OrgRole = "OrgAuditor"
+)
+
+// SpaceRole represents a role a user can have in a space.
+type SpaceRole string
+
+const (
+	// SpaceManagerRole lets the user manage the space.
+	SpaceManagerRole SpaceRole = "SpaceManager"
+
+	// SpaceDeveloperRole lets the user manage apps and services in the space.
+	SpaceDeveloperRole SpaceRole = "SpaceDeveloper"
+
+	// SpaceAuditorRole gives the user read-only access to the space.
+	SpaceAuditorRole SpaceRole = "SpaceAuditor"
+)
@@ -15,26 +15,37 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppRequest                                     = "DeleteApp"
 	DeleteOrganizationRequest                            = "DeleteOrganization"
 	DeleteRouteAppRequest                                = "DeleteRouteApp"
 	DeleteRouteRequest                                   = "DeleteRoute"
 	DeleteSecurityGroupSpaceRequest                      = "DeleteSecurityGroupSpace"
 	DeleteSecurityGroupStagingSpaceRequest               = "DeleteSecurityGroupStagingSpace"
 	DeleteServiceBindingRequest                          = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest                         = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                              = "DeleteServiceKey"
 	DeleteSpaceRequest                                   = "DeleteSpace"
+	DeleteUserProvidedServiceInstanceRequest             = "DeleteUserProvidedServiceInstance"
 	GetAppInstancesRequest                               = "GetAppInstances"
 	GetAppRequest                                        = "GetApp"
 	GetAppRoutesRequest                                  = "GetAppRoutes"
 	GetAppsRequest                                       = "GetApps"
 	GetAppStatsRequest                                   = "GetAppStats"
 	GetConfigFeatureFlagsRequest                         = "GetConfigFeatureFlags"
+	GetConfigRunningSecurityGroupsRequest                = "GetConfigRunningSecurityGroups"
 	GetEventsRequest                                     = "GetEvents"
 	GetInfoRequest                                       = "GetInfo"
 	GetJobRequest                                        = "GetJob"
+	GetOrganizationAuditorsRequest                       = "GetOrganizationAuditors"
+	GetOrganizationBillingManagersRequest                = "GetOrganizationBillingManagers"
+	GetOrganizationManagersRequest                       = "GetOrganizationManagers"
 	GetOrganizationPrivateDomainsRequest                 = "GetOrganizationPrivateDomains"
 	GetOrganizationQuotaDefinitionRequest                = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest               = "GetOrganizationQuotaDefinitions"
 	GetOrganizationRequest                               = "GetOrganization"
+	GetOrganizationSpaceQuotaDefinitionsRequest          = "GetOrganizationSpaceQuotaDefinitions"
 	GetOrganizationsRequest                              = "GetOrganizations"
+	GetOrganizationUsersRequest                          = "GetOrganizationUsers"
 	GetPrivateDomainRequest                              = "GetPrivateDomain"
 	GetPrivateDomainsRequest                             = "GetPrivateDomains"
 	GetRouteAppsRequest                                  = "GetRouteApps"
@@ -48,14 +59,17 @@ const (
 	GetSecurityGroupSpacesRequest                        = "GetSecurityGroupSpaces"
 	GetSecurityGroupsRequest                             = "GetSecurityGroups"
 	GetSecurityGroupStagingSpacesRequest                 = "GetSecurityGroupStagingSpaces"
+	GetServiceBindingParametersRequest                   = "GetServiceBindingParameters"
 	GetServiceBindingRequest                             = "GetServiceBinding"
 	GetServiceBindingsRequest                            = "GetServiceBindings"
 	GetServiceBrokersRequest                             = "GetServiceBrokers"
+	GetServiceInstanceParametersRequest                  = "GetServiceInstanceParameters"
 	GetServiceInstanceRequest                            = "GetServiceInstance"
 	GetServiceInstanceServiceBindingsRequest             = "GetServiceInstanceServiceBindings"
 	GetServiceInstanceSharedFromRequest                  = "GetServiceInstanceSharedFrom"
 	GetServiceInstanceSharedToRequest                    = "GetServiceInstanceSharedTo"
 	GetServiceInstancesRequest                           = "GetServiceInstances"
+	GetServiceKeysRequest                                = "GetServiceKeys"
 	GetServicePlanRequest                                = "GetServicePlan"
 	GetServicePlansRequest                               = "GetServicePlans"
 	GetServicePlanVisibilitiesRequest                    = "GetServicePlanVisibilities"
@@ -63,6 +77,9 @@ const (
 	GetServicesRequest                                   = "GetServices"
 	GetSharedDomainRequest                               = "GetSharedDomain"
 	GetSharedDomainsRequest                              = "GetSharedDomains"
+	GetSpaceAuditorsRequest                              = "GetSpaceAuditors"
+	GetSpaceDevelopersRequest                            = "GetSpaceDevelopers"
+	GetSpaceManagersRequest                              = "GetSpaceManagers"
 	GetSpaceQuotaDefinitionRequest                       = "GetSpaceQuotaDefinition"
 	GetSpaceRoutesRequest                                = "GetSpaceRoutes"
 	GetSpaceSecurityGroupsRequest                        = "GetSpaceSecurityGroups"
@@ -79,16 +96,28 @@ const (
 	PostBuildpackRequest                                 = "PostBuildpack"
 	PostOrganizationRequest                              = "PostOrganization"
 	PostRouteRequest                                     = "PostRoute"
+	PostSecurityGroupRequest                             = "PostSecurityGroup"
 	PostServiceBindingRequest                            = "PostServiceBinding"
+	PostSpaceRequest                                     = "PostSpace"
 	PostUserRequest                                      = "PostUser"
 	PutAppBitsRequest                                    = "PutAppBits"
 	PutAppRequest                                        = "PutApp"
 	PutBuildpackRequest                                  = "PutBuildpack"
 	PutDropletRequest                                    = "PutDroplet"
+	PutOrganizationAuditorsRequest                       = "PutOrganizationAuditors"
+	PutOrganizationBillingManagersRequest                = "PutOrganizationBillingManagers"
+	PutOrganizationManagersRequest                       = "PutOrganizationManagers"
+	PutOrganizationRequest                               = "PutOrganization"
+	PutOrganizationUsersRequest                          = "PutOrganizationUsers"
 	PutResourceMatchRequest                              = "PutResourceMatch"
 	PutRouteAppRequest                                   = "PutRouteApp"
+	PutSecurityGroupRequest                              = "PutSecurityGroup"
 	PutSecurityGroupSpaceRequest                         = "PutSecurityGroupSpace"
 	PutSecurityGroupStagingSpaceRequest                  = "PutSecurityGroupStagingSpace"
+	PutSpaceAuditorsRequest                              = "PutSpaceAuditors"
+	PutSpaceDevelopersRequest                            = "PutSpaceDevelopers"
+	PutSpaceManagersRequest                              = "PutSpaceManagers"
+	PutSpaceQuotaDefinitionSpaceRequest                  = "PutSpaceQuotaDefinitionSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -96,6 +125,7 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
@@ -107,6 +137,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/buildpacks", Method: http.MethodPost, Name: PostBuildpackRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackRequest},
 	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
+	{Path: "/v2/config/running_security_groups", Method: http.MethodGet, Name: GetConfigRunningSecurityGroupsRequest},
 	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
@@ -114,9 +145,20 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodGet, Name: GetOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodPut, Name: PutOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodGet, Name: GetOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodPut, Name: PutOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodGet, Name: GetOrganizationManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagersRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotaDefinitionsRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodGet, Name: GetOrganizationUsersRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodPut, Name: PutOrganizationUsersRequest},
 	{Path: "/v2/private_domains", Method: http.MethodGet, Name: GetPrivateDomainsRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatchRequest},
 	{Path: "/v2/route_mappings", Method: http.MethodGet, Name: GetRouteMappingsRequest},
@@ -132,6 +174,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
+	{Path: "/v2/security_groups", Method: http.MethodPost, Name: PostSecurityGroupRequest},
+	{Path: "/v2/security_groups/:security_group_guid", Method: http.MethodPut, Name: PutSecurityGroupRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSecurityGroupSpaceRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSecurityGroupSpaceRequest},
@@ -142,12 +186,17 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodGet, Name: GetServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid/parameters", Method: http.MethodGet, Name: GetServiceBindingParametersRequest},
 	{Path: "/v2/service_brokers", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/parameters", Method: http.MethodGet, Name: GetServiceInstanceParametersRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
 	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
 	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
@@ -156,15 +205,24 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionSpaceRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodGet, Name: GetSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodPut, Name: PutSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodGet, Name: GetSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodPut, Name: PutSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodGet, Name: GetSpaceManagersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodPut, Name: PutSpaceManagersRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/user_provided_service_instances", Method: http.MethodGet, Name: GetUserProvidedServiceInstancesRequest},
+	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid", Method: http.MethodDelete, Name: DeleteUserProvidedServiceInstanceRequest},
 	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetUserProvidedServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
 }
@@ -157,3 +157,34 @@ func (client *Client) GetOrganizations(filters ...Filter) ([]Organization, Warni
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganizationQuota assigns the Organization Quota associated with the
+// provided quota GUID to the organization associated with the provided
+// organization GUID.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		QuotaDefinitionGUID string `json:"quota_definition_guid"`
+	}{
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
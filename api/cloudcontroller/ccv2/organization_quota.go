@@ -2,7 +2,9 @@ package ccv2
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // OrganizationQuota is the definition of a quota for an organization.
@@ -13,6 +15,18 @@ type OrganizationQuota struct {
 
 	// Name is the name of the OrganizationQuota.
 	Name string
+
+	// MemoryLimit is the total memory that the started app instances may use.
+	// It is unset when unlimited.
+	MemoryLimit types.NullByteSizeInMb
+
+	// InstanceMemoryLimit is the memory a single app instance may use. It is
+	// unset when unlimited.
+	InstanceMemoryLimit types.NullByteSizeInMb
+
+	// AppInstanceLimit is the number of app instances that may be started. It
+	// is unset when unlimited.
+	AppInstanceLimit types.NullInt
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -20,7 +34,10 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                string `json:"name"`
+			MemoryLimit         *int   `json:"memory_limit"`
+			InstanceMemoryLimit *int   `json:"instance_memory_limit"`
+			AppInstanceLimit    *int   `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccOrgQuota)
@@ -30,6 +47,9 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimit = quotaLimitInMb(ccOrgQuota.Entity.MemoryLimit)
+	application.InstanceMemoryLimit = quotaLimitInMb(ccOrgQuota.Entity.InstanceMemoryLimit)
+	application.AppInstanceLimit = quotaLimit(ccOrgQuota.Entity.AppInstanceLimit)
 
 	return nil
 }
@@ -53,3 +73,30 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns back a list of Organization Quotas based off
+// of the provided filters.
+func (client *Client) GetOrganizationQuotas(filters ...Filter) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if orgQuota, ok := item.(OrganizationQuota); ok {
+			fullOrgQuotasList = append(fullOrgQuotasList, orgQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgQuotasList, warnings, err
+}
@@ -5,6 +5,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -26,7 +28,10 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"instance_memory_limit": -1,
+					"app_instance_limit": 25
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +47,10 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:             "some-org-quota-guid",
+					Name:             "some-org-quota",
+					MemoryLimit:      types.NullByteSizeInMb{IsSet: true, Value: 10240},
+					AppInstanceLimit: types.NullInt{IsSet: true, Value: 25},
 				}))
 			})
 		})
@@ -73,4 +80,39 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-org-quota-guid"},
+							"entity": {"name": "some-org-quota"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the filtered organization quotas and all warnings", func() {
+				orgQuotas, warnings, err := client.GetOrganizationQuotas(Filter{
+					Type:     constant.NameFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org-quota"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orgQuotas).To(Equal([]OrganizationQuota{
+					{GUID: "some-org-quota-guid", Name: "some-org-quota"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"warning-1"}))
+			})
+		})
+	})
 })
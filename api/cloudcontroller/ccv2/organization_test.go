@@ -319,4 +319,60 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						VerifyJSON(`{"quota_definition_guid":"some-quota-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the updated org and all warnings", func() {
+				org, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(org).To(Equal(Organization{
+					GUID:                "some-org-guid",
+					Name:                "some-org",
+					QuotaDefinitionGUID: "some-quota-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The organization could not be found: some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,78 @@
+package ccv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paginate", func() {
+	var (
+		client *Client
+
+		mutex          sync.Mutex
+		requestedPages []string
+		failingPage    string
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient(Config{PaginationConcurrency: 3})
+
+		requestedPages = nil
+		failingPage = ""
+
+		server.RouteToHandler(http.MethodGet, "/v2/stacks", func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page")
+
+			mutex.Lock()
+			requestedPages = append(requestedPages, page)
+			mutex.Unlock()
+
+			if failingPage != "" && page == failingPage {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"code": 10000, "description": "Unknown request", "error_code": "CF-NotFound"}`)
+				return
+			}
+
+			if page == "" {
+				page = "1"
+			}
+			w.Header().Set("X-Cf-Warnings", "warning-"+page)
+			fmt.Fprintf(w, `{
+				"total_pages": 4,
+				"next_url": "/v2/stacks?order-direction=asc&page=2&results-per-page=1",
+				"resources": [{"metadata": {"guid": "stack-guid-%s"}, "entity": {"name": "stack-%s"}}]
+			}`, page, page)
+		})
+	})
+
+	Context("when the first page reports the total number of pages", func() {
+		It("fetches the remaining pages and returns the resources in page order", func() {
+			stacks, warnings, err := client.GetStacks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stacks).To(Equal([]Stack{
+				{GUID: "stack-guid-1", Name: "stack-1"},
+				{GUID: "stack-guid-2", Name: "stack-2"},
+				{GUID: "stack-guid-3", Name: "stack-3"},
+				{GUID: "stack-guid-4", Name: "stack-4"},
+			}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			Expect(requestedPages).To(ConsistOf("", "2", "3", "4"))
+		})
+	})
+
+	Context("when fetching one of the remaining pages fails", func() {
+		BeforeEach(func() {
+			failingPage = "3"
+		})
+
+		It("returns the error", func() {
+			_, _, err := client.GetStacks()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 // PaginatedResources represents a page of resources returned by the Cloud
 // Controller.
 type PaginatedResources struct {
+	TotalPages     int             `json:"total_pages"`
 	NextURL        string          `json:"next_url"`
 	ResourcesBytes json.RawMessage `json:"resources"`
 	resourceType   reflect.Type
@@ -42,33 +43,31 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 	fullWarningsList := Warnings{}
 
 	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
-		}
-
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		list, err := wrapper.Resources()
+		err = appendResources(wrapper, appendToExternalList)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
-		}
-
 		if wrapper.NextURL == "" {
 			break
 		}
 
+		// Once the number of pages is known, the remaining pages can be fetched
+		// at the same time.
+		if client.paginationConcurrency > 1 && wrapper.TotalPages > 0 {
+			if nextPage, pageErr := cloudcontroller.PageNumber(wrapper.NextURL); pageErr == nil {
+				warnings, err = client.getRemainingPages(wrapper.NextURL, nextPage, wrapper.TotalPages, obj, appendToExternalList)
+				fullWarningsList = append(fullWarningsList, warnings...)
+				return fullWarningsList, err
+			}
+		}
+
 		request, err = client.newHTTPRequest(requestOptions{
 			URI:    wrapper.NextURL,
 			Method: http.MethodGet,
@@ -80,3 +79,71 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+// getRemainingPages fetches the pages from firstPage to lastPage concurrently
+// and appends their resources in page order.
+func (client Client) getRemainingPages(pageURI string, firstPage int, lastPage int, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	pages := make([]*PaginatedResources, lastPage-firstPage+1)
+	pageWarnings := make([]Warnings, lastPage-firstPage+1)
+
+	err := cloudcontroller.FetchPages(firstPage, lastPage, client.paginationConcurrency, func(page int) error {
+		uri, err := cloudcontroller.PageURL(pageURI, page)
+		if err != nil {
+			return err
+		}
+
+		request, err := client.newHTTPRequest(requestOptions{
+			URI:    uri,
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return err
+		}
+
+		pages[page-firstPage], pageWarnings[page-firstPage], err = client.getPage(request, obj)
+		return err
+	})
+
+	allWarnings := Warnings{}
+	for _, warnings := range pageWarnings {
+		allWarnings = append(allWarnings, warnings...)
+	}
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, wrapper := range pages {
+		err = appendResources(wrapper, appendToExternalList)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+func appendResources(wrapper *PaginatedResources, appendToExternalList func(interface{}) error) error {
+	list, err := wrapper.Resources()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package ccv2
+
+import "code.cloudfoundry.org/cli/types"
+
+// quotaLimit converts a quota definition limit, where -1 means unlimited, to
+// a NullInt that is unset when unlimited.
+func quotaLimit(limit *int) types.NullInt {
+	if limit == nil || *limit < 0 {
+		return types.NullInt{}
+	}
+	return types.NullInt{IsSet: true, Value: *limit}
+}
+
+// quotaLimitInMb converts a quota definition limit in megabytes, where -1
+// means unlimited, to a NullByteSizeInMb that is unset when unlimited.
+func quotaLimitInMb(limit *int) types.NullByteSizeInMb {
+	if limit == nil || *limit < 0 {
+		return types.NullByteSizeInMb{}
+	}
+	return types.NullByteSizeInMb{IsSet: true, Value: uint64(*limit)}
+}
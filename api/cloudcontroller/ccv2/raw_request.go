@@ -0,0 +1,58 @@
+package ccv2
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// MakeRawRequest performs an arbitrary request against the Cloud Controller
+// and returns the raw response body and HTTP response. The path is relative
+// to the Cloud Controller API URL and may include a query string. Headers
+// override the default request headers.
+//
+// Responses with 4xx and 5xx status codes are returned without an error so
+// that their bodies can be displayed; an error is only returned when no
+// response was received.
+func (client *Client) MakeRawRequest(method string, path string, headers http.Header, body []byte) ([]byte, *http.Response, Warnings, error) {
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var requestBody io.ReadSeeker
+	if len(body) > 0 {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: method,
+		URI:    "/" + strings.TrimLeft(path, "/"),
+		Body:   requestBody,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for name, values := range headers {
+		request.Header.Del(name)
+		for _, value := range values {
+			request.Header.Add(name, value)
+		}
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if err != nil && (response.HTTPResponse == nil || response.HTTPResponse.StatusCode < http.StatusBadRequest) {
+		return nil, nil, response.Warnings, err
+	}
+
+	// The connection substitutes an empty JSON object for 204 responses.
+	if response.HTTPResponse.StatusCode == http.StatusNoContent {
+		return []byte{}, response.HTTPResponse, response.Warnings, nil
+	}
+
+	return response.RawResponse, response.HTTPResponse, response.Warnings, nil
+}
@@ -0,0 +1,122 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Raw Request", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("MakeRawRequest", func() {
+		var (
+			method  string
+			path    string
+			headers http.Header
+			body    []byte
+
+			rawResponse  []byte
+			httpResponse *http.Response
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			method = ""
+			path = "v3/apps?names=some-app"
+			headers = nil
+			body = nil
+		})
+
+		JustBeforeEach(func() {
+			rawResponse, httpResponse, warnings, executeErr = client.MakeRawRequest(method, path, headers, body)
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+						VerifyHeaderKV("Accept", "application/json"),
+						RespondWith(http.StatusOK, `{"resources":[]}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("defaults to GET and returns the raw response and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rawResponse).To(MatchJSON(`{"resources":[]}`))
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusOK))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when a method, headers and a body are provided", func() {
+			BeforeEach(func() {
+				method = http.MethodPost
+				path = "/v2/apps"
+				headers = http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+				body = []byte("q=name:some-app")
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBody([]byte("q=name:some-app")),
+						RespondWith(http.StatusCreated, `{}`),
+					))
+			})
+
+			It("sends them with the request", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusCreated))
+			})
+		})
+
+		Context("when the response has no content", func() {
+			BeforeEach(func() {
+				method = http.MethodDelete
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/apps"),
+						RespondWith(http.StatusNoContent, nil),
+					))
+			})
+
+			It("returns an empty body", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rawResponse).To(BeEmpty())
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error status", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the response without an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rawResponse).To(ContainSubstring("Unknown request"))
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
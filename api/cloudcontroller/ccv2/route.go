@@ -33,6 +33,10 @@ type Route struct {
 
 	// SpaceGUID is the unique Space identifier.
 	SpaceGUID string `json:"space_guid"`
+
+	// ServiceInstanceGUID is the unique identifier of the route service bound
+	// to the route. It is empty when no route service is bound.
+	ServiceInstanceGUID string `json:"-"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Route response.
@@ -40,11 +44,12 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	var ccRoute struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Host       string        `json:"host"`
-			Path       string        `json:"path"`
-			Port       types.NullInt `json:"port"`
-			DomainGUID string        `json:"domain_guid"`
-			SpaceGUID  string        `json:"space_guid"`
+			Host                string        `json:"host"`
+			Path                string        `json:"path"`
+			Port                types.NullInt `json:"port"`
+			DomainGUID          string        `json:"domain_guid"`
+			SpaceGUID           string        `json:"space_guid"`
+			ServiceInstanceGUID string        `json:"service_instance_guid"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccRoute)
@@ -58,6 +63,7 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	route.Port = ccRoute.Entity.Port
 	route.DomainGUID = ccRoute.Entity.DomainGUID
 	route.SpaceGUID = ccRoute.Entity.SpaceGUID
+	route.ServiceInstanceGUID = ccRoute.Entity.ServiceInstanceGUID
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // RouteMapping represents a Cloud Controller map between an application and route.
@@ -16,6 +17,10 @@ type RouteMapping struct {
 
 	// RouteGUID is the unique route identifier.
 	RouteGUID string
+
+	// AppPort is the port the app receives the route's traffic on. It is not
+	// set when the app uses its default port.
+	AppPort types.NullInt
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Route Mapping
@@ -23,8 +28,9 @@ func (routeMapping *RouteMapping) UnmarshalJSON(data []byte) error {
 	var ccRouteMapping struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			AppGUID   string `json:"app_guid"`
-			RouteGUID string `json:"route_guid"`
+			AppGUID   string        `json:"app_guid"`
+			RouteGUID string        `json:"route_guid"`
+			AppPort   types.NullInt `json:"app_port"`
 		} `json:"entity"`
 	}
 
@@ -36,6 +42,7 @@ func (routeMapping *RouteMapping) UnmarshalJSON(data []byte) error {
 	routeMapping.GUID = ccRouteMapping.Metadata.GUID
 	routeMapping.AppGUID = ccRouteMapping.Entity.AppGUID
 	routeMapping.RouteGUID = ccRouteMapping.Entity.RouteGUID
+	routeMapping.AppPort = ccRouteMapping.Entity.AppPort
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -89,6 +90,7 @@ var _ = Describe("RouteMappings", func() {
 					GUID:      "route-mapping-guid-1",
 					AppGUID:   "some-app-guid-1",
 					RouteGUID: "some-route-guid-1",
+					AppPort:   types.NullInt{IsSet: true, Value: 8888},
 				}))
 			})
 		})
@@ -185,21 +187,25 @@ var _ = Describe("RouteMappings", func() {
 						GUID:      "route-mapping-guid-1",
 						AppGUID:   "some-app-guid-1",
 						RouteGUID: "some-route-guid-1",
+						AppPort:   types.NullInt{IsSet: true, Value: 8888},
 					},
 					{
 						GUID:      "route-mapping-guid-2",
 						AppGUID:   "some-app-guid-2",
 						RouteGUID: "some-route-guid-2",
+						AppPort:   types.NullInt{IsSet: true, Value: 8888},
 					},
 					{
 						GUID:      "route-mapping-guid-3",
 						AppGUID:   "some-app-guid-3",
 						RouteGUID: "some-route-guid-3",
+						AppPort:   types.NullInt{IsSet: true, Value: 8888},
 					},
 					{
 						GUID:      "route-mapping-guid-4",
 						AppGUID:   "some-app-guid-4",
 						RouteGUID: "some-route-guid-4",
+						AppPort:   types.NullInt{IsSet: true, Value: 8888},
 					},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
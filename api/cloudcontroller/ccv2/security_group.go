@@ -1,9 +1,13 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SecurityGroup represents a Cloud Controller Security Group.
@@ -30,10 +34,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 			GUID  string `json:"guid"`
 			Name  string `json:"name"`
 			Rules []struct {
-				Description string `json:"description"`
-				Destination string `json:"destination"`
-				Ports       string `json:"ports"`
-				Protocol    string `json:"protocol"`
+				Description string         `json:"description"`
+				Destination string         `json:"destination"`
+				Ports       string         `json:"ports"`
+				Protocol    string         `json:"protocol"`
+				Type        types.NullInt  `json:"type"`
+				Code        types.NullInt  `json:"code"`
+				Log         types.NullBool `json:"log"`
 			} `json:"rules"`
 			RunningDefault bool `json:"running_default"`
 			StagingDefault bool `json:"staging_default"`
@@ -52,12 +59,86 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 		securityGroup.Rules[i].Destination = ccRule.Destination
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		securityGroup.Rules[i].Type = ccRule.Type
+		securityGroup.Rules[i].Code = ccRule.Code
+		securityGroup.Rules[i].Log = ccRule.Log
 	}
 	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
 	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
 	return nil
 }
 
+// MarshalJSON converts a security group into a Cloud Controller Security
+// Group request body.
+func (securityGroup SecurityGroup) MarshalJSON() ([]byte, error) {
+	type ccRule struct {
+		Description string `json:"description,omitempty"`
+		Destination string `json:"destination"`
+		Ports       string `json:"ports,omitempty"`
+		Protocol    string `json:"protocol"`
+		Type        *int   `json:"type,omitempty"`
+		Code        *int   `json:"code,omitempty"`
+		Log         *bool  `json:"log,omitempty"`
+	}
+
+	ccSecurityGroup := struct {
+		Name  string   `json:"name"`
+		Rules []ccRule `json:"rules"`
+	}{
+		Name:  securityGroup.Name,
+		Rules: []ccRule{},
+	}
+
+	for _, rule := range securityGroup.Rules {
+		newRule := ccRule{
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+		}
+		if rule.Type.IsSet {
+			ruleType := rule.Type.Value
+			newRule.Type = &ruleType
+		}
+		if rule.Code.IsSet {
+			code := rule.Code.Value
+			newRule.Code = &code
+		}
+		if rule.Log.IsSet {
+			log := rule.Log.Value
+			newRule.Log = &log
+		}
+		ccSecurityGroup.Rules = append(ccSecurityGroup.Rules, newRule)
+	}
+
+	return json.Marshal(ccSecurityGroup)
+}
+
+// CreateSecurityGroup creates a security group with the provided name and
+// rules.
+func (client *Client) CreateSecurityGroup(securityGroup SecurityGroup) (SecurityGroup, Warnings, error) {
+	body, err := json.Marshal(securityGroup)
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSecurityGroupRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var createdSecurityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &createdSecurityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdSecurityGroup, response.Warnings, err
+}
+
 // DeleteSecurityGroupSpace disassociates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -102,6 +183,32 @@ func (client *Client) DeleteSecurityGroupStagingSpace(securityGroupGUID string,
 	return response.Warnings, err
 }
 
+// GetRunningSecurityGroups returns the Security Groups that are applied to
+// all running apps in the CF instance.
+func (client *Client) GetRunningSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigRunningSecurityGroupsRequest,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var securityGroupsList []SecurityGroup
+	warnings, err := client.paginate(request, SecurityGroup{}, func(item interface{}) error {
+		if securityGroup, ok := item.(SecurityGroup); ok {
+			securityGroupsList = append(securityGroupsList, securityGroup)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SecurityGroup{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return securityGroupsList, warnings, err
+}
+
 // GetSecurityGroups returns a list of Security Groups based off the provided
 // filters.
 func (client *Client) GetSecurityGroups(filters ...Filter) ([]SecurityGroup, Warnings, error) {
@@ -142,6 +249,32 @@ func (client *Client) GetSpaceStagingSecurityGroups(spaceGUID string, filters ..
 	return client.getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID, internal.GetSpaceStagingSecurityGroupsRequest, filters)
 }
 
+// UpdateSecurityGroup updates the name and rules of the security group with
+// the provided GUID.
+func (client *Client) UpdateSecurityGroup(securityGroup SecurityGroup) (SecurityGroup, Warnings, error) {
+	body, err := json.Marshal(securityGroup)
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": securityGroup.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var updatedSecurityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &updatedSecurityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSecurityGroup, response.Warnings, err
+}
+
 // UpdateSecurityGroupSpace associates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -1,5 +1,7 @@
 package ccv2
 
+import "code.cloudfoundry.org/cli/types"
+
 // SecurityGroupRule represents a Cloud Controller Security Group Role.
 type SecurityGroupRule struct {
 	// Description is a short message discribing the rule.
@@ -13,4 +15,13 @@ type SecurityGroupRule struct {
 
 	// Protocol can be tcp, icmp, udp, all.
 	Protocol string
+
+	// Type is the ICMP type of an icmp rule.
+	Type types.NullInt
+
+	// Code is the ICMP code of an icmp rule.
+	Code types.NullInt
+
+	// Log is true when packets matching the rule are logged.
+	Log types.NullBool
 }
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -18,6 +19,108 @@ var _ = Describe("Security Groups", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			err           error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, err = client.CreateSecurityGroup(SecurityGroup{
+				Name: "security-group-name",
+				Rules: []SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https"},
+					{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+					{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: 0}, Log: types.NullBool{IsSet: true, Value: true}},
+				},
+			})
+		})
+
+		Context("when the client call is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "security-group-name",
+						"rules": [
+							{
+								"protocol": "tcp",
+								"destination": "10.0.0.0/8",
+								"ports": "443",
+								"description": "https"
+							},
+							{
+								"protocol": "all",
+								"destination": "0.0.0.0-9.255.255.255"
+							},
+							{
+								"protocol": "icmp",
+								"destination": "10.0.0.0/8",
+								"type": 0,
+								"code": 0,
+								"log": true
+							}
+						],
+						"running_default": false,
+						"staging_default": false
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						VerifyJSON(`{
+							"name": "security-group-name",
+							"rules": [
+								{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "443", "description": "https"},
+								{"protocol": "all", "destination": "0.0.0.0-9.255.255.255"},
+								{"protocol": "icmp", "destination": "10.0.0.0/8", "type": 0, "code": 0, "log": true}
+							]
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the created security group and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "security-group-guid",
+					Name: "security-group-name",
+					Rules: []SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https"},
+						{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: 0}, Log: types.NullBool{IsSet: true, Value: true}},
+					},
+				}))
+			})
+		})
+
+		Context("when the client call is unsuccessful", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 300005,
+  "description": "The security group name is taken: security-group-name",
+  "error_code": "CF-SecurityGroupNameTaken"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "The security group name is taken: security-group-name",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+			})
+		})
+	})
+
 	Describe("DeleteSecurityGroupSpace", func() {
 		var (
 			warnings Warnings
@@ -124,6 +227,84 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetRunningSecurityGroups", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "security-group-guid-1"
+							},
+							"entity": {
+								"name": "security-group-1",
+								"rules": [
+									{
+										"protocol": "tcp",
+										"destination": "10.0.0.0/8",
+										"ports": "443"
+									}
+								],
+								"running_default": true,
+								"staging_default": false
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the running security groups and all warnings", func() {
+				securityGroups, warnings, err := client.GetRunningSecurityGroups()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID:           "security-group-guid-1",
+						Name:           "security-group-1",
+						Rules:          []SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}},
+						RunningDefault: true,
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetRunningSecurityGroups()
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroups", func() {
 		Context("when no errors are encountered", func() {
 			Context("when results are paginated", func() {
@@ -615,6 +796,78 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("UpdateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			err           error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, err = client.UpdateSecurityGroup(SecurityGroup{
+				GUID: "security-group-guid",
+				Name: "security-group-name",
+			})
+		})
+
+		Context("when the client call is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "security-group-name",
+						"rules": []
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						VerifyJSON(`{"name": "security-group-name", "rules": []}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the updated security group and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID:  "security-group-guid",
+					Name:  "security-group-name",
+					Rules: []SecurityGroupRule{},
+				}))
+			})
+		})
+
+		Context("when the client call is unsuccessful", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+			})
+		})
+	})
+
 	Describe("UpdateSecurityGroupSpace", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
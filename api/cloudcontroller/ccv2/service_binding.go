@@ -135,6 +135,26 @@ func (client *Client) GetServiceBinding(guid string) (ServiceBinding, Warnings,
 	return serviceBinding, response.Warnings, err
 }
 
+// GetServiceBindingParameters returns back the configuration parameters the
+// service broker was given for the service binding with the given GUID.
+func (client *Client) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingParametersRequest,
+		URIParams:   Params{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided filters.
 func (client *Client) GetServiceBindings(filters ...Filter) ([]ServiceBinding, Warnings, error) {
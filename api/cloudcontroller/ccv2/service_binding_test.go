@@ -384,6 +384,61 @@ var _ = Describe("Service Binding", func() {
 		})
 	})
 
+	Describe("GetServiceBindingParameters", func() {
+		var (
+			parameters map[string]interface{}
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			parameters, warnings, executeErr = client.GetServiceBindingParameters("some-service-binding-guid")
+		})
+
+		Context("when the service broker returns parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"permissions": "read-only"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 90011,
+					"description": "This service does not support fetching service binding parameters.",
+					"error_code": "CF-ServiceFetchBindingParametersNotSupported"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceParametersFetchNotSupportedError and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceParametersFetchNotSupportedError{
+					Message: "This service does not support fetching service binding parameters.",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetServiceBindings", func() {
 		BeforeEach(func() {
 			response1 := `{
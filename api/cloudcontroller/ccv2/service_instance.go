@@ -1,6 +1,8 @@
 package ccv2
 
 import (
+	"net/url"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -85,6 +87,40 @@ func (serviceInstance ServiceInstance) UserProvided() bool {
 	return serviceInstance.Type == constant.ServiceInstanceTypeUserProvidedService
 }
 
+// DeleteServiceInstance deletes the managed service instance with the given
+// GUID. Brokers that delete asynchronously may still be deleting the instance
+// when this returns.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteUserProvidedServiceInstance deletes the user provided service
+// instance with the given GUID.
+func (client *Client) DeleteUserProvidedServiceInstance(serviceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
@@ -105,6 +141,26 @@ func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceIns
 	return serviceInstance, response.Warnings, err
 }
 
+// GetServiceInstanceParameters returns back the configuration parameters the
+// service broker was given for the service instance with the given GUID.
+func (client *Client) GetServiceInstanceParameters(serviceInstanceGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceParametersRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // GetServiceInstances returns back a list of *managed* Service Instances based
 // off of the provided filters.
 func (client *Client) GetServiceInstances(filters ...Filter) ([]ServiceInstance, Warnings, error) {
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -121,6 +122,71 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service instance is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusAccepted, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/user_provided_service_instances/some-service-instance-guid"),
+					RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service instance and returns all warnings", func() {
+			warnings, err := client.DeleteUserProvidedServiceInstance("some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
@@ -183,6 +249,71 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceParameters", func() {
+		var (
+			parameters map[string]interface{}
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			parameters, warnings, executeErr = client.GetServiceInstanceParameters("some-service-instance-guid")
+		})
+
+		Context("when the service broker returns parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"ram_gb": 4,
+					"cluster": {
+						"nodes": 3
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/parameters"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{
+					"ram_gb": json.Number("4"),
+					"cluster": map[string]interface{}{
+						"nodes": json.Number("3"),
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120004,
+					"description": "This service does not support fetching service instance parameters.",
+					"error_code": "CF-ServiceFetchInstanceParametersNotSupported"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceParametersFetchNotSupportedError and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ServiceParametersFetchNotSupportedError{
+					Message: "This service does not support fetching service instance parameters.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceInstances", func() {
 		BeforeEach(func() {
 			response1 := `{
@@ -0,0 +1,84 @@
+package ccv2
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceKey represents a Cloud Controller Service Key.
+type ServiceKey struct {
+	// CreatedAt is the time the service key was created.
+	CreatedAt time.Time
+	// GUID is the unique Service Key identifier.
+	GUID string
+	// Name is the name of the service key.
+	Name string
+	// ServiceInstanceGUID is the associated service instance GUID.
+	ServiceInstanceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Key response.
+func (serviceKey *ServiceKey) UnmarshalJSON(data []byte) error {
+	var ccServiceKey struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name                string `json:"name"`
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+		} `json:"entity"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccServiceKey)
+	if err != nil {
+		return err
+	}
+
+	serviceKey.CreatedAt = ccServiceKey.Metadata.CreatedAt
+	serviceKey.GUID = ccServiceKey.Metadata.GUID
+	serviceKey.Name = ccServiceKey.Entity.Name
+	serviceKey.ServiceInstanceGUID = ccServiceKey.Entity.ServiceInstanceGUID
+	return nil
+}
+
+// DeleteServiceKey deletes the service key with the given GUID.
+func (client *Client) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceKeyRequest,
+		URIParams:   Params{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetServiceKeys returns back a list of Service Keys based off of the provided
+// filters.
+func (client *Client) GetServiceKeys(filters ...Filter) ([]ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeysRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullKeysList []ServiceKey
+	warnings, err := client.paginate(request, ServiceKey{}, func(item interface{}) error {
+		if key, ok := item.(ServiceKey); ok {
+			fullKeysList = append(fullKeysList, key)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceKey{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullKeysList, warnings, err
+}
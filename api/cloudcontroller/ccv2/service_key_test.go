@@ -0,0 +1,129 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("DeleteServiceKey", func() {
+		Context("when the service key is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service key does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 360003,
+					"description": "The service key could not be found: some-service-key-guid",
+					"error_code": "CF-ServiceKeyNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service key could not be found: some-service-key-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceKeys", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_keys?q=service_instance_guid+IN+some-instance-guid-1,some-instance-guid-2&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-1",
+							"created_at": "2017-01-01T10:00:00Z"
+						},
+						"entity": {
+							"name": "service-key-1",
+							"service_instance_guid": "some-instance-guid-1"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-2",
+							"created_at": "2017-02-01T10:00:00Z"
+						},
+						"entity": {
+							"name": "service-key-2",
+							"service_instance_guid": "some-instance-guid-2"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid+IN+some-instance-guid-1,some-instance-guid-2"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid+IN+some-instance-guid-1,some-instance-guid-2&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the service keys and warnings", func() {
+			keys, warnings, err := client.GetServiceKeys(Filter{
+				Type:     constant.ServiceInstanceGUIDFilter,
+				Operator: constant.InOperator,
+				Values:   []string{"some-instance-guid-1", "some-instance-guid-2"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			createdAt1, err := time.Parse(time.RFC3339, "2017-01-01T10:00:00Z")
+			Expect(err).NotTo(HaveOccurred())
+			createdAt2, err := time.Parse(time.RFC3339, "2017-02-01T10:00:00Z")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keys).To(Equal([]ServiceKey{
+				{GUID: "service-key-guid-1", Name: "service-key-1", ServiceInstanceGUID: "some-instance-guid-1", CreatedAt: createdAt1},
+				{GUID: "service-key-guid-2", Name: "service-key-2", ServiceInstanceGUID: "some-instance-guid-2", CreatedAt: createdAt2},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -52,6 +54,37 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CreateSpace creates a new Space with the provided name in the organization
+// associated with the provided GUID.
+func (client *Client) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Name             string `json:"name"`
+		OrganizationGUID string `json:"organization_guid"`
+	}{
+		Name:             spaceName,
+		OrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
 // DeleteSpace deletes the Space associated with the provided
 // GUID. It will return the Cloud Controller job that is assigned to the
 // Space deletion.
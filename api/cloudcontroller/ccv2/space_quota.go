@@ -2,7 +2,9 @@ package ccv2
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SpaceQuota represents the Cloud Controller configured quota assigned to the
@@ -14,6 +16,18 @@ type SpaceQuota struct {
 
 	// Name is the name given to the space quota.
 	Name string
+
+	// MemoryLimit is the total memory that the started app instances may use.
+	// It is unset when unlimited.
+	MemoryLimit types.NullByteSizeInMb
+
+	// InstanceMemoryLimit is the memory a single app instance may use. It is
+	// unset when unlimited.
+	InstanceMemoryLimit types.NullByteSizeInMb
+
+	// AppInstanceLimit is the number of app instances that may be started. It
+	// is unset when unlimited.
+	AppInstanceLimit types.NullInt
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -21,7 +35,10 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                string `json:"name"`
+			MemoryLimit         *int   `json:"memory_limit"`
+			InstanceMemoryLimit *int   `json:"instance_memory_limit"`
+			AppInstanceLimit    *int   `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccSpaceQuota)
@@ -31,6 +48,9 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.MemoryLimit = quotaLimitInMb(ccSpaceQuota.Entity.MemoryLimit)
+	spaceQuota.InstanceMemoryLimit = quotaLimitInMb(ccSpaceQuota.Entity.InstanceMemoryLimit)
+	spaceQuota.AppInstanceLimit = quotaLimit(ccSpaceQuota.Entity.AppInstanceLimit)
 	return nil
 }
 
@@ -52,3 +72,49 @@ func (client *Client) GetSpaceQuotaDefinition(guid string) (SpaceQuota, Warnings
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// GetSpaceQuotas returns the Space Quotas defined in the organization
+// associated with the provided GUID.
+func (client *Client) GetSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotaDefinitionsRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpaceQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			fullSpaceQuotasList = append(fullSpaceQuotasList, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpaceQuotasList, warnings, err
+}
+
+// SetSpaceQuota assigns the Space Quota associated with the provided quota
+// GUID to the space associated with the provided space GUID.
+func (client *Client) SetSpaceQuota(spaceGUID string, quotaGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionSpaceRequest,
+		URIParams: Params{
+			"space_quota_guid": quotaGUID,
+			"space_guid":       spaceGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -27,7 +28,10 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"instance_memory_limit": 512,
+						"app_instance_limit": -1
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +47,10 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:                "space-quota",
+					GUID:                "space-quota-guid",
+					MemoryLimit:         types.NullByteSizeInMb{IsSet: true, Value: 2048},
+					InstanceMemoryLimit: types.NullByteSizeInMb{IsSet: true, Value: 512},
 				}))
 			})
 		})
@@ -71,4 +77,81 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "space-quota-guid-1"},
+							"entity": {"name": "space-quota-1"}
+						},
+						{
+							"metadata": {"guid": "space-quota-guid-2"},
+							"entity": {"name": "space-quota-2"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the Space Quotas of the organization", func() {
+				spaceQuotas, warnings, err := client.GetSpaceQuotas("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{
+					{GUID: "space-quota-guid-1", Name: "space-quota-1"},
+					{GUID: "space-quota-guid-2", Name: "space-quota-2"},
+				}))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("assigns the Space Quota to the space", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "some-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40004,
+					"description": "The space could not be found: some-space-guid",
+					"error_code": "CF-SpaceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "some-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The space could not be found: some-space-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
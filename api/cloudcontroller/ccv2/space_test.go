@@ -18,6 +18,64 @@ var _ = Describe("Space", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateSpace", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSON(`{"name":"some-space","organization_guid":"some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("creates the Space and returns all warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+				Expect(space).To(Equal(Space{
+					GUID:             "some-space-guid",
+					Name:             "some-space",
+					OrganizationGUID: "some-org-guid",
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "The app space name is taken: some-space",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+			})
+		})
+	})
+
 	Describe("DeleteSpace", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -12,12 +14,18 @@ import (
 type User struct {
 	// GUID is the unique user identifier.
 	GUID string
+
+	// Username is the name the user logs in with.
+	Username string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller User response.
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccUser)
 	if err != nil {
@@ -25,6 +33,7 @@ func (user *User) UnmarshalJSON(data []byte) error {
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -62,3 +71,123 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+var getOrganizationUsersByRoleRequests = map[constant.OrgRole]string{
+	constant.OrgUserRole:           internal.GetOrganizationUsersRequest,
+	constant.OrgManagerRole:        internal.GetOrganizationManagersRequest,
+	constant.OrgBillingManagerRole: internal.GetOrganizationBillingManagersRequest,
+	constant.OrgAuditorRole:        internal.GetOrganizationAuditorsRequest,
+}
+
+var putOrganizationUserByRoleRequests = map[constant.OrgRole]string{
+	constant.OrgUserRole:           internal.PutOrganizationUsersRequest,
+	constant.OrgManagerRole:        internal.PutOrganizationManagersRequest,
+	constant.OrgBillingManagerRole: internal.PutOrganizationBillingManagersRequest,
+	constant.OrgAuditorRole:        internal.PutOrganizationAuditorsRequest,
+}
+
+var getSpaceUsersByRoleRequests = map[constant.SpaceRole]string{
+	constant.SpaceManagerRole:   internal.GetSpaceManagersRequest,
+	constant.SpaceDeveloperRole: internal.GetSpaceDevelopersRequest,
+	constant.SpaceAuditorRole:   internal.GetSpaceAuditorsRequest,
+}
+
+var putSpaceUserByRoleRequests = map[constant.SpaceRole]string{
+	constant.SpaceManagerRole:   internal.PutSpaceManagersRequest,
+	constant.SpaceDeveloperRole: internal.PutSpaceDevelopersRequest,
+	constant.SpaceAuditorRole:   internal.PutSpaceAuditorsRequest,
+}
+
+// GetOrganizationUsersByRole returns the users that have the provided role in
+// the organization associated with the provided GUID.
+func (client *Client) GetOrganizationUsersByRole(role constant.OrgRole, guid string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: getOrganizationUsersByRoleRequests[role],
+		URIParams:   Params{"organization_guid": guid},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateOrganizationUserByRole gives the user with the provided username the
+// provided role in the organization associated with the provided GUID.
+func (client *Client) UpdateOrganizationUserByRole(role constant.OrgRole, guid string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(usernameRequestBody{Username: username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: putOrganizationUserByRoleRequests[role],
+		URIParams:   Params{"organization_guid": guid},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetSpaceUsersByRole returns the users that have the provided role in the
+// space associated with the provided GUID.
+func (client *Client) GetSpaceUsersByRole(role constant.SpaceRole, guid string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: getSpaceUsersByRoleRequests[role],
+		URIParams:   Params{"space_guid": guid},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateSpaceUserByRole gives the user with the provided username the
+// provided role in the space associated with the provided GUID. The user
+// must already be a member of the space's organization.
+func (client *Client) UpdateSpaceUserByRole(role constant.SpaceRole, guid string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(usernameRequestBody{Username: username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: putSpaceUserByRoleRequests[role],
+		URIParams:   Params{"space_guid": guid},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+type usernameRequestBody struct {
+	Username string `json:"username"`
+}
+
+func (client *Client) paginateUsers(request *cloudcontroller.Request) ([]User, Warnings, error) {
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
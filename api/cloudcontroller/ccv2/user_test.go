@@ -5,7 +5,9 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
@@ -87,4 +89,137 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		Context("when an error does not occur", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/managers?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "user-guid-1"},
+							"entity": {"username": "user-1"}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "user-guid-2"},
+							"entity": {"username": "user-2"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the users with the role and all warnings", func() {
+				users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgManagerRole, "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(Equal([]User{
+					{GUID: "user-guid-1", Username: "user-1"},
+					{GUID: "user-guid-2", Username: "user-2"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/users"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetOrganizationUsersByRole(constant.OrgUserRole, "some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	DescribeTable("UpdateOrganizationUserByRole",
+		func(role constant.OrgRole, path string) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, path),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+
+			warnings, err := client.UpdateOrganizationUserByRole(role, "some-org-guid", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		},
+		Entry("org user", constant.OrgUserRole, "/v2/organizations/some-org-guid/users"),
+		Entry("org manager", constant.OrgManagerRole, "/v2/organizations/some-org-guid/managers"),
+		Entry("billing manager", constant.OrgBillingManagerRole, "/v2/organizations/some-org-guid/billing_managers"),
+		Entry("org auditor", constant.OrgAuditorRole, "/v2/organizations/some-org-guid/auditors"),
+	)
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "user-guid-1"},
+						"entity": {"username": "user-1"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the users with the role and all warnings", func() {
+			users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(Equal([]User{{GUID: "user-guid-1", Username: "user-1"}}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	DescribeTable("UpdateSpaceUserByRole",
+		func(role constant.SpaceRole, path string) {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, path),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+
+			warnings, err := client.UpdateSpaceUserByRole(role, "some-space-guid", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		},
+		Entry("space manager", constant.SpaceManagerRole, "/v2/spaces/some-space-guid/managers"),
+		Entry("space developer", constant.SpaceDeveloperRole, "/v2/spaces/some-space-guid/developers"),
+		Entry("space auditor", constant.SpaceAuditorRole, "/v2/spaces/some-space-guid/auditors"),
+	)
 })
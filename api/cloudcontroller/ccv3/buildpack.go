@@ -0,0 +1,246 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Buildpack represents a Cloud Controller V3 Buildpack.
+type Buildpack struct {
+	// Enabled is true when the buildpack can be used for staging.
+	Enabled types.NullBool
+	// Filename is the name of the uploaded buildpack file.
+	Filename string
+	// GUID is the unique buildpack identifier.
+	GUID string
+	// Locked is true when the buildpack cannot be updated.
+	Locked types.NullBool
+	// Name is the name of the buildpack.
+	Name string
+	// Position is the order in which the buildpacks are checked during
+	// buildpack auto-detection.
+	Position types.NullInt
+	// Stack is the name of the stack the buildpack can be used with. An empty
+	// stack means the buildpack can be used with any stack.
+	Stack string
+	// State is the state of the buildpack's bits.
+	State constant.BuildpackState
+}
+
+// MarshalJSON converts a Buildpack into a Cloud Controller Buildpack. Only the
+// fields that are set are included, so it can be used to update a subset of
+// the buildpack's settings.
+func (b Buildpack) MarshalJSON() ([]byte, error) {
+	ccBuildpack := map[string]interface{}{}
+
+	if b.Name != "" {
+		ccBuildpack["name"] = b.Name
+	}
+	if b.Stack != "" {
+		ccBuildpack["stack"] = b.Stack
+	}
+	if b.Position.IsSet {
+		ccBuildpack["position"] = b.Position
+	}
+	if b.Enabled.IsSet {
+		ccBuildpack["enabled"] = b.Enabled
+	}
+	if b.Locked.IsSet {
+		ccBuildpack["locked"] = b.Locked
+	}
+
+	return json.Marshal(ccBuildpack)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (b *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Enabled  types.NullBool          `json:"enabled"`
+		Filename string                  `json:"filename"`
+		GUID     string                  `json:"guid"`
+		Locked   types.NullBool          `json:"locked"`
+		Name     string                  `json:"name"`
+		Position types.NullInt           `json:"position"`
+		Stack    string                  `json:"stack"`
+		State    constant.BuildpackState `json:"state"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccBuildpack)
+	if err != nil {
+		return err
+	}
+
+	b.Enabled = ccBuildpack.Enabled
+	b.Filename = ccBuildpack.Filename
+	b.GUID = ccBuildpack.GUID
+	b.Locked = ccBuildpack.Locked
+	b.Name = ccBuildpack.Name
+	b.Position = ccBuildpack.Position
+	b.Stack = ccBuildpack.Stack
+	b.State = ccBuildpack.State
+
+	return nil
+}
+
+// CreateBuildpack creates a buildpack with the given settings. The buildpack
+// has no bits until UploadBuildpack is called.
+func (client *Client) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	body, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostBuildpackRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var responseBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &responseBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBuildpack, response.Warnings, err
+}
+
+// GetBuildpacks lists buildpacks with optional filters.
+func (client *Client) GetBuildpacks(query ...Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
+
+// UpdateBuildpack updates the buildpack with the GUID of the given buildpack
+// to the settings that are set on it.
+func (client *Client) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	body, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchBuildpackRequest,
+		URIParams:   internal.Params{"buildpack_guid": buildpack.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var responseBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &responseBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBuildpack, response.Warnings, err
+}
+
+// UploadBuildpack streams the buildpack bits read from buildpack to the
+// buildpack with the given GUID. buildpackPath is only used to name the
+// uploaded file. The bits are processed asynchronously; poll the returned job
+// URL to know when the buildpack is ready. The buildpack reader cannot be
+// rewound, so the request is not retried.
+func (client *Client) UploadBuildpack(buildpackGUID string, buildpackPath string, buildpack io.Reader, buildpackLength int64) (JobURL, Warnings, error) {
+	contentLength, err := client.calculateBuildpackRequestSize(buildpackPath, buildpackLength)
+	if err != nil {
+		return "", nil, err
+	}
+
+	contentType, body, writeErrors := client.createMultipartBodyAndHeaderForBuildpack(buildpackPath, buildpack)
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostBuildpackBitsRequest,
+		URIParams:   internal.Params{"buildpack_guid": buildpackGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = contentLength
+
+	response := cloudcontroller.Response{}
+	err = client.makeWhileWriting(request, &response, writeErrors)
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+func (*Client) calculateBuildpackRequestSize(buildpackPath string, buildpackLength int64) (int64, error) {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	_, err := form.CreateFormFile("bits", filepath.Base(buildpackPath))
+	if err != nil {
+		return 0, err
+	}
+	err = form.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(body.Len()) + buildpackLength, nil
+}
+
+func (*Client) createMultipartBodyAndHeaderForBuildpack(buildpackPath string, buildpack io.Reader) (string, io.ReadSeeker, <-chan error) {
+	writerOutput, writerInput := cloudcontroller.NewPipeBomb()
+	form := multipart.NewWriter(writerInput)
+
+	writeErrors := make(chan error)
+
+	go func() {
+		defer close(writeErrors)
+		defer writerInput.Close()
+
+		writer, err := form.CreateFormFile("bits", filepath.Base(buildpackPath))
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		_, err = io.Copy(writer, buildpack)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		err = form.Close()
+		if err != nil {
+			writeErrors <- err
+		}
+	}()
+
+	return form.FormDataContentType(), writerOutput, writeErrors
+}
@@ -0,0 +1,358 @@
+package ccv3_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		var (
+			buildpacks []Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			buildpacks, warnings, executeErr = client.GetBuildpacks(
+				Query{Key: StackFilter, Values: []string{"cflinuxfs2"}},
+				Query{Key: OrderBy, Values: []string{PositionOrder}},
+			)
+		})
+
+		Context("when buildpacks exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/buildpacks?stacks=cflinuxfs2&order_by=position&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "buildpack-guid-1",
+			"name": "ruby_buildpack",
+			"stack": "cflinuxfs2",
+			"state": "READY",
+			"filename": "ruby_buildpack-v1.7.18.zip",
+			"position": 1,
+			"enabled": true,
+			"locked": false
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "buildpack-guid-2",
+			"name": "staticfile_buildpack",
+			"stack": "cflinuxfs2",
+			"state": "AWAITING_UPLOAD",
+			"filename": null,
+			"position": 2,
+			"enabled": false,
+			"locked": true
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/buildpacks", "stacks=cflinuxfs2&order_by=position"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/buildpacks", "stacks=cflinuxfs2&order_by=position&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the buildpacks and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{
+						GUID:     "buildpack-guid-1",
+						Name:     "ruby_buildpack",
+						Stack:    "cflinuxfs2",
+						State:    constant.BuildpackReady,
+						Filename: "ruby_buildpack-v1.7.18.zip",
+						Position: types.NullInt{Value: 1, IsSet: true},
+						Enabled:  types.NullBool{Value: true, IsSet: true},
+						Locked:   types.NullBool{Value: false, IsSet: true},
+					},
+					{
+						GUID:     "buildpack-guid-2",
+						Name:     "staticfile_buildpack",
+						Stack:    "cflinuxfs2",
+						State:    constant.BuildpackAwaitingUpload,
+						Position: types.NullInt{Value: 2, IsSet: true},
+						Enabled:  types.NullBool{Value: false, IsSet: true},
+						Locked:   types.NullBool{Value: true, IsSet: true},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "The request is semantically invalid: command presence",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateBuildpack", func() {
+		var (
+			buildpack  Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			buildpack, warnings, executeErr = client.CreateBuildpack(Buildpack{
+				Name:     "some-buildpack",
+				Stack:    "cflinuxfs2",
+				Position: types.NullInt{Value: 3, IsSet: true},
+				Enabled:  types.NullBool{Value: false, IsSet: true},
+			})
+		})
+
+		Context("when the buildpack is created", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-buildpack-guid",
+	"name": "some-buildpack",
+	"stack": "cflinuxfs2",
+	"state": "AWAITING_UPLOAD",
+	"position": 3,
+	"enabled": false,
+	"locked": false
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/buildpacks"),
+						VerifyJSON(`{"name": "some-buildpack", "stack": "cflinuxfs2", "position": 3, "enabled": false}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the created buildpack and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					Stack:    "cflinuxfs2",
+					State:    constant.BuildpackAwaitingUpload,
+					Position: types.NullInt{Value: 3, IsSet: true},
+					Enabled:  types.NullBool{Value: false, IsSet: true},
+					Locked:   types.NullBool{Value: false, IsSet: true},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Name has already been taken",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/buildpacks"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Name has already been taken"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateBuildpack", func() {
+		var (
+			buildpack  Buildpack
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			buildpack, warnings, executeErr = client.UpdateBuildpack(Buildpack{
+				GUID:   "some-buildpack-guid",
+				Locked: types.NullBool{Value: true, IsSet: true},
+			})
+		})
+
+		Context("when the buildpack is updated", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-buildpack-guid",
+	"name": "some-buildpack",
+	"stack": "",
+	"state": "READY",
+	"position": 1,
+	"enabled": true,
+	"locked": true
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/buildpacks/some-buildpack-guid"),
+						VerifyJSON(`{"locked": true}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("only sends the settings that are set and returns the updated buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					State:    constant.BuildpackReady,
+					Position: types.NullInt{Value: 1, IsSet: true},
+					Enabled:  types.NullBool{Value: true, IsSet: true},
+					Locked:   types.NullBool{Value: true, IsSet: true},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		var (
+			bits       []byte
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			bits = []byte("some-buildpack-bits")
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.UploadBuildpack("some-buildpack-guid", "/some/path/some-buildpack.zip", bytes.NewReader(bits), int64(len(bits)))
+		})
+
+		Context("when the upload is accepted", func() {
+			BeforeEach(func() {
+				verifyBody := func(_ http.ResponseWriter, req *http.Request) {
+					contentType := req.Header.Get("Content-Type")
+					Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+
+					defer req.Body.Close()
+					requestReader := multipart.NewReader(req.Body, contentType[30:])
+
+					part, err := requestReader.NextPart()
+					Expect(err).NotTo(HaveOccurred())
+					defer part.Close()
+
+					Expect(part.FormName()).To(Equal("bits"))
+					Expect(part.FileName()).To(Equal("some-buildpack.zip"))
+					Expect(ioutil.ReadAll(part)).To(Equal(bits))
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/buildpacks/some-buildpack-guid/upload"),
+						verifyBody,
+						RespondWith(http.StatusAccepted, "{}", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Buildpack is locked",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/buildpacks/some-buildpack-guid/upload"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Buildpack is locked"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -106,6 +106,9 @@ func SetupV3Response() {
 			"builds": {
 				"href": "SERVER_URL/v3/builds"
 			},
+			"buildpacks": {
+				"href": "SERVER_URL/v3/buildpacks"
+			},
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
@@ -123,6 +126,15 @@ func SetupV3Response() {
 			},
 			"droplets": {
 				"href": "SERVER_URL/v3/droplets"
+			},
+			"environment_variable_groups": {
+				"href": "SERVER_URL/v3/environment_variable_groups"
+			},
+			"roles": {
+				"href": "SERVER_URL/v3/roles"
+			},
+			"users": {
+				"href": "SERVER_URL/v3/users"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
@@ -95,8 +95,9 @@ type Client struct {
 	userAgent  string
 	wrappers   []ConnectionWrapper
 
-	jobPollingInterval time.Duration
-	jobPollingTimeout  time.Duration
+	jobPollingInterval    time.Duration
+	jobPollingTimeout     time.Duration
+	paginationConcurrency int
 }
 
 // Config allows the Client to be configured
@@ -113,6 +114,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationConcurrency is the maximum number of pages of a list that are
+	// requested at the same time. Pages are requested one after the other when
+	// it is 1 or less.
+	PaginationConcurrency int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -121,9 +127,10 @@ type Config struct {
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
-		userAgent:          userAgent,
-		jobPollingInterval: config.JobPollingInterval,
-		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:             userAgent,
+		jobPollingInterval:    config.JobPollingInterval,
+		jobPollingTimeout:     config.JobPollingTimeout,
+		paginationConcurrency: config.PaginationConcurrency,
+		wrappers:              append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
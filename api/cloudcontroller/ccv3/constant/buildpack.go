@@ -0,0 +1,12 @@
+package constant
+
+// BuildpackState represents the state of a buildpack.
+type BuildpackState string
+
+const (
+	// BuildpackAwaitingUpload is a buildpack that has been created but does
+	// not have any bits yet.
+	BuildpackAwaitingUpload BuildpackState = "AWAITING_UPLOAD"
+	// BuildpackReady is a buildpack that has bits and can be used for staging.
+	BuildpackReady BuildpackState = "READY"
+)
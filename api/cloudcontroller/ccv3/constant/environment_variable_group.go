@@ -0,0 +1,14 @@
+package constant
+
+// EnvironmentVariableGroupName is the name of a platform wide environment
+// variable group.
+type EnvironmentVariableGroupName string
+
+const (
+	// RunningEnvironmentVariableGroup is the group of variables available to
+	// every running application.
+	RunningEnvironmentVariableGroup EnvironmentVariableGroupName = "running"
+	// StagingEnvironmentVariableGroup is the group of variables available to
+	// every application while staging.
+	StagingEnvironmentVariableGroup EnvironmentVariableGroupName = "staging"
+)
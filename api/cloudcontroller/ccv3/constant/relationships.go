@@ -9,6 +9,13 @@ const (
 	// application.
 	RelationshipTypeApplication RelationshipType = "app"
 
+	// RelationshipTypeOrganization is a relationship with a Cloud Controller
+	// organization.
+	RelationshipTypeOrganization RelationshipType = "organization"
+
 	// RelationshipTypeSpace is a relationship with a CloudController space.
 	RelationshipTypeSpace RelationshipType = "space"
+
+	// RelationshipTypeUser is a relationship with a Cloud Controller user.
+	RelationshipTypeUser RelationshipType = "user"
 )
@@ -0,0 +1,23 @@
+package constant
+
+// RoleType is the type of access a role grants a user to an organization or
+// space.
+type RoleType string
+
+const (
+	// RoleOrganizationUser is the role of a member of an organization.
+	RoleOrganizationUser RoleType = "organization_user"
+	// RoleOrganizationAuditor is the role of an organization auditor.
+	RoleOrganizationAuditor RoleType = "organization_auditor"
+	// RoleOrganizationManager is the role of an organization manager.
+	RoleOrganizationManager RoleType = "organization_manager"
+	// RoleOrganizationBillingManager is the role of an organization billing
+	// manager.
+	RoleOrganizationBillingManager RoleType = "organization_billing_manager"
+	// RoleSpaceAuditor is the role of a space auditor.
+	RoleSpaceAuditor RoleType = "space_auditor"
+	// RoleSpaceDeveloper is the role of a space developer.
+	RoleSpaceDeveloper RoleType = "space_developer"
+	// RoleSpaceManager is the role of a space manager.
+	RoleSpaceManager RoleType = "space_manager"
+)
@@ -33,6 +33,23 @@ type DropletBuildpack struct {
 	DetectOutput string `json:"detect_output"`
 }
 
+// DeleteDroplet deletes the droplet with the given GUID. Returns back a
+// resulting job URL to poll.
+func (client *Client) DeleteDroplet(dropletGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
 // GetApplicationDropletCurrent returns the current droplet for a given
 // application.
 func (client *Client) GetApplicationDropletCurrent(appGUID string) (Droplet, Warnings, error) {
@@ -105,6 +105,63 @@ var _ = Describe("Droplet", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteDroplet("some-droplet-guid")
+		})
+
+		Context("when the droplet is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, nil, http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all given warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetDroplet", func() {
 		var (
 			droplet    Droplet
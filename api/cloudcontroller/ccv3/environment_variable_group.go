@@ -0,0 +1,56 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// GetEnvironmentVariableGroup returns the variables of the running or staging
+// environment variable group.
+func (client *Client) GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (EnvironmentVariables, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		URIParams:   internal.Params{"group_name": string(group)},
+		RequestName: internal.GetEnvironmentVariableGroupRequest,
+	})
+	if err != nil {
+		return EnvironmentVariables{}, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+	return responseEnvVars, response.Warnings, err
+}
+
+// UpdateEnvironmentVariableGroup adds/updates the variables of the running or
+// staging environment variable group. Variables that are not set are removed
+// from the group. Applications must be restarted or restaged for changes to
+// take effect.
+func (client *Client) UpdateEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envVars EnvironmentVariables) (EnvironmentVariables, Warnings, error) {
+	bodyBytes, err := json.Marshal(envVars)
+	if err != nil {
+		return EnvironmentVariables{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URIParams:   internal.Params{"group_name": string(group)},
+		RequestName: internal.PatchEnvironmentVariableGroupRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return EnvironmentVariables{}, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+	return responseEnvVars, response.Warnings, err
+}
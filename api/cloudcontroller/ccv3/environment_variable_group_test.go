@@ -0,0 +1,149 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("EnvironmentVariableGroup", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		var (
+			envVars    EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = client.GetEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+		})
+
+		Context("when the request errors", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/environment_variable_groups/running"),
+						RespondWith(http.StatusTeapot, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{ResponseCode: http.StatusTeapot}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				responseBody := `{
+					"name": "running",
+					"updated_at": "2017-06-01T00:00:00Z",
+					"var": {
+						"DEBUG": "false",
+						"HTTP_PROXY": "http://proxy.example.com"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/environment_variable_groups/running"),
+						RespondWith(http.StatusOK, responseBody, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the variables of the group and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(envVars).To(Equal(EnvironmentVariables{
+					"DEBUG":      {Value: "false", IsSet: true},
+					"HTTP_PROXY": {Value: "http://proxy.example.com", IsSet: true},
+				}))
+			})
+		})
+	})
+
+	Describe("UpdateEnvironmentVariableGroup", func() {
+		var (
+			envVars        EnvironmentVariables
+			patchedEnvVars EnvironmentVariables
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			envVars = EnvironmentVariables{
+				"my-var":    {Value: "my-val", IsSet: true},
+				"delete-me": {},
+			}
+		})
+
+		JustBeforeEach(func() {
+			patchedEnvVars, warnings, executeErr = client.UpdateEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, envVars)
+		})
+
+		Context("when the request errors", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/environment_variable_groups/staging"),
+						RespondWith(http.StatusTeapot, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{ResponseCode: http.StatusTeapot}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"var": map[string]interface{}{
+						"my-var":    "my-val",
+						"delete-me": nil,
+					},
+				}
+
+				responseBody := `{
+					"name": "staging",
+					"var": {
+						"DEBUG": "false",
+						"my-var": "my-val"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/environment_variable_groups/staging"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, responseBody, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated group and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(patchedEnvVars).To(Equal(EnvironmentVariables{
+					"DEBUG":  {Value: "false", IsSet: true},
+					"my-var": {Value: "my-val", IsSet: true},
+				}))
+			})
+		})
+	})
+})
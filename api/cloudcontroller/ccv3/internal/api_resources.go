@@ -1,14 +1,18 @@
 package internal
 
 const (
-	AppsResource              = "apps"
-	BuildsResource            = "builds"
-	DropletsResource          = "droplets"
-	IsolationSegmentsResource = "isolation_segments"
-	OrgsResource              = "organizations"
-	PackagesResource          = "packages"
-	ProcessesResource         = "processes"
-	ServiceInstancesResource  = "service_instances"
-	SpacesResource            = "spaces"
-	TasksResource             = "tasks"
+	AppsResource                      = "apps"
+	BuildpacksResource                = "buildpacks"
+	BuildsResource                    = "builds"
+	DropletsResource                  = "droplets"
+	EnvironmentVariableGroupsResource = "environment_variable_groups"
+	IsolationSegmentsResource         = "isolation_segments"
+	OrgsResource                      = "organizations"
+	PackagesResource                  = "packages"
+	ProcessesResource                 = "processes"
+	RolesResource                     = "roles"
+	ServiceInstancesResource          = "service_instances"
+	SpacesResource                    = "spaces"
+	TasksResource                     = "tasks"
+	UsersResource                     = "users"
 )
@@ -138,6 +138,23 @@ func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
 	return responsePackage, response.Warnings, err
 }
 
+// DeletePackage deletes the package with the given GUID. Returns back a
+// resulting job URL to poll.
+func (client *Client) DeletePackage(packageGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
 // GetPackage returns the package with the given GUID.
 func (client *Client) GetPackage(packageGUID string) (Package, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -340,12 +357,19 @@ func (client *Client) uploadAsynchronously(request *cloudcontroller.Request, wri
 		Result: &pkg,
 	}
 
+	err := client.makeWhileWriting(request, &response, writeErrors)
+	return pkg, response.Warnings, err
+}
+
+// makeWhileWriting makes the request while its body is being written by
+// another goroutine, and returns the first error from either side.
+func (client *Client) makeWhileWriting(request *cloudcontroller.Request, response *cloudcontroller.Response, writeErrors <-chan error) error {
 	httpErrors := make(chan error)
 
 	go func() {
 		defer close(httpErrors)
 
-		err := client.connection.Make(request, &response)
+		err := client.connection.Make(request, response)
 		if err != nil {
 			httpErrors <- err
 		}
@@ -384,7 +408,7 @@ func (client *Client) uploadAsynchronously(request *cloudcontroller.Request, wri
 		}
 	}
 
-	return pkg, response.Warnings, firstError
+	return firstError
 }
 
 func (client *Client) uploadExistingResourcesOnly(uploadLink APILink, existingResources []Resource) (Package, Warnings, error) {
@@ -0,0 +1,80 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paginate", func() {
+	var (
+		client *Client
+
+		mutex          sync.Mutex
+		requestedPages []string
+		failingPage    string
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient(Config{PaginationConcurrency: 3})
+
+		requestedPages = nil
+		failingPage = ""
+
+		server.RouteToHandler(http.MethodGet, "/v3/apps", func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page")
+
+			mutex.Lock()
+			requestedPages = append(requestedPages, page)
+			mutex.Unlock()
+
+			if failingPage != "" && page == failingPage {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"errors": [{"code": 10010, "detail": "App not found", "title": "CF-ResourceNotFound"}]}`)
+				return
+			}
+
+			if page == "" {
+				page = "1"
+			}
+			w.Header().Set("X-Cf-Warnings", "warning-"+page)
+			fmt.Fprintf(w, `{
+				"pagination": {
+					"total_pages": 4,
+					"next": {"href": "%s/v3/apps?names=some-app-name&page=2&per_page=1"}
+				},
+				"resources": [{"name": "app-name-%s", "guid": "app-guid-%s"}]
+			}`, server.URL(), page, page)
+		})
+	})
+
+	Context("when the first page reports the total number of pages", func() {
+		It("fetches the remaining pages and returns the resources in page order", func() {
+			apps, warnings, err := client.GetApplications(Query{Key: NameFilter, Values: []string{"some-app-name"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]Application{
+				{Name: "app-name-1", GUID: "app-guid-1"},
+				{Name: "app-name-2", GUID: "app-guid-2"},
+				{Name: "app-name-3", GUID: "app-guid-3"},
+				{Name: "app-name-4", GUID: "app-guid-4"},
+			}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			Expect(requestedPages).To(ConsistOf("", "2", "3", "4"))
+		})
+	})
+
+	Context("when fetching one of the remaining pages fails", func() {
+		BeforeEach(func() {
+			failingPage = "3"
+		})
+
+		It("returns the error", func() {
+			_, _, err := client.GetApplications()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
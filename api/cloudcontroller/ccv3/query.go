@@ -15,12 +15,25 @@ const (
 	GUIDFilter QueryKey = "guids"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
+	// OriginFilter is a query parameter for listing users by origin.
+	OriginFilter QueryKey = "origins"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
 	OrganizationGUIDFilter QueryKey = "organization_guids"
+	// PartialUsernameFilter is a query parameter for listing users whose
+	// username contains the given value.
+	PartialUsernameFilter QueryKey = "partial_usernames"
+	// RoleTypeFilter is a query parameter for listing roles by type.
+	RoleTypeFilter QueryKey = "types"
 	// SequenceIDFilter is a query parameter for listing objects by sequence ID.
 	SequenceIDFilter QueryKey = "sequence_ids"
 	// SpaceGUIDFilter is a query parameter for listing objects by Space GUID.
 	SpaceGUIDFilter QueryKey = "space_guids"
+	// StackFilter is a query parameter for listing objects by stack name.
+	StackFilter QueryKey = "stacks"
+	// UserGUIDFilter is a query parameter for listing objects by User GUID.
+	UserGUIDFilter QueryKey = "user_guids"
+	// UsernameFilter is a query parameter for listing users by username.
+	UsernameFilter QueryKey = "usernames"
 
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
@@ -30,6 +43,12 @@ const (
 	// NameOrder is a query value for ordering by name. This value is used in
 	// conjunction with the OrderBy QueryKey.
 	NameOrder = "name"
+	// PositionOrder is a query value for ordering buildpacks by position.
+	// This value is used in conjunction with the OrderBy QueryKey.
+	PositionOrder = "position"
+	// UsernameOrder is a query value for ordering users by username. This
+	// value is used in conjunction with the OrderBy QueryKey.
+	UsernameOrder = "username"
 )
 
 // Query is additional settings that can be passed to some requests that can
@@ -0,0 +1,89 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Role represents a Cloud Controller V3 Role, which grants a user access to
+// an organization or space.
+type Role struct {
+	// GUID is the unique role identifier.
+	GUID string
+	// Type is the type of access the role grants.
+	Type constant.RoleType
+	// UserGUID is the GUID of the user the role is granted to.
+	UserGUID string
+	// OrganizationGUID is the GUID of the organization for organization
+	// roles.
+	OrganizationGUID string
+	// SpaceGUID is the GUID of the space for space roles.
+	SpaceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Role response.
+func (r *Role) UnmarshalJSON(data []byte) error {
+	var ccRole struct {
+		GUID          string            `json:"guid"`
+		Type          constant.RoleType `json:"type"`
+		Relationships Relationships     `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccRole)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRole.GUID
+	r.Type = ccRole.Type
+	r.UserGUID = ccRole.Relationships[constant.RelationshipTypeUser].GUID
+	r.OrganizationGUID = ccRole.Relationships[constant.RelationshipTypeOrganization].GUID
+	r.SpaceGUID = ccRole.Relationships[constant.RelationshipTypeSpace].GUID
+
+	return nil
+}
+
+// DeleteRole deletes the role with the given GUID. Returns back a resulting
+// job URL to poll.
+func (client *Client) DeleteRole(roleGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRoleRequest,
+		URIParams:   internal.Params{"role_guid": roleGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// GetRoles lists roles with optional filters.
+func (client *Client) GetRoles(query ...Query) ([]Role, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRolesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRolesList []Role
+	warnings, err := client.paginate(request, Role{}, func(item interface{}) error {
+		if role, ok := item.(Role); ok {
+			fullRolesList = append(fullRolesList, role)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Role{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRolesList, warnings, err
+}
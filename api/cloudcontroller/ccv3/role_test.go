@@ -0,0 +1,184 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Role", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRoles", func() {
+		var (
+			roles      []Role
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			roles, warnings, executeErr = client.GetRoles(Query{Key: UserGUIDFilter, Values: []string{"some-user-guid"}})
+		})
+
+		Context("when roles exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/roles?user_guids=some-user-guid&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "role-guid-1",
+			"type": "organization_manager",
+			"relationships": {
+				"user": {"data": {"guid": "some-user-guid"}},
+				"organization": {"data": {"guid": "some-org-guid"}},
+				"space": {"data": null}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "role-guid-2",
+			"type": "space_developer",
+			"relationships": {
+				"user": {"data": {"guid": "some-user-guid"}},
+				"organization": {"data": null},
+				"space": {"data": {"guid": "some-space-guid"}}
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/roles", "user_guids=some-user-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/roles", "user_guids=some-user-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the roles and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(roles).To(ConsistOf(
+					Role{GUID: "role-guid-1", Type: constant.RoleOrganizationManager, UserGUID: "some-user-guid", OrganizationGUID: "some-org-guid"},
+					Role{GUID: "role-guid-2", Type: constant.RoleSpaceDeveloper, UserGUID: "some-user-guid", SpaceGUID: "some-space-guid"},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "The request is semantically invalid: command presence",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/roles"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("DeleteRole", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteRole("some-role-guid")
+		})
+
+		Context("when the role is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/roles/some-role-guid"),
+						RespondWith(http.StatusAccepted, nil, http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the role does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Role not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/roles/some-role-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Role not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
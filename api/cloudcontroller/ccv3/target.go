@@ -1,6 +1,7 @@
 package ccv3
 
 import (
+	"crypto/tls"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -23,6 +24,11 @@ type TargetSettings struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// TLSConfig is the base TLS configuration used to connect to the Cloud
+	// Controller, such as additional trusted CA certificates or a client
+	// certificate. It may be nil.
+	TLSConfig *tls.Config
+
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
 }
@@ -35,6 +41,7 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
+		TLSConfig:         settings.TLSConfig,
 	})
 
 	for _, wrapper := range client.wrappers {
@@ -0,0 +1,65 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// User represents a Cloud Controller V3 User.
+type User struct {
+	// GUID is the unique user identifier, shared with the UAA user ID.
+	GUID string `json:"guid"`
+	// Username is the name the user logs in with. It is empty for UAA
+	// clients.
+	Username string `json:"username"`
+	// PresentationName is the username, or the client ID for UAA clients.
+	PresentationName string `json:"presentation_name"`
+	// Origin is the identity provider the user authenticates with.
+	Origin string `json:"origin"`
+}
+
+// GetUser returns the user with the given GUID.
+func (client *Client) GetUser(userGUID string) (User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUserRequest,
+		URIParams:   internal.Params{"user_guid": userGUID},
+	})
+	if err != nil {
+		return User{}, nil, err
+	}
+
+	var user User
+	response := cloudcontroller.Response{
+		Result: &user,
+	}
+
+	err = client.connection.Make(request, &response)
+	return user, response.Warnings, err
+}
+
+// GetUsers lists users with optional filters.
+func (client *Client) GetUsers(query ...Query) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
@@ -0,0 +1,137 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("User", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetUser", func() {
+		var (
+			user       User
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			user, warnings, executeErr = client.GetUser("some-user-guid")
+		})
+
+		Context("when the user exists", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-user-guid",
+	"username": "some-user",
+	"presentation_name": "some-user",
+	"origin": "uaa"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/users/some-user-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the user and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(user).To(Equal(User{GUID: "some-user-guid", Username: "some-user", PresentationName: "some-user", Origin: "uaa"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "User not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/users/some-user-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "User not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetUsers", func() {
+		var (
+			users      []User
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			users, warnings, executeErr = client.GetUsers(
+				Query{Key: PartialUsernameFilter, Values: []string{"some"}},
+				Query{Key: OrderBy, Values: []string{UsernameOrder}},
+			)
+		})
+
+		Context("when users exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/users?partial_usernames=some&order_by=username&page=2"
+		}
+	},
+	"resources": [
+		{"guid": "user-guid-1", "username": "some-user-1", "presentation_name": "some-user-1", "origin": "uaa"}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{"guid": "user-guid-2", "username": "some-user-2", "presentation_name": "some-user-2", "origin": "ldap"}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/users", "partial_usernames=some&order_by=username"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/users", "partial_usernames=some&order_by=username&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the users and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(users).To(Equal([]User{
+					{GUID: "user-guid-1", Username: "some-user-1", PresentationName: "some-user-1", Origin: "uaa"},
+					{GUID: "user-guid-2", Username: "some-user-2", PresentationName: "some-user-2", Origin: "ldap"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
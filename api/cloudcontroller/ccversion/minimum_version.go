@@ -18,12 +18,15 @@ const (
 
 	MinVersionProvideNameForServiceBinding = "2.99.0"
 
-	MinVersionIsolationSegmentV3 = "3.11.0"
-	MinVersionNetworkingV3       = "3.19.0"
-	MinVersionRoutingV3          = "3.16.0"
-	MinVersionRunTaskV3          = "3.0.0"
-	MinVersionShareServiceV3     = "3.36.0"
-	MinVersionV3                 = "3.27.0"
+	MinVersionBuildpacksV3                = "3.48.0"
+	MinVersionEnvironmentVariableGroupsV3 = "3.65.0"
+	MinVersionIsolationSegmentV3          = "3.11.0"
+	MinVersionNetworkingV3                = "3.19.0"
+	MinVersionRolesV3                     = "3.68.0"
+	MinVersionRoutingV3                   = "3.16.0"
+	MinVersionRunTaskV3                   = "3.0.0"
+	MinVersionShareServiceV3              = "3.36.0"
+	MinVersionV3                          = "3.27.0"
 
 	MinVersionManifestBuildpacksV3        = "3.25.0"
 	MinVersionBuildpackStackAssociationV3 = "99.0.0"
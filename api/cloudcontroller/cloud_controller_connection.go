@@ -17,6 +17,11 @@ import (
 type Config struct {
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
+
+	// TLSConfig is the base TLS configuration for the connection, such as
+	// additional trusted CA certificates or a client certificate. It may be
+	// nil.
+	TLSConfig *tls.Config
 }
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -29,11 +34,15 @@ type CloudControllerConnection struct {
 // NewConnection returns a new CloudControllerConnection with provided
 // configuration.
 func NewConnection(config Config) *CloudControllerConnection {
+	tlsConfig := &tls.Config{}
+	if config.TLSConfig != nil {
+		tlsConfig = config.TLSConfig.Clone()
+	}
+	tlsConfig.InsecureSkipVerify = config.SkipSSLValidation
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   config.DialTimeout,
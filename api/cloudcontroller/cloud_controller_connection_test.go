@@ -1,6 +1,8 @@
 package cloudcontroller_test
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -239,6 +241,32 @@ var _ = Describe("Cloud Controller Connection", func() {
 				})
 			})
 
+			Context("when the server's certificate is signed by a CA in the TLS config", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "{}"),
+						),
+					)
+
+					certPool := x509.NewCertPool()
+					certPool.AddCert(server.HTTPTestServer.Certificate())
+					connection = NewConnection(Config{TLSConfig: &tls.Config{RootCAs: certPool}})
+				})
+
+				It("verifies the server", func() {
+					req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+					request := &Request{Request: req}
+
+					var response Response
+					err = connection.Make(request, &response)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+
 			Context("when the server's certificate does not match the hostname", func() {
 				Context("skipSSLValidation is false", func() {
 					BeforeEach(func() {
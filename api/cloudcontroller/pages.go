@@ -0,0 +1,87 @@
+package cloudcontroller
+
+import (
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// PageURL returns pageURL with its page query parameter set to page.
+func PageURL(pageURL string, page int) (string, error) {
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsedURL.Query()
+	query.Set("page", strconv.Itoa(page))
+	parsedURL.RawQuery = query.Encode()
+
+	return parsedURL.String(), nil
+}
+
+// PageNumber returns the value of the page query parameter of pageURL.
+func PageNumber(pageURL string) (int, error) {
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(parsedURL.Query().Get("page"))
+}
+
+// FetchPages calls fetchPage for every page from firstPage to lastPage,
+// running at most maxConcurrency calls at the same time. No new pages are
+// fetched once a call has failed. The error of the lowest failed page is
+// returned.
+func FetchPages(firstPage int, lastPage int, maxConcurrency int, fetchPage func(page int) error) error {
+	if lastPage < firstPage {
+		return nil
+	}
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		failed    bool
+		errs      = make([]error, lastPage-firstPage+1)
+		semaphore = make(chan struct{}, maxConcurrency)
+	)
+
+	for page := firstPage; page <= lastPage; page++ {
+		semaphore <- struct{}{}
+
+		mutex.Lock()
+		stop := failed
+		mutex.Unlock()
+		if stop {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func(page int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := fetchPage(page); err != nil {
+				mutex.Lock()
+				errs[page-firstPage] = err
+				failed = true
+				mutex.Unlock()
+			}
+		}(page)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
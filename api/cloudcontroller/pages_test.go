@@ -0,0 +1,99 @@
+package cloudcontroller_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pages", func() {
+	Describe("PageURL", func() {
+		It("sets the page query parameter", func() {
+			pageURL, err := PageURL("/v2/apps?order-direction=asc&page=2&results-per-page=50", 7)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pageURL).To(Equal("/v2/apps?order-direction=asc&page=7&results-per-page=50"))
+		})
+
+		It("adds the page query parameter when it is missing", func() {
+			pageURL, err := PageURL("https://api.example.com/v3/apps?per_page=5000", 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pageURL).To(Equal("https://api.example.com/v3/apps?page=3&per_page=5000"))
+		})
+	})
+
+	Describe("PageNumber", func() {
+		It("returns the page query parameter", func() {
+			page, err := PageNumber("https://api.example.com/v3/apps?page=2&per_page=5000")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page).To(Equal(2))
+		})
+
+		It("returns an error when the page query parameter is missing", func() {
+			_, err := PageNumber("https://api.example.com/v3/apps")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("FetchPages", func() {
+		It("fetches every page", func() {
+			var mutex sync.Mutex
+			var fetched []int
+			err := FetchPages(2, 6, 3, func(page int) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				fetched = append(fetched, page)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched).To(ConsistOf(2, 3, 4, 5, 6))
+		})
+
+		It("runs at most maxConcurrency fetches at the same time", func() {
+			var running, maxRunning int32
+			err := FetchPages(1, 10, 3, func(int) error {
+				current := atomic.AddInt32(&running, 1)
+				for {
+					seen := atomic.LoadInt32(&maxRunning)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(maxRunning).To(BeNumerically("<=", 3))
+			Expect(maxRunning).To(BeNumerically(">", 1))
+		})
+
+		It("returns the error of the lowest failed page", func() {
+			err := FetchPages(1, 5, 5, func(page int) error {
+				if page >= 3 {
+					return fmt.Errorf("page %d", page)
+				}
+				return nil
+			})
+			Expect(err).To(MatchError("page 3"))
+		})
+
+		It("stops fetching new pages once a page has failed", func() {
+			var calls int32
+			err := FetchPages(1, 100, 1, func(page int) error {
+				atomic.AddInt32(&calls, 1)
+				if page == 2 {
+					return errors.New("boom")
+				}
+				return nil
+			})
+			Expect(err).To(MatchError("boom"))
+			Expect(atomic.LoadInt32(&calls)).To(BeNumerically("<", 100))
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/throttle"
+)
+
+// RateLimiter is a wrapper that waits for the limiter before making each
+// request.
+type RateLimiter struct {
+	limiter    *throttle.Limiter
+	connection cloudcontroller.Connection
+}
+
+// NewRateLimiter returns a pointer to a RateLimiter wrapper.
+func NewRateLimiter(limiter *throttle.Limiter) *RateLimiter {
+	return &RateLimiter{
+		limiter: limiter,
+	}
+}
+
+// Make waits for the limiter and then makes the request.
+func (rateLimiter *RateLimiter) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	rateLimiter.limiter.Wait()
+	return rateLimiter.connection.Make(request, passedResponse)
+}
+
+// Wrap sets the connection in the RateLimiter and returns itself.
+func (rateLimiter *RateLimiter) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	rateLimiter.connection = innerconnection
+	return rateLimiter
+}
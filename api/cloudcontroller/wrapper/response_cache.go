@@ -0,0 +1,122 @@
+package wrapper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// ResponseCache is a wrapper that stores successful GET responses on disk for
+// a short time and replays them instead of making the same request again.
+// Responses are keyed by URL and by the subject of the access token, so they
+// are never shared between users. Cached responses are not invalidated by
+// requests that change resources, whether they are made through this wrapper
+// or by other commands, so a replayed response may be stale for up to the TTL.
+type ResponseCache struct {
+	dir        string
+	ttl        time.Duration
+	subject    string
+	connection cloudcontroller.Connection
+}
+
+type cachedResponse struct {
+	URL        string      `json:"url"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Warnings   []string    `json:"warnings"`
+	Body       []byte      `json:"body"`
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// responses in dir for ttl on behalf of subject.
+func NewResponseCache(dir string, ttl time.Duration, subject string) *ResponseCache {
+	return &ResponseCache{
+		dir:     dir,
+		ttl:     ttl,
+		subject: subject,
+	}
+}
+
+// Make returns the cached response for GET requests when one exists and has
+// not expired. Otherwise it makes the request and caches successful GET
+// responses.
+func (cache *ResponseCache) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if request.Method != http.MethodGet {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	path := cache.path(request)
+	if cached, ok := cache.read(path); ok {
+		passedResponse.RawResponse = cached.Body
+		passedResponse.Warnings = cached.Warnings
+		passedResponse.HTTPResponse = &http.Response{
+			StatusCode: cached.StatusCode,
+			Header:     cached.Header,
+			Request:    request.Request,
+		}
+
+		if passedResponse.Result != nil {
+			return cloudcontroller.DecodeJSON(cached.Body, passedResponse.Result)
+		}
+		return nil
+	}
+
+	err := cache.connection.Make(request, passedResponse)
+	if err == nil && passedResponse.HTTPResponse != nil && passedResponse.HTTPResponse.StatusCode == http.StatusOK {
+		cache.write(path, cachedResponse{
+			URL:        request.URL.String(),
+			ExpiresAt:  time.Now().Add(cache.ttl),
+			StatusCode: passedResponse.HTTPResponse.StatusCode,
+			Header:     passedResponse.HTTPResponse.Header,
+			Warnings:   passedResponse.Warnings,
+			Body:       passedResponse.RawResponse,
+		})
+	}
+	return err
+}
+
+// Wrap sets the connection in the ResponseCache and returns itself.
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+func (cache *ResponseCache) path(request *cloudcontroller.Request) string {
+	sum := sha256.Sum256([]byte(cache.subject + "\n" + request.URL.String()))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (*ResponseCache) read(path string) (cachedResponse, bool) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var cached cachedResponse
+	if err := json.Unmarshal(raw, &cached); err != nil || time.Now().After(cached.ExpiresAt) {
+		return cachedResponse{}, false
+	}
+	return cached, true
+}
+
+// write stores the response on a best effort basis; failing to cache a
+// response does not fail the request.
+func (cache *ResponseCache) write(path string, cached cachedResponse) {
+	raw, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(cache.dir, 0700); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(path, raw, 0600)
+}
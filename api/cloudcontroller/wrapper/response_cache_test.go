@@ -0,0 +1,143 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		cacheDir       string
+		ttl            time.Duration
+		subject        string
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+	)
+
+	type resource struct {
+		Name string `json:"name"`
+	}
+
+	newRequest := func(method string, url string) *cloudcontroller.Request {
+		req, err := http.NewRequest(method, url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return cloudcontroller.NewRequest(req, nil)
+	}
+
+	makeRequest := func(method string, url string) (resource, *cloudcontroller.Response, error) {
+		var result resource
+		response := &cloudcontroller.Response{Result: &result}
+		err := NewResponseCache(cacheDir, ttl, subject).Wrap(fakeConnection).Make(newRequest(method, url), response)
+		return result, response, err
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "response-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		ttl = time.Minute
+		subject = "some-user"
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte(`{"name": "some-name"}`)
+			passedResponse.Warnings = []string{"some-warning"}
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+			return cloudcontroller.DecodeJSON(passedResponse.RawResponse, passedResponse.Result)
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("replays a cached GET response without making the request", func() {
+		_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		Expect(err).ToNot(HaveOccurred())
+
+		result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(result).To(Equal(resource{Name: "some-name"}))
+		Expect(response.Warnings).To(ConsistOf("some-warning"))
+		Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("does not share responses between URLs", func() {
+		_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/apps?page=2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+	})
+
+	It("does not share responses between subjects", func() {
+		_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		Expect(err).ToNot(HaveOccurred())
+
+		subject = "some-other-user"
+		_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+	})
+
+	Context("when the cached response has expired", func() {
+		BeforeEach(func() {
+			ttl = -time.Second
+		})
+
+		It("makes the request again", func() {
+			_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the response is not successful", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+				return ccerror.ResourceNotFoundError{}
+			}
+		})
+
+		It("does not cache it", func() {
+			_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).To(HaveOccurred())
+			_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when a request other than GET is made", func() {
+		It("makes the request every time without caching it", func() {
+			for i := 0; i < 2; i++ {
+				_, _, err := makeRequest(http.MethodPut, "https://api.example.com/v2/apps/some-guid")
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+
+		It("does not invalidate cached GET responses", func() {
+			_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest(http.MethodPut, "https://api.example.com/v2/apps/some-guid")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/apps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+})
@@ -1,27 +1,38 @@
 package wrapper
 
 import (
-	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/throttle"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 429 or 5XX status code, waiting between attempts.
 type RetryRequest struct {
 	maxRetries int
+	backoff    throttle.Backoff
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper using the
+// default backoff.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithBackoff(maxRetries, throttle.DefaultBackoff())
+}
+
+// NewRetryRequestWithBackoff returns a pointer to a RetryRequest wrapper that
+// waits between attempts according to backoff.
+func NewRetryRequestWithBackoff(maxRetries int, backoff throttle.Backoff) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		backoff:    backoff,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 429 or 5XX status code.
+// See throttle.ShouldRetry for which requests are retried.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
@@ -31,7 +42,12 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.maxRetries || !throttle.ShouldRetry(request.Method, passedResponse.HTTPResponse) {
+			break
+		}
+
+		delay, ok := retry.backoff.Delay(i, passedResponse.HTTPResponse)
+		if !ok {
 			break
 		}
 
@@ -43,6 +59,8 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			}
 			return resetErr
 		}
+
+		time.Sleep(delay)
 	}
 	return err
 }
@@ -52,14 +70,3 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	retry.connection = innerconnection
 	return retry
 }
-
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
-}
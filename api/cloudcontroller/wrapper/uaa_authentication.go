@@ -1,6 +1,8 @@
 package wrapper
 
 import (
+	"sync"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -24,11 +26,13 @@ type TokenCache interface {
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
-// requests
+// requests. It is safe to use from concurrent requests; only one of them
+// refreshes an expired token.
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+	tokenLock  sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -48,18 +52,16 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		err := t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -69,13 +71,39 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", t.accessToken())
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
 
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+	return t.cache.AccessToken()
+}
+
+// refreshToken refreshes the access token that was rejected, unless another
+// request has already replaced it.
+func (t *UAAAuthentication) refreshToken(rejectedToken string) error {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if t.cache.AccessToken() != rejectedToken {
+		return nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return nil
+}
+
 // SetClient sets the UAA client that the wrapper will use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
@@ -169,6 +169,27 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 
+			Context("when another request has already refreshed the token", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+						if request.Header.Get("Authorization") == "what" {
+							inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+							return ccerror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with the new token without refreshing it again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+					requestArg, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(requestArg.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+				})
+			})
+
 			Context("when a PipeSeekError is returned from ResetBody", func() {
 				BeforeEach(func() {
 					body, writer := cloudcontroller.NewPipeBomb()
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"crypto/tls"
 	"fmt"
 	"runtime"
 	"time"
@@ -33,6 +34,11 @@ type Config struct {
 	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
 	// be used only for testing.
 	SkipSSLValidation bool
+
+	// TLSConfig is the base TLS configuration used to connect to plugin
+	// repositories, such as additional trusted CA certificates or a client
+	// certificate. It may be nil.
+	TLSConfig *tls.Config
 }
 
 // NewClient returns a new plugin Client.
@@ -46,7 +52,7 @@ func NewClient(config Config) *Client {
 	)
 	client := Client{
 		userAgent:  userAgent,
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout, config.TLSConfig),
 	}
 
 	return &client
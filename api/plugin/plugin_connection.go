@@ -21,13 +21,18 @@ type PluginConnection struct {
 	proxyReader ProxyReader
 }
 
-// NewConnection returns a new PluginConnection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *PluginConnection {
+// NewConnection returns a new PluginConnection. tlsConfig is the base TLS
+// configuration for the connection and may be nil.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration, tlsConfig *tls.Config) *PluginConnection {
+	connectionTLSConfig := &tls.Config{}
+	if tlsConfig != nil {
+		connectionTLSConfig = tlsConfig.Clone()
+	}
+	connectionTLSConfig.InsecureSkipVerify = skipSSLValidation
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: skipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: connectionTLSConfig,
+		Proxy:           http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   dialTimeout,
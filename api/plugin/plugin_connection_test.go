@@ -29,7 +29,7 @@ var _ = Describe("Plugin Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, 0)
+		connection = NewConnection(true, 0, nil)
 		fakeProxyReader = new(pluginfakes.FakeProxyReader)
 
 		fakeProxyReader.WrapStub = func(reader io.Reader) io.ReadCloser {
@@ -135,7 +135,7 @@ var _ = Describe("Plugin Connection", func() {
 		Describe("Request errors", func() {
 			Context("when the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, 0)
+					connection = NewConnection(false, 0, nil)
 				})
 
 				It("returns a RequestError", func() {
@@ -161,7 +161,7 @@ var _ = Describe("Plugin Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(false, 0, nil)
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -187,7 +187,7 @@ var _ = Describe("Plugin Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(false, 0, nil)
 					})
 
 					// loopback.cli.fun is a custom DNS record setup to point to 127.0.0.1
@@ -0,0 +1,34 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/throttle"
+)
+
+// RateLimiter is a wrapper that waits for the limiter before making each
+// request.
+type RateLimiter struct {
+	limiter    *throttle.Limiter
+	connection plugin.Connection
+}
+
+// NewRateLimiter returns a pointer to a RateLimiter wrapper.
+func NewRateLimiter(limiter *throttle.Limiter) *RateLimiter {
+	return &RateLimiter{
+		limiter: limiter,
+	}
+}
+
+// Make waits for the limiter and then makes the request.
+func (rateLimiter *RateLimiter) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+	rateLimiter.limiter.Wait()
+	return rateLimiter.connection.Make(request, passedResponse, proxyReader)
+}
+
+// Wrap sets the connection in the RateLimiter and returns itself.
+func (rateLimiter *RateLimiter) Wrap(innerconnection plugin.Connection) plugin.Connection {
+	rateLimiter.connection = innerconnection
+	return rateLimiter
+}
@@ -0,0 +1,51 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/throttle"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rate Limiter", func() {
+	var (
+		fakeConnection *pluginfakes.FakeConnection
+		wrapper        plugin.Connection
+		request        *http.Request
+		response       *plugin.Response
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(pluginfakes.FakeConnection)
+		wrapper = NewRateLimiter(throttle.NewLimiter(20, 1)).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response = &plugin.Response{}
+	})
+
+	It("makes the request", func() {
+		expectedErr := errors.New("some error")
+		fakeConnection.MakeReturns(expectedErr)
+
+		err := wrapper.Make(request, response, nil)
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	It("limits the rate of requests", func() {
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			Expect(wrapper.Make(request, response, nil)).To(Succeed())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+	})
+})
@@ -4,25 +4,37 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/throttle"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 429 or 5XX status code, waiting between attempts.
 type RetryRequest struct {
 	maxRetries int
+	backoff    throttle.Backoff
 	connection plugin.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper using the
+// default backoff.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithBackoff(maxRetries, throttle.DefaultBackoff())
+}
+
+// NewRetryRequestWithBackoff returns a pointer to a RetryRequest wrapper that
+// waits between attempts according to backoff.
+func NewRetryRequestWithBackoff(maxRetries int, backoff throttle.Backoff) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		backoff:    backoff,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 429 or 5XX status code.
+// See throttle.ShouldRetry for which requests are retried.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
 	var err error
 	var rawRequestBody []byte
@@ -44,9 +56,15 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Re
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.maxRetries || !throttle.ShouldRetry(request.Method, passedResponse.HTTPResponse) {
 			break
 		}
+
+		delay, ok := retry.backoff.Delay(i, passedResponse.HTTPResponse)
+		if !ok {
+			break
+		}
+		time.Sleep(delay)
 	}
 	return err
 }
@@ -56,14 +74,3 @@ func (retry *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connec
 	retry.connection = innerconnection
 	return retry
 }
-
-// skipRetry if the request method is POST, or not one of the following http
-// status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
-}
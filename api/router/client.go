@@ -0,0 +1,89 @@
+// Package router represents a client for the Cloud Foundry routing API.
+//
+// These sets of packages are still under development/pre-pre-pre...alpha. Use
+// at your own risk! Functionality and design may change without warning.
+//
+// The client reuses the Cloud Controller connection and its wrappers, so
+// requests are authenticated, retried and logged the same way as requests to
+// the Cloud Controller. Rejected access tokens are converted to the errors
+// those wrappers expect, so they are refreshed the same way too.
+//
+// For more information on the routing API see
+// https://github.com/cloudfoundry/routing-api/blob/master/docs/api_docs.md
+package router
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"runtime"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// Client is a client that can be used to talk to the routing API.
+type Client struct {
+	connection cloudcontroller.Connection
+	routingURL string
+	userAgent  string
+}
+
+// Config allows the Client to be configured.
+type Config struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// DialTimeout is the DNS timeout used to make all requests to the routing
+	// API.
+	DialTimeout time.Duration
+
+	// RoutingEndpoint is the routing endpoint advertised by the Cloud
+	// Controller.
+	RoutingEndpoint string
+
+	// SkipSSLValidation controls whether the client verifies the server's
+	// certificate chain and host name.
+	SkipSSLValidation bool
+
+	// TLSConfig is the base TLS configuration used to connect to the routing
+	// API. It may be nil.
+	TLSConfig *tls.Config
+
+	// Wrappers that apply to the client connection.
+	Wrappers []ConnectionWrapper
+}
+
+// NewClient returns a new routing API Client.
+func NewClient(config Config) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	var connection cloudcontroller.Connection = cloudcontroller.NewConnection(cloudcontroller.Config{
+		DialTimeout:       config.DialTimeout,
+		SkipSSLValidation: config.SkipSSLValidation,
+		TLSConfig:         config.TLSConfig,
+	})
+	for _, wrapper := range append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...) {
+		connection = wrapper.Wrap(connection)
+	}
+
+	return &Client{
+		connection: connection,
+		routingURL: config.RoutingEndpoint,
+		userAgent:  userAgent,
+	}
+}
+
+func (client Client) newHTTPRequest(method string, path string) (*cloudcontroller.Request, error) {
+	request, err := http.NewRequest(method, client.routingURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", client.userAgent)
+	return cloudcontroller.NewRequest(request, nil), nil
+}
@@ -0,0 +1,10 @@
+package router
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller"
+
+// ConnectionWrapper can wrap a given connection allowing the wrapper to modify
+// all requests going in and out of the given connection.
+type ConnectionWrapper interface {
+	cloudcontroller.Connection
+	Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection
+}
@@ -0,0 +1,46 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// errorResponse is the error body returned by the routing API.
+type errorResponse struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// errorWrapper is the wrapper that converts routing API error responses to
+// the errors the Cloud Controller connection wrappers handle.
+type errorWrapper struct {
+	connection cloudcontroller.Connection
+}
+
+func newErrorWrapper() *errorWrapper {
+	return new(errorWrapper)
+}
+
+// Make converts a rejected access token to an InvalidAuthTokenError, so that
+// the UAA authentication wrapper refreshes it. Other errors are returned as
+// is.
+func (e *errorWrapper) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	err := e.connection.Make(request, passedResponse)
+
+	if rawHTTPStatusErr, ok := err.(ccerror.RawHTTPStatusError); ok && rawHTTPStatusErr.StatusCode == http.StatusUnauthorized {
+		var response errorResponse
+		if json.Unmarshal(rawHTTPStatusErr.RawResponse, &response) == nil && response.Name == "UnauthorizedError" {
+			return ccerror.InvalidAuthTokenError{Message: response.Message}
+		}
+	}
+	return err
+}
+
+// Wrap wraps a routing API connection in this error handling wrapper.
+func (e *errorWrapper) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	e.connection = innerconnection
+	return e
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RouterGroup represents a group of routers that share a set of domains.
+type RouterGroup struct {
+	// GUID is the unique router group identifier.
+	GUID string `json:"guid"`
+
+	// Name is the name given to the router group.
+	Name string `json:"name"`
+
+	// Type is the type of the router group, either tcp or http.
+	Type string `json:"type"`
+
+	// ReservedPorts are the ports reserved for TCP routes, as a comma separated
+	// list of ports and port ranges.
+	ReservedPorts string `json:"reserved_ports"`
+}
+
+// GetRouterGroups returns all the router groups.
+func (client Client) GetRouterGroups() ([]RouterGroup, error) {
+	request, err := client.newHTTPRequest(http.MethodGet, "/v1/router_groups")
+	if err != nil {
+		return nil, err
+	}
+
+	var routerGroups []RouterGroup
+	response := cloudcontroller.Response{
+		Result: &routerGroups,
+	}
+
+	err = client.connection.Make(request, &response)
+	return routerGroups, err
+}
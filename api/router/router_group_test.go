@@ -0,0 +1,136 @@
+package router_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Router Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the routing API returns router groups", func() {
+			BeforeEach(func() {
+				response := `[
+					{
+						"guid": "tcp-group-guid",
+						"name": "default-tcp",
+						"type": "tcp",
+						"reserved_ports": "1024-1033,2000"
+					},
+					{
+						"guid": "http-group-guid",
+						"name": "default-http",
+						"type": "http"
+					}
+				]`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						VerifyHeaderKV("Accept", "application/json"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the router groups", func() {
+				routerGroups, err := client.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroups).To(ConsistOf(
+					RouterGroup{GUID: "tcp-group-guid", Name: "default-tcp", Type: "tcp", ReservedPorts: "1024-1033,2000"},
+					RouterGroup{GUID: "http-group-guid", Name: "default-http", Type: "http"},
+				))
+			})
+		})
+
+		Context("when the routing API rejects the access token", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusUnauthorized, `{"name": "UnauthorizedError", "message": "Token is expired"}`),
+					),
+				)
+			})
+
+			It("returns an InvalidAuthTokenError", func() {
+				_, err := client.GetRouterGroups()
+				Expect(err).To(MatchError(ccerror.InvalidAuthTokenError{Message: "Token is expired"}))
+			})
+		})
+
+		Context("when the access token has expired and the client refreshes tokens", func() {
+			var (
+				fakeUAAClient  *wrapperfakes.FakeUAAClient
+				fakeTokenCache *wrapperfakes.FakeTokenCache
+			)
+
+			BeforeEach(func() {
+				fakeUAAClient = new(wrapperfakes.FakeUAAClient)
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: "new-token", Type: "bearer"}, nil)
+				fakeTokenCache = new(wrapperfakes.FakeTokenCache)
+				fakeTokenCache.AccessTokenReturns("bearer old-token")
+				fakeTokenCache.SetAccessTokenStub = func(token string) {
+					fakeTokenCache.AccessTokenReturns(token)
+				}
+
+				client = NewClient(Config{
+					RoutingEndpoint:   server.URL() + "/routing",
+					SkipSSLValidation: true,
+					Wrappers:          []ConnectionWrapper{wrapper.NewUAAAuthentication(fakeUAAClient, fakeTokenCache)},
+				})
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						VerifyHeaderKV("Authorization", "bearer old-token"),
+						RespondWith(http.StatusUnauthorized, `{"name": "UnauthorizedError", "message": "Token is expired"}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						VerifyHeaderKV("Authorization", "bearer new-token"),
+						RespondWith(http.StatusOK, `[]`),
+					),
+				)
+			})
+
+			It("refreshes the token and retries the request", func() {
+				_, err := client.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the routing API returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusForbidden, `{"name": "UnauthorizedError", "message": "You are not authorized to perform the requested action"}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetRouterGroups()
+				Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+					StatusCode:  http.StatusForbidden,
+					RawResponse: []byte(`{"name": "UnauthorizedError", "message": "You are not authorized to perform the requested action"}`),
+				}))
+			})
+		})
+	})
+})
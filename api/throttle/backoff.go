@@ -0,0 +1,139 @@
+// Package throttle contains the retry and rate limiting policies shared by the
+// Cloud Controller, UAA and plugin repository connection wrappers.
+package throttle
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseDelay is the delay before the first retry of a failed request.
+	DefaultBaseDelay = 500 * time.Millisecond
+
+	// DefaultMaxDelay is the longest the CLI will wait before retrying a failed
+	// request, including delays requested by the server via Retry-After.
+	DefaultMaxDelay = 30 * time.Second
+)
+
+// Backoff computes how long to wait between retries of a failed request.
+// Delays grow exponentially from BaseDelay and are capped at MaxDelay. A
+// random jitter of up to half the delay is subtracted so that concurrent
+// clients do not retry in lock step.
+type Backoff struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	randMutex *sync.Mutex
+	rand      *rand.Rand
+}
+
+// NewBackoff returns a Backoff with the provided delays.
+func NewBackoff(baseDelay time.Duration, maxDelay time.Duration) Backoff {
+	return Backoff{
+		BaseDelay: baseDelay,
+		MaxDelay:  maxDelay,
+		randMutex: new(sync.Mutex),
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// DefaultBackoff returns a Backoff using DefaultBaseDelay and DefaultMaxDelay.
+func DefaultBackoff() Backoff {
+	return NewBackoff(DefaultBaseDelay, DefaultMaxDelay)
+}
+
+// Delay returns how long to wait before the retry following the given
+// attempt, where attempt 0 is the original request. When the server asked the
+// client to come back later via the Retry-After header of a 429 or 503
+// response, that delay is used instead. Delay returns false when the server
+// asked for a delay longer than MaxDelay, in which case the request should not
+// be retried.
+func (backoff Backoff) Delay(attempt int, response *http.Response) (time.Duration, bool) {
+	if retryAfter, ok := RetryAfter(response, time.Now()); ok {
+		if retryAfter > backoff.MaxDelay {
+			return 0, false
+		}
+		return retryAfter, true
+	}
+
+	delay := backoff.BaseDelay
+	for i := 0; i < attempt && delay < backoff.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > backoff.MaxDelay {
+		delay = backoff.MaxDelay
+	}
+
+	if delay <= 0 || backoff.rand == nil {
+		return delay, true
+	}
+
+	backoff.randMutex.Lock()
+	jitter := time.Duration(backoff.rand.Int63n(int64(delay/2) + 1))
+	backoff.randMutex.Unlock()
+
+	return delay - jitter, true
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of a 429 or
+// 503 response. The header may either be a number of seconds or an HTTP date,
+// which is compared to now.
+func RetryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil ||
+		response.StatusCode != http.StatusTooManyRequests &&
+			response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// ShouldRetry returns true when a request that failed with response can be
+// safely retried. Requests are retried on 429 and on 500, 502, 503 and 504
+// status codes. Because POST requests are not idempotent, they are only
+// retried when the server has rejected them without processing them: on 429,
+// or on 503 with a Retry-After header. Requests that failed without a response
+// are retried unless they are POST requests.
+func ShouldRetry(httpMethod string, response *http.Response) bool {
+	if response == nil {
+		return httpMethod != http.MethodPost
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		if httpMethod == http.MethodPost {
+			_, ok := RetryAfter(response, time.Now())
+			return ok
+		}
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return httpMethod != http.MethodPost
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package throttle_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/throttle"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backoff", func() {
+	var backoff Backoff
+
+	BeforeEach(func() {
+		backoff = NewBackoff(time.Second, 10*time.Second)
+	})
+
+	Describe("Delay", func() {
+		DescribeTable("exponential delays with jitter",
+			func(attempt int, min time.Duration, max time.Duration) {
+				for i := 0; i < 20; i++ {
+					delay, ok := backoff.Delay(attempt, &http.Response{StatusCode: http.StatusInternalServerError})
+					Expect(ok).To(BeTrue())
+					Expect(delay).To(BeNumerically(">=", min))
+					Expect(delay).To(BeNumerically("<=", max))
+				}
+			},
+
+			Entry("first retry", 0, 500*time.Millisecond, time.Second),
+			Entry("second retry", 1, time.Second, 2*time.Second),
+			Entry("third retry", 2, 2*time.Second, 4*time.Second),
+			Entry("capped at the max delay", 10, 5*time.Second, 10*time.Second),
+		)
+
+		Context("when the server provides a Retry-After header", func() {
+			It("waits for the requested number of seconds", func() {
+				delay, ok := backoff.Delay(0, &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": {"3"}},
+				})
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(Equal(3 * time.Second))
+			})
+
+			It("does not retry when the requested delay exceeds the max delay", func() {
+				_, ok := backoff.Delay(0, &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{"Retry-After": {"60"}},
+				})
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RetryAfter", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC)
+		})
+
+		It("parses a number of seconds", func() {
+			delay, ok := RetryAfter(&http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": {"5"}},
+			}, now)
+			Expect(ok).To(BeTrue())
+			Expect(delay).To(Equal(5 * time.Second))
+		})
+
+		It("parses an HTTP date", func() {
+			delay, ok := RetryAfter(&http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Retry-After": {now.Add(7 * time.Second).Format(http.TimeFormat)}},
+			}, now)
+			Expect(ok).To(BeTrue())
+			Expect(delay).To(Equal(7 * time.Second))
+		})
+
+		It("ignores the header on other status codes", func() {
+			_, ok := RetryAfter(&http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Header:     http.Header{"Retry-After": {"5"}},
+			}, now)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("ignores invalid values", func() {
+			_, ok := RetryAfter(&http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": {"soon"}},
+			}, now)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	DescribeTable("ShouldRetry",
+		func(method string, response *http.Response, expected bool) {
+			Expect(ShouldRetry(method, response)).To(Equal(expected))
+		},
+
+		Entry("GET without a response", http.MethodGet, nil, true),
+		Entry("POST without a response", http.MethodPost, nil, false),
+		Entry("GET 429", http.MethodGet, &http.Response{StatusCode: http.StatusTooManyRequests}, true),
+		Entry("POST 429", http.MethodPost, &http.Response{StatusCode: http.StatusTooManyRequests}, true),
+		Entry("GET 500", http.MethodGet, &http.Response{StatusCode: http.StatusInternalServerError}, true),
+		Entry("POST 500", http.MethodPost, &http.Response{StatusCode: http.StatusInternalServerError}, false),
+		Entry("PUT 502", http.MethodPut, &http.Response{StatusCode: http.StatusBadGateway}, true),
+		Entry("DELETE 504", http.MethodDelete, &http.Response{StatusCode: http.StatusGatewayTimeout}, true),
+		Entry("POST 503", http.MethodPost, &http.Response{StatusCode: http.StatusServiceUnavailable}, false),
+		Entry("POST 503 with Retry-After", http.MethodPost, &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Retry-After": {"1"}},
+		}, true),
+		Entry("GET 404", http.MethodGet, &http.Response{StatusCode: http.StatusNotFound}, false),
+	)
+})
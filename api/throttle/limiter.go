@@ -0,0 +1,64 @@
+package throttle
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter. The bucket holds up to burst tokens
+// and is refilled at a constant rate; every request takes one token, waiting
+// for the bucket to refill when it is empty. A single Limiter can be shared
+// between connections so that they are limited as a whole.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second
+// on average and bursts of up to burst requests. A burst lower than 1 is
+// treated as 1, and a rate of 0 or less does not limit requests.
+func NewLimiter(requestsPerSecond float64, burst int) *Limiter {
+	burstSize := math.Max(float64(burst), 1)
+	return &Limiter{
+		rate:   requestsPerSecond,
+		burst:  burstSize,
+		tokens: burstSize,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed to be made.
+func (limiter *Limiter) Wait() {
+	if delay := limiter.reserve(); delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to
+// wait for that token to become available.
+func (limiter *Limiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if limiter.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	if elapsed := now.Sub(limiter.last); elapsed > 0 {
+		limiter.tokens = math.Min(limiter.burst, limiter.tokens+elapsed.Seconds()*limiter.rate)
+		limiter.last = now
+	}
+
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-limiter.tokens / limiter.rate * float64(time.Second))
+}
@@ -0,0 +1,60 @@
+package throttle_test
+
+import (
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/throttle"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	It("allows bursts without waiting", func() {
+		limiter := NewLimiter(1, 5)
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			limiter.Wait()
+		}
+		Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+	})
+
+	It("limits requests to the configured rate once the burst is used", func() {
+		limiter := NewLimiter(20, 1)
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			limiter.Wait()
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 190*time.Millisecond))
+	})
+
+	It("is shared between concurrent callers", func() {
+		limiter := NewLimiter(20, 1)
+
+		start := time.Now()
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				limiter.Wait()
+			}()
+		}
+		wg.Wait()
+		Expect(time.Since(start)).To(BeNumerically(">=", 190*time.Millisecond))
+	})
+
+	Context("when the rate is 0", func() {
+		It("does not limit requests", func() {
+			limiter := NewLimiter(0, 0)
+
+			start := time.Now()
+			for i := 0; i < 100; i++ {
+				limiter.Wait()
+			}
+			Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package uaa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// PKCE is a Proof Key for Code Exchange (RFC 7636). The challenge is sent
+// with the authorization request and the verifier with the token request, so
+// that an intercepted authorization code cannot be exchanged by anyone else.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE returns a random verifier and its S256 challenge.
+func NewPKCE() (PKCE, error) {
+	verifier, err := randomURLSafeString(32)
+	if err != nil {
+		return PKCE{}, err
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+// AuthorizationCodeURL returns the URL of the login page that redirects the
+// browser to redirectURI with an authorization code once the user has logged
+// in.
+func (client *Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query: url.Values{
+			"response_type":         {"code"},
+			"client_id":             {client.config.UAAOAuthClient()},
+			"redirect_uri":          {redirectURI},
+			"state":                 {state},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// AuthenticateWithAuthorizationCode exchanges an authorization code for an
+// access token and a refresh token.
+func (client *Client) AuthenticateWithAuthorizationCode(code string, redirectURI string, codeVerifier string) (string, string, error) {
+	requestBody := url.Values{
+		"grant_type":    {string(constant.GrantTypeAuthorizationCode)},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {codeVerifier},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, responseBody.RefreshToken, err
+}
+
+// AuthenticateWithBrowser logs the user in with the authorization code flow.
+// It listens for the login redirect on a loopback address, passes the login
+// page URL to openURL and, once the browser is redirected back, exchanges the
+// code for an access token and a refresh token. It gives up after timeout.
+func (client *Client) AuthenticateWithBrowser(openURL func(loginURL string) error, timeout time.Duration) (string, string, error) {
+	pkce, err := NewPKCE()
+	if err != nil {
+		return "", "", err
+	}
+
+	state, err := randomURLSafeString(16)
+	if err != nil {
+		return "", "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", "", err
+	}
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	callback := newAuthorizationCallback(state)
+	server := &http.Server{Handler: callback}
+	go server.Serve(listener)
+	defer server.Close()
+
+	loginURL, err := client.AuthorizationCodeURL(redirectURI, state, pkce.Challenge)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = openURL(loginURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	var result authorizationCallbackResult
+	select {
+	case result = <-callback.results:
+	case <-time.After(timeout):
+		return "", "", BrowserLoginTimeoutError{Timeout: timeout}
+	}
+	if result.err != nil {
+		return "", "", result.err
+	}
+
+	return client.AuthenticateWithAuthorizationCode(result.code, redirectURI, pkce.Verifier)
+}
+
+type authorizationCallbackResult struct {
+	code string
+	err  error
+}
+
+// authorizationCallback handles the redirect from the login page and reports
+// the first login result it receives. Requests that do not belong to this
+// login attempt or that carry no result are answered with 400 Bad Request
+// and do not end the wait, so that a stray or malformed request to the
+// loopback address cannot abort the login.
+type authorizationCallback struct {
+	state   string
+	results chan authorizationCallbackResult
+}
+
+func newAuthorizationCallback(state string) *authorizationCallback {
+	return &authorizationCallback{
+		state:   state,
+		results: make(chan authorizationCallbackResult, 1),
+	}
+}
+
+func (callback *authorizationCallback) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.URL.Path != "/callback" {
+		http.NotFound(writer, request)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	query := request.URL.Query()
+	var result authorizationCallbackResult
+	switch {
+	case query.Get("state") != callback.state:
+		callback.rejectRequest(writer, AuthorizationCallbackError{Type: "invalid_state", Description: "the login response does not match this login attempt"})
+		return
+	case query.Get("error") != "":
+		result.err = AuthorizationCallbackError{Type: query.Get("error"), Description: query.Get("error_description")}
+	case query.Get("code") == "":
+		callback.rejectRequest(writer, AuthorizationCallbackError{Type: "invalid_response", Description: "the login response has no authorization code"})
+		return
+	default:
+		result.code = query.Get("code")
+	}
+
+	if result.err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(writer, "%s\nReturn to the terminal for details.\n", result.err)
+	} else {
+		fmt.Fprintln(writer, "Login successful. You can close this window and return to the terminal.")
+	}
+
+	select {
+	case callback.results <- result:
+	default:
+	}
+}
+
+func (*authorizationCallback) rejectRequest(writer http.ResponseWriter, err error) {
+	writer.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintln(writer, err)
+}
+
+func randomURLSafeString(numBytes int) (string, error) {
+	randomBytes := make([]byte, numBytes)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
@@ -6,6 +6,7 @@
 package uaa
 
 import (
+	"crypto/tls"
 	"fmt"
 	"runtime"
 
@@ -21,8 +22,10 @@ type Client struct {
 	userAgent  string
 }
 
-// NewClient returns a new UAA Client with the provided configuration
-func NewClient(config Config) *Client {
+// NewClient returns a new UAA Client with the provided configuration.
+// tlsConfig is the base TLS configuration for the client's connection, such as
+// additional trusted CA certificates or a client certificate, and may be nil.
+func NewClient(config Config, tlsConfig *tls.Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
 		config.BinaryName(),
 		config.BinaryVersion(),
@@ -34,7 +37,7 @@ func NewClient(config Config) *Client {
 	client := Client{
 		config: config,
 
-		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
+		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout(), tlsConfig),
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
@@ -4,6 +4,10 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for browser based logins, where the
+	// user authenticates with UAA and the CLI exchanges the returned code for
+	// tokens.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
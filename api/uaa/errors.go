@@ -1,6 +1,9 @@
 package uaa
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // RawHTTPStatusError represents any response with a 4xx or 5xx status code.
 type RawHTTPStatusError struct {
@@ -88,3 +91,28 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// AuthorizationCallbackError is returned when the browser is redirected back
+// to the CLI without an authorization code, either because UAA reported an
+// error or because the callback did not match the login attempt.
+type AuthorizationCallbackError struct {
+	Type        string
+	Description string
+}
+
+func (e AuthorizationCallbackError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("Authorization failed: %s", e.Type)
+	}
+	return fmt.Sprintf("Authorization failed: %s: %s", e.Type, e.Description)
+}
+
+// BrowserLoginTimeoutError is returned when the browser login is not
+// completed within the timeout.
+type BrowserLoginTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e BrowserLoginTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for the browser login to complete.", e.Timeout)
+}
@@ -20,7 +20,7 @@ var _ = Describe("SetupResources", func() {
 
 	BeforeEach(func() {
 		fakeConfig = NewTestConfig()
-		client = NewClient(fakeConfig)
+		client = NewClient(fakeConfig, nil)
 	})
 
 	JustBeforeEach(func() {
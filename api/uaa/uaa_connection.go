@@ -17,8 +17,15 @@ type UAAConnection struct {
 	HTTPClient *http.Client
 }
 
-// NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration) *UAAConnection {
+// NewConnection returns a pointer to a new UAA Connection. tlsConfig is the
+// base TLS configuration for the connection and may be nil.
+func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration, tlsConfig *tls.Config) *UAAConnection {
+	connectionTLSConfig := &tls.Config{}
+	if tlsConfig != nil {
+		connectionTLSConfig = tlsConfig.Clone()
+	}
+	connectionTLSConfig.InsecureSkipVerify = skipSSLValidation
+
 	tr := &http.Transport{
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
@@ -26,9 +33,7 @@ func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout t
 		}).DialContext,
 		DisableKeepAlives: disableKeepAlives,
 		Proxy:             http.ProxyFromEnvironment,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: skipSSLValidation,
-		},
+		TLSClientConfig:   connectionTLSConfig,
 	}
 
 	return &UAAConnection{
@@ -69,7 +69,7 @@ func NewTestConfig() *uaafakes.FakeConfig {
 func NewTestUAAClientAndStore(config Config) *Client {
 	SetupBootstrapResponse()
 
-	client := NewClient(config)
+	client := NewClient(config, nil)
 
 	// the 'uaaServer' is discovered via the bootstrapping when we hit the /login
 	// endpoint on 'server'
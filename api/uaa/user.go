@@ -3,14 +3,18 @@ package uaa
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID       string
+	Username string
+	Origin   string
 }
 
 // newUserRequestBody represents the body of the request.
@@ -83,3 +87,53 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// listUsersResponse represents the HTTP JSON response of a user search.
+type listUsersResponse struct {
+	Resources []struct {
+		ID       string `json:"id"`
+		Username string `json:"userName"`
+		Origin   string `json:"origin"`
+	} `json:"resources"`
+}
+
+// ListUsers returns the UAA user accounts with the provided username. When
+// origin is not empty, only accounts from that identity provider are
+// returned.
+func (client *Client) ListUsers(userName string, origin string) ([]User, error) {
+	filter := fmt.Sprintf("userName eq %q", userName)
+	if origin != "" {
+		filter = fmt.Sprintf("%s and origin eq %q", filter, origin)
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query: url.Values{
+			"filter": {filter},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usersResponse listUsersResponse
+	response := Response{
+		Result: &usersResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]User, 0, len(usersResponse.Resources))
+	for _, user := range usersResponse.Resources {
+		users = append(users, User{
+			ID:       user.ID,
+			Username: user.Username,
+			Origin:   user.Origin,
+		})
+	}
+
+	return users, nil
+}
@@ -100,4 +100,88 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("ListUsers", func() {
+		var (
+			users []User
+			err   error
+		)
+
+		Context("when an origin is provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{"id": "some-user-id", "userName": "some-user", "origin": "ldap"}
+					],
+					"totalResults": 1
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", `filter=userName+eq+%22some-user%22+and+origin+eq+%22ldap%22`),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the users with that username and origin", func() {
+				users, err = client.ListUsers("some-user", "ldap")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(Equal([]User{
+					{ID: "some-user-id", Username: "some-user", Origin: "ldap"},
+				}))
+			})
+		})
+
+		Context("when no origin is provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{"id": "user-id-1", "userName": "some-user", "origin": "uaa"},
+						{"id": "user-id-2", "userName": "some-user", "origin": "ldap"}
+					],
+					"totalResults": 2
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", `filter=userName+eq+%22some-user%22`),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the users from every origin", func() {
+				users, err = client.ListUsers("some-user", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(Equal([]User{
+					{ID: "user-id-1", Username: "some-user", Origin: "uaa"},
+					{ID: "user-id-2", Username: "some-user", Origin: "ldap"},
+				}))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err = client.ListUsers("some-user", "")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
 })
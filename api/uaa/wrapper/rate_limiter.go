@@ -0,0 +1,34 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/throttle"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// RateLimiter is a wrapper that waits for the limiter before making each
+// request.
+type RateLimiter struct {
+	limiter    *throttle.Limiter
+	connection uaa.Connection
+}
+
+// NewRateLimiter returns a pointer to a RateLimiter wrapper.
+func NewRateLimiter(limiter *throttle.Limiter) *RateLimiter {
+	return &RateLimiter{
+		limiter: limiter,
+	}
+}
+
+// Make waits for the limiter and then makes the request.
+func (rateLimiter *RateLimiter) Make(request *http.Request, passedResponse *uaa.Response) error {
+	rateLimiter.limiter.Wait()
+	return rateLimiter.connection.Make(request, passedResponse)
+}
+
+// Wrap sets the connection in the RateLimiter and returns itself.
+func (rateLimiter *RateLimiter) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	rateLimiter.connection = innerconnection
+	return rateLimiter
+}
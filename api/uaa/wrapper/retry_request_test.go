@@ -4,7 +4,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/api/throttle"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
@@ -40,7 +42,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequestWithBackoff(2, throttle.Backoff{}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -51,6 +53,9 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
@@ -75,4 +80,47 @@ var _ = Describe("Retry Request", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Context("when the response has a Retry-After header", func() {
+		var (
+			response       *uaa.Response
+			fakeConnection *uaafakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			response = &uaa.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+				},
+			}
+
+			fakeConnection = new(uaafakes.FakeConnection)
+			fakeConnection.MakeReturns(uaa.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable})
+		})
+
+		It("retries a POST after waiting for the requested delay", func() {
+			response.HTTPResponse.Header.Set("Retry-After", "1")
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			wrapper := NewRetryRequestWithBackoff(1, throttle.NewBackoff(0, 5*time.Second)).Wrap(fakeConnection)
+			start := time.Now()
+			err = wrapper.Make(request, response)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		})
+
+		It("does not retry when the requested delay is longer than the max delay", func() {
+			response.HTTPResponse.Header.Set("Retry-After", "60")
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			wrapper := NewRetryRequestWithBackoff(2, throttle.NewBackoff(0, 5*time.Second)).Wrap(fakeConnection)
+			err = wrapper.Make(request, response)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
 })
@@ -99,7 +99,8 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.domainRepo = NewCloudControllerDomainRepository(config, cloudControllerGateway)
 	loc.endpointRepo = NewEndpointRepository(cloudControllerGateway)
 
-	tlsConfig := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled())
+	// Errors loading the certificate files are reported by the gateways.
+	tlsConfig, _ := net.NewTLSConfigWithCertificateFiles([]tls.Certificate{}, config.IsSSLDisabled(), config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
 
 	var noaaRetryTimeout time.Duration
 	convertedTime, err := strconv.Atoi(envDialTimeout)
@@ -33,12 +33,13 @@ func (cmd *Scale) MetaData() commandregistry.CommandMetadata {
 	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
 	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force restart of app without prompt")}
+	fs["skip-quota-check"] = &flags.BoolFlag{Name: "skip-quota-check", Usage: T("Do not check that the org and space quotas have room for the new instances or memory before scaling")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "scale",
 		Description: T("Change or view the instance count, disk space limit, and memory limit for an app"),
 		Usage: []string{
-			T("CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"),
+			T("CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f] [--skip-quota-check]"),
 		},
 		Flags: fs,
 	}
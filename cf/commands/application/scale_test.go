@@ -180,6 +180,14 @@ var _ = Describe("scale command", func() {
 				Expect(params.Memory).To(BeNil())
 			})
 
+			It("accepts --skip-quota-check, which is handled before the legacy command runs", func() {
+				testcmd.RunCLICommand("scale", []string{"-i", "5", "--skip-quota-check", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				appGUID, params := appRepo.UpdateArgsForCall(0)
+				Expect(appGUID).To(Equal("my-app-guid"))
+				Expect(*params.InstanceCount).To(Equal(5))
+			})
+
 			It("does not scale the app's instance count if it is not specified", func() {
 				testcmd.RunCLICommand("scale", []string{"-m", "512M", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
 
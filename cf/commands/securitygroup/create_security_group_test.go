@@ -132,6 +132,63 @@ var _ = Describe("create-security-group command", func() {
 			})
 		})
 
+		Context("when the file specified has icmp rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"icmp","destination":"0.0.0.0/0","type":0,"code":-1}]`))
+			})
+
+			It("creates the security group with those rules", func() {
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(1))
+				_, rules := securityGroupRepo.CreateArgsForCall(0)
+				Expect(rules).To(Equal([]map[string]interface{}{
+					{"protocol": "icmp", "destination": "0.0.0.0/0", "type": float64(0), "code": float64(-1)},
+				}))
+			})
+		})
+
+		Context("when the file specified has icmp rules without a code", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"icmp","destination":"10.0.0.0/8","type":0}]`))
+			})
+
+			It("fails without creating the security group", func() {
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Invalid security group rules:"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file specified has invalid rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"tcp","ports":"80","destination":"10.0.0.300"}]`))
+			})
+
+			It("fails without creating the security group", func() {
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Invalid security group rules:"},
+					[]string{"rule 1:", "10.0.0.300", "is not a valid IPv4 address"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file specified has overlapping rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"tcp","ports":"80,443","destination":"10.0.0.0/8"},{"protocol":"tcp","ports":443,"destination":"10.0.0.1"}]`))
+			})
+
+			It("warns and creates the security group", func() {
+				Expect(ui.WarnOutputs).To(ContainSubstrings(
+					[]string{"rule 2: overlaps with rule 1"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when the file specified has invalid json", func() {
 			BeforeEach(func() {
 				tempFile.Write([]byte(`[{noquote: thiswontwork}]`))
@@ -0,0 +1,66 @@
+package securitygroup
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	rules "code.cloudfoundry.org/cli/util/securitygroups"
+)
+
+// validateRules checks the provided rules before they are sent to the
+// Cloud Controller. Problems that would allow more traffic than intended are
+// displayed as warnings; invalid rules are returned as an error.
+func validateRules(ui terminal.UI, rawRules []map[string]interface{}) error {
+	var invalidRules []string
+	for _, problem := range rules.ValidateRules(convertRules(rawRules)) {
+		if problem.IsError {
+			invalidRules = append(invalidRules, problem.String())
+			continue
+		}
+		ui.Warn(T("Warning: {{.Problem}}", map[string]interface{}{"Problem": problem.String()}))
+	}
+
+	if len(invalidRules) > 0 {
+		return errors.New(T("Invalid security group rules:\n{{.Problems}}", map[string]interface{}{
+			"Problems": strings.Join(invalidRules, "\n"),
+		}))
+	}
+	return nil
+}
+
+func convertRules(rawRules []map[string]interface{}) []rules.Rule {
+	convertedRules := make([]rules.Rule, len(rawRules))
+	for i, rawRule := range rawRules {
+		convertedRules[i] = rules.Rule{
+			Protocol:    stringValue(rawRule["protocol"]),
+			Destination: stringValue(rawRule["destination"]),
+			Ports:       stringValue(rawRule["ports"]),
+			Type:        intValue(rawRule["type"]),
+			Code:        intValue(rawRule["code"]),
+			Description: stringValue(rawRule["description"]),
+		}
+	}
+	return convertedRules
+}
+
+func stringValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// intValue returns the JSON number as an int, or nil when the value is missing
+// or not a whole number.
+func intValue(value interface{}) *int {
+	number, ok := value.(float64)
+	if !ok || number != float64(int(number)) {
+		return nil
+	}
+
+	converted := int(number)
+	return &converted
+}
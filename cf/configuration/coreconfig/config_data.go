@@ -22,7 +22,11 @@ type Data struct {
 	AccessToken              string
 	APIVersion               string
 	AsyncTimeout             uint
+	RequestRateLimit         float64
 	AuthorizationEndpoint    string
+	CACertFile               string
+	ClientCertFile           string
+	ClientKeyFile            string
 	ColorEnabled             string
 	ConfigVersion            int
 	DopplerEndPoint          string
@@ -15,6 +15,9 @@ var _ = Describe("V3 Config files", func() {
 		"Target": "api.example.com",
 		"APIVersion": "3",
 		"AuthorizationEndpoint": "auth.example.com",
+		"CACertFile": "path/to/ca.pem",
+		"ClientCertFile": "path/to/client.pem",
+		"ClientKeyFile": "path/to/client.key",
 		"DopplerEndPoint": "doppler.example.com",
 		"UaaEndpoint": "uaa.example.com",
 		"RoutingAPIEndpoint": "routing-api.example.com",
@@ -44,6 +47,7 @@ var _ = Describe("V3 Config files", func() {
 		},
 		"SSLDisabled": true,
 		"AsyncTimeout": 1000,
+		"RequestRateLimit": 5,
 		"Trace": "path/to/some/file",
 		"ColorEnabled": "true",
 		"Locale": "fr_FR",
@@ -119,6 +123,9 @@ var _ = Describe("V3 Config files", func() {
 				Target:                   "api.example.com",
 				APIVersion:               "3",
 				AuthorizationEndpoint:    "auth.example.com",
+				CACertFile:               "path/to/ca.pem",
+				ClientCertFile:           "path/to/client.pem",
+				ClientKeyFile:            "path/to/client.key",
 				RoutingAPIEndpoint:       "routing-api.example.com",
 				DopplerEndPoint:          "doppler.example.com",
 				UaaEndpoint:              "uaa.example.com",
@@ -138,11 +145,12 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:      true,
+				Trace:            "path/to/some/file",
+				AsyncTimeout:     1000,
+				RequestRateLimit: 5,
+				ColorEnabled:     "true",
+				Locale:           "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
@@ -171,6 +179,9 @@ var _ = Describe("V3 Config files", func() {
 				Target:                   "api.example.com",
 				APIVersion:               "3",
 				AuthorizationEndpoint:    "auth.example.com",
+				CACertFile:               "path/to/ca.pem",
+				ClientCertFile:           "path/to/client.pem",
+				ClientKeyFile:            "path/to/client.key",
 				RoutingAPIEndpoint:       "routing-api.example.com",
 				DopplerEndPoint:          "doppler.example.com",
 				UaaEndpoint:              "uaa.example.com",
@@ -189,11 +200,12 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:      true,
+				Trace:            "path/to/some/file",
+				AsyncTimeout:     1000,
+				RequestRateLimit: 5,
+				ColorEnabled:     "true",
+				Locale:           "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
@@ -1,6 +1,7 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
@@ -81,6 +82,9 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -185,6 +189,42 @@ func (c *ConfigRepository) APIVersion() (apiVersion string) {
 	return
 }
 
+// CACertFile returns the CA bundle to trust in addition to the system's. The
+// CF_CA_CERT_FILE environment variable takes precedence over the config.
+func (c *ConfigRepository) CACertFile() (caCertFile string) {
+	if envCACertFile := os.Getenv("CF_CA_CERT_FILE"); envCACertFile != "" {
+		return envCACertFile
+	}
+	c.read(func() {
+		caCertFile = c.data.CACertFile
+	})
+	return
+}
+
+// ClientCertFile returns the client certificate to present to servers. The
+// CF_CLIENT_CERT_FILE environment variable takes precedence over the config.
+func (c *ConfigRepository) ClientCertFile() (clientCertFile string) {
+	if envClientCertFile := os.Getenv("CF_CLIENT_CERT_FILE"); envClientCertFile != "" {
+		return envClientCertFile
+	}
+	c.read(func() {
+		clientCertFile = c.data.ClientCertFile
+	})
+	return
+}
+
+// ClientKeyFile returns the key of the client certificate. The
+// CF_CLIENT_KEY_FILE environment variable takes precedence over the config.
+func (c *ConfigRepository) ClientKeyFile() (clientKeyFile string) {
+	if envClientKeyFile := os.Getenv("CF_CLIENT_KEY_FILE"); envClientKeyFile != "" {
+		return envClientKeyFile
+	}
+	c.read(func() {
+		clientKeyFile = c.data.ClientKeyFile
+	})
+	return
+}
+
 func (c *ConfigRepository) AuthenticationEndpoint() (authEndpoint string) {
 	c.read(func() {
 		authEndpoint = c.data.AuthorizationEndpoint
@@ -127,6 +127,45 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("TLS certificate files", func() {
+		BeforeEach(func() {
+			persistor.LoadStub = func(data configuration.DataInterface) error {
+				coreData := data.(*coreconfig.Data)
+				coreData.CACertFile = "config-ca.pem"
+				coreData.ClientCertFile = "config-client.pem"
+				coreData.ClientKeyFile = "config-client.key"
+				return nil
+			}
+			config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+		})
+
+		It("returns the files from the config", func() {
+			Expect(config.CACertFile()).To(Equal("config-ca.pem"))
+			Expect(config.ClientCertFile()).To(Equal("config-client.pem"))
+			Expect(config.ClientKeyFile()).To(Equal("config-client.key"))
+		})
+
+		Context("when the environment variables are set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CA_CERT_FILE", "env-ca.pem")).To(Succeed())
+				Expect(os.Setenv("CF_CLIENT_CERT_FILE", "env-client.pem")).To(Succeed())
+				Expect(os.Setenv("CF_CLIENT_KEY_FILE", "env-client.key")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CA_CERT_FILE")).To(Succeed())
+				Expect(os.Unsetenv("CF_CLIENT_CERT_FILE")).To(Succeed())
+				Expect(os.Unsetenv("CF_CLIENT_KEY_FILE")).To(Succeed())
+			})
+
+			It("prefers the environment variables", func() {
+				Expect(config.CACertFile()).To(Equal("env-ca.pem"))
+				Expect(config.ClientCertFile()).To(Equal("env-client.pem"))
+				Expect(config.ClientKeyFile()).To(Equal("env-client.key"))
+			})
+		})
+	})
+
 	Describe("HasAPIEndpoint", func() {
 		Context("when both endpoint and version are set", func() {
 			BeforeEach(func() {
@@ -199,6 +199,33 @@ type FakeRepository struct {
 	isSSLDisabledReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	clientCertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
+	clientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	IsMinAPIVersionStub        func(semver.Version) bool
 	isMinAPIVersionMutex       sync.RWMutex
 	isMinAPIVersionArgsForCall []struct {
@@ -1272,6 +1299,126 @@ func (fake *FakeRepository) IsSSLDisabledReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeRepository) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeRepository) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeRepository) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	ret, specificReturn := fake.clientCertFileReturnsOnCall[len(fake.clientCertFileArgsForCall)]
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientCertFileReturns.result1
+}
+
+func (fake *FakeRepository) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeRepository) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) ClientCertFileReturnsOnCall(i int, result1 string) {
+	fake.ClientCertFileStub = nil
+	if fake.clientCertFileReturnsOnCall == nil {
+		fake.clientCertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientCertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	ret, specificReturn := fake.clientKeyFileReturnsOnCall[len(fake.clientKeyFileArgsForCall)]
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientKeyFileReturns.result1
+}
+
+func (fake *FakeRepository) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeRepository) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) ClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.ClientKeyFileStub = nil
+	if fake.clientKeyFileReturnsOnCall == nil {
+		fake.clientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeRepository) IsMinAPIVersion(arg1 semver.Version) bool {
 	fake.isMinAPIVersionMutex.Lock()
 	ret, specificReturn := fake.isMinAPIVersionReturnsOnCall[len(fake.isMinAPIVersionArgsForCall)]
@@ -2381,6 +2528,12 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.isLoggedInMutex.RUnlock()
 	fake.isSSLDisabledMutex.RLock()
 	defer fake.isSSLDisabledMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	fake.isMinAPIVersionMutex.RLock()
 	defer fake.isMinAPIVersionMutex.RUnlock()
 	fake.isMinCLIVersionMutex.RLock()
@@ -63,6 +63,7 @@ type Gateway struct {
 	PollingEnabled  bool
 	PollingThrottle time.Duration
 	trustedCerts    []tls.Certificate
+	transportErr    error
 	config          coreconfig.Reader
 	warnings        *[]string
 	Clock           func() time.Time
@@ -414,6 +415,9 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	if gateway.transport == nil {
 		makeHTTPTransport(&gateway)
 	}
+	if gateway.transportErr != nil {
+		return nil, gateway.transportErr
+	}
 
 	httpClient := NewHTTPClient(gateway.transport, NewRequestDumper(gateway.logger))
 
@@ -447,13 +451,22 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 }
 
 func makeHTTPTransport(gateway *Gateway) {
+	var tlsConfig *tls.Config
+	tlsConfig, gateway.transportErr = NewTLSConfigWithCertificateFiles(
+		gateway.trustedCerts,
+		gateway.config.IsSSLDisabled(),
+		gateway.config.CACertFile(),
+		gateway.config.ClientCertFile(),
+		gateway.config.ClientKeyFile(),
+	)
+
 	gateway.transport = &http.Transport{
 		DisableKeepAlives: true,
 		Dial: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   gateway.DialTimeout,
 		}).Dial,
-		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 	}
 }
@@ -3,6 +3,7 @@ package net_test
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -595,6 +596,40 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 
+		Context("when a CA certificate file is configured", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				file, err := ioutil.TempFile("", "gateway-ca")
+				Expect(err).NotTo(HaveOccurred())
+				caCertFile = file.Name()
+				Expect(pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: apiServer.Certificate().Raw})).To(Succeed())
+				Expect(file.Close()).To(Succeed())
+
+				Expect(os.Setenv("CF_CA_CERT_FILE", caCertFile)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CA_CERT_FILE")).To(Succeed())
+				Expect(os.RemoveAll(caCertFile)).To(Succeed())
+			})
+
+			It("trusts the server's certificate", func() {
+				_, apiErr := ccGateway.PerformRequest(request)
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+
+			Context("when the file cannot be read", func() {
+				BeforeEach(func() {
+					Expect(os.RemoveAll(caCertFile)).To(Succeed())
+				})
+
+				It("returns an error", func() {
+					_, apiErr := ccGateway.PerformRequest(request)
+					Expect(apiErr).To(MatchError(ContainSubstring("unable to load CA certificate file " + caCertFile)))
+				})
+			})
+		})
 	})
 
 	Describe("collecting warnings", func() {
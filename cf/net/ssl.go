@@ -3,6 +3,8 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
+
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
@@ -23,3 +25,26 @@ func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *t
 
 	return
 }
+
+// NewTLSConfigWithCertificateFiles returns the TLS configuration built by
+// NewTLSConfig, additionally trusting the certificates in caCertFile and
+// presenting the client certificate in clientCertFile and clientKeyFile.
+func NewTLSConfigWithCertificateFiles(trustedCerts []tls.Certificate, disableSSL bool, caCertFile string, clientCertFile string, clientKeyFile string) (*tls.Config, error) {
+	TLSConfig := NewTLSConfig(trustedCerts, disableSSL)
+
+	userConfig, err := tlsconfig.New(caCertFile, clientCertFile, clientKeyFile)
+	if err != nil || userConfig == nil {
+		return TLSConfig, err
+	}
+
+	if userConfig.RootCAs != nil {
+		for _, tlsCert := range trustedCerts {
+			cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
+			userConfig.RootCAs.AddCert(cert)
+		}
+		TLSConfig.RootCAs = userConfig.RootCAs
+	}
+	TLSConfig.Certificates = userConfig.Certificates
+
+	return TLSConfig, nil
+}
@@ -57,6 +57,24 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	BlueGreenStateDirStub        func() string
+	blueGreenStateDirMutex       sync.RWMutex
+	blueGreenStateDirArgsForCall []struct{}
+	blueGreenStateDirReturns     struct {
+		result1 string
+	}
+	blueGreenStateDirReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct{}
@@ -75,6 +93,24 @@ type FakeConfig struct {
 	cFUsernameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	clientCertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
+	clientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct{}
@@ -166,6 +202,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -202,6 +247,15 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PaginationConcurrencyStub        func() int
+	paginationConcurrencyMutex       sync.RWMutex
+	paginationConcurrencyArgsForCall []struct{}
+	paginationConcurrencyReturns     struct {
+		result1 int
+	}
+	paginationConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct{}
@@ -252,6 +306,15 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RequestRateLimitStub        func() float64
+	requestRateLimitMutex       sync.RWMutex
+	requestRateLimitArgsForCall []struct{}
+	requestRateLimitReturns     struct {
+		result1 float64
+	}
+	requestRateLimitReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct{}
@@ -261,6 +324,33 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ResourceCacheDirStub        func() string
+	resourceCacheDirMutex       sync.RWMutex
+	resourceCacheDirArgsForCall []struct{}
+	resourceCacheDirReturns     struct {
+		result1 string
+	}
+	resourceCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ResponseCacheDirStub        func() string
+	responseCacheDirMutex       sync.RWMutex
+	responseCacheDirArgsForCall []struct{}
+	responseCacheDirReturns     struct {
+		result1 string
+	}
+	responseCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ResponseCacheTTLStub        func() time.Duration
+	responseCacheTTLMutex       sync.RWMutex
+	responseCacheTTLArgsForCall []struct{}
+	responseCacheTTLReturns     struct {
+		result1 time.Duration
+	}
+	responseCacheTTLReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -295,6 +385,13 @@ type FakeConfig struct {
 		routing           string
 		skipSSLValidation bool
 	}
+	SetTLSCertificateFilesStub        func(caCertFile string, clientCertFile string, clientKeyFile string)
+	setTLSCertificateFilesMutex       sync.RWMutex
+	setTLSCertificateFilesArgsForCall []struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}
 	SetTokenInformationStub        func(accessToken string, refreshToken string, sshOAuthClient string)
 	setTokenInformationMutex       sync.RWMutex
 	setTokenInformationArgsForCall []struct {
@@ -381,6 +478,19 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TLSCertificateFilesStub        func() (string, string, string)
+	tLSCertificateFilesMutex       sync.RWMutex
+	tLSCertificateFilesArgsForCall []struct{}
+	tLSCertificateFilesReturns     struct {
+		result1 string
+		result2 string
+		result3 string
+	}
+	tLSCertificateFilesReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 string
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct{}
@@ -659,6 +769,86 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) BlueGreenStateDir() string {
+	fake.blueGreenStateDirMutex.Lock()
+	ret, specificReturn := fake.blueGreenStateDirReturnsOnCall[len(fake.blueGreenStateDirArgsForCall)]
+	fake.blueGreenStateDirArgsForCall = append(fake.blueGreenStateDirArgsForCall, struct{}{})
+	fake.recordInvocation("BlueGreenStateDir", []interface{}{})
+	fake.blueGreenStateDirMutex.Unlock()
+	if fake.BlueGreenStateDirStub != nil {
+		return fake.BlueGreenStateDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.blueGreenStateDirReturns.result1
+}
+
+func (fake *FakeConfig) BlueGreenStateDirCallCount() int {
+	fake.blueGreenStateDirMutex.RLock()
+	defer fake.blueGreenStateDirMutex.RUnlock()
+	return len(fake.blueGreenStateDirArgsForCall)
+}
+
+func (fake *FakeConfig) BlueGreenStateDirReturns(result1 string) {
+	fake.BlueGreenStateDirStub = nil
+	fake.blueGreenStateDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) BlueGreenStateDirReturnsOnCall(i int, result1 string) {
+	fake.BlueGreenStateDirStub = nil
+	if fake.blueGreenStateDirReturnsOnCall == nil {
+		fake.blueGreenStateDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.blueGreenStateDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeConfig) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -739,6 +929,86 @@ func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	ret, specificReturn := fake.clientCertFileReturnsOnCall[len(fake.clientCertFileArgsForCall)]
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientCertFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertFileReturnsOnCall(i int, result1 string) {
+	fake.ClientCertFileStub = nil
+	if fake.clientCertFileReturnsOnCall == nil {
+		fake.clientCertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientCertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	ret, specificReturn := fake.clientKeyFileReturnsOnCall[len(fake.clientKeyFileArgsForCall)]
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientKeyFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.ClientKeyFileStub = nil
+	if fake.clientKeyFileReturnsOnCall == nil {
+		fake.clientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -1124,6 +1394,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -1284,6 +1594,46 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PaginationConcurrency() int {
+	fake.paginationConcurrencyMutex.Lock()
+	ret, specificReturn := fake.paginationConcurrencyReturnsOnCall[len(fake.paginationConcurrencyArgsForCall)]
+	fake.paginationConcurrencyArgsForCall = append(fake.paginationConcurrencyArgsForCall, struct{}{})
+	fake.recordInvocation("PaginationConcurrency", []interface{}{})
+	fake.paginationConcurrencyMutex.Unlock()
+	if fake.PaginationConcurrencyStub != nil {
+		return fake.PaginationConcurrencyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.paginationConcurrencyReturns.result1
+}
+
+func (fake *FakeConfig) PaginationConcurrencyCallCount() int {
+	fake.paginationConcurrencyMutex.RLock()
+	defer fake.paginationConcurrencyMutex.RUnlock()
+	return len(fake.paginationConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturns(result1 int) {
+	fake.PaginationConcurrencyStub = nil
+	fake.paginationConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.PaginationConcurrencyStub = nil
+	if fake.paginationConcurrencyReturnsOnCall == nil {
+		fake.paginationConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.paginationConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -1508,6 +1858,46 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RequestRateLimit() float64 {
+	fake.requestRateLimitMutex.Lock()
+	ret, specificReturn := fake.requestRateLimitReturnsOnCall[len(fake.requestRateLimitArgsForCall)]
+	fake.requestRateLimitArgsForCall = append(fake.requestRateLimitArgsForCall, struct{}{})
+	fake.recordInvocation("RequestRateLimit", []interface{}{})
+	fake.requestRateLimitMutex.Unlock()
+	if fake.RequestRateLimitStub != nil {
+		return fake.RequestRateLimitStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestRateLimitReturns.result1
+}
+
+func (fake *FakeConfig) RequestRateLimitCallCount() int {
+	fake.requestRateLimitMutex.RLock()
+	defer fake.requestRateLimitMutex.RUnlock()
+	return len(fake.requestRateLimitArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRateLimitReturns(result1 float64) {
+	fake.RequestRateLimitStub = nil
+	fake.requestRateLimitReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRateLimitReturnsOnCall(i int, result1 float64) {
+	fake.RequestRateLimitStub = nil
+	if fake.requestRateLimitReturnsOnCall == nil {
+		fake.requestRateLimitReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.requestRateLimitReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1548,6 +1938,126 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheDir() string {
+	fake.resourceCacheDirMutex.Lock()
+	ret, specificReturn := fake.resourceCacheDirReturnsOnCall[len(fake.resourceCacheDirArgsForCall)]
+	fake.resourceCacheDirArgsForCall = append(fake.resourceCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheDir", []interface{}{})
+	fake.resourceCacheDirMutex.Unlock()
+	if fake.ResourceCacheDirStub != nil {
+		return fake.ResourceCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheDirCallCount() int {
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
+	return len(fake.resourceCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturns(result1 string) {
+	fake.ResourceCacheDirStub = nil
+	fake.resourceCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheDirStub = nil
+	if fake.resourceCacheDirReturnsOnCall == nil {
+		fake.resourceCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDir() string {
+	fake.responseCacheDirMutex.Lock()
+	ret, specificReturn := fake.responseCacheDirReturnsOnCall[len(fake.responseCacheDirArgsForCall)]
+	fake.responseCacheDirArgsForCall = append(fake.responseCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheDir", []interface{}{})
+	fake.responseCacheDirMutex.Unlock()
+	if fake.ResponseCacheDirStub != nil {
+		return fake.ResponseCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheDirCallCount() int {
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
+	return len(fake.responseCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturns(result1 string) {
+	fake.ResponseCacheDirStub = nil
+	fake.responseCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResponseCacheDirStub = nil
+	if fake.responseCacheDirReturnsOnCall == nil {
+		fake.responseCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.responseCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheTTL() time.Duration {
+	fake.responseCacheTTLMutex.Lock()
+	ret, specificReturn := fake.responseCacheTTLReturnsOnCall[len(fake.responseCacheTTLArgsForCall)]
+	fake.responseCacheTTLArgsForCall = append(fake.responseCacheTTLArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheTTL", []interface{}{})
+	fake.responseCacheTTLMutex.Unlock()
+	if fake.ResponseCacheTTLStub != nil {
+		return fake.ResponseCacheTTLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheTTLReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheTTLCallCount() int {
+	fake.responseCacheTTLMutex.RLock()
+	defer fake.responseCacheTTLMutex.RUnlock()
+	return len(fake.responseCacheTTLArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheTTLReturns(result1 time.Duration) {
+	fake.ResponseCacheTTLStub = nil
+	fake.responseCacheTTLReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheTTLReturnsOnCall(i int, result1 time.Duration) {
+	fake.ResponseCacheTTLStub = nil
+	if fake.responseCacheTTLReturnsOnCall == nil {
+		fake.responseCacheTTLReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.responseCacheTTLReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1677,6 +2187,32 @@ func (fake *FakeConfig) SetTargetInformationArgsForCall(i int) (string, string,
 	return fake.setTargetInformationArgsForCall[i].api, fake.setTargetInformationArgsForCall[i].apiVersion, fake.setTargetInformationArgsForCall[i].auth, fake.setTargetInformationArgsForCall[i].minCLIVersion, fake.setTargetInformationArgsForCall[i].doppler, fake.setTargetInformationArgsForCall[i].routing, fake.setTargetInformationArgsForCall[i].skipSSLValidation
 }
 
+func (fake *FakeConfig) SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) {
+	fake.setTLSCertificateFilesMutex.Lock()
+	fake.setTLSCertificateFilesArgsForCall = append(fake.setTLSCertificateFilesArgsForCall, struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}{caCertFile, clientCertFile, clientKeyFile})
+	fake.recordInvocation("SetTLSCertificateFiles", []interface{}{caCertFile, clientCertFile, clientKeyFile})
+	fake.setTLSCertificateFilesMutex.Unlock()
+	if fake.SetTLSCertificateFilesStub != nil {
+		fake.SetTLSCertificateFilesStub(caCertFile, clientCertFile, clientKeyFile)
+	}
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesCallCount() int {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return len(fake.setTLSCertificateFilesArgsForCall)
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesArgsForCall(i int) (string, string, string) {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return fake.setTLSCertificateFilesArgsForCall[i].caCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientKeyFile
+}
+
 func (fake *FakeConfig) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
 	fake.setTokenInformationMutex.Lock()
 	fake.setTokenInformationArgsForCall = append(fake.setTokenInformationArgsForCall, struct {
@@ -2056,6 +2592,52 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TLSCertificateFiles() (string, string, string) {
+	fake.tLSCertificateFilesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificateFilesReturnsOnCall[len(fake.tLSCertificateFilesArgsForCall)]
+	fake.tLSCertificateFilesArgsForCall = append(fake.tLSCertificateFilesArgsForCall, struct{}{})
+	fake.recordInvocation("TLSCertificateFiles", []interface{}{})
+	fake.tLSCertificateFilesMutex.Unlock()
+	if fake.TLSCertificateFilesStub != nil {
+		return fake.TLSCertificateFilesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.tLSCertificateFilesReturns.result1, fake.tLSCertificateFilesReturns.result2, fake.tLSCertificateFilesReturns.result3
+}
+
+func (fake *FakeConfig) TLSCertificateFilesCallCount() int {
+	fake.tLSCertificateFilesMutex.RLock()
+	defer fake.tLSCertificateFilesMutex.RUnlock()
+	return len(fake.tLSCertificateFilesArgsForCall)
+}
+
+func (fake *FakeConfig) TLSCertificateFilesReturns(result1 string, result2 string, result3 string) {
+	fake.TLSCertificateFilesStub = nil
+	fake.tLSCertificateFilesReturns = struct {
+		result1 string
+		result2 string
+		result3 string
+	}{result1, result2, result3}
+}
+
+func (fake *FakeConfig) TLSCertificateFilesReturnsOnCall(i int, result1 string, result2 string, result3 string) {
+	fake.TLSCertificateFilesStub = nil
+	if fake.tLSCertificateFilesReturnsOnCall == nil {
+		fake.tLSCertificateFilesReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 string
+		})
+	}
+	fake.tLSCertificateFilesReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 string
+	}{result1, result2, result3}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -2362,10 +2944,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.blueGreenStateDirMutex.RLock()
+	defer fake.blueGreenStateDirMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.currentUserMutex.RLock()
@@ -2384,6 +2974,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
@@ -2392,6 +2984,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.paginationConcurrencyMutex.RLock()
+	defer fake.paginationConcurrencyMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -2404,8 +2998,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.requestRateLimitMutex.RLock()
+	defer fake.requestRateLimitMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.resourceCacheDirMutex.RLock()
+	defer fake.resourceCacheDirMutex.RUnlock()
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
+	fake.responseCacheTTLMutex.RLock()
+	defer fake.responseCacheTTLMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -2416,6 +3018,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setSpaceInformationMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
@@ -2438,6 +3042,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.tLSCertificateFilesMutex.RLock()
+	defer fake.tLSCertificateFilesMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
@@ -26,6 +26,7 @@ type commandList struct {
 	V3DeleteApp          v3.V3DeleteCommand             `command:"v3-delete" description:"Delete a V3 App"`
 	V3Droplets           v3.V3DropletsCommand           `command:"v3-droplets" description:"List droplets of an app"`
 	V3Env                v3.V3EnvCommand                `command:"v3-env" description:"Show all env variables for an app"`
+	V3EnvGroup           v3.V3EnvGroupCommand           `command:"v3-env-group" description:"Show the variables of the running or staging environment variable group"`
 	V3GetHealthCheck     v3.V3GetHealthCheckCommand     `command:"v3-get-health-check" description:"Show the type of health check performed on an app"`
 	V3Packages           v3.V3PackagesCommand           `command:"v3-packages" description:"List packages of an app"`
 	V3Push               v3.V3PushCommand               `command:"v3-push" description:"Push a new app or sync changes to an existing app"`
@@ -34,6 +35,7 @@ type commandList struct {
 	V3Scale              v3.V3ScaleCommand              `command:"v3-scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
 	V3SetDroplet         v3.V3SetDropletCommand         `command:"v3-set-droplet" description:"Set the droplet used to run an app"`
 	V3SetEnv             v3.V3SetEnvCommand             `command:"v3-set-env" description:"Set an env variable for an app"`
+	V3SetEnvGroup        v3.V3SetEnvGroupCommand        `command:"v3-set-env-group" description:"Set variables of the running or staging environment variable group"`
 	V3SetHealthCheck     v3.V3SetHealthCheckCommand     `command:"v3-set-health-check" description:"Change type of health check performed on an app's process"`
 	V3Stage              v3.V3StageCommand              `command:"v3-stage" description:"Create a new droplet for an app"`
 	V3Start              v3.V3StartCommand              `command:"v3-start" description:"Start an app"`
@@ -45,6 +47,8 @@ type commandList struct {
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyOrgConfig                     v2.ApplyOrgConfigCommand                     `command:"apply-org-config" description:"Create and update orgs, spaces, quotas, isolation segments and roles as described in a file"`
+	ApplySecurityGroups                v2.ApplySecurityGroupsCommand                `command:"apply-security-groups" description:"Create, update and bind security groups as described in a file"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -52,8 +56,12 @@ type commandList struct {
 	BindSecurityGroup                  v2.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
-	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	Buildpack                          v3.BuildpackCommand                          `command:"buildpack" description:"Show information about a buildpack"`
+	Buildpacks                         v3.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	ChangeStack                        v2.ChangeStackCommand                        `command:"change-stack" description:"Restage an app on a different stack, rolling back if it fails to stage or start"`
+	CheckEgress                        v2.CheckEgressCommand                        `command:"check-egress" description:"Check whether apps in the targeted space can reach a host and port"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	Cleanup                            v2.CleanupCommand                            `command:"cleanup" description:"Review and delete unused service instances, service keys, stopped apps, droplets and packages in the targeted space"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -108,6 +116,7 @@ type commandList struct {
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	IgnoredFiles                       v2.IgnoredFilesCommand                       `command:"ignored-files" description:"List the files of an app directory that push would upload or ignore"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
@@ -122,6 +131,7 @@ type commandList struct {
 	Orgs                               v2.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
+	PackageBuildpack                   v2.PackageBuildpackCommand                   `command:"package-buildpack" description:"Validate a buildpack directory and zip it for upload"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
@@ -143,6 +153,8 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then restart an app instance"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	RevokeAllRoles                     v3.RevokeAllRolesCommand                     `command:"revoke-all-roles" description:"Revoke all org and space roles of a user"`
+	RouteDiagnose                      v2.RouteDiagnoseCommand                      `command:"route-diagnose" description:"Report the apps, route service and router group of a route and probe it from this machine"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -181,6 +193,7 @@ type commandList struct {
 	SSH                                v2.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	Stacks                             v2.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	Stack                              v2.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StackAudit                         v2.StackAuditCommand                         `command:"stack-audit" description:"List the apps on each stack across all orgs"`
 	StagingEnvironmentVariableGroup    v2.StagingEnvironmentVariableGroupCommand    `command:"staging-environment-variable-group" alias:"sevg" description:"Retrieve the contents of the staging environment variable group"`
 	StagingSecurityGroups              v2.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups in the staging set for applications"`
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
@@ -201,7 +214,7 @@ type commandList struct {
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
 	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
-	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
+	UpdateBuildpack                    v3.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v2.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
@@ -209,6 +222,9 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UsageReport                        v2.UsageReportCommand                        `command:"usage-report" description:"Report the memory, disk and instances allocated to and used by apps across an org"`
+	User                               v3.UserCommand                               `command:"user" description:"Show all org and space roles of a user"`
+	Users                              v3.UsersCommand                              `command:"users" description:"List users and their org and space roles"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
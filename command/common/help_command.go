@@ -312,6 +312,9 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_REQUEST_RATE_LIMIT=10", cmd.UI.TranslateText("Max number of API requests per second")},
+		{"CF_RESOURCE_CACHE=false", cmd.UI.TranslateText("Do not cache file hashes and uploaded resources between pushes")},
+		{"CF_RESPONSE_CACHE_TTL=30", cmd.UI.TranslateText("Cache API responses of list commands for this many seconds")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
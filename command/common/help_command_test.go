@@ -600,6 +600,9 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_REQUEST_RATE_LIMIT=10           Max number of API requests per second"))
+				Expect(testUI.Out).To(Say("   CF_RESOURCE_CACHE=false            Do not cache file hashes and uploaded resources between pushes"))
+				Expect(testUI.Out).To(Say("   CF_RESPONSE_CACHE_TTL=30           Cache API responses of list commands for this many seconds"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
@@ -626,6 +629,8 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   v3-env\\s+Show all env variables for an app"))
 				Expect(testUI.Out).To(Say("   v3-set-env\\s+Set an env variable for an app"))
 				Expect(testUI.Out).To(Say("   v3-unset-env\\s+Remove an env variable from an app"))
+				Expect(testUI.Out).To(Say("   v3-env-group\\s+Show the variables of the running or staging environment variable group"))
+				Expect(testUI.Out).To(Say("   v3-set-env-group\\s+Set variables of the running or staging environment variable group"))
 				Expect(testUI.Out).To(Say("   v3-get-health-check\\s+Show the type of health check performed on an app"))
 				Expect(testUI.Out).To(Say("   v3-set-health-check\\s+Change type of health check performed on an app's process"))
 				Expect(testUI.Out).To(Say("   v3-packages\\s+List packages of an app"))
@@ -17,8 +17,8 @@ var HelpCategoryList = []HelpCategory{
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"stacks", "stack", "stack-audit", "change-stack"},
+			{"copy-source", "create-app-manifest", "ignored-files"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -45,6 +45,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"spaces", "space"},
 			{"create-space", "delete-space", "rename-space"},
+			{"cleanup"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
 	},
@@ -58,7 +59,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
-			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
+			{"routes", "create-route", "check-route", "route-diagnose", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
 		},
 	},
 	{
@@ -70,13 +71,14 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
-			{"buildpacks", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
+			{"buildpacks", "buildpack", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack", "package-buildpack"},
 		},
 	},
 	{
 		CategoryName: "USER ADMIN:",
 		CommandList: [][]string{
 			{"create-user", "delete-user"},
+			{"users", "user", "revoke-all-roles"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
 		},
@@ -87,6 +89,7 @@ var HelpCategoryList = []HelpCategory{
 			{"quotas", "quota", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"apply-org-config", "usage-report"},
 		},
 	},
 	{
@@ -112,6 +115,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"apply-security-groups", "check-egress"},
 		},
 	},
 	{
@@ -161,6 +165,7 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 			{"v3-start", "v3-stop", "v3-restart", "v3-stage", "v3-restart-app-instance", "v3-apply-manifest"},
 			{"v3-droplets", "v3-set-droplet"},
 			{"v3-env", "v3-set-env", "v3-unset-env"},
+			{"v3-env-group", "v3-set-env-group"},
 			{"v3-get-health-check", "v3-set-health-check"},
 			{"v3-packages", "v3-create-package"},
 			{"v3-ssh"},
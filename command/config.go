@@ -16,8 +16,12 @@ type Config interface {
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	BlueGreenStateDir() string
+	CACertFile() string
 	CFPassword() string
 	CFUsername() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
@@ -27,22 +31,29 @@ type Config interface {
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
 	Locale() string
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	OverallPollingTimeout() time.Duration
+	PaginationConcurrency() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RequestRateLimit() float64
 	RequestRetryCount() int
+	ResourceCacheDir() string
+	ResponseCacheDir() string
+	ResponseCacheTTL() time.Duration
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
+	SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
@@ -54,6 +65,7 @@ type Config interface {
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TLSCertificateFiles() (string, string, string)
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
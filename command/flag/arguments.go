@@ -36,6 +36,10 @@ type ServiceInstance struct {
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance name"`
 }
 
+type OptionalServiceInstance struct {
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" description:"The service instance name"`
+}
+
 type Organization struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 }
@@ -135,6 +139,19 @@ type BindSecurityGroupArgs struct {
 	SpaceName         string `positional-arg-name:"SPACE" description:"The space name"`
 }
 
+type ApplyOrgConfigArgs struct {
+	PathToFile PathWithExistenceCheck `positional-arg-name:"PATH_TO_FILE" required:"true" description:"Path to a YAML or JSON file describing orgs, their spaces, quotas, isolation segments and user roles"`
+}
+
+type ApplySecurityGroupsArgs struct {
+	PathToFile PathWithExistenceCheck `positional-arg-name:"PATH_TO_FILE" required:"true" description:"Path to a YAML or JSON file describing security groups, their rules and the spaces they are bound to"`
+}
+
+type CheckEgressArgs struct {
+	Host string `positional-arg-name:"HOST" required:"true" description:"The IP address or hostname to check"`
+	Port int    `positional-arg-name:"PORT" required:"true" description:"The port to check"`
+}
+
 type UnbindSecurityGroupArgs struct {
 	SecurityGroupName string `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group name"`
 	OrganizationName  string `positional-arg-name:"ORG" description:"The organization group name"`
@@ -156,6 +173,22 @@ type SetEnvironmentArgs struct {
 	EnvironmentVariableValue EnvironmentVariable `positional-arg-name:"ENV_VAR_VALUE" required:"true" description:"The environment variable value"`
 }
 
+type SetEnvironmentOrFileArgs struct {
+	AppName                  string                      `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	EnvironmentVariableName  string                      `positional-arg-name:"ENV_VAR_NAME" description:"The environment variable name"`
+	EnvironmentVariableValue OptionalEnvironmentVariable `positional-arg-name:"ENV_VAR_VALUE" description:"The environment variable value"`
+}
+
+type EnvironmentVariableGroupArgs struct {
+	Group EnvironmentVariableGroupName `positional-arg-name:"GROUP" required:"true" description:"The environment variable group, running or staging"`
+}
+
+type SetEnvironmentVariableGroupArgs struct {
+	Group                    EnvironmentVariableGroupName `positional-arg-name:"GROUP" required:"true" description:"The environment variable group, running or staging"`
+	EnvironmentVariableName  string                       `positional-arg-name:"ENV_VAR_NAME" description:"The environment variable name"`
+	EnvironmentVariableValue OptionalEnvironmentVariable  `positional-arg-name:"ENV_VAR_VALUE" description:"The environment variable value"`
+}
+
 type UnsetEnvironmentArgs struct {
 	AppName                 string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	EnvironmentVariableName string `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
@@ -223,6 +256,15 @@ type CreateBuildpackArgs struct {
 	Position  int                         `positional-arg-name:"POSITION" required:"true" description:"The position that sets priority"`
 }
 
+type PackageBuildpackArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"PATH" required:"true" description:"Path to the buildpack directory"`
+}
+
+type ChangeStackArgs struct {
+	AppName   string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	StackName string `positional-arg-name:"NEW_STACK" required:"true" description:"The stack to move the app to"`
+}
+
 type RenameBuildpackArgs struct {
 	OldBuildpackName string `positional-arg-name:"BUILDPACK_NAME" required:"true" description:"The old buildpack name"`
 	NewBuildpackName string `positional-arg-name:"NEW_BUILDPACK_NAME" required:"true" description:"The new buildpack name"`
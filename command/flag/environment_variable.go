@@ -31,3 +31,15 @@ func (e *EnvironmentVariable) UnmarshalFlag(val string) error {
 	*e = EnvironmentVariable(strings.TrimLeft(val, WorkAroundPrefix))
 	return nil
 }
+
+// OptionalEnvironmentVariable is an EnvironmentVariable that records whether it
+// was provided, so that an empty value can be told apart from a missing one.
+type OptionalEnvironmentVariable struct {
+	EnvironmentVariable
+	IsSet bool
+}
+
+func (e *OptionalEnvironmentVariable) UnmarshalFlag(val string) error {
+	e.IsSet = true
+	return e.EnvironmentVariable.UnmarshalFlag(val)
+}
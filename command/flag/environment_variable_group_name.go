@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type EnvironmentVariableGroupName struct {
+	Group string
+}
+
+func (EnvironmentVariableGroupName) Complete(prefix string) []flags.Completion {
+	return completions([]string{"running", "staging"}, prefix, false)
+}
+
+func (e *EnvironmentVariableGroupName) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "running", "staging":
+		e.Group = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `GROUP must be "running" or "staging"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvironmentVariableGroupName", func() {
+	var groupName EnvironmentVariableGroupName
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := groupName.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'running' when passed 'r'", "r",
+				[]flags.Completion{{Item: "running"}}),
+			Entry("returns 'staging' when passed 'S'", "S",
+				[]flags.Completion{{Item: "staging"}}),
+			Entry("returns 'running' and 'staging' when passed nothing", "",
+				[]flags.Completion{{Item: "running"}, {Item: "staging"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			groupName = EnvironmentVariableGroupName{}
+		})
+
+		DescribeTable("accepts the group names case insensitively",
+			func(val string, expected string) {
+				err := groupName.UnmarshalFlag(val)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(groupName.Group).To(Equal(expected))
+			},
+			Entry("running", "running", "running"),
+			Entry("Staging", "Staging", "staging"),
+		)
+
+		It("errors on anything else", func() {
+			err := groupName.UnmarshalFlag("system")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `GROUP must be "running" or "staging"`,
+			}))
+			Expect(groupName.Group).To(BeEmpty())
+		})
+	})
+})
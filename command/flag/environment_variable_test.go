@@ -131,3 +131,25 @@ var _ = Describe("EnvironmentVariable", func() {
 		})
 	})
 })
+
+var _ = Describe("OptionalEnvironmentVariable", func() {
+	var envVar OptionalEnvironmentVariable
+
+	BeforeEach(func() {
+		envVar = OptionalEnvironmentVariable{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("records that an empty value was provided", func() {
+			Expect(envVar.UnmarshalFlag("")).To(Succeed())
+			Expect(envVar.IsSet).To(BeTrue())
+			Expect(string(envVar.EnvironmentVariable)).To(BeEmpty())
+		})
+
+		It("removes the WorkAroundPrefix", func() {
+			Expect(envVar.UnmarshalFlag(WorkAroundPrefix + "-SOME_VALUE")).To(Succeed())
+			Expect(envVar.IsSet).To(BeTrue())
+			Expect(string(envVar.EnvironmentVariable)).To(Equal("-SOME_VALUE"))
+		})
+	})
+})
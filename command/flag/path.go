@@ -101,6 +101,30 @@ func (p *PathWithExistenceCheckOrURL) UnmarshalFlag(path string) error {
 	return nil
 }
 
+type PathWithExistenceCheckOrSourceURL string
+
+func (PathWithExistenceCheckOrSourceURL) Complete(prefix string) []flags.Completion {
+	return completeWithTilde(prefix)
+}
+
+// UnmarshalFlag accepts archive URLs and git repositories (git+URL[#REF]) in
+// addition to existing local paths.
+func (p *PathWithExistenceCheckOrSourceURL) UnmarshalFlag(path string) error {
+	if strings.HasPrefix(path, "git+") && strings.Contains(path, "://") {
+		*p = PathWithExistenceCheckOrSourceURL(path)
+		return nil
+	}
+
+	var pathOrURL PathWithExistenceCheckOrURL
+	err := pathOrURL.UnmarshalFlag(path)
+	if err != nil {
+		return err
+	}
+
+	*p = PathWithExistenceCheckOrSourceURL(pathOrURL)
+	return nil
+}
+
 type PathWithAt string
 
 func (PathWithAt) Complete(prefix string) []flags.Completion {
@@ -343,6 +343,53 @@ var _ = Describe("path types", func() {
 		})
 	})
 
+	Describe("PathWithExistenceCheckOrSourceURL", func() {
+		var pathWithExistenceCheckOrSourceURL PathWithExistenceCheckOrSourceURL
+
+		BeforeEach(func() {
+			pathWithExistenceCheckOrSourceURL = PathWithExistenceCheckOrSourceURL("")
+		})
+
+		// The Complete method is not tested because it shares the same code as
+		// Path.Complete().
+
+		Describe("UnmarshalFlag", func() {
+			Context("when the path is a git repository", func() {
+				It("sets the path", func() {
+					err := pathWithExistenceCheckOrSourceURL.UnmarshalFlag("git+file:///some/repo#v1.2.3")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathWithExistenceCheckOrSourceURL).To(BeEquivalentTo("git+file:///some/repo#v1.2.3"))
+				})
+			})
+
+			Context("when the path is a URL", func() {
+				It("sets the path", func() {
+					err := pathWithExistenceCheckOrSourceURL.UnmarshalFlag("https://example.com/app.zip")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathWithExistenceCheckOrSourceURL).To(BeEquivalentTo("https://example.com/app.zip"))
+				})
+			})
+
+			Context("when the path does not exist", func() {
+				It("returns a path does not exist error", func() {
+					err := pathWithExistenceCheckOrSourceURL.UnmarshalFlag("./git+some-dir")
+					Expect(err).To(MatchError(&flags.Error{
+						Type:    flags.ErrRequired,
+						Message: "The specified path './git+some-dir' does not exist.",
+					}))
+				})
+			})
+
+			Context("when the path exists", func() {
+				It("sets the path", func() {
+					err := pathWithExistenceCheckOrSourceURL.UnmarshalFlag("abc")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathWithExistenceCheckOrSourceURL).To(BeEquivalentTo("abc"))
+				})
+			})
+		})
+	})
+
 	Describe("PathWithAt", func() {
 		var pathWithAt PathWithAt
 
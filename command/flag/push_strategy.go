@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type PushStrategy struct {
+	Strategy string
+}
+
+func (PushStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{"blue-green"}, prefix, false)
+}
+
+func (s *PushStrategy) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "blue-green":
+		s.Strategy = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "blue-green"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type ReportFormat struct {
+	Format string
+}
+
+func (ReportFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"csv", "json"}, prefix, false)
+}
+
+func (f *ReportFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "csv", "json":
+		f.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FORMAT must be "csv" or "json"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReportFormat", func() {
+	var format ReportFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := format.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'csv' when passed 'c'", "c",
+				[]flags.Completion{{Item: "csv"}}),
+			Entry("returns 'json' when passed 'J'", "J",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'csv' and 'json' when passed ''", "",
+				[]flags.Completion{{Item: "csv"}, {Item: "json"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			format = ReportFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(input string, expectedFormat string) {
+				err := format.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'csv' when passed 'csv'", "csv", "csv"),
+			Entry("sets 'csv' when passed 'CSV'", "CSV", "csv"),
+			Entry("sets 'json' when passed 'Json'", "Json", "json"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := format.UnmarshalFlag("yaml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FORMAT must be "csv" or "json"`,
+				}))
+				Expect(format.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -4,11 +4,16 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
 // passed in config.
-func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *plugin.Client {
+func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) (*plugin.Client, error) {
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, err
+	}
 
 	verbose, location := config.Verbose()
 
@@ -17,8 +22,12 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		AppVersion:        config.BinaryVersion(),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: skipSSLValidation,
+		TLSConfig:         tlsConfig,
 	})
 
+	if requestLimiter := command.RequestLimiter(config); requestLimiter != nil {
+		pluginClient.WrapConnection(wrapper.NewRateLimiter(requestLimiter))
+	}
 	if verbose {
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
@@ -28,5 +37,5 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 
 	pluginClient.WrapConnection(wrapper.NewRetryRequest(config.RequestRetryCount()))
 
-	return pluginClient
+	return pluginClient, nil
 }
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"math"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/throttle"
+)
+
+var (
+	requestLimiterMutex sync.Mutex
+	requestLimiter      *throttle.Limiter
+	requestLimiterRate  float64
+)
+
+// RequestLimiter returns the rate limiter shared by all the API clients
+// created while running a command, so that the configured request rate
+// applies to Cloud Controller, UAA and plugin repository requests as a whole.
+// It returns nil when requests are not rate limited.
+func RequestLimiter(config Config) *throttle.Limiter {
+	rate := config.RequestRateLimit()
+	if rate <= 0 {
+		return nil
+	}
+
+	requestLimiterMutex.Lock()
+	defer requestLimiterMutex.Unlock()
+
+	if requestLimiter == nil || requestLimiterRate != rate {
+		requestLimiter = throttle.NewLimiter(rate, int(math.Ceil(rate)))
+		requestLimiterRate = rate
+	}
+	return requestLimiter
+}
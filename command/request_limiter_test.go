@@ -0,0 +1,40 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequestLimiter", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+	})
+
+	Context("when no request rate limit is configured", func() {
+		It("returns nil", func() {
+			Expect(RequestLimiter(fakeConfig)).To(BeNil())
+		})
+	})
+
+	Context("when a request rate limit is configured", func() {
+		BeforeEach(func() {
+			fakeConfig.RequestRateLimitReturns(10)
+		})
+
+		It("returns the same limiter every time", func() {
+			limiter := RequestLimiter(fakeConfig)
+			Expect(limiter).ToNot(BeNil())
+			Expect(RequestLimiter(fakeConfig)).To(BeIdenticalTo(limiter))
+		})
+
+		It("returns a new limiter when the rate changes", func() {
+			limiter := RequestLimiter(fakeConfig)
+			fakeConfig.RequestRateLimitReturns(5)
+			Expect(RequestLimiter(fakeConfig)).ToNot(BeIdenticalTo(limiter))
+		})
+	})
+})
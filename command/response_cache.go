@@ -0,0 +1,22 @@
+package command
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+)
+
+// NewResponseCache returns a Cloud Controller connection wrapper caching the
+// responses of read-only commands for the current user, keyed by the subject of
+// their access token, or nil when response caching is disabled.
+func NewResponseCache(config Config) *wrapper.ResponseCache {
+	ttl := config.ResponseCacheTTL()
+	if ttl <= 0 {
+		return nil
+	}
+
+	user, err := config.CurrentUser()
+	if err != nil || user.GUID == "" {
+		return nil
+	}
+
+	return wrapper.NewResponseCache(config.ResponseCacheDir(), ttl, user.GUID)
+}
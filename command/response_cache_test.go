@@ -0,0 +1,58 @@
+package command_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewResponseCache", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.ResponseCacheDirReturns("some-dir")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user", GUID: "some-user-guid"}, nil)
+	})
+
+	Context("when the response cache is disabled", func() {
+		It("returns nil", func() {
+			Expect(NewResponseCache(fakeConfig)).To(BeNil())
+		})
+	})
+
+	Context("when the response cache is enabled", func() {
+		BeforeEach(func() {
+			fakeConfig.ResponseCacheTTLReturns(30 * time.Second)
+		})
+
+		It("returns a response cache", func() {
+			Expect(NewResponseCache(fakeConfig)).ToNot(BeNil())
+		})
+
+		Context("when the current user cannot be determined", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("bad token"))
+			})
+
+			It("returns nil", func() {
+				Expect(NewResponseCache(fakeConfig)).To(BeNil())
+			})
+		})
+
+		Context("when the access token has no subject", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			})
+
+			It("returns nil", func() {
+				Expect(NewResponseCache(fakeConfig)).To(BeNil())
+			})
+		})
+	})
+})
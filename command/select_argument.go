@@ -0,0 +1,34 @@
+package command
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// SelectRequiredArgument returns value when it is set. Otherwise, when the CLI
+// is attached to a terminal, it lists the names returned by listNames and lets
+// the user pick one. When it is not, or there is nothing to pick from, it
+// returns a RequiredArgumentError for argumentName.
+func SelectRequiredArgument(ui UI, config Config, value string, argumentName string, prompt string, listNames func() ([]string, []string, error)) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+
+	if !config.IsTTY() {
+		return "", translatableerror.RequiredArgumentError{ArgumentName: argumentName}
+	}
+
+	names, warnings, err := listNames()
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	if len(names) == 0 {
+		return "", translatableerror.RequiredArgumentError{ArgumentName: argumentName}
+	}
+
+	sort.Strings(names)
+	return ui.DisplaySelectionPrompt(names, prompt)
+}
@@ -0,0 +1,107 @@
+package command_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SelectRequiredArgument", func() {
+	var (
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+
+		value       string
+		listCalls   int
+		names       []string
+		listErr     error
+		selected    string
+		selectedErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.IsTTYReturns(true)
+
+		value = ""
+		listCalls = 0
+		names = []string{"some-other-app", "some-app"}
+		listErr = nil
+	})
+
+	JustBeforeEach(func() {
+		selected, selectedErr = SelectRequiredArgument(testUI, fakeConfig, value, "APP_NAME", "App>", func() ([]string, []string, error) {
+			listCalls++
+			return names, []string{"list-warning"}, listErr
+		})
+	})
+
+	Context("when the value is provided", func() {
+		BeforeEach(func() {
+			value = "some-app"
+		})
+
+		It("returns the value without listing", func() {
+			Expect(selectedErr).ToNot(HaveOccurred())
+			Expect(selected).To(Equal("some-app"))
+			Expect(listCalls).To(Equal(0))
+		})
+	})
+
+	Context("when the value is missing", func() {
+		BeforeEach(func() {
+			input.Write([]byte("2\n"))
+		})
+
+		It("lets the user pick from the sorted names", func() {
+			Expect(selectedErr).ToNot(HaveOccurred())
+			Expect(selected).To(Equal("some-other-app"))
+
+			Expect(testUI.Out).To(Say("1\\. some-app"))
+			Expect(testUI.Out).To(Say("2\\. some-other-app"))
+			Expect(testUI.Err).To(Say("list-warning"))
+		})
+
+		Context("when not attached to a terminal", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(false)
+			})
+
+			It("returns a RequiredArgumentError without listing", func() {
+				Expect(selectedErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+				Expect(listCalls).To(Equal(0))
+			})
+		})
+
+		Context("when there is nothing to pick from", func() {
+			BeforeEach(func() {
+				names = nil
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(selectedErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			})
+		})
+
+		Context("when listing fails", func() {
+			BeforeEach(func() {
+				listErr = errors.New("some-list-error")
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(selectedErr).To(MatchError("some-list-error"))
+				Expect(testUI.Err).To(Say("list-warning"))
+			})
+		})
+	})
+})
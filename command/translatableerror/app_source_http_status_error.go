@@ -0,0 +1,17 @@
+package translatableerror
+
+// AppSourceHTTPStatusError is returned when downloading the app bits from the
+// URL provided with -p fails.
+type AppSourceHTTPStatusError struct {
+	Status string
+}
+
+func (AppSourceHTTPStatusError) Error() string {
+	return "Download attempt failed; server returned {{.Status}}\nUnable to push; app bits are not available from the given URL."
+}
+
+func (e AppSourceHTTPStatusError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Status": e.Status,
+	})
+}
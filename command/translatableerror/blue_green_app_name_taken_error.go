@@ -0,0 +1,19 @@
+package translatableerror
+
+// BlueGreenAppNameTakenError is returned when a blue-green push needs a
+// temporary app name that is already in use.
+type BlueGreenAppNameTakenError struct {
+	AppName string
+	Name    string
+}
+
+func (BlueGreenAppNameTakenError) Error() string {
+	return "Cannot push app {{.AppName}} with the blue-green strategy because app {{.Name}} already exists. Rename or delete it and try again."
+}
+
+func (e BlueGreenAppNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Name":    e.Name,
+	})
+}
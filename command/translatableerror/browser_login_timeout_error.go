@@ -0,0 +1,17 @@
+package translatableerror
+
+import "time"
+
+type BrowserLoginTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (BrowserLoginTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} minute(s) waiting for the browser login to complete."
+}
+
+func (e BrowserLoginTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout": e.Timeout.Minutes(),
+	})
+}
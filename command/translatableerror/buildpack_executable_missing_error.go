@@ -0,0 +1,17 @@
+package translatableerror
+
+import "strings"
+
+type BuildpackExecutableMissingError struct {
+	Executables []string
+}
+
+func (BuildpackExecutableMissingError) Error() string {
+	return "The buildpack is missing {{.Executables}}, which must be an executable file."
+}
+
+func (e BuildpackExecutableMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Executables": strings.Join(e.Executables, " or "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "os"
+
+type BuildpackExecutableModeError struct {
+	Executable string
+	Mode       os.FileMode
+}
+
+func (BuildpackExecutableModeError) Error() string {
+	return "{{.Executable}} is not executable (mode {{.Mode}}). Run 'chmod +x {{.Executable}}' and try again."
+}
+
+func (e BuildpackExecutableModeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Executable": e.Executable,
+		"Mode":       e.Mode.String(),
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+type BuildpackNotFoundError struct {
+	Name  string
+	Stack string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.Stack != "" {
+		return "Buildpack '{{.Name}}' with stack '{{.Stack}}' not found."
+	}
+	return "Buildpack '{{.Name}}' not found."
+}
+
+func (e BuildpackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":  e.Name,
+		"Stack": e.Stack,
+	})
+}
@@ -0,0 +1,24 @@
+package translatableerror
+
+// CACertFileError is returned when the CA certificate bundle cannot be
+// loaded.
+type CACertFileError struct {
+	Path string
+	Err  error
+}
+
+func (CACertFileError) Error() string {
+	return "Unable to load CA certificate file {{.Path}}: {{.Err}}"
+}
+
+func (e CACertFileError) Translate(translate func(string, ...interface{}) string) string {
+	var message string
+	if e.Err != nil {
+		message = e.Err.Error()
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+		"Err":  message,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// ChecksumMismatchError is returned when downloaded app bits do not match the
+// checksum provided with --checksum.
+type ChecksumMismatchError struct {
+	Source   string
+	Expected string
+	Actual   string
+}
+
+func (ChecksumMismatchError) Error() string {
+	return "Checksum of {{.Source}} is {{.Actual}}, expected {{.Expected}}."
+}
+
+func (e ChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source":   e.Source,
+		"Expected": e.Expected,
+		"Actual":   e.Actual,
+	})
+}
@@ -0,0 +1,26 @@
+package translatableerror
+
+// ClientCertificateError is returned when the client certificate and key
+// cannot be loaded.
+type ClientCertificateError struct {
+	CertFile string
+	KeyFile  string
+	Err      error
+}
+
+func (ClientCertificateError) Error() string {
+	return "Unable to load client certificate {{.CertFile}} and key {{.KeyFile}}: {{.Err}}"
+}
+
+func (e ClientCertificateError) Translate(translate func(string, ...interface{}) string) string {
+	var message string
+	if e.Err != nil {
+		message = e.Err.Error()
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"CertFile": e.CertFile,
+		"KeyFile":  e.KeyFile,
+		"Err":      message,
+	})
+}
@@ -9,9 +9,13 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/cfignore"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/envfile"
+	"code.cloudfoundry.org/cli/util/jsonfilter"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -30,6 +34,16 @@ func ConvertToTranslatableError(err error) error {
 		return AppNotFoundInManifestError(e)
 	case actionerror.AssignDropletError:
 		return AssignDropletError(e)
+	case actionerror.BlueGreenAppNameTakenError:
+		return BlueGreenAppNameTakenError(e)
+	case actionerror.BuildpackExecutableMissingError:
+		return BuildpackExecutableMissingError(e)
+	case actionerror.BuildpackExecutableModeError:
+		return BuildpackExecutableModeError(e)
+	case actionerror.BuildpackNotFoundError:
+		return BuildpackNotFoundError(e)
+	case actionerror.ChecksumMismatchError:
+		return ChecksumMismatchError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
 	case actionerror.DockerPasswordNotSetError:
@@ -44,12 +58,18 @@ func ConvertToTranslatableError(err error) error {
 		return FileChangedError(e)
 	case actionerror.GettingPluginRepositoryError:
 		return GettingPluginRepositoryError(e)
+	case actionerror.GitCommandError:
+		return GitCommandError(e)
 	case actionerror.HostnameWithTCPDomainError:
 		return HostnameWithTCPDomainError(e)
 	case actionerror.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case actionerror.InvalidBuildpackManifestError:
+		return InvalidBuildpackManifestError(e)
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidGitRefError:
+		return InvalidGitRefError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
@@ -60,6 +80,10 @@ func ConvertToTranslatableError(err error) error {
 		return IsolationSegmentNotFoundError(e)
 	case actionerror.MissingNameError:
 		return RequiredNameForPushError{}
+	case actionerror.MultipleBuildpacksFoundError:
+		return MultipleBuildpacksFoundError(e)
+	case actionerror.MultipleUsersFoundError:
+		return MultipleUsersFoundError(e)
 	case actionerror.NoCompatibleBinaryError:
 		return NoCompatibleBinaryError{}
 	case actionerror.NoDomainsFoundError:
@@ -94,6 +118,14 @@ func ConvertToTranslatableError(err error) error {
 		return ProcessNotFoundError(e)
 	case actionerror.PropertyCombinationError:
 		return PropertyCombinationError(e)
+	case actionerror.QuotaExceededError:
+		return QuotaExceededError{
+			Scope:     e.Scope,
+			QuotaName: e.QuotaName,
+			Resource:  string(e.Resource),
+			Requested: e.Requested,
+			Available: e.Available,
+		}
 	case actionerror.RepositoryNameTakenError:
 		return RepositoryNameTakenError(e)
 	case actionerror.RepositoryNotRegisteredError:
@@ -115,6 +147,8 @@ func ConvertToTranslatableError(err error) error {
 		return ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: e.ServiceInstanceName}
 	case actionerror.SharedServiceInstanceNotFoundError:
 		return SharedServiceInstanceNotFoundError(e)
+	case actionerror.SmokeTestFailedError:
+		return SmokeTestFailedError(e)
 	case actionerror.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
 	case actionerror.StackNotFoundError:
@@ -127,8 +161,12 @@ func ConvertToTranslatableError(err error) error {
 		return TCPRouteOptionsNotProvidedError{}
 	case actionerror.TriggerLegacyPushError:
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
+	case actionerror.UnsupportedChecksumError:
+		return UnsupportedChecksumError(e)
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
+	case actionerror.UserNotFoundError:
+		return UserNotFoundError(e)
 	case actionerror.CommandLineOptionsAndManifestConflictError:
 		return CommandLineOptionsAndManifestConflictError{
 			ManifestAttribute:  e.ManifestAttribute,
@@ -186,14 +224,34 @@ func ConvertToTranslatableError(err error) error {
 	// UAA Errors
 	case uaa.BadCredentialsError:
 		return BadCredentialsError{}
+	case uaa.BrowserLoginTimeoutError:
+		return BrowserLoginTimeoutError(e)
 	case uaa.InsufficientScopeError:
 		return UnauthorizedToPerformActionError{}
 	case uaa.InvalidAuthTokenError:
 		return InvalidRefreshTokenError{}
 
 	// Other Errors
+	case cfignore.IncludeCycleError:
+		return IgnoreFileIncludeCycleError(e)
+	case cfignore.InvalidPatternError:
+		return InvalidIgnorePatternError(e)
 	case download.RawHTTPStatusError:
 		return HTTPStatusError{Status: e.Status}
+	case envfile.InvalidJSONError:
+		return InvalidEnvironmentVariablesJSONError(e)
+	case envfile.InvalidLineError:
+		return InvalidEnvironmentVariableLineError(e)
+	case envfile.InvalidValueError:
+		return InvalidEnvironmentVariableValueError(e)
+	case jsonfilter.EvaluationError:
+		return FilterEvaluationError(e)
+	case jsonfilter.InvalidFilterError:
+		return InvalidFilterError(e)
+	case tlsconfig.CACertFileError:
+		return CACertFileError(e)
+	case tlsconfig.ClientCertificateError:
+		return ClientCertificateError(e)
 	}
 
 	return err
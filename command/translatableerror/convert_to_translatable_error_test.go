@@ -10,9 +10,13 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/cfignore"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/envfile"
+	"code.cloudfoundry.org/cli/util/jsonfilter"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -51,6 +55,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.AssignDropletError{Message: "some-message"},
 			AssignDropletError{Message: "some-message"}),
 
+		Entry("actionerror.BlueGreenAppNameTakenError -> BlueGreenAppNameTakenError",
+			actionerror.BlueGreenAppNameTakenError{AppName: "some-app", Name: "some-app-new"},
+			BlueGreenAppNameTakenError{AppName: "some-app", Name: "some-app-new"}),
+
+		Entry("actionerror.BuildpackExecutableMissingError -> BuildpackExecutableMissingError",
+			actionerror.BuildpackExecutableMissingError{Executables: []string{"bin/compile", "bin/supply"}},
+			BuildpackExecutableMissingError{Executables: []string{"bin/compile", "bin/supply"}}),
+
+		Entry("actionerror.BuildpackExecutableModeError -> BuildpackExecutableModeError",
+			actionerror.BuildpackExecutableModeError{Executable: "bin/detect", Mode: 0644},
+			BuildpackExecutableModeError{Executable: "bin/detect", Mode: 0644}),
+
+		Entry("actionerror.BuildpackNotFoundError -> BuildpackNotFoundError",
+			actionerror.BuildpackNotFoundError{Name: "some-buildpack", Stack: "some-stack"},
+			BuildpackNotFoundError{Name: "some-buildpack", Stack: "some-stack"}),
+
+		Entry("actionerror.ChecksumMismatchError -> ChecksumMismatchError",
+			actionerror.ChecksumMismatchError{Source: "some-url", Expected: "some-checksum", Actual: "some-other-checksum"},
+			ChecksumMismatchError{Source: "some-url", Expected: "some-checksum", Actual: "some-other-checksum"}),
+
 		Entry("actionerror.CommandLineOptionsWithMultipleAppsError -> CommandLineArgsWithMultipleAppsError",
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
@@ -84,6 +108,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
 
+		Entry("actionerror.GitCommandError -> GitCommandError",
+			actionerror.GitCommandError{Command: "clone", Output: "some-output"},
+			GitCommandError{Command: "clone", Output: "some-output"}),
+
 		Entry("actionerror.HostnameWithTCPDomainError -> HostnameWithTCPDomainError",
 			actionerror.HostnameWithTCPDomainError{},
 			HostnameWithTCPDomainError{}),
@@ -92,10 +120,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.HTTPHealthCheckInvalidError{},
 			HTTPHealthCheckInvalidError{}),
 
+		Entry("actionerror.InvalidBuildpackManifestError -> InvalidBuildpackManifestError",
+			actionerror.InvalidBuildpackManifestError{Path: "manifest.yml", Reason: "some-reason"},
+			InvalidBuildpackManifestError{Path: "manifest.yml", Reason: "some-reason"}),
+
 		Entry("actionerror.InvalidBuildpacksError -> InvalidBuildpacksError",
 			actionerror.InvalidBuildpacksError{},
 			InvalidBuildpacksError{}),
 
+		Entry("actionerror.InvalidGitRefError -> InvalidGitRefError",
+			actionerror.InvalidGitRefError{Ref: "-some-ref"},
+			InvalidGitRefError{Ref: "-some-ref"}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
@@ -112,6 +148,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.MissingNameError{},
 			RequiredNameForPushError{}),
 
+		Entry("actionerror.MultipleBuildpacksFoundError -> MultipleBuildpacksFoundError",
+			actionerror.MultipleBuildpacksFoundError{Name: "some-buildpack", Stacks: []string{"stack-1", "stack-2"}},
+			MultipleBuildpacksFoundError{Name: "some-buildpack", Stacks: []string{"stack-1", "stack-2"}}),
+
+		Entry("actionerror.MultipleUsersFoundError -> MultipleUsersFoundError",
+			actionerror.MultipleUsersFoundError{Username: "some-user", Origins: []string{"ldap", "uaa"}},
+			MultipleUsersFoundError{Username: "some-user", Origins: []string{"ldap", "uaa"}}),
+
 		Entry("actionerror.NoCompatibleBinaryError -> NoCompatibleBinaryError",
 			actionerror.NoCompatibleBinaryError{},
 			NoCompatibleBinaryError{}),
@@ -194,6 +238,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PropertyCombinationError{Properties: []string{"property-1", "property-2"}},
 			PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 
+		Entry("actionerror.QuotaExceededError -> QuotaExceededError",
+			actionerror.QuotaExceededError{Scope: "space", QuotaName: "some-quota", Resource: actionerror.QuotaResourceMemory, Requested: 1024, Available: 512},
+			QuotaExceededError{Scope: "space", QuotaName: "some-quota", Resource: "memory", Requested: 1024, Available: 512}),
+
 		Entry("actionerror.RepositoryNameTakenError -> RepositoryNameTakenError",
 			actionerror.RepositoryNameTakenError{Name: "some-repo"},
 			RepositoryNameTakenError{Name: "some-repo"}),
@@ -230,6 +278,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SharedServiceInstanceNotFoundError{},
 			SharedServiceInstanceNotFoundError{}),
 
+		Entry("actionerror.SmokeTestFailedError -> SmokeTestFailedError",
+			actionerror.SmokeTestFailedError{AppName: "some-app", Reason: "some-reason"},
+			SmokeTestFailedError{AppName: "some-app", Reason: "some-reason"}),
+
 		Entry("actionerror.SpaceNotFoundError -> SpaceNotFoundError",
 			actionerror.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
@@ -250,10 +302,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}},
 			TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}),
 
+		Entry("actionerror.UnsupportedChecksumError -> UnsupportedChecksumError",
+			actionerror.UnsupportedChecksumError{Checksum: "some-checksum"},
+			UnsupportedChecksumError{Checksum: "some-checksum"}),
+
 		Entry("actionerror.UploadFailedError -> UploadFailedError",
 			actionerror.UploadFailedError{Err: actionerror.NoDomainsFoundError{}},
 			UploadFailedError{Err: NoDomainsFoundError{}}),
 
+		Entry("actionerror.UserNotFoundError -> UserNotFoundError",
+			actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"},
+			UserNotFoundError{Username: "some-user", Origin: "ldap"}),
+
 		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
 			actionerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
@@ -326,11 +386,47 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			unprocessableEntityError,
 			unprocessableEntityError),
 
+		Entry("cfignore.IncludeCycleError -> IgnoreFileIncludeCycleError",
+			cfignore.IncludeCycleError{Path: ".cfignore"},
+			IgnoreFileIncludeCycleError{Path: ".cfignore"}),
+
+		Entry("cfignore.InvalidPatternError -> InvalidIgnorePatternError",
+			cfignore.InvalidPatternError{Source: ".cfignore", Line: 1, Pattern: "some-pattern"},
+			InvalidIgnorePatternError{Source: ".cfignore", Line: 1, Pattern: "some-pattern"}),
+
 		Entry("download.RawHTTPStatusError -> HTTPStatusError",
 			download.RawHTTPStatusError{Status: "some status"},
 			HTTPStatusError{Status: "some status"},
 		),
 
+		Entry("envfile.InvalidJSONError -> InvalidEnvironmentVariablesJSONError",
+			envfile.InvalidJSONError{Source: "vars.json", Reason: "some-reason"},
+			InvalidEnvironmentVariablesJSONError{Source: "vars.json", Reason: "some-reason"}),
+
+		Entry("envfile.InvalidLineError -> InvalidEnvironmentVariableLineError",
+			envfile.InvalidLineError{Source: ".env", Line: 1},
+			InvalidEnvironmentVariableLineError{Source: ".env", Line: 1}),
+
+		Entry("envfile.InvalidValueError -> InvalidEnvironmentVariableValueError",
+			envfile.InvalidValueError{Source: "vars.json", Name: "some-name"},
+			InvalidEnvironmentVariableValueError{Source: "vars.json", Name: "some-name"}),
+
+		Entry("jsonfilter.EvaluationError -> FilterEvaluationError",
+			jsonfilter.EvaluationError{Filter: ".name", Reason: "some-reason"},
+			FilterEvaluationError{Filter: ".name", Reason: "some-reason"}),
+
+		Entry("jsonfilter.InvalidFilterError -> InvalidFilterError",
+			jsonfilter.InvalidFilterError{Filter: "name", Reason: "some-reason"},
+			InvalidFilterError{Filter: "name", Reason: "some-reason"}),
+
+		Entry("tlsconfig.CACertFileError -> CACertFileError",
+			tlsconfig.CACertFileError{Path: "some-path", Err: errors.New("some-error")},
+			CACertFileError{Path: "some-path", Err: errors.New("some-error")}),
+
+		Entry("tlsconfig.ClientCertificateError -> ClientCertificateError",
+			tlsconfig.ClientCertificateError{CertFile: "some-cert", KeyFile: "some-key", Err: errors.New("some-error")},
+			ClientCertificateError{CertFile: "some-cert", KeyFile: "some-key", Err: errors.New("some-error")}),
+
 		Entry("json.SyntaxError -> JSONSyntaxError",
 			jsonErr,
 			JSONSyntaxError{Err: jsonErr},
@@ -377,6 +473,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			uaa.BadCredentialsError{},
 			BadCredentialsError{}),
 
+		Entry("uaa.BrowserLoginTimeoutError -> BrowserLoginTimeoutError",
+			uaa.BrowserLoginTimeoutError{Timeout: time.Minute},
+			BrowserLoginTimeoutError{Timeout: time.Minute}),
+
 		Entry("uaa.InsufficientScopeError -> UnauthorizedToPerformActionError",
 			uaa.InsufficientScopeError{},
 			UnauthorizedToPerformActionError{}),
@@ -0,0 +1,19 @@
+package translatableerror
+
+// EgressNotAllowedError is returned when none of the running security groups
+// of a space allow traffic to a destination.
+type EgressNotAllowedError struct {
+	Destination string
+	SpaceName   string
+}
+
+func (EgressNotAllowedError) Error() string {
+	return "Egress from space {{.SpaceName}} to {{.Destination}} is not allowed by any running security group."
+}
+
+func (e EgressNotAllowedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Destination": e.Destination,
+		"SpaceName":   e.SpaceName,
+	})
+}
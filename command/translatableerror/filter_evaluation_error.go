@@ -0,0 +1,19 @@
+package translatableerror
+
+// FilterEvaluationError is returned when a --filter expression cannot be
+// applied to a response.
+type FilterEvaluationError struct {
+	Filter string
+	Reason string
+}
+
+func (FilterEvaluationError) Error() string {
+	return "Unable to apply filter '{{.Filter}}': {{.Reason}}"
+}
+
+func (e FilterEvaluationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Filter": e.Filter,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// GitCommandError is returned when checking out a git app source fails.
+type GitCommandError struct {
+	Command string
+	Output  string
+}
+
+func (GitCommandError) Error() string {
+	return "Unable to check out app source; git {{.Command}} failed:\n{{.Output}}"
+}
+
+func (e GitCommandError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+		"Output":  e.Output,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// IgnoreFileIncludeCycleError is returned when .cfignore files include each
+// other.
+type IgnoreFileIncludeCycleError struct {
+	Path string
+}
+
+func (IgnoreFileIncludeCycleError) Error() string {
+	return "Ignore file {{.Path}} includes itself."
+}
+
+func (e IgnoreFileIncludeCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
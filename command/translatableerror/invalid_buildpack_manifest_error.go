@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidBuildpackManifestError struct {
+	Path   string
+	Reason string
+}
+
+func (InvalidBuildpackManifestError) Error() string {
+	return "Invalid buildpack manifest {{.Path}}: {{.Reason}}"
+}
+
+func (e InvalidBuildpackManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":   e.Path,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidEnvironmentVariableLineError is returned when a line of an
+// environment variables file is not a NAME=VALUE assignment.
+type InvalidEnvironmentVariableLineError struct {
+	Source string
+	Line   int
+}
+
+func (InvalidEnvironmentVariableLineError) Error() string {
+	return "Invalid environment variable assignment in {{.Source}} on line {{.Line}}. Expected NAME=VALUE."
+}
+
+func (e InvalidEnvironmentVariableLineError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source": e.Source,
+		"Line":   e.Line,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidEnvironmentVariableValueError is returned when a variable in a JSON
+// environment variables file is not a string, number or boolean.
+type InvalidEnvironmentVariableValueError struct {
+	Source string
+	Name   string
+}
+
+func (InvalidEnvironmentVariableValueError) Error() string {
+	return "Invalid value for environment variable {{.Name}} in {{.Source}}. Values must be strings, numbers or booleans."
+}
+
+func (e InvalidEnvironmentVariableValueError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source": e.Source,
+		"Name":   e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidEnvironmentVariablesJSONError is returned when a JSON environment
+// variables file is not a single JSON object.
+type InvalidEnvironmentVariablesJSONError struct {
+	Source string
+	Reason string
+}
+
+func (InvalidEnvironmentVariablesJSONError) Error() string {
+	return "Invalid JSON in {{.Source}}: {{.Reason}}"
+}
+
+func (e InvalidEnvironmentVariablesJSONError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source": e.Source,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// InvalidFilterError is returned when a --filter expression cannot be parsed.
+type InvalidFilterError struct {
+	Filter string
+	Reason string
+}
+
+func (InvalidFilterError) Error() string {
+	return "Invalid filter '{{.Filter}}': {{.Reason}}"
+}
+
+func (e InvalidFilterError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Filter": e.Filter,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidGitRefError is returned when the ref of a git app source starts with
+// '-'.
+type InvalidGitRefError struct {
+	Ref string
+}
+
+func (InvalidGitRefError) Error() string {
+	return "Invalid git ref '{{.Ref}}'."
+}
+
+func (e InvalidGitRefError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Ref": e.Ref,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidHeaderError is returned when a custom request header is not of the
+// form 'NAME: VALUE'.
+type InvalidHeaderError struct {
+	Header string
+}
+
+func (InvalidHeaderError) Error() string {
+	return "Invalid header '{{.Header}}': headers must be in the form 'NAME: VALUE'"
+}
+
+func (e InvalidHeaderError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Header": e.Header,
+	})
+}
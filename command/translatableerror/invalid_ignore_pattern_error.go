@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidIgnorePatternError is returned when a .cfignore file contains a
+// pattern that cannot be parsed.
+type InvalidIgnorePatternError struct {
+	Source  string
+	Line    int
+	Pattern string
+}
+
+func (InvalidIgnorePatternError) Error() string {
+	return "Invalid pattern '{{.Pattern}}' in {{.Source}} on line {{.Line}}."
+}
+
+func (e InvalidIgnorePatternError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source":  e.Source,
+		"Line":    e.Line,
+		"Pattern": e.Pattern,
+	})
+}
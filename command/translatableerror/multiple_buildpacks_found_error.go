@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+type MultipleBuildpacksFoundError struct {
+	Name   string
+	Stacks []string
+}
+
+func (MultipleBuildpacksFoundError) Error() string {
+	return "Buildpack '{{.Name}}' exists for multiple stacks: {{.Stacks}}\nUse the -s flag to choose one."
+}
+
+func (e MultipleBuildpacksFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":   e.Name,
+		"Stacks": strings.Join(e.Stacks, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+type MultipleUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (MultipleUsersFoundError) Error() string {
+	return "User '{{.Username}}' exists in multiple origins: {{.Origins}}\nUse the --origin flag to choose one."
+}
+
+func (e MultipleUsersFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origins":  strings.Join(e.Origins, ", "),
+	})
+}
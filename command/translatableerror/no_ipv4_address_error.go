@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoIPv4AddressError is returned when a host is neither an IPv4 address nor
+// resolves to one.
+type NoIPv4AddressError struct {
+	Host string
+}
+
+func (NoIPv4AddressError) Error() string {
+	return "{{.Host}} is not an IPv4 address and does not resolve to one. Security groups only apply to IPv4 traffic."
+}
+
+func (e NoIPv4AddressError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Host": e.Host,
+	})
+}
@@ -0,0 +1,41 @@
+package translatableerror
+
+import "code.cloudfoundry.org/bytefmt"
+
+// QuotaExceededError is returned when starting app instances would exceed a
+// limit of an org or space quota. Memory is in megabytes.
+type QuotaExceededError struct {
+	Scope     string
+	QuotaName string
+	Resource  string
+	Requested uint64
+	Available uint64
+}
+
+func (e QuotaExceededError) Error() string {
+	var message string
+	switch e.Resource {
+	case "instance memory":
+		message = "Each instance would be allocated {{.Requested}} of memory, but {{.Scope}} quota '{{.QuotaName}}' limits instances to {{.Available}}."
+	case "app instances":
+		message = "Starting would use {{.Requested}} app instances, but only {{.Available}} are left in {{.Scope}} quota '{{.QuotaName}}'."
+	default:
+		message = "Starting would allocate {{.Requested}} of memory, but only {{.Available}} is left in {{.Scope}} quota '{{.QuotaName}}'."
+	}
+	return message + "\nTIP: Use '--skip-quota-check' to skip this check."
+}
+
+func (e QuotaExceededError) Translate(translate func(string, ...interface{}) string) string {
+	requested, available := interface{}(e.Requested), interface{}(e.Available)
+	if e.Resource != "app instances" {
+		requested = bytefmt.ByteSize(e.Requested * bytefmt.MEGABYTE)
+		available = bytefmt.ByteSize(e.Available * bytefmt.MEGABYTE)
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Scope":     e.Scope,
+		"QuotaName": e.QuotaName,
+		"Requested": requested,
+		"Available": available,
+	})
+}
@@ -0,0 +1,48 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaExceededError", func() {
+	Describe("Translate()", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				err := t.Execute(buffer, subs[0])
+				Expect(err).NotTo(HaveOccurred())
+				return buffer.String()
+			}
+		})
+
+		Context("when the memory limit would be exceeded", func() {
+			It("prints the memory as a byte size", func() {
+				err := QuotaExceededError{Scope: "space", QuotaName: "some-quota", Resource: "memory", Requested: 2048, Available: 512}
+				Expect(err.Translate(translateFunc)).To(Equal("Starting would allocate 2G of memory, but only 512M is left in space quota 'some-quota'.\nTIP: Use '--skip-quota-check' to skip this check."))
+			})
+		})
+
+		Context("when the instance memory limit would be exceeded", func() {
+			It("prints the instance memory limit", func() {
+				err := QuotaExceededError{Scope: "org", QuotaName: "some-quota", Resource: "instance memory", Requested: 2048, Available: 1024}
+				Expect(err.Translate(translateFunc)).To(Equal("Each instance would be allocated 2G of memory, but org quota 'some-quota' limits instances to 1G.\nTIP: Use '--skip-quota-check' to skip this check."))
+			})
+		})
+
+		Context("when the app instance limit would be exceeded", func() {
+			It("prints the instance counts", func() {
+				err := QuotaExceededError{Scope: "org", QuotaName: "some-quota", Resource: "app instances", Requested: 5, Available: 4}
+				Expect(err.Translate(translateFunc)).To(Equal("Starting would use 5 app instances, but only 4 are left in org quota 'some-quota'.\nTIP: Use '--skip-quota-check' to skip this check."))
+			})
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package translatableerror
+
+// SmokeTestFailedError is returned when the new version of an app fails its
+// smoke test during a blue-green push.
+type SmokeTestFailedError struct {
+	AppName string
+	Reason  string
+}
+
+func (SmokeTestFailedError) Error() string {
+	return "Smoke test of app {{.AppName}} failed: {{.Reason}}"
+}
+
+func (e SmokeTestFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Reason":  e.Reason,
+	})
+}
@@ -41,10 +41,19 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("AppSourceHTTPStatusError", AppSourceHTTPStatusError{Status: "some status"}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
+		Entry("BlueGreenAppNameTakenError", BlueGreenAppNameTakenError{}),
+		Entry("BrowserLoginTimeoutError", BrowserLoginTimeoutError{}),
+		Entry("BuildpackExecutableMissingError", BuildpackExecutableMissingError{}),
+		Entry("BuildpackExecutableModeError", BuildpackExecutableModeError{}),
+		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
+		Entry("CACertFileError", CACertFileError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
+		Entry("ChecksumMismatchError", ChecksumMismatchError{}),
+		Entry("ClientCertificateError", ClientCertificateError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
@@ -54,13 +63,21 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
+		Entry("FilterEvaluationError", FilterEvaluationError{}),
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
+		Entry("GitCommandError", GitCommandError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HostAndPathNotAllowedWithTCPDomainError", HostAndPathNotAllowedWithTCPDomainError{}),
 		Entry("HostnameWithTCPDomainError", HostnameWithTCPDomainError{}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
+		Entry("IgnoreFileIncludeCycleError", IgnoreFileIncludeCycleError{}),
+		Entry("InvalidBuildpackManifestError", InvalidBuildpackManifestError{}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidFilterError", InvalidFilterError{}),
+		Entry("InvalidGitRefError", InvalidGitRefError{}),
+		Entry("InvalidHeaderError", InvalidHeaderError{}),
+		Entry("InvalidIgnorePatternError", InvalidIgnorePatternError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
@@ -74,6 +91,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
 		Entry("MissingCredentialsError", MissingCredentialsError{}),
 		Entry("MultiError", MultiError{}),
+		Entry("MultipleBuildpacksFoundError", MultipleBuildpacksFoundError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
@@ -99,6 +117,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
+		Entry("QuotaExceededError", QuotaExceededError{Resource: "memory"}),
+		Entry("QuotaExceededError", QuotaExceededError{Resource: "app instances"}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
@@ -110,6 +130,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
+		Entry("SmokeTestFailedError", SmokeTestFailedError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
 		Entry("SSLCertError", SSLCertError{}),
@@ -122,6 +143,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("UnsupportedChecksumError", UnsupportedChecksumError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
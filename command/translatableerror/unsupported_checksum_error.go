@@ -0,0 +1,17 @@
+package translatableerror
+
+// UnsupportedChecksumError is returned when --checksum is not a SHA1 or SHA256
+// hex digest.
+type UnsupportedChecksumError struct {
+	Checksum string
+}
+
+func (UnsupportedChecksumError) Error() string {
+	return "Unsupported checksum '{{.Checksum}}'. Provide a SHA1 or SHA256 hex digest, optionally prefixed with 'sha1:' or 'sha256:'."
+}
+
+func (e UnsupportedChecksumError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Checksum": e.Checksum,
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	if e.Origin != "" {
+		return "User '{{.Username}}' with origin '{{.Origin}}' not found."
+	}
+	return "User '{{.Username}}' not found."
+}
+
+func (e UserNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origin":   e.Origin,
+	})
+}
@@ -11,12 +11,14 @@ import (
 type UI interface {
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplaySelectionPrompt(options []string, template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
 	DisplayError(err error)
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
+	DisplayLiveUpdate(display func())
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
@@ -2,12 +2,15 @@ package v2
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 //go:generate counterfeiter . ApiActor
@@ -18,11 +21,14 @@ type ApiActor interface {
 }
 
 type ApiCommand struct {
-	OptionalArgs      flag.APITarget `positional-args:"yes"`
-	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
-	relatedCommands   interface{}    `related_commands:"auth, login, target"`
+	OptionalArgs      flag.APITarget              `positional-args:"yes"`
+	CACertFile        flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM encoded bundle of additional CA certificates to trust"`
+	ClientCertFile    flag.PathWithExistenceCheck `long:"client-cert" description:"Path to a PEM encoded client certificate to present to the API endpoints"`
+	ClientKeyFile     flag.PathWithExistenceCheck `long:"client-key" description:"Path to the PEM encoded private key of the client certificate"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Unset             bool                        `long:"unset" description:"Remove all api endpoint targeting"`
+	usage             interface{}                 `usage:"CF_NAME api [URL] [--ca-cert CA_FILE] [--client-cert CERT_FILE --client-key KEY_FILE]\n\nENVIRONMENT VARIABLES:\n   CF_CA_CERT_FILE=ca.pem            Additional CA certificates to trust. Overrides the value set with --ca-cert.\n   CF_CLIENT_CERT_FILE=client.pem    Client certificate to present. Overrides the value set with --client-cert.\n   CF_CLIENT_KEY_FILE=client.key     Client certificate key. Overrides the value set with --client-key."`
+	relatedCommands   interface{}                 `related_commands:"auth, login, target"`
 
 	UI     command.UI
 	Actor  ApiActor
@@ -84,6 +90,7 @@ func (cmd *ApiCommand) Execute(args []string) error {
 func (cmd *ApiCommand) ClearTarget() error {
 	cmd.UI.DisplayTextWithFlavor("Unsetting api endpoint...")
 	cmd.Actor.ClearTarget()
+	cmd.Config.SetTLSCertificateFiles("", "", "")
 	cmd.UI.DisplayOK()
 	return nil
 }
@@ -95,15 +102,33 @@ func (cmd *ApiCommand) setAPI() error {
 
 	apiURL := processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(v2action.TargetSettings{
-		URL:               apiURL,
-		SkipSSLValidation: cmd.SkipSSLValidation,
-		DialTimeout:       cmd.Config.DialTimeout(),
-	})
+	caCertFile, clientCertFile, clientKeyFile, err := cmd.tlsCertificateFiles()
 	if err != nil {
 		return err
 	}
 
+	// The configured certificate files belong to the currently targeted API,
+	// so they are dropped when targeting a different API without new files.
+	// Files provided through the environment still apply.
+	previousCACertFile, previousClientCertFile, previousClientKeyFile := cmd.Config.TLSCertificateFiles()
+	clearTLSFiles := !cmd.hasTLSFlags() && apiURL != cmd.Config.Target()
+	if clearTLSFiles {
+		cmd.Config.SetTLSCertificateFiles("", "", "")
+		caCertFile, clientCertFile, clientKeyFile = cmd.Config.CACertFile(), cmd.Config.ClientCertFile(), cmd.Config.ClientKeyFile()
+	}
+
+	err = cmd.targetAPI(apiURL, caCertFile, clientCertFile, clientKeyFile)
+	if err != nil {
+		if clearTLSFiles {
+			cmd.Config.SetTLSCertificateFiles(previousCACertFile, previousClientCertFile, previousClientKeyFile)
+		}
+		return err
+	}
+
+	if cmd.hasTLSFlags() {
+		cmd.Config.SetTLSCertificateFiles(caCertFile, clientCertFile, clientKeyFile)
+	}
+
 	if strings.HasPrefix(apiURL, "http:") {
 		cmd.UI.DisplayText("Warning: Insecure http API endpoint detected: secure https API endpoints are recommended")
 	}
@@ -113,6 +138,53 @@ func (cmd *ApiCommand) setAPI() error {
 	return nil
 }
 
+func (cmd *ApiCommand) targetAPI(apiURL string, caCertFile string, clientCertFile string, clientKeyFile string) error {
+	tlsConfig, err := tlsconfig.New(caCertFile, clientCertFile, clientKeyFile)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.SetTarget(v2action.TargetSettings{
+		URL:               apiURL,
+		SkipSSLValidation: cmd.SkipSSLValidation,
+		TLSConfig:         tlsConfig,
+		DialTimeout:       cmd.Config.DialTimeout(),
+	})
+	return err
+}
+
+// tlsCertificateFiles returns the absolute paths of the TLS certificate files
+// provided on the command line. When none are provided, the files already
+// configured are returned.
+func (cmd *ApiCommand) tlsCertificateFiles() (string, string, string, error) {
+	if !cmd.hasTLSFlags() {
+		return cmd.Config.CACertFile(), cmd.Config.ClientCertFile(), cmd.Config.ClientKeyFile(), nil
+	}
+
+	if (cmd.ClientCertFile == "") != (cmd.ClientKeyFile == "") {
+		return "", "", "", translatableerror.RequiredFlagsError{Arg1: "--client-cert", Arg2: "--client-key"}
+	}
+
+	var files []string
+	for _, file := range []flag.PathWithExistenceCheck{cmd.CACertFile, cmd.ClientCertFile, cmd.ClientKeyFile} {
+		if file == "" {
+			files = append(files, "")
+			continue
+		}
+		absPath, err := filepath.Abs(string(file))
+		if err != nil {
+			return "", "", "", err
+		}
+		files = append(files, absPath)
+	}
+
+	return files[0], files[1], files[2], nil
+}
+
+func (cmd *ApiCommand) hasTLSFlags() bool {
+	return cmd.CACertFile != "" || cmd.ClientCertFile != "" || cmd.ClientKeyFile != ""
+}
+
 func processURL(apiURL string) string {
 	if !strings.HasPrefix(apiURL, "http") {
 		return fmt.Sprintf("https://%s", apiURL)
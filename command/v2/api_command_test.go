@@ -1,13 +1,22 @@
 package v2_test
 
 import (
+	"encoding/pem"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -82,6 +91,12 @@ var _ = Describe("api Command", func() {
 				Expect(testUI.Out).To(Say("Unsetting api endpoint..."))
 				Expect(testUI.Out).To(Say("OK"))
 				Expect(fakeActor.ClearTargetCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(1))
+				caCertFile, clientCertFile, clientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+				Expect(caCertFile).To(BeEmpty())
+				Expect(clientCertFile).To(BeEmpty())
+				Expect(clientKeyFile).To(BeEmpty())
 			})
 		})
 	})
@@ -156,6 +171,157 @@ api version:    100.200.300`,
 			})
 		})
 
+		Context("when TLS certificate files are provided", func() {
+			var (
+				server     *httptest.Server
+				caCertFile string
+			)
+
+			BeforeEach(func() {
+				server = httptest.NewTLSServer(http.NotFoundHandler())
+
+				file, tempErr := ioutil.TempFile("", "ca-cert")
+				Expect(tempErr).ToNot(HaveOccurred())
+				Expect(pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})).To(Succeed())
+				Expect(file.Close()).To(Succeed())
+				caCertFile = file.Name()
+
+				cmd.OptionalArgs.URL = "https://api.foo.com"
+			})
+
+			AfterEach(func() {
+				server.Close()
+				Expect(os.Remove(caCertFile)).To(Succeed())
+			})
+
+			Context("when --ca-cert is passed", func() {
+				BeforeEach(func() {
+					cmd.CACertFile = flag.PathWithExistenceCheck(caCertFile)
+				})
+
+				It("targets with the CA and stores the files", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+					settings := fakeActor.SetTargetArgsForCall(0)
+					Expect(settings.TLSConfig).ToNot(BeNil())
+					Expect(settings.TLSConfig.RootCAs).ToNot(BeNil())
+
+					Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(1))
+					storedCACertFile, storedClientCertFile, storedClientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+					Expect(storedCACertFile).To(Equal(caCertFile))
+					Expect(storedClientCertFile).To(BeEmpty())
+					Expect(storedClientKeyFile).To(BeEmpty())
+				})
+
+				Context("when targeting fails", func() {
+					BeforeEach(func() {
+						fakeActor.SetTargetReturns(nil, errors.New("some-error"))
+					})
+
+					It("does not store the files", func() {
+						Expect(err).To(MatchError("some-error"))
+						Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when --client-cert is passed without --client-key", func() {
+				BeforeEach(func() {
+					cmd.ClientCertFile = flag.PathWithExistenceCheck(caCertFile)
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(err).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--client-cert", Arg2: "--client-key"}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a relative --ca-cert path is passed", func() {
+				BeforeEach(func() {
+					workingDir, wdErr := os.Getwd()
+					Expect(wdErr).ToNot(HaveOccurred())
+					relativePath, relErr := filepath.Rel(workingDir, caCertFile)
+					Expect(relErr).ToNot(HaveOccurred())
+					cmd.CACertFile = flag.PathWithExistenceCheck(relativePath)
+				})
+
+				It("stores the absolute path", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					storedCACertFile, _, _ := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+					Expect(filepath.IsAbs(storedCACertFile)).To(BeTrue())
+					Expect(storedCACertFile).To(Equal(caCertFile))
+				})
+			})
+
+			Context("when no TLS flags are passed", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetReturns("https://api.foo.com")
+					fakeConfig.CACertFileReturns(caCertFile)
+				})
+
+				It("uses the configured files and leaves them unchanged", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					settings := fakeActor.SetTargetArgsForCall(0)
+					Expect(settings.TLSConfig).ToNot(BeNil())
+					Expect(settings.TLSConfig.RootCAs).ToNot(BeNil())
+					Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(0))
+				})
+
+				Context("when a different API is targeted", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetReturns("https://api.bar.com")
+						fakeConfig.SetTLSCertificateFilesStub = func(string, string, string) {
+							fakeConfig.CACertFileReturns("")
+						}
+					})
+
+					It("clears the configured files and targets without them", func() {
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(1))
+						storedCACertFile, storedClientCertFile, storedClientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+						Expect(storedCACertFile).To(BeEmpty())
+						Expect(storedClientCertFile).To(BeEmpty())
+						Expect(storedClientKeyFile).To(BeEmpty())
+
+						settings := fakeActor.SetTargetArgsForCall(0)
+						Expect(settings.TLSConfig).To(BeNil())
+					})
+
+					Context("when targeting fails", func() {
+						BeforeEach(func() {
+							fakeActor.SetTargetReturns(nil, errors.New("some-error"))
+							fakeConfig.TLSCertificateFilesReturns("config-ca", "config-cert", "config-key")
+						})
+
+						It("restores the files stored in the config file, not the environment overrides", func() {
+							Expect(err).To(MatchError("some-error"))
+
+							Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(2))
+							storedCACertFile, storedClientCertFile, storedClientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(1)
+							Expect(storedCACertFile).To(Equal("config-ca"))
+							Expect(storedClientCertFile).To(Equal("config-cert"))
+							Expect(storedClientKeyFile).To(Equal("config-key"))
+						})
+					})
+				})
+
+				Context("when the configured files cannot be loaded", func() {
+					BeforeEach(func() {
+						fakeConfig.CACertFileReturns("/some/missing/ca.crt")
+					})
+
+					It("returns the error", func() {
+						Expect(err).To(BeAssignableToTypeOf(tlsconfig.CACertFileError{}))
+						Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
 		Context("when the API does not have SSL", func() {
 			var CCAPI string
 
@@ -5,6 +5,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -18,7 +19,8 @@ type AppActor interface {
 type AppCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
 	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
+	Watch           bool         `long:"watch" description:"Refresh the app's health and status until Ctrl-C is pressed, highlighting instances that crashed or restarted"`
+	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid | --watch]"`
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI          command.UI
@@ -42,6 +44,12 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Watch {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--guid", "--watch"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -81,6 +89,10 @@ func (cmd AppCommand) displayAppSummary() error {
 		})
 	cmd.UI.DisplayNewline()
 
+	if cmd.Watch {
+		return cmd.watchAppSummary()
+	}
+
 	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -91,3 +103,33 @@ func (cmd AppCommand) displayAppSummary() error {
 
 	return nil
 }
+
+func (cmd AppCommand) watchAppSummary() error {
+	var previous []v2action.ApplicationInstanceWithStats
+	return command.Watch(cmd.UI, cmd.Config, func(displayWarnings func([]string)) error {
+		appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		displayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		shared.DisplayAppSummary(cmd.UI, appSummary, false)
+
+		changes := v2action.ApplicationInstanceChangesSince(appSummary.RunningInstances, previous)
+		previous = appSummary.RunningInstances
+		if len(changes) > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		for _, change := range changes {
+			templateValues := map[string]interface{}{"Index": change.ID}
+			switch change.Type {
+			case v2action.InstanceCrashed:
+				cmd.UI.DisplayTextWithBold("instance #{{.Index}} crashed", templateValues)
+			case v2action.InstanceRestarted:
+				cmd.UI.DisplayTextWithBold("instance #{{.Index}} restarted", templateValues)
+			}
+		}
+
+		return nil
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/types"
@@ -405,5 +406,63 @@ var _ = Describe("App Command", func() {
 				})
 			})
 		})
+
+		Context("when the --watch flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Watch = true
+
+				summaryWithInstances := func(instances ...v2action.ApplicationInstanceWithStats) v2action.ApplicationSummary {
+					return v2action.ApplicationSummary{
+						Application: v2action.Application{
+							Name:  "some-app",
+							State: constant.ApplicationStarted,
+						},
+						RunningInstances: instances,
+					}
+				}
+
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(0,
+					summaryWithInstances(
+						v2action.ApplicationInstanceWithStats{ID: 0, State: v2action.ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+						v2action.ApplicationInstanceWithStats{ID: 1, State: v2action.ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 100},
+					), v2action.Warnings{"warning-1"}, nil)
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(1,
+					summaryWithInstances(
+						v2action.ApplicationInstanceWithStats{ID: 0, State: v2action.ApplicationInstanceState(constant.ApplicationInstanceRunning), Since: 200},
+						v2action.ApplicationInstanceWithStats{ID: 1, State: v2action.ApplicationInstanceState(constant.ApplicationInstanceCrashed)},
+					), v2action.Warnings{"warning-1"}, nil)
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(2,
+					v2action.ApplicationSummary{}, nil, errors.New("some-error"))
+			})
+
+			It("refreshes the summary and highlights crashed and restarted instances", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(3))
+
+				Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+				Expect(testUI.Out).To(Say("name:\\s+some-app"))
+				Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+				Expect(testUI.Out).To(Say("name:\\s+some-app"))
+				Expect(testUI.Out).To(Say("instance #0 restarted"))
+				Expect(testUI.Out).To(Say("instance #1 crashed"))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).ToNot(Say("warning-1"))
+			})
+
+			Context("when the --guid flag is also provided", func() {
+				BeforeEach(func() {
+					cmd.GUID = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--guid", "--watch"},
+					}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,279 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyOrgConfigActor
+
+type ApplyOrgConfigActor interface {
+	ApplyOrganizationConfigChanges(changes v2action.OrganizationConfigChanges) (v2action.OrganizationConfigChanges, v2action.Warnings, error)
+	GetOrganizationConfigChanges(desiredOrgs []orgconfig.Organization) ([]v2action.OrganizationConfigChanges, v2action.Warnings, error)
+	ReadOrgConfigFile(pathToFile string) ([]orgconfig.Organization, error)
+}
+
+//go:generate counterfeiter . ApplyOrgConfigActorV3
+
+type ApplyOrgConfigActorV3 interface {
+	ApplyOrganizationIsolationSegmentChanges(orgGUID string, changes v3action.OrganizationIsolationSegmentChanges, spaceGUIDs map[string]string) (v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetOrganizationIsolationSegmentChanges(orgGUID string, desiredOrg orgconfig.Organization, spaceGUIDs map[string]string) (v3action.OrganizationIsolationSegmentChanges, v3action.Warnings, error)
+}
+
+type ApplyOrgConfigCommand struct {
+	RequiredArgs    flag.ApplyOrgConfigArgs `positional-args:"yes"`
+	DryRun          bool                    `long:"dry-run" description:"Show the changes that would be made without applying them"`
+	usage           interface{}             `usage:"CF_NAME apply-org-config PATH_TO_FILE [--dry-run]\n\n   The file lists orgs, their spaces, quotas, isolation segments and user roles:\n\n   orgs:\n   - name: my-org\n     quota: default\n     isolation_segments: [my-segment]\n     default_isolation_segment: my-segment\n     managers: [alice]\n     billing_managers: [bob]\n     auditors: [carol]\n     spaces:\n     - name: my-space\n       quota: small\n       isolation_segment: my-segment\n       managers: [alice]\n       developers: [dave]\n       auditors: [carol]\n\n   Orgs and spaces that do not exist are created. Quotas and space quotas are referenced by name and must already exist. Space quotas belong to an org, so the space quotas of a new org are assigned after it is created; if they do not exist yet, create them with create-space-quota and run this command again. Users listed in any role are also made org users.\n   Changes are additive: roles, isolation segment entitlements and spaces that are not listed are left unchanged."`
+	relatedCommands interface{}             `related_commands:"create-org, create-space, enable-org-isolation, set-org-role, set-quota, set-space-quota, set-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyOrgConfigActor
+	ActorV3     ApplyOrgConfigActorV3
+}
+
+func (cmd *ApplyOrgConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd ApplyOrgConfigCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	desiredOrgs, err := cmd.Actor.ReadOrgConfigFile(string(cmd.RequiredArgs.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	err = cmd.checkIsolationSegmentSupport(desiredOrgs)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting org config changes from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.RequiredArgs.PathToFile,
+		"Username": user.Name,
+	})
+
+	allChanges, warnings, err := cmd.Actor.GetOrganizationConfigChanges(desiredOrgs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	allIsolationSegmentChanges := make([]v3action.OrganizationIsolationSegmentChanges, len(allChanges))
+	for i, changes := range allChanges {
+		if len(desiredOrgs[i].IsolationSegments) == 0 {
+			continue
+		}
+
+		isolationSegmentChanges, v3Warnings, err := cmd.ActorV3.GetOrganizationIsolationSegmentChanges(changes.GUID, desiredOrgs[i], spaceGUIDsByName(changes))
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return err
+		}
+		allIsolationSegmentChanges[i] = isolationSegmentChanges
+	}
+	cmd.UI.DisplayNewline()
+
+	var changedOrgs []int
+	for i, changes := range allChanges {
+		if changes.HasChanges() || allIsolationSegmentChanges[i].HasChanges() {
+			changedOrgs = append(changedOrgs, i)
+		}
+	}
+
+	if len(changedOrgs) == 0 {
+		cmd.UI.DisplayText("All orgs are up to date.")
+		return nil
+	}
+
+	for _, i := range changedOrgs {
+		err = cmd.displayOrganizationChanges(allChanges[i], allIsolationSegmentChanges[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("No changes were applied because --dry-run was provided.")
+		return nil
+	}
+
+	for _, i := range changedOrgs {
+		cmd.UI.DisplayTextWithFlavor("Applying changes to org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  allChanges[i].Name,
+			"Username": user.Name,
+		})
+
+		appliedChanges, warnings, err := cmd.Actor.ApplyOrganizationConfigChanges(allChanges[i])
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if allIsolationSegmentChanges[i].HasChanges() {
+			v3Warnings, err := cmd.ActorV3.ApplyOrganizationIsolationSegmentChanges(appliedChanges.GUID, allIsolationSegmentChanges[i], spaceGUIDsByName(appliedChanges))
+			cmd.UI.DisplayWarnings(v3Warnings)
+			if err != nil {
+				return err
+			}
+		}
+
+		cmd.UI.DisplayOK()
+	}
+
+	return nil
+}
+
+// checkIsolationSegmentSupport returns an error when the file configures
+// isolation segments and the targeted API does not support them.
+func (cmd ApplyOrgConfigCommand) checkIsolationSegmentSupport(desiredOrgs []orgconfig.Organization) error {
+	for _, org := range desiredOrgs {
+		if len(org.IsolationSegments) == 0 {
+			continue
+		}
+
+		if cmd.ActorV3 == nil {
+			return translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Configuring isolation segments",
+				MinimumVersion: ccversion.MinVersionIsolationSegmentV3,
+			}
+		}
+		return command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3, "Configuring isolation segments")
+	}
+
+	return nil
+}
+
+func (cmd ApplyOrgConfigCommand) displayOrganizationChanges(changes v2action.OrganizationConfigChanges, isolationSegmentChanges v3action.OrganizationIsolationSegmentChanges) error {
+	if changes.Create {
+		cmd.UI.DisplayText("Org {{.OrgName}} will be created:", map[string]interface{}{
+			"OrgName": changes.Name,
+		})
+	} else {
+		cmd.UI.DisplayText("Org {{.OrgName}} will be updated:", map[string]interface{}{
+			"OrgName": changes.Name,
+		})
+	}
+
+	var orgChanges []ui.Change
+	if changes.QuotaChanged() {
+		orgChanges = append(orgChanges, ui.Change{Header: "quota:", CurrentValue: changes.CurrentQuota, NewValue: changes.Quota})
+	}
+	if len(isolationSegmentChanges.Entitle) > 0 {
+		orgChanges = append(orgChanges, ui.Change{Header: "isolation segments:", CurrentValue: []string{}, NewValue: isolationSegmentChanges.Entitle})
+	}
+	if isolationSegmentChanges.DefaultChanged() {
+		orgChanges = append(orgChanges, ui.Change{Header: "default isolation segment:", CurrentValue: isolationSegmentChanges.CurrentDefault, NewValue: isolationSegmentChanges.Default})
+	}
+	if len(changes.RolesToAdd) > 0 {
+		var roles []string
+		for _, role := range changes.RolesToAdd {
+			roles = append(roles, fmt.Sprintf("%s (%s)", role.Username, role.Role))
+		}
+		orgChanges = append(orgChanges, ui.Change{Header: "roles:", CurrentValue: []string{}, NewValue: roles})
+	}
+
+	err := cmd.UI.DisplayChangesForPush(orgChanges)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	spaceIsolationSegments := map[string]v3action.SpaceIsolationSegmentChange{}
+	for _, space := range isolationSegmentChanges.Spaces {
+		spaceIsolationSegments[space.SpaceName] = space
+	}
+
+	for _, space := range changes.Spaces {
+		isolationSegment, isolationSegmentChanged := spaceIsolationSegments[space.Name]
+		if !space.HasChanges() && !isolationSegmentChanged {
+			continue
+		}
+
+		if space.Create {
+			cmd.UI.DisplayText("Space {{.SpaceName}} in org {{.OrgName}} will be created:", map[string]interface{}{
+				"SpaceName": space.Name,
+				"OrgName":   changes.Name,
+			})
+		} else {
+			cmd.UI.DisplayText("Space {{.SpaceName}} in org {{.OrgName}} will be updated:", map[string]interface{}{
+				"SpaceName": space.Name,
+				"OrgName":   changes.Name,
+			})
+		}
+
+		var spaceChanges []ui.Change
+		if space.QuotaChanged() {
+			spaceChanges = append(spaceChanges, ui.Change{Header: "quota:", CurrentValue: space.CurrentQuota, NewValue: space.Quota})
+		}
+		if isolationSegmentChanged {
+			spaceChanges = append(spaceChanges, ui.Change{Header: "isolation segment:", CurrentValue: isolationSegment.Current, NewValue: isolationSegment.Desired})
+		}
+		if len(space.RolesToAdd) > 0 {
+			var roles []string
+			for _, role := range space.RolesToAdd {
+				roles = append(roles, fmt.Sprintf("%s (%s)", role.Username, role.Role))
+			}
+			spaceChanges = append(spaceChanges, ui.Change{Header: "roles:", CurrentValue: []string{}, NewValue: roles})
+		}
+
+		err = cmd.UI.DisplayChangesForPush(spaceChanges)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+func spaceGUIDsByName(changes v2action.OrganizationConfigChanges) map[string]string {
+	spaceGUIDs := map[string]string{}
+	for _, space := range changes.Spaces {
+		if space.GUID != "" {
+			spaceGUIDs[space.Name] = space.GUID
+		}
+	}
+	return spaceGUIDs
+}
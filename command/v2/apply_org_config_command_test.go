@@ -0,0 +1,281 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-org-config Command", func() {
+	var (
+		cmd             ApplyOrgConfigCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyOrgConfigActor
+		fakeActorV3     *v2fakes.FakeApplyOrgConfigActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyOrgConfigActor)
+		fakeActorV3 = new(v2fakes.FakeApplyOrgConfigActorV3)
+
+		cmd = ApplyOrgConfigCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
+
+		cmd.RequiredArgs.PathToFile = "some-path"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when reading the file fails", func() {
+		BeforeEach(func() {
+			fakeActor.ReadOrgConfigFileReturns(nil, orgconfig.InvalidOrgConfigError{Message: "some-message"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(orgconfig.InvalidOrgConfigError{Message: "some-message"}))
+			Expect(fakeActor.ReadOrgConfigFileArgsForCall(0)).To(Equal("some-path"))
+			Expect(fakeActor.GetOrganizationConfigChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the file is read", func() {
+		var desiredOrgs []orgconfig.Organization
+
+		BeforeEach(func() {
+			desiredOrgs = []orgconfig.Organization{
+				{Name: "org-1", IsolationSegments: []string{"iso-1"}},
+				{Name: "org-2"},
+				{Name: "org-3"},
+			}
+			fakeActor.ReadOrgConfigFileReturns(desiredOrgs, nil)
+		})
+
+		Context("when the API does not support isolation segments", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Configuring isolation segments",
+					CurrentVersion: "3.0.0",
+					MinimumVersion: ccversion.MinVersionIsolationSegmentV3,
+				}))
+				Expect(fakeActor.GetOrganizationConfigChangesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the changes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationConfigChangesReturns(nil, v2action.Warnings{"changes-warning"}, errors.New("changes-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("changes-error"))
+				Expect(testUI.Err).To(Say("changes-warning"))
+			})
+		})
+
+		Context("when nothing changed", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationConfigChangesReturns(
+					[]v2action.OrganizationConfigChanges{{Name: "org-1", GUID: "org-1-guid"}, {Name: "org-2", GUID: "org-2-guid"}, {Name: "org-3", GUID: "org-3-guid"}},
+					nil,
+					nil)
+				fakeActorV3.GetOrganizationIsolationSegmentChangesReturns(v3action.OrganizationIsolationSegmentChanges{OrganizationName: "org-1"}, nil, nil)
+			})
+
+			It("displays that the orgs are up to date", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("All orgs are up to date."))
+				Expect(fakeActor.ApplyOrganizationConfigChangesCallCount()).To(Equal(0))
+
+				Expect(fakeActorV3.GetOrganizationIsolationSegmentChangesCallCount()).To(Equal(1))
+				orgGUID, desiredOrg, _ := fakeActorV3.GetOrganizationIsolationSegmentChangesArgsForCall(0)
+				Expect(orgGUID).To(Equal("org-1-guid"))
+				Expect(desiredOrg).To(Equal(desiredOrgs[0]))
+			})
+		})
+
+		Context("when there are changes", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationConfigChangesReturns(
+					[]v2action.OrganizationConfigChanges{
+						{
+							Name:      "org-1",
+							Create:    true,
+							Quota:     "large",
+							QuotaGUID: "large-guid",
+							RolesToAdd: []v2action.OrganizationUserRole{
+								{Username: "alice", Role: constant.OrgUserRole},
+								{Username: "alice", Role: constant.OrgManagerRole},
+							},
+							Spaces: []v2action.SpaceConfigChanges{
+								{Name: "space-1", Create: true, RolesToAdd: []v2action.SpaceUserRole{{Username: "alice", Role: constant.SpaceDeveloperRole}}},
+							},
+						},
+						{
+							Name:         "org-2",
+							GUID:         "org-2-guid",
+							CurrentQuota: "default",
+							Quota:        "default",
+							Spaces: []v2action.SpaceConfigChanges{
+								{Name: "space-2", GUID: "space-2-guid", CurrentQuota: "small", Quota: "medium"},
+								{Name: "space-3", GUID: "space-3-guid"},
+							},
+						},
+						{Name: "org-3", GUID: "org-3-guid"},
+					},
+					v2action.Warnings{"changes-warning"},
+					nil)
+				fakeActorV3.GetOrganizationIsolationSegmentChangesReturns(
+					v3action.OrganizationIsolationSegmentChanges{
+						OrganizationName: "org-1",
+						Entitle:          []string{"iso-1"},
+						Default:          "iso-1",
+						Spaces:           []v3action.SpaceIsolationSegmentChange{{SpaceName: "space-1", Desired: "iso-1"}},
+					},
+					v3action.Warnings{"isolation-segment-changes-warning"},
+					nil)
+				fakeActor.ApplyOrganizationConfigChangesStub = func(changes v2action.OrganizationConfigChanges) (v2action.OrganizationConfigChanges, v2action.Warnings, error) {
+					if changes.Create {
+						changes.GUID = "org-1-guid"
+						changes.Spaces[0].GUID = "space-1-guid"
+					}
+					return changes, v2action.Warnings{"apply-warning"}, nil
+				}
+				fakeActorV3.ApplyOrganizationIsolationSegmentChangesReturns(v3action.Warnings{"apply-isolation-segments-warning"}, nil)
+			})
+
+			It("displays the changes and applies them to the changed orgs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting org config changes from some-path as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("Org org-1 will be created:"))
+				Expect(testUI.Out).To(Say(`\+ quota:\s+large`))
+				Expect(testUI.Out).To(Say(`isolation segments:`))
+				Expect(testUI.Out).To(Say(`\+\s+iso-1`))
+				Expect(testUI.Out).To(Say(`\+ default isolation segment:\s+iso-1`))
+				Expect(testUI.Out).To(Say(`roles:`))
+				Expect(testUI.Out).To(Say(`\+\s+alice \(OrgManager\)`))
+				Expect(testUI.Out).To(Say(`\+\s+alice \(OrgUser\)`))
+				Expect(testUI.Out).To(Say("Space space-1 in org org-1 will be created:"))
+				Expect(testUI.Out).To(Say(`\+ isolation segment:\s+iso-1`))
+				Expect(testUI.Out).To(Say(`\+\s+alice \(SpaceDeveloper\)`))
+				Expect(testUI.Out).To(Say("Org org-2 will be updated:"))
+				Expect(testUI.Out).To(Say("Space space-2 in org org-2 will be updated:"))
+				Expect(testUI.Out).To(Say(`- quota:\s+small`))
+				Expect(testUI.Out).To(Say(`\+ quota:\s+medium`))
+				Expect(testUI.Out).ToNot(Say("space-3"))
+				Expect(testUI.Out).ToNot(Say("org-3"))
+
+				Expect(testUI.Out).To(Say(`Applying changes to org org-1 as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`Applying changes to org org-2 as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("changes-warning"))
+				Expect(testUI.Err).To(Say("isolation-segment-changes-warning"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(testUI.Err).To(Say("apply-isolation-segments-warning"))
+
+				Expect(fakeActor.ApplyOrganizationConfigChangesCallCount()).To(Equal(2))
+				Expect(fakeActor.ApplyOrganizationConfigChangesArgsForCall(1).Name).To(Equal("org-2"))
+
+				Expect(fakeActorV3.ApplyOrganizationIsolationSegmentChangesCallCount()).To(Equal(1))
+				orgGUID, isolationSegmentChanges, spaceGUIDs := fakeActorV3.ApplyOrganizationIsolationSegmentChangesArgsForCall(0)
+				Expect(orgGUID).To(Equal("org-1-guid"))
+				Expect(isolationSegmentChanges.OrganizationName).To(Equal("org-1"))
+				Expect(spaceGUIDs).To(Equal(map[string]string{"space-1": "space-1-guid"}))
+			})
+
+			Context("when --dry-run is provided", func() {
+				BeforeEach(func() {
+					cmd.DryRun = true
+				})
+
+				It("displays the changes without applying them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Org org-1 will be created:"))
+					Expect(testUI.Out).To(Say("No changes were applied because --dry-run was provided."))
+					Expect(fakeActor.ApplyOrganizationConfigChangesCallCount()).To(Equal(0))
+					Expect(fakeActorV3.ApplyOrganizationIsolationSegmentChangesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when applying the changes fails", func() {
+				BeforeEach(func() {
+					fakeActor.ApplyOrganizationConfigChangesStub = nil
+					fakeActor.ApplyOrganizationConfigChangesReturns(v2action.OrganizationConfigChanges{}, v2action.Warnings{"apply-warning"}, errors.New("apply-error"))
+				})
+
+				It("returns the error and stops", func() {
+					Expect(executeErr).To(MatchError("apply-error"))
+					Expect(testUI.Err).To(Say("apply-warning"))
+					Expect(fakeActor.ApplyOrganizationConfigChangesCallCount()).To(Equal(1))
+					Expect(fakeActorV3.ApplyOrganizationIsolationSegmentChangesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the v3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Configuring isolation segments",
+					MinimumVersion: ccversion.MinVersionIsolationSegmentV3,
+				}))
+			})
+		})
+	})
+})
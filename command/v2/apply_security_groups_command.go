@@ -0,0 +1,187 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplySecurityGroupsActor
+
+type ApplySecurityGroupsActor interface {
+	ApplySecurityGroupChanges(changes v2action.SecurityGroupChanges) (v2action.Warnings, error)
+	GetSecurityGroupsChanges(desiredSecurityGroups []securitygroups.SecurityGroup) ([]v2action.SecurityGroupChanges, v2action.Warnings, error)
+	ReadSecurityGroupsFile(pathToFile string) ([]securitygroups.SecurityGroup, error)
+}
+
+type ApplySecurityGroupsCommand struct {
+	RequiredArgs    flag.ApplySecurityGroupsArgs `positional-args:"yes"`
+	DryRun          bool                         `long:"dry-run" description:"Show the changes that would be made without applying them"`
+	usage           interface{}                  `usage:"CF_NAME apply-security-groups PATH_TO_FILE [--dry-run]\n\n   The file lists security groups, their rules and the spaces they are bound to:\n\n   security_groups:\n   - name: public-networks\n     rules:\n     - protocol: tcp\n       destination: 10.0.0.0-10.255.255.255\n       ports: \"443\"\n       description: \"Allow https\"\n     running_spaces:\n     - org: my-org\n       space: my-space\n     staging_spaces:\n     - org: my-org\n       space: my-space\n\n   Security groups that do not exist are created. The rules and space bindings of the listed security groups are replaced; security groups that are not listed are left unchanged.\n\nTIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications."`
+	relatedCommands interface{}                  `related_commands:"bind-security-group, create-security-group, security-groups, unbind-security-group, update-security-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplySecurityGroupsActor
+}
+
+func (cmd *ApplySecurityGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ApplySecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	desiredSecurityGroups, err := cmd.Actor.ReadSecurityGroupsFile(string(cmd.RequiredArgs.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting security group changes from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.RequiredArgs.PathToFile,
+		"Username": user.Name,
+	})
+
+	allChanges, warnings, err := cmd.Actor.GetSecurityGroupsChanges(desiredSecurityGroups)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	var changedSecurityGroups []v2action.SecurityGroupChanges
+	for _, changes := range allChanges {
+		if changes.HasChanges() {
+			changedSecurityGroups = append(changedSecurityGroups, changes)
+		}
+	}
+
+	if len(changedSecurityGroups) == 0 {
+		cmd.UI.DisplayText("All security groups are up to date.")
+		return nil
+	}
+
+	for _, changes := range changedSecurityGroups {
+		err = cmd.displaySecurityGroupChanges(changes)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("No changes were applied because --dry-run was provided.")
+		return nil
+	}
+
+	for _, changes := range changedSecurityGroups {
+		cmd.UI.DisplayTextWithFlavor("Applying changes to security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+			"SecurityGroupName": changes.Name,
+			"Username":          user.Name,
+		})
+
+		warnings, err = cmd.Actor.ApplySecurityGroupChanges(changes)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications.")
+
+	return nil
+}
+
+func (cmd ApplySecurityGroupsCommand) displaySecurityGroupChanges(changes v2action.SecurityGroupChanges) error {
+	if changes.Create {
+		cmd.UI.DisplayText("Security group {{.SecurityGroupName}} will be created:", map[string]interface{}{
+			"SecurityGroupName": changes.Name,
+		})
+	} else {
+		cmd.UI.DisplayText("Security group {{.SecurityGroupName}} will be updated:", map[string]interface{}{
+			"SecurityGroupName": changes.Name,
+		})
+	}
+
+	return cmd.UI.DisplayChangesForPush([]ui.Change{
+		{
+			Header:       "rules:",
+			CurrentValue: securityGroupRulesToStrings(changes.CurrentRules),
+			NewValue:     securityGroupRulesToStrings(changes.Rules),
+		},
+		{
+			Header:       "running spaces:",
+			CurrentValue: securityGroupBindingsToStrings(changes.CurrentBindings, constant.SecurityGroupLifecycleRunning),
+			NewValue:     securityGroupBindingsToStrings(changes.Bindings, constant.SecurityGroupLifecycleRunning),
+		},
+		{
+			Header:       "staging spaces:",
+			CurrentValue: securityGroupBindingsToStrings(changes.CurrentBindings, constant.SecurityGroupLifecycleStaging),
+			NewValue:     securityGroupBindingsToStrings(changes.Bindings, constant.SecurityGroupLifecycleStaging),
+		},
+	})
+}
+
+func securityGroupRulesToStrings(rules []ccv2.SecurityGroupRule) []string {
+	ruleStrings := []string{}
+	for _, rule := range rules {
+		ruleString := fmt.Sprintf("%s %s", rule.Protocol, rule.Destination)
+		if rule.Ports != "" {
+			ruleString = fmt.Sprintf("%s ports %s", ruleString, rule.Ports)
+		}
+		if rule.Type.IsSet {
+			ruleString = fmt.Sprintf("%s type %d", ruleString, rule.Type.Value)
+		}
+		if rule.Code.IsSet {
+			ruleString = fmt.Sprintf("%s code %d", ruleString, rule.Code.Value)
+		}
+		if rule.Log.Value {
+			ruleString = fmt.Sprintf("%s log", ruleString)
+		}
+		if rule.Description != "" {
+			ruleString = fmt.Sprintf("%s (%s)", ruleString, rule.Description)
+		}
+		ruleStrings = append(ruleStrings, ruleString)
+	}
+	return ruleStrings
+}
+
+func securityGroupBindingsToStrings(bindings []v2action.SecurityGroupSpaceBinding, lifecycle constant.SecurityGroupLifecycle) []string {
+	bindingStrings := []string{}
+	for _, binding := range bindings {
+		if binding.Lifecycle == lifecycle {
+			bindingStrings = append(bindingStrings, fmt.Sprintf("%s/%s", binding.OrganizationName, binding.SpaceName))
+		}
+	}
+	return bindingStrings
+}
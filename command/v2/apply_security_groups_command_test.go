@@ -0,0 +1,208 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-security-groups Command", func() {
+	var (
+		cmd             ApplySecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplySecurityGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplySecurityGroupsActor)
+
+		cmd = ApplySecurityGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		cmd.RequiredArgs.PathToFile = "some-path"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when reading the file fails", func() {
+		BeforeEach(func() {
+			fakeActor.ReadSecurityGroupsFileReturns(nil, securitygroups.InvalidSecurityGroupsError{Message: "some-message"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(securitygroups.InvalidSecurityGroupsError{Message: "some-message"}))
+			Expect(fakeActor.ReadSecurityGroupsFileArgsForCall(0)).To(Equal("some-path"))
+			Expect(fakeActor.GetSecurityGroupsChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the file is read", func() {
+		var desiredSecurityGroups []securitygroups.SecurityGroup
+
+		BeforeEach(func() {
+			desiredSecurityGroups = []securitygroups.SecurityGroup{{Name: "group-1"}, {Name: "group-2"}, {Name: "group-3"}}
+			fakeActor.ReadSecurityGroupsFileReturns(desiredSecurityGroups, nil)
+		})
+
+		Context("when getting the changes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupsChangesReturns(nil, v2action.Warnings{"changes-warning"}, errors.New("changes-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("changes-error"))
+				Expect(testUI.Err).To(Say("changes-warning"))
+			})
+		})
+
+		Context("when nothing changed", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupsChangesReturns(
+					[]v2action.SecurityGroupChanges{{Name: "group-1", GUID: "group-1-guid"}},
+					nil,
+					nil)
+			})
+
+			It("displays that the security groups are up to date", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("All security groups are up to date."))
+				Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there are changes", func() {
+			var allChanges []v2action.SecurityGroupChanges
+
+			BeforeEach(func() {
+				allChanges = []v2action.SecurityGroupChanges{
+					{
+						Name:   "group-1",
+						Create: true,
+						Rules:  []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https"}},
+						Bindings: []v2action.SecurityGroupSpaceBinding{
+							{OrganizationName: "org-1", SpaceName: "space-1", SpaceGUID: "space-1-guid", Lifecycle: constant.SecurityGroupLifecycleRunning},
+						},
+					},
+					{
+						Name:         "group-2",
+						GUID:         "group-2-guid",
+						CurrentRules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+						Rules:        []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+						CurrentBindings: []v2action.SecurityGroupSpaceBinding{
+							{OrganizationName: "org-1", SpaceName: "space-2", SpaceGUID: "space-2-guid", Lifecycle: constant.SecurityGroupLifecycleStaging},
+						},
+					},
+					{
+						Name: "group-3",
+						GUID: "group-3-guid",
+					},
+				}
+				fakeActor.GetSecurityGroupsChangesReturns(allChanges, v2action.Warnings{"changes-warning"}, nil)
+				fakeActor.ApplySecurityGroupChangesReturns(v2action.Warnings{"apply-warning"}, nil)
+			})
+
+			It("displays the changes of the changed security groups", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting security group changes from some-path as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("Security group group-1 will be created:"))
+				Expect(testUI.Out).To(Say(`rules:`))
+				Expect(testUI.Out).To(Say(`\+\s+tcp 10\.0\.0\.0/8 ports 443 \(https\)`))
+				Expect(testUI.Out).To(Say(`running spaces:`))
+				Expect(testUI.Out).To(Say(`\+\s+org-1/space-1`))
+				Expect(testUI.Out).To(Say("Security group group-2 will be updated:"))
+				Expect(testUI.Out).To(Say(`\s+all 0\.0\.0\.0/0`))
+				Expect(testUI.Out).To(Say(`staging spaces:`))
+				Expect(testUI.Out).To(Say(`-\s+org-1/space-2`))
+				Expect(testUI.Out).ToNot(Say("group-3"))
+				Expect(testUI.Err).To(Say("changes-warning"))
+
+				Expect(fakeActor.GetSecurityGroupsChangesArgsForCall(0)).To(Equal(desiredSecurityGroups))
+			})
+
+			It("applies the changes of the changed security groups", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Applying changes to security group group-1 as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Applying changes to security group group-2 as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Changes require an app restart"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+
+				Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(2))
+				Expect(fakeActor.ApplySecurityGroupChangesArgsForCall(0)).To(Equal(allChanges[0]))
+				Expect(fakeActor.ApplySecurityGroupChangesArgsForCall(1)).To(Equal(allChanges[1]))
+			})
+
+			Context("when applying the changes fails", func() {
+				BeforeEach(func() {
+					fakeActor.ApplySecurityGroupChangesReturns(v2action.Warnings{"apply-warning"}, errors.New("apply-error"))
+				})
+
+				It("stops at the first error", func() {
+					Expect(executeErr).To(MatchError("apply-error"))
+					Expect(testUI.Err).To(Say("apply-warning"))
+					Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when --dry-run is provided", func() {
+				BeforeEach(func() {
+					cmd.DryRun = true
+				})
+
+				It("displays the changes without applying them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Security group group-1 will be created:"))
+					Expect(testUI.Out).To(Say("No changes were applied because --dry-run was provided."))
+					Expect(fakeActor.ApplySecurityGroupChangesCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
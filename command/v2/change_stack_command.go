@@ -0,0 +1,222 @@
+package v2
+
+import (
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ChangeStackActor
+
+type ChangeStackActor interface {
+	AppActor
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	RestageApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	RestartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+}
+
+//go:generate counterfeiter . ChangeStackActorV3
+
+type ChangeStackActorV3 interface {
+	CloudControllerAPIVersion() string
+	GetCurrentDropletByApplication(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (v3action.Warnings, error)
+}
+
+type ChangeStackCommand struct {
+	RequiredArgs        flag.ChangeStackArgs `positional-args:"yes"`
+	usage               interface{}          `usage:"CF_NAME change-stack APP_NAME NEW_STACK\n\n   Restages the app on the new stack. If staging or starting the app fails, the app is moved back to its previous stack and droplet.\n\nEXAMPLES:\n   CF_NAME change-stack my-app cflinuxfs3"`
+	relatedCommands     interface{}          `related_commands:"restage, stack-audit, stacks"`
+	envCFStagingTimeout interface{}          `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ChangeStackActor
+	ActorV3     ChangeStackActorV3
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *ChangeStackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd ChangeStackCommand) Execute(args []string) error {
+	// the previous droplet can only be restored through the V3 API
+	if cmd.ActorV3 == nil {
+		return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+	}
+	err := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	newStack, warnings, err := cmd.Actor.GetStackByName(cmd.RequiredArgs.StackName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.StackGUID == newStack.GUID {
+		cmd.UI.DisplayText("App {{.AppName}} is already on stack {{.StackName}}.", map[string]interface{}{
+			"AppName":   app.Name,
+			"StackName": newStack.Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	oldStack, warnings, err := cmd.Actor.GetStack(app.StackGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		// the app's stack may have been deleted, which is a common reason to
+		// move it to another one
+		if _, ok := err.(actionerror.StackNotFoundError); !ok {
+			return err
+		}
+		oldStack.Name = cmd.UI.TranslateText("unknown")
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Changing stack of app {{.AppName}} from {{.OldStack}} to {{.NewStack}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     app.Name,
+			"OldStack":    oldStack.Name,
+			"NewStack":    newStack.Name,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	droplet, v3Warnings, err := cmd.ActorV3.GetCurrentDropletByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(v3Warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); !ok {
+			return err
+		}
+	}
+
+	updatedApp, warnings, err := cmd.Actor.UpdateApplication(v2action.Application{
+		GUID:      app.GUID,
+		StackGUID: newStack.GUID,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(updatedApp, cmd.NOAAClient)
+	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	if err != nil {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("Failed to restage app {{.AppName}} on stack {{.NewStack}}. Rolling back to stack {{.OldStack}}...", map[string]interface{}{
+			"AppName":  app.Name,
+			"NewStack": newStack.Name,
+			"OldStack": oldStack.Name,
+		})
+
+		rollbackErr := cmd.rollback(app, droplet)
+		if rollbackErr != nil {
+			cmd.UI.DisplayWarning("Rollback failed: {{.Error}}", map[string]interface{}{
+				"Error": rollbackErr.Error(),
+			})
+		} else {
+			cmd.UI.DisplayText("App {{.AppName}} was rolled back to stack {{.OldStack}}.", map[string]interface{}{
+				"AppName":  app.Name,
+				"OldStack": oldStack.Name,
+			})
+		}
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(app.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+	return nil
+}
+
+// rollback moves the app back to the stack it was on before the change and
+// makes its previous droplet current again. The app is restarted when it was
+// started before the change.
+func (cmd ChangeStackCommand) rollback(app v2action.Application, droplet v3action.Droplet) error {
+	rolledBackApp, warnings, err := cmd.Actor.UpdateApplication(v2action.Application{
+		GUID:      app.GUID,
+		StackGUID: app.StackGUID,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if droplet.GUID != "" {
+		v3Warnings, err := cmd.ActorV3.SetApplicationDroplet(app.GUID, droplet.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !app.Started() || droplet.GUID == "" {
+		return nil
+	}
+
+	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestartApplication(rolledBackApp, cmd.NOAAClient)
+	return shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+}
@@ -0,0 +1,320 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("change-stack Command", func() {
+	var (
+		cmd             ChangeStackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeChangeStackActor
+		fakeActorV3     *v2fakes.FakeChangeStackActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	// startStreams returns the channels of a restage or restart that ends
+	// with err, or succeeds when err is nil.
+	startStreams := func(err error) func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+		return func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appState := make(chan v2action.ApplicationStateChange)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				appState <- v2action.ApplicationStateStaging
+				if err != nil {
+					errs <- err
+				}
+				close(messages)
+				close(logErrs)
+				close(appState)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appState, warnings, errs
+		}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeChangeStackActor)
+		fakeActorV3 = new(v2fakes.FakeChangeStackActorV3)
+
+		cmd = ChangeStackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.StackName = "new-stack"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: constant.ApplicationStarted},
+			v2action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetStackByNameReturns(v2action.Stack{GUID: "new-stack-guid", Name: "new-stack"}, v2action.Warnings{"get-new-stack-warning"}, nil)
+		fakeActor.GetStackReturns(v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"}, v2action.Warnings{"get-old-stack-warning"}, nil)
+		fakeActorV3.GetCurrentDropletByApplicationReturns(v3action.Droplet{GUID: "old-droplet-guid"}, v3action.Warnings{"get-droplet-warning"}, nil)
+		fakeActor.UpdateApplicationStub = func(app v2action.Application) (v2action.Application, v2action.Warnings, error) {
+			app.State = constant.ApplicationStarted
+			return app, v2action.Warnings{"update-app-warning"}, nil
+		}
+		fakeActor.RestageApplicationStub = startStreams(nil)
+		fakeActor.RestartApplicationStub = startStreams(nil)
+		fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+			v2action.ApplicationSummary{Application: v2action.Application{Name: "some-app"}},
+			v2action.Warnings{"summary-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the V3 API is not available", func() {
+		BeforeEach(func() {
+			cmd.ActorV3 = nil
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when the V3 API is below the minimum version", func() {
+		BeforeEach(func() {
+			fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(v2action.Stack{}, v2action.Warnings{"get-new-stack-warning"}, actionerror.StackNotFoundError{Name: "new-stack"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "new-stack"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-new-stack-warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is already on the stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"}, nil, nil)
+		})
+
+		It("does not restage the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is already on stack old-stack\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app's current stack no longer exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackReturns(v2action.Stack{}, v2action.Warnings{"get-old-stack-warning"}, actionerror.StackNotFoundError{GUID: "old-stack-guid"})
+		})
+
+		It("displays the old stack as unknown and changes the stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Changing stack of app some-app from unknown to new-stack in org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Err).To(Say("get-old-stack-warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the app's current stack fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackReturns(v2action.Stack{}, nil, errors.New("get-stack-error"))
+		})
+
+		It("returns the error without changing the stack", func() {
+			Expect(executeErr).To(MatchError("get-stack-error"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when restaging on the new stack succeeds", func() {
+		It("updates the stack, restages the app and displays the summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Changing stack of app some-app from old-stack to new-stack in org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Staging app and tracing logs\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name:\\s+some-app"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-new-stack-warning"))
+			Expect(testUI.Err).To(Say("get-old-stack-warning"))
+			Expect(testUI.Err).To(Say("get-droplet-warning"))
+			Expect(testUI.Err).To(Say("update-app-warning"))
+			Expect(testUI.Err).To(Say("summary-warning"))
+
+			Expect(fakeActorV3.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "new-stack-guid",
+			}))
+
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+			app, _ := fakeActor.RestageApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+
+			Expect(fakeActorV3.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when restaging on the new stack fails", func() {
+		BeforeEach(func() {
+			fakeActor.RestageApplicationStub = startStreams(actionerror.StagingFailedError{Reason: "no buildpack for stack"})
+		})
+
+		It("rolls back to the old stack and droplet and returns the staging error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StagingFailedError{Message: "no buildpack for stack"}))
+
+			Expect(testUI.Err).To(Say("Failed to restage app some-app on stack new-stack\\. Rolling back to stack old-stack\\.\\.\\."))
+			Expect(testUI.Out).To(Say("App some-app was rolled back to stack old-stack\\."))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "old-stack-guid",
+			}))
+
+			Expect(fakeActorV3.SetApplicationDropletCallCount()).To(Equal(1))
+			appGUID, dropletGUID := fakeActorV3.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(Equal("old-droplet-guid"))
+
+			Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+			app, _ := fakeActor.RestartApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+		})
+
+		Context("when the app was stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: constant.ApplicationStopped},
+					nil, nil)
+			})
+
+			It("does not restart the app", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeActorV3.SetApplicationDropletCallCount()).To(Equal(1))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app did not have a droplet", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetCurrentDropletByApplicationReturns(v3action.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+			})
+
+			It("only restores the stack", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActorV3.SetApplicationDropletCallCount()).To(Equal(0))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the rollback fails", func() {
+			BeforeEach(func() {
+				fakeActorV3.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, errors.New("set droplet failed"))
+			})
+
+			It("displays the rollback error and returns the staging error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StagingFailedError{Message: "no buildpack for stack"}))
+				Expect(testUI.Err).To(Say("set-droplet-warning"))
+				Expect(testUI.Err).To(Say("Rollback failed: set droplet failed"))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the app fails to start on the new stack", func() {
+		BeforeEach(func() {
+			fakeActor.RestageApplicationStub = startStreams(actionerror.ApplicationInstanceCrashedError{Name: "some-app"})
+		})
+
+		It("rolls back to the old droplet", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+			Expect(fakeActorV3.SetApplicationDropletCallCount()).To(Equal(1))
+			Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the current droplet fails", func() {
+		BeforeEach(func() {
+			fakeActorV3.GetCurrentDropletByApplicationReturns(v3action.Droplet{}, nil, errors.New("droplet error"))
+		})
+
+		It("returns the error without changing the stack", func() {
+			Expect(executeErr).To(MatchError("droplet error"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"net"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . CheckEgressActor
+
+type CheckEgressActor interface {
+	GetSpaceRunningSecurityGroupRules(spaceGUID string) ([]v2action.SecurityGroupEgressRule, v2action.Warnings, error)
+}
+
+type CheckEgressCommand struct {
+	RequiredArgs    flag.CheckEgressArgs `positional-args:"yes"`
+	Protocol        string               `long:"protocol" choice:"tcp" choice:"udp" default:"tcp" description:"Protocol of the traffic"`
+	usage           interface{}          `usage:"CF_NAME check-egress HOST PORT [--protocol (tcp | udp)]\n\n   Checks whether the running security groups of the targeted space, including the platform wide running security groups, allow apps to reach HOST on PORT.\n   Hostnames are resolved on this machine, which may differ from how they resolve inside app containers.\n\nEXAMPLES:\n   CF_NAME check-egress 10.0.11.20 5432\n   CF_NAME check-egress dns.example.com 53 --protocol udp"`
+	relatedCommands interface{}          `related_commands:"bind-security-group, running-security-groups, security-groups, space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CheckEgressActor
+
+	// LookupIP resolves hostnames. It defaults to net.LookupIP.
+	LookupIP func(host string) ([]net.IP, error)
+}
+
+func (cmd *CheckEgressCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+	cmd.LookupIP = net.LookupIP
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd CheckEgressCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	destination := net.JoinHostPort(cmd.RequiredArgs.Host, strconv.Itoa(cmd.RequiredArgs.Port))
+	cmd.UI.DisplayTextWithFlavor("Checking {{.Protocol}} egress to {{.Destination}} from space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Protocol":    cmd.Protocol,
+		"Destination": destination,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"Username":    user.Name,
+	})
+
+	ips, err := cmd.resolveHost()
+	if err != nil {
+		return err
+	}
+
+	allRules, warnings, err := cmd.Actor.GetSpaceRunningSecurityGroupRules(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	allowed := true
+	for _, ip := range ips {
+		cmd.UI.DisplayNewline()
+
+		var rules []v2action.SecurityGroupEgressRule
+		for _, rule := range allRules {
+			if rule.Rule.Allows(cmd.Protocol, ip, cmd.RequiredArgs.Port) {
+				rules = append(rules, rule)
+			}
+		}
+
+		if len(rules) == 0 {
+			allowed = false
+			cmd.UI.DisplayText("{{.IP}}: no running security group rule allows this traffic.", map[string]interface{}{
+				"IP": ip.String(),
+			})
+			continue
+		}
+
+		cmd.UI.DisplayText("{{.IP}}: allowed by", map[string]interface{}{
+			"IP": ip.String(),
+		})
+		table := [][]string{
+			{
+				cmd.UI.TranslateText("security group"),
+				cmd.UI.TranslateText("protocol"),
+				cmd.UI.TranslateText("destination"),
+				cmd.UI.TranslateText("ports"),
+				cmd.UI.TranslateText("description"),
+			},
+		}
+		for _, rule := range rules {
+			table = append(table, []string{
+				rule.SecurityGroupName,
+				rule.Rule.Protocol,
+				rule.Rule.Destination,
+				rule.Rule.Ports,
+				rule.Rule.Description,
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
+	if !allowed {
+		return translatableerror.EgressNotAllowedError{
+			Destination: destination,
+			SpaceName:   cmd.Config.TargetedSpace().Name,
+		}
+	}
+
+	return nil
+}
+
+// resolveHost returns the IPv4 addresses of the host argument, which is
+// either an IP address or a hostname.
+func (cmd CheckEgressCommand) resolveHost() ([]net.IP, error) {
+	if ip := net.ParseIP(cmd.RequiredArgs.Host); ip != nil {
+		if ip.To4() == nil {
+			return nil, translatableerror.NoIPv4AddressError{Host: cmd.RequiredArgs.Host}
+		}
+		return []net.IP{ip}, nil
+	}
+
+	resolvedIPs, err := cmd.LookupIP(cmd.RequiredArgs.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	var ipv4s []net.IP
+	for _, ip := range resolvedIPs {
+		if ip.To4() != nil {
+			ipv4s = append(ipv4s, ip)
+		}
+	}
+	if len(ipv4s) == 0 {
+		return nil, translatableerror.NoIPv4AddressError{Host: cmd.RequiredArgs.Host}
+	}
+
+	return ipv4s, nil
+}
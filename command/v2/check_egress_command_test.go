@@ -0,0 +1,184 @@
+package v2_test
+
+import (
+	"errors"
+	"net"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/securitygroups"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("check-egress Command", func() {
+	var (
+		cmd             CheckEgressCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCheckEgressActor
+		lookedUpHosts   []string
+		lookupIPs       []net.IP
+		lookupErr       error
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCheckEgressActor)
+		lookedUpHosts = nil
+		lookupIPs = nil
+		lookupErr = nil
+
+		cmd = CheckEgressCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			LookupIP: func(host string) ([]net.IP, error) {
+				lookedUpHosts = append(lookedUpHosts, host)
+				return lookupIPs, lookupErr
+			},
+			Protocol: "tcp",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		cmd.RequiredArgs.Host = "10.0.0.5"
+		cmd.RequiredArgs.Port = 443
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when a rule allows the traffic", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceRunningSecurityGroupRulesReturns(
+				[]v2action.SecurityGroupEgressRule{
+					{SecurityGroupName: "some-group", Rule: securitygroups.Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https"}},
+					{SecurityGroupName: "other-group", Rule: securitygroups.Rule{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "443"}},
+				},
+				v2action.Warnings{"egress-warning"},
+				nil)
+		})
+
+		It("displays the matching rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Checking tcp egress to 10\.0\.0\.5:443 from space some-space in org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`10\.0\.0\.5: allowed by`))
+			Expect(testUI.Out).To(Say(`security group\s+protocol\s+destination\s+ports\s+description`))
+			Expect(testUI.Out).To(Say(`some-group\s+tcp\s+10\.0\.0\.0/8\s+443\s+https`))
+			Expect(testUI.Out).ToNot(Say("other-group"))
+			Expect(testUI.Err).To(Say("egress-warning"))
+
+			Expect(lookedUpHosts).To(BeEmpty())
+			Expect(fakeActor.GetSpaceRunningSecurityGroupRulesArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when no rule allows the traffic", func() {
+		It("returns an EgressNotAllowedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.EgressNotAllowedError{
+				Destination: "10.0.0.5:443",
+				SpaceName:   "some-space",
+			}))
+			Expect(testUI.Out).To(Say(`10\.0\.0\.5: no running security group rule allows this traffic\.`))
+		})
+	})
+
+	Context("when getting the rules fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceRunningSecurityGroupRulesReturns(nil, v2action.Warnings{"egress-warning"}, errors.New("egress-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("egress-error"))
+			Expect(testUI.Err).To(Say("egress-warning"))
+		})
+	})
+
+	Context("when the host is a hostname", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Host = "db.example.com"
+			lookupIPs = []net.IP{net.ParseIP("::1"), net.ParseIP("10.0.0.5"), net.ParseIP("10.0.0.6")}
+			fakeActor.GetSpaceRunningSecurityGroupRulesReturns(
+				[]v2action.SecurityGroupEgressRule{
+					{SecurityGroupName: "some-group", Rule: securitygroups.Rule{Protocol: "tcp", Destination: "10.0.0.5", Ports: "443"}},
+				}, nil, nil)
+		})
+
+		It("checks every IPv4 address the hostname resolves to against the rules fetched once", func() {
+			Expect(lookedUpHosts).To(ConsistOf("db.example.com"))
+			Expect(fakeActor.GetSpaceRunningSecurityGroupRulesCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say(`10\.0\.0\.5: allowed by`))
+			Expect(testUI.Out).To(Say(`10\.0\.0\.6: no running security group rule allows this traffic\.`))
+			Expect(executeErr).To(MatchError(translatableerror.EgressNotAllowedError{
+				Destination: "db.example.com:443",
+				SpaceName:   "some-space",
+			}))
+		})
+
+		Context("when the hostname cannot be resolved", func() {
+			BeforeEach(func() {
+				lookupErr = errors.New("lookup-error")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("lookup-error"))
+				Expect(fakeActor.GetSpaceRunningSecurityGroupRulesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the hostname only has IPv6 addresses", func() {
+			BeforeEach(func() {
+				lookupIPs = []net.IP{net.ParseIP("::1")}
+			})
+
+			It("returns a NoIPv4AddressError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoIPv4AddressError{Host: "db.example.com"}))
+			})
+		})
+	})
+
+	Context("when the host is an IPv6 address", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Host = "::1"
+		})
+
+		It("returns a NoIPv4AddressError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoIPv4AddressError{Host: "::1"}))
+		})
+	})
+})
@@ -0,0 +1,390 @@
+package v2
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	cleanupUnboundServices = "unbound-services"
+	cleanupServiceKeys     = "service-keys"
+	cleanupStoppedApps     = "stopped-apps"
+	cleanupDroplets        = "droplets"
+	cleanupPackages        = "packages"
+	cleanupExpiredPackages = "expired-packages"
+)
+
+//go:generate counterfeiter . CleanupActor
+
+type CleanupActor interface {
+	DeleteApplication(guid string) (v2action.Warnings, error)
+	DeleteServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.Warnings, error)
+	DeleteServiceKey(guid string) (v2action.Warnings, error)
+	GetSpaceCleanup(spaceGUID string, cutoff time.Time) (v2action.SpaceCleanup, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . CleanupActorV3
+
+type CleanupActorV3 interface {
+	CloudControllerAPIVersion() string
+	DeleteDroplet(dropletGUID string) (v3action.Warnings, error)
+	DeletePackage(packageGUID string) (v3action.Warnings, error)
+	GetUnusedApplicationArtifactsBySpace(spaceGUID string) ([]v3action.UnusedApplicationArtifacts, v3action.Warnings, error)
+}
+
+type CleanupCommand struct {
+	OlderThan       flag.PositiveInteger `long:"older-than" default:"30" description:"Number of days a service key or stopped app must be unchanged for before it is cleaned up"`
+	Only            []string             `long:"only" choice:"unbound-services" choice:"service-keys" choice:"stopped-apps" choice:"droplets" choice:"packages" choice:"expired-packages" description:"Only clean up the given category; can be specified multiple times"`
+	Force           bool                 `short:"f" description:"Delete without asking for confirmation"`
+	usage           interface{}          `usage:"CF_NAME cleanup [--older-than DAYS] [--only CATEGORY]... [-f]\n\n   Finds resources in the targeted space that are likely no longer needed, displays them, and deletes each category after confirmation. The categories are:\n\n   unbound-services   Service instances with no app bindings, service keys or bound routes\n   service-keys       Service keys created more than DAYS days ago\n   stopped-apps       Stopped apps that have not been updated in DAYS days\n   droplets           Droplets other than each app's current droplet\n   packages           Packages other than each app's most recent ready package\n   expired-packages   Packages whose bits have expired\n\nEXAMPLES:\n   CF_NAME cleanup\n   CF_NAME cleanup --older-than 90 --only stopped-apps --only service-keys"`
+	relatedCommands interface{}          `related_commands:"delete, delete-orphaned-routes, delete-service, delete-service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CleanupActor
+	ActorV3     CleanupActorV3
+}
+
+// cleanupCategory is a group of resources that is reviewed and deleted
+// together.
+type cleanupCategory struct {
+	title  string
+	header []string
+	items  []cleanupItem
+}
+
+type cleanupItem struct {
+	description string
+	row         []string
+	delete      func() ([]string, error)
+}
+
+func (cmd *CleanupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd CleanupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	olderThan := cmd.OlderThan.Value
+
+	cmd.UI.DisplayTextWithFlavor("Getting cleanup plan for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	var categories []cleanupCategory
+	// deleting a stopped app deletes its droplets and packages, so they are
+	// not listed separately when stopped apps are cleaned up
+	staleAppGUIDs := map[string]bool{}
+
+	if cmd.includes(cleanupUnboundServices) || cmd.includes(cleanupServiceKeys) || cmd.includes(cleanupStoppedApps) {
+		cutoff := time.Now().Add(-time.Duration(olderThan) * 24 * time.Hour)
+		spaceCleanup, warnings, err := cmd.Actor.GetSpaceCleanup(cmd.Config.TargetedSpace().GUID, cutoff)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		categories = append(categories, cmd.spaceCategories(spaceCleanup, olderThan)...)
+
+		if cmd.includes(cleanupStoppedApps) {
+			for _, app := range spaceCleanup.StaleStoppedApplications {
+				staleAppGUIDs[app.GUID] = true
+			}
+		}
+	}
+
+	if cmd.includes(cleanupDroplets) || cmd.includes(cleanupPackages) || cmd.includes(cleanupExpiredPackages) {
+		if cmd.v3Available() {
+			artifacts, warnings, err := cmd.ActorV3.GetUnusedApplicationArtifactsBySpace(cmd.Config.TargetedSpace().GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+
+			categories = append(categories, cmd.artifactCategories(artifacts, staleAppGUIDs)...)
+		} else {
+			cmd.UI.DisplayWarning("Droplets and packages are skipped because they require CC API version {{.MinimumVersion}} or higher.", map[string]interface{}{
+				"MinimumVersion": ccversion.MinVersionV3,
+			})
+		}
+	}
+
+	total := 0
+	for _, category := range categories {
+		cmd.displayCategory(category)
+		total += len(category.items)
+	}
+
+	if total == 0 {
+		cmd.UI.DisplayText("Nothing to clean up.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for _, category := range categories {
+		if len(category.items) == 0 {
+			continue
+		}
+
+		if !cmd.Force {
+			confirmed, promptErr := cmd.UI.DisplayBoolPrompt(false, "Delete {{.Count}} {{.Category}}?", map[string]interface{}{
+				"Count":    len(category.items),
+				"Category": strings.ToLower(category.title),
+			})
+			if promptErr != nil {
+				return promptErr
+			}
+
+			if !confirmed {
+				continue
+			}
+		}
+
+		for _, item := range category.items {
+			cmd.UI.DisplayText("Deleting {{.Item}}...", map[string]interface{}{
+				"Item": item.description,
+			})
+
+			warnings, err := item.delete()
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd CleanupCommand) includes(category string) bool {
+	if len(cmd.Only) == 0 {
+		return true
+	}
+
+	for _, only := range cmd.Only {
+		if only == category {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd CleanupCommand) v3Available() bool {
+	if cmd.ActorV3 == nil {
+		return false
+	}
+
+	return command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionV3) == nil
+}
+
+func (cmd CleanupCommand) spaceCategories(spaceCleanup v2action.SpaceCleanup, olderThan int) []cleanupCategory {
+	var categories []cleanupCategory
+
+	if cmd.includes(cleanupUnboundServices) {
+		category := cleanupCategory{
+			title:  cmd.UI.TranslateText("Unbound service instances"),
+			header: []string{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("type")},
+		}
+		for _, serviceInstance := range spaceCleanup.UnboundServiceInstances {
+			serviceInstance := serviceInstance
+			serviceType := cmd.UI.TranslateText("managed")
+			if serviceInstance.IsUserProvided() {
+				serviceType = cmd.UI.TranslateText("user-provided")
+			}
+
+			category.items = append(category.items, cleanupItem{
+				description: cmd.UI.TranslateText("service instance {{.Name}}", map[string]interface{}{"Name": serviceInstance.Name}),
+				row:         []string{serviceInstance.Name, serviceType},
+				delete: func() ([]string, error) {
+					return cmd.Actor.DeleteServiceInstance(serviceInstance)
+				},
+			})
+		}
+		categories = append(categories, category)
+	}
+
+	if cmd.includes(cleanupServiceKeys) {
+		category := cleanupCategory{
+			title: cmd.UI.TranslateText("Service keys older than {{.Days}} days", map[string]interface{}{"Days": olderThan}),
+			header: []string{
+				cmd.UI.TranslateText("service instance"),
+				cmd.UI.TranslateText("key"),
+				cmd.UI.TranslateText("created"),
+			},
+		}
+		for _, serviceKey := range spaceCleanup.StaleServiceKeys {
+			serviceKey := serviceKey
+			category.items = append(category.items, cleanupItem{
+				description: cmd.UI.TranslateText("service key {{.Name}} of {{.ServiceInstance}}", map[string]interface{}{
+					"Name":            serviceKey.Name,
+					"ServiceInstance": serviceKey.ServiceInstanceName,
+				}),
+				row: []string{serviceKey.ServiceInstanceName, serviceKey.Name, cmd.UI.UserFriendlyDate(serviceKey.CreatedAt)},
+				delete: func() ([]string, error) {
+					return cmd.Actor.DeleteServiceKey(serviceKey.GUID)
+				},
+			})
+		}
+		categories = append(categories, category)
+	}
+
+	if cmd.includes(cleanupStoppedApps) {
+		category := cleanupCategory{
+			title:  cmd.UI.TranslateText("Stopped apps not updated in {{.Days}} days", map[string]interface{}{"Days": olderThan}),
+			header: []string{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("last updated")},
+		}
+		for _, app := range spaceCleanup.StaleStoppedApplications {
+			app := app
+			category.items = append(category.items, cleanupItem{
+				description: cmd.UI.TranslateText("app {{.Name}}", map[string]interface{}{"Name": app.Name}),
+				row:         []string{app.Name, cmd.UI.UserFriendlyDate(app.UpdatedAt)},
+				delete: func() ([]string, error) {
+					return cmd.Actor.DeleteApplication(app.GUID)
+				},
+			})
+		}
+		categories = append(categories, category)
+	}
+
+	return categories
+}
+
+// artifactCategories returns the droplets and packages of every app except
+// the excluded ones.
+func (cmd CleanupCommand) artifactCategories(allArtifacts []v3action.UnusedApplicationArtifacts, excludedAppGUIDs map[string]bool) []cleanupCategory {
+	droplets := cleanupCategory{
+		title:  cmd.UI.TranslateText("Unused droplets"),
+		header: []string{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("droplet"), cmd.UI.TranslateText("state"), cmd.UI.TranslateText("created")},
+	}
+	packages := cleanupCategory{
+		title:  cmd.UI.TranslateText("Unused packages"),
+		header: []string{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("package"), cmd.UI.TranslateText("state"), cmd.UI.TranslateText("created")},
+	}
+	expiredPackages := cleanupCategory{
+		title:  cmd.UI.TranslateText("Expired packages"),
+		header: []string{cmd.UI.TranslateText("app"), cmd.UI.TranslateText("package"), cmd.UI.TranslateText("state"), cmd.UI.TranslateText("created")},
+	}
+
+	for _, artifacts := range allArtifacts {
+		if excludedAppGUIDs[artifacts.Application.GUID] {
+			continue
+		}
+		appName := artifacts.Application.Name
+
+		for _, droplet := range artifacts.UnusedDroplets {
+			dropletGUID := droplet.GUID
+			droplets.items = append(droplets.items, cleanupItem{
+				description: cmd.UI.TranslateText("droplet {{.GUID}} of app {{.AppName}}", map[string]interface{}{"GUID": dropletGUID, "AppName": appName}),
+				row:         []string{appName, dropletGUID, cmd.UI.TranslateText(strings.ToLower(string(droplet.State))), cmd.createdDate(droplet.CreatedAt)},
+				delete: func() ([]string, error) {
+					return cmd.ActorV3.DeleteDroplet(dropletGUID)
+				},
+			})
+		}
+
+		packages.items = append(packages.items, cmd.packageItems(appName, artifacts.UnusedPackages)...)
+		expiredPackages.items = append(expiredPackages.items, cmd.packageItems(appName, artifacts.ExpiredPackages)...)
+	}
+
+	var categories []cleanupCategory
+	if cmd.includes(cleanupDroplets) {
+		categories = append(categories, droplets)
+	}
+	if cmd.includes(cleanupPackages) {
+		categories = append(categories, packages)
+	}
+	if cmd.includes(cleanupExpiredPackages) {
+		categories = append(categories, expiredPackages)
+	}
+	return categories
+}
+
+func (cmd CleanupCommand) packageItems(appName string, packages []v3action.Package) []cleanupItem {
+	var items []cleanupItem
+	for _, pkg := range packages {
+		packageGUID := pkg.GUID
+		items = append(items, cleanupItem{
+			description: cmd.UI.TranslateText("package {{.GUID}} of app {{.AppName}}", map[string]interface{}{"GUID": packageGUID, "AppName": appName}),
+			row:         []string{appName, packageGUID, cmd.UI.TranslateText(strings.ToLower(string(pkg.State))), cmd.createdDate(pkg.CreatedAt)},
+			delete: func() ([]string, error) {
+				return cmd.ActorV3.DeletePackage(packageGUID)
+			},
+		})
+	}
+	return items
+}
+
+// createdDate formats a V3 timestamp, falling back to the raw value when it
+// cannot be parsed.
+func (cmd CleanupCommand) createdDate(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return createdAt
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
+
+func (cmd CleanupCommand) displayCategory(category cleanupCategory) {
+	cmd.UI.DisplayText("{{.Title}} ({{.Count}}):", map[string]interface{}{
+		"Title": category.title,
+		"Count": len(category.items),
+	})
+
+	if len(category.items) == 0 {
+		cmd.UI.DisplayText("None found")
+		cmd.UI.DisplayNewline()
+		return
+	}
+
+	table := [][]string{category.header}
+	for _, item := range category.items {
+		table = append(table, item.row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
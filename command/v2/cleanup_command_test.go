@@ -0,0 +1,285 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	constantV3 "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cleanup Command", func() {
+	var (
+		cmd             CleanupCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCleanupActor
+		fakeActorV3     *v2fakes.FakeCleanupActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCleanupActor)
+		fakeActorV3 = new(v2fakes.FakeCleanupActorV3)
+
+		cmd = CleanupCommand{
+			OlderThan:   flag.PositiveInteger{Value: 30},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetSpaceCleanupReturns(
+			v2action.SpaceCleanup{
+				UnboundServiceInstances: []v2action.ServiceInstance{
+					{GUID: "ups-guid", Name: "some-ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+				},
+				StaleServiceKeys: []v2action.StaleServiceKey{
+					{
+						ServiceKey:          v2action.ServiceKey{GUID: "key-guid", Name: "some-key", CreatedAt: time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)},
+						ServiceInstanceName: "some-db",
+					},
+				},
+			},
+			v2action.Warnings{"space-cleanup-warning"}, nil)
+
+		fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeActorV3.GetUnusedApplicationArtifactsBySpaceReturns(
+			[]v3action.UnusedApplicationArtifacts{
+				{
+					Application: v3action.Application{Name: "some-app"},
+					UnusedDroplets: []v3action.Droplet{
+						{GUID: "droplet-guid", State: constantV3.DropletStaged, CreatedAt: "2017-01-02T03:04:05Z"},
+					},
+					ExpiredPackages: []v3action.Package{
+						{GUID: "package-guid", State: constantV3.PackageExpired, CreatedAt: "2016-01-02T03:04:05Z"},
+					},
+				},
+			},
+			v3action.Warnings{"artifacts-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays the plan", func() {
+		Expect(testUI.Out).To(Say("Getting cleanup plan for org some-org / space some-space as some-user\\.\\.\\."))
+		Expect(testUI.Out).To(Say("Unbound service instances \\(1\\):"))
+		Expect(testUI.Out).To(Say("name\\s+type"))
+		Expect(testUI.Out).To(Say("some-ups\\s+user-provided"))
+		Expect(testUI.Out).To(Say("Service keys older than 30 days \\(1\\):"))
+		Expect(testUI.Out).To(Say("service instance\\s+key\\s+created"))
+		Expect(testUI.Out).To(Say("some-db\\s+some-key\\s+\\w{3} \\d{2} \\w{3} [\\d:]+ \\S+ 2017"))
+		Expect(testUI.Out).To(Say("Stopped apps not updated in 30 days \\(0\\):"))
+		Expect(testUI.Out).To(Say("None found"))
+		Expect(testUI.Out).To(Say("Unused droplets \\(1\\):"))
+		Expect(testUI.Out).To(Say("app\\s+droplet\\s+state\\s+created"))
+		Expect(testUI.Out).To(Say("some-app\\s+droplet-guid\\s+staged\\s+\\w{3} \\d{2} \\w{3} [\\d:]+ \\S+ 2017"))
+		Expect(testUI.Out).To(Say("Unused packages \\(0\\):"))
+		Expect(testUI.Out).To(Say("Expired packages \\(1\\):"))
+		Expect(testUI.Out).To(Say("some-app\\s+package-guid\\s+expired\\s+\\w{3} \\d{2} \\w{3} [\\d:]+ \\S+ 2016"))
+
+		Expect(testUI.Err).To(Say("space-cleanup-warning"))
+		Expect(testUI.Err).To(Say("artifacts-warning"))
+
+		spaceGUID, cutoff := fakeActor.GetSpaceCleanupArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(cutoff).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+		Expect(fakeActorV3.GetUnusedApplicationArtifactsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+	})
+
+	Context("when the user confirms some categories", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\nn\ny\ny\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeActor.DeleteServiceInstanceReturns(v2action.Warnings{"delete-instance-warning"}, nil)
+			fakeActorV3.DeletePackageReturns(v3action.Warnings{"delete-package-warning"}, nil)
+		})
+
+		It("only deletes the confirmed categories", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Delete 1 unbound service instances\\? \\[yN\\]:"))
+			Expect(testUI.Out).To(Say("Deleting service instance some-ups\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Delete 1 service keys older than 30 days\\? \\[yN\\]:"))
+			Expect(testUI.Out).To(Say("Delete 1 unused droplets\\? \\[yN\\]:"))
+			Expect(testUI.Out).To(Say("Deleting droplet droplet-guid of app some-app\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Delete 1 expired packages\\? \\[yN\\]:"))
+			Expect(testUI.Out).To(Say("Deleting package package-guid of app some-app\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("delete-instance-warning"))
+			Expect(testUI.Err).To(Say("delete-package-warning"))
+
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteServiceInstanceArgsForCall(0).GUID).To(Equal("ups-guid"))
+			Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+			Expect(fakeActorV3.DeleteDropletArgsForCall(0)).To(Equal("droplet-guid"))
+			Expect(fakeActorV3.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+		})
+	})
+
+	Context("when --only is provided", func() {
+		BeforeEach(func() {
+			cmd.Only = []string{"service-keys"}
+			cmd.Force = true
+		})
+
+		It("only reviews and deletes that category without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Unbound service instances"))
+			Expect(testUI.Out).To(Say("Service keys older than 30 days \\(1\\):"))
+			Expect(testUI.Out).ToNot(Say("\\[yN\\]"))
+			Expect(testUI.Out).To(Say("Deleting service key some-key of some-db\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("key-guid"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+			Expect(fakeActorV3.GetUnusedApplicationArtifactsBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there is nothing to clean up", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceCleanupReturns(v2action.SpaceCleanup{}, nil, nil)
+			fakeActorV3.GetUnusedApplicationArtifactsBySpaceReturns(nil, nil, nil)
+		})
+
+		It("says so without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Nothing to clean up\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).ToNot(Say("\\[yN\\]"))
+		})
+	})
+
+	Context("when the V3 API is not available", func() {
+		BeforeEach(func() {
+			cmd.ActorV3 = nil
+			cmd.Force = true
+		})
+
+		It("skips droplets and packages with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Droplets and packages are skipped because they require CC API version %s or higher\\.", ccversion.MinVersionV3))
+			Expect(testUI.Out).ToNot(Say("Unused droplets"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when stale stopped apps are cleaned up", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.GetSpaceCleanupReturns(v2action.SpaceCleanup{
+				StaleStoppedApplications: []v2action.Application{
+					{GUID: "stopped-app-guid", Name: "stopped-app"},
+				},
+			}, nil, nil)
+			fakeActorV3.GetUnusedApplicationArtifactsBySpaceReturns(
+				[]v3action.UnusedApplicationArtifacts{
+					{
+						Application:    v3action.Application{GUID: "stopped-app-guid", Name: "stopped-app"},
+						UnusedDroplets: []v3action.Droplet{{GUID: "stopped-droplet-guid", State: constantV3.DropletStaged}},
+					},
+					{
+						Application:    v3action.Application{GUID: "some-app-guid", Name: "some-app"},
+						UnusedDroplets: []v3action.Droplet{{GUID: "droplet-guid", State: constantV3.DropletStaged}},
+					},
+				},
+				nil, nil)
+		})
+
+		It("does not list or delete the droplets and packages of those apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Stopped apps not updated in 30 days \\(1\\):"))
+			Expect(testUI.Out).To(Say("Unused droplets \\(1\\):"))
+			Expect(testUI.Out).ToNot(Say("stopped-droplet-guid"))
+
+			Expect(fakeActor.DeleteApplicationArgsForCall(0)).To(Equal("stopped-app-guid"))
+			Expect(fakeActorV3.DeleteDropletCallCount()).To(Equal(1))
+			Expect(fakeActorV3.DeleteDropletArgsForCall(0)).To(Equal("droplet-guid"))
+		})
+
+		Context("when stopped apps are not cleaned up", func() {
+			BeforeEach(func() {
+				cmd.Only = []string{"droplets"}
+			})
+
+			It("lists and deletes their droplets", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(0))
+				Expect(fakeActorV3.DeleteDropletCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when a deletion fails", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.DeleteServiceInstanceReturns(v2action.Warnings{"delete-instance-warning"}, errors.New("delete error"))
+		})
+
+		It("returns the error and stops deleting", func() {
+			Expect(executeErr).To(MatchError("delete error"))
+			Expect(testUI.Err).To(Say("delete-instance-warning"))
+			Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the space cleanup fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceCleanupReturns(v2action.SpaceCleanup{}, v2action.Warnings{"space-cleanup-warning"}, errors.New("cleanup error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("cleanup error"))
+			Expect(testUI.Err).To(Say("space-cleanup-warning"))
+		})
+	})
+})
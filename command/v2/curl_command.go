@@ -1,11 +1,35 @@
 package v2
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/jsonfilter"
 )
 
+//go:generate counterfeiter . CurlActor
+
+type CurlActor interface {
+	Curl(request v2action.CurlRequest) (v2action.CurlResponse, v2action.Warnings, error)
+	CurlPathRequiresTargetedOrganization(path string) bool
+	CurlPathRequiresTargetedSpace(path string) bool
+	ResolveCurlPath(path string, orgGUID string, spaceGUID string) (string, v2action.Warnings, error)
+}
+
 type CurlCommand struct {
 	RequiredArgs          flag.APIPath    `positional-args:"yes"`
 	CustomHeaders         []string        `short:"H" description:"Custom headers to include in the request, flag can be specified multiple times"`
@@ -13,13 +37,189 @@ type CurlCommand struct {
 	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, or '@' followed by a file name to read the data from"`
 	IncludeReponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile            flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	Paginate              bool            `long:"paginate" description:"Follow the pagination links of list responses and merge the resources of every page"`
+	Filter                string          `long:"filter" description:"Extract values from the JSON response with a jq-style path, e.g. '.resources[].name'"`
+	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--paginate] [--filter FILTER]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   The placeholders {org_guid} and {space_guid} in PATH are replaced with the\n   GUIDs of the targeted org and space, and {app:APP_NAME} with the GUID of the\n   named app in the targeted space.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   CF_NAME curl \"/v3/apps?space_guids={space_guid}\" --paginate --filter '.resources[].name'\n   CF_NAME curl \"/v3/apps/{app:myapp}/env\""`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CurlActor
+}
+
+func (cmd *CurlCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
 }
 
-func (CurlCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd CurlCommand) Execute(args []string) error {
+	request, err := cmd.curlRequest()
+	if err != nil {
+		return err
+	}
+
+	var filter jsonfilter.Filter
+	if cmd.Filter != "" {
+		filter, err = jsonfilter.Parse(cmd.Filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Actor.CurlPathRequiresTargetedOrganization(request.Path) {
+		err = cmd.SharedActor.CheckTarget(true, cmd.Actor.CurlPathRequiresTargetedSpace(request.Path))
+		if err != nil {
+			return err
+		}
+
+		var warnings v2action.Warnings
+		request.Path, warnings, err = cmd.Actor.ResolveCurlPath(request.Path, cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	response, warnings, err := cmd.Actor.Curl(request)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	// The request logger already displays the raw response when tracing to
+	// the terminal, so only the output file and filtered values are left.
+	verbose, _ := cmd.Config.Verbose()
+
+	if cmd.IncludeReponseHeaders && !verbose {
+		headers, err := httputil.DumpResponse(response.HTTPResponse, false)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.UI.GetOut(), string(headers))
+	}
+
+	body := response.Body
+	if cmd.Filter != "" {
+		body, err = cmd.applyFilter(filter, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.OutputFile != "" {
+		return cmd.writeToFile(body, string(cmd.OutputFile))
+	}
+
+	if verbose && cmd.Filter == "" {
+		return nil
+	}
+
+	if cmd.Filter == "" && strings.Contains(response.HTTPResponse.Header.Get("Content-Type"), "application/json") {
+		buffer := bytes.Buffer{}
+		if json.Indent(&buffer, body, "", "   ") == nil {
+			body = buffer.Bytes()
+		}
+	}
+
+	fmt.Fprintln(cmd.UI.GetOut(), string(body))
 	return nil
 }
 
-func (CurlCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd CurlCommand) curlRequest() (v2action.CurlRequest, error) {
+	if cmd.Paginate {
+		if cmd.HTTPMethod != "" && !strings.EqualFold(cmd.HTTPMethod, http.MethodGet) {
+			return v2action.CurlRequest{}, translatableerror.ArgumentCombinationError{Args: []string{"--paginate", "-X " + cmd.HTTPMethod}}
+		}
+		if cmd.HTTPData != "" {
+			return v2action.CurlRequest{}, translatableerror.ArgumentCombinationError{Args: []string{"--paginate", "-d"}}
+		}
+	}
+
+	request := v2action.CurlRequest{
+		Method:   cmd.HTTPMethod,
+		Path:     cmd.RequiredArgs.Path,
+		Headers:  http.Header{},
+		Paginate: cmd.Paginate,
+	}
+
+	for _, header := range cmd.CustomHeaders {
+		for _, line := range strings.Split(header, "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			nameAndValue := strings.SplitN(line, ":", 2)
+			name := strings.TrimSpace(nameAndValue[0])
+			if len(nameAndValue) != 2 || name == "" {
+				return v2action.CurlRequest{}, translatableerror.InvalidHeaderError{Header: line}
+			}
+			request.Headers.Add(textproto.CanonicalMIMEHeaderKey(name), strings.TrimSpace(nameAndValue[1]))
+		}
+	}
+
+	if cmd.HTTPData != "" {
+		data := []byte(cmd.HTTPData)
+		if strings.HasPrefix(string(cmd.HTTPData), "@") {
+			path := strings.TrimPrefix(string(cmd.HTTPData), "@")
+
+			var err error
+			data, err = ioutil.ReadFile(path)
+			if os.IsNotExist(err) {
+				return v2action.CurlRequest{}, translatableerror.FileNotFoundError{Path: path}
+			} else if err != nil {
+				return v2action.CurlRequest{}, err
+			}
+		}
+		request.Body = data
+
+		if request.Method == "" {
+			request.Method = http.MethodPost
+		}
+	}
+
+	return request, nil
+}
+
+func (CurlCommand) applyFilter(filter jsonfilter.Filter, body []byte) ([]byte, error) {
+	values, err := filter.ApplyJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			lines = append(lines, str)
+			continue
+		}
+
+		buffer := bytes.Buffer{}
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "   ")
+		if err := encoder.Encode(value); err != nil {
+			return nil, err
+		}
+		lines = append(lines, strings.TrimSuffix(buffer.String(), "\n"))
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+func (CurlCommand) writeToFile(body []byte, path string) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, body, 0644)
 }
@@ -0,0 +1,432 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/jsonfilter"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("curl Command", func() {
+	var (
+		cmd             CurlCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCurlActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCurlActor)
+
+		cmd = CurlCommand{
+			RequiredArgs: flag.APIPath{Path: "/v2/apps"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.CurlReturns(
+			v2action.CurlResponse{
+				Body: []byte(`{"resources":[{"name":"app-1"},{"name":"app-2"}]}`),
+				HTTPResponse: &http.Response{
+					Proto:      "HTTP/1.1",
+					ProtoMajor: 1,
+					ProtoMinor: 1,
+					Status:     "200 OK",
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+				},
+			},
+			v2action.Warnings{"curl-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the request succeeds", func() {
+		It("displays the indented JSON body and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`\{
+   "resources": \[
+      \{
+         "name": "app-1"
+      \},`))
+			Expect(testUI.Err).To(Say("curl-warning"))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.CurlCallCount()).To(Equal(1))
+			Expect(fakeActor.CurlArgsForCall(0)).To(Equal(v2action.CurlRequest{
+				Path:    "/v2/apps",
+				Headers: http.Header{},
+			}))
+		})
+	})
+
+	Context("when the response is not JSON", func() {
+		BeforeEach(func() {
+			fakeActor.CurlReturns(
+				v2action.CurlResponse{
+					Body:         []byte("some-text"),
+					HTTPResponse: &http.Response{StatusCode: http.StatusOK, Header: http.Header{}},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the body as is", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("some-text"))
+		})
+	})
+
+	Context("when the request fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("curl error")
+			fakeActor.CurlReturns(v2action.CurlResponse{}, v2action.Warnings{"curl-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("curl-warning"))
+		})
+	})
+
+	Context("when a method, headers and data are provided", func() {
+		BeforeEach(func() {
+			cmd.HTTPMethod = "PUT"
+			cmd.CustomHeaders = []string{"content-type: application/x-www-form-urlencoded", "X-Some-Header:  value-1\nX-Some-Header: value-2"}
+			cmd.HTTPData = "q=name:myapp"
+		})
+
+		It("passes them to the request", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.CurlArgsForCall(0)).To(Equal(v2action.CurlRequest{
+				Method: "PUT",
+				Path:   "/v2/apps",
+				Headers: http.Header{
+					"Content-Type":  {"application/x-www-form-urlencoded"},
+					"X-Some-Header": {"value-1", "value-2"},
+				},
+				Body: []byte("q=name:myapp"),
+			}))
+		})
+	})
+
+	Context("when data is provided without a method", func() {
+		BeforeEach(func() {
+			cmd.HTTPData = `{"name":"some-app"}`
+		})
+
+		It("performs a POST", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.CurlArgsForCall(0).Method).To(Equal("POST"))
+		})
+	})
+
+	Context("when the data is read from a file", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "curl-command-test")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		Context("when the file exists", func() {
+			BeforeEach(func() {
+				dataFile := filepath.Join(tmpDir, "data.json")
+				Expect(ioutil.WriteFile(dataFile, []byte(`{"name":"some-app"}`), 0600)).To(Succeed())
+				cmd.HTTPData = flag.PathWithAt("@" + dataFile)
+			})
+
+			It("sends the contents of the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.CurlArgsForCall(0).Body).To(Equal([]byte(`{"name":"some-app"}`)))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			var dataFile string
+
+			BeforeEach(func() {
+				dataFile = filepath.Join(tmpDir, "missing.json")
+				cmd.HTTPData = flag.PathWithAt("@" + dataFile)
+			})
+
+			It("returns a FileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: dataFile}))
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when a header is invalid", func() {
+		BeforeEach(func() {
+			cmd.CustomHeaders = []string{"not-a-header"}
+		})
+
+		It("returns an InvalidHeaderError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidHeaderError{Header: "not-a-header"}))
+			Expect(fakeActor.CurlCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --paginate is provided", func() {
+		BeforeEach(func() {
+			cmd.Paginate = true
+		})
+
+		It("requests pagination", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.CurlArgsForCall(0).Paginate).To(BeTrue())
+		})
+
+		Context("when a method other than GET is provided", func() {
+			BeforeEach(func() {
+				cmd.HTTPMethod = "DELETE"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--paginate", "-X DELETE"}}))
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when data is provided", func() {
+			BeforeEach(func() {
+				cmd.HTTPData = "some-data"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--paginate", "-d"}}))
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when a filter is provided", func() {
+		BeforeEach(func() {
+			cmd.Filter = ".resources[].name"
+		})
+
+		It("displays the filtered values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("app-1\napp-2\n"))
+		})
+
+		Context("when the filter selects objects", func() {
+			BeforeEach(func() {
+				cmd.Filter = ".resources[0]"
+			})
+
+			It("displays them as indented JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\{
+   "name": "app-1"
+\}`))
+			})
+		})
+
+		Context("when the filter is invalid", func() {
+			BeforeEach(func() {
+				cmd.Filter = "resources"
+			})
+
+			It("returns the error without making a request", func() {
+				Expect(executeErr).To(MatchError(jsonfilter.InvalidFilterError{Filter: "resources", Reason: "expected '.' at position 1"}))
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the filter cannot be applied", func() {
+			BeforeEach(func() {
+				cmd.Filter = ".resources.name"
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(jsonfilter.EvaluationError{Filter: ".resources.name", Reason: `cannot index array with "name"`}))
+			})
+		})
+	})
+
+	Context("when -i is provided", func() {
+		BeforeEach(func() {
+			cmd.IncludeReponseHeaders = true
+		})
+
+		It("displays the response headers before the body", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("HTTP/1.1 200 OK"))
+			Expect(testUI.Out).To(Say("Content-Type: application/json"))
+			Expect(testUI.Out).To(Say(`"resources"`))
+		})
+	})
+
+	Context("when tracing to the terminal", func() {
+		BeforeEach(func() {
+			fakeConfig.VerboseReturns(true, nil)
+		})
+
+		It("does not display the body again", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("resources"))
+		})
+
+		Context("when a filter is provided", func() {
+			BeforeEach(func() {
+				cmd.Filter = ".resources[].name"
+			})
+
+			It("displays the filtered values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("app-1\napp-2\n"))
+			})
+		})
+
+		Context("when --output is provided", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "curl-command-test")
+				Expect(err).ToNot(HaveOccurred())
+
+				cmd.OutputFile = flag.Path(filepath.Join(tmpDir, "output.json"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("still writes the body to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(string(cmd.OutputFile))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(contents).To(MatchJSON(`{"resources":[{"name":"app-1"},{"name":"app-2"}]}`))
+			})
+		})
+	})
+
+	Context("when --output is provided", func() {
+		var (
+			tmpDir     string
+			outputFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "curl-command-test")
+			Expect(err).ToNot(HaveOccurred())
+
+			outputFile = filepath.Join(tmpDir, "some-dir", "output.json")
+			cmd.OutputFile = flag.Path(outputFile)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("writes the body to the file instead of displaying it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("resources"))
+
+			contents, err := ioutil.ReadFile(outputFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(MatchJSON(`{"resources":[{"name":"app-1"},{"name":"app-2"}]}`))
+		})
+	})
+
+	Context("when the path contains target placeholders", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Path = "/v3/apps/{app:some-app}"
+			fakeActor.CurlPathRequiresTargetedOrganizationReturns(true)
+			fakeActor.CurlPathRequiresTargetedSpaceReturns(true)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: binaryName})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the placeholders are resolved", func() {
+			BeforeEach(func() {
+				fakeActor.ResolveCurlPathReturns("/v3/apps/some-app-guid", v2action.Warnings{"resolve-warning"}, nil)
+			})
+
+			It("requests the resolved path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("resolve-warning"))
+
+				Expect(fakeActor.ResolveCurlPathCallCount()).To(Equal(1))
+				path, orgGUID, spaceGUID := fakeActor.ResolveCurlPathArgsForCall(0)
+				Expect(path).To(Equal("/v3/apps/{app:some-app}"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.CurlArgsForCall(0).Path).To(Equal("/v3/apps/some-app-guid"))
+			})
+		})
+
+		Context("when resolving the placeholders fails", func() {
+			BeforeEach(func() {
+				fakeActor.ResolveCurlPathReturns("", v2action.Warnings{"resolve-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("resolve-warning"))
+				Expect(fakeActor.CurlCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -6,6 +6,11 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
+// DeleteCommand still runs the legacy implementation, which parses the app
+// name from the original command line, so it cannot prompt for a missing app
+// name with command.SelectRequiredArgument. Until it is refactored, a missing
+// name is a usage error, which also keeps a filter that matches a single app
+// from selecting it for deletion.
 type DeleteCommand struct {
 	RequiredArgs       flag.AppName `positional-args:"yes"`
 	ForceDelete        bool         `short:"f" description:"Force deletion without confirmation"`
@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . IgnoredFilesActor
+
+type IgnoredFilesActor interface {
+	GetDirectoryIgnoreStatuses(sourceDir string) ([]sharedaction.IgnoreStatus, error)
+}
+
+type IgnoredFilesCommand struct {
+	AppPath         flag.PathWithExistenceCheck `short:"p" description:"Path to app directory (defaults to the current directory)"`
+	usage           interface{}                 `usage:"CF_NAME ignored-files [-p APP_PATH]\n\n   Lists the files of an app directory and whether 'CF_NAME push' would upload or ignore them, along with the .cfignore rule that decided it.\n\n   Every directory may contain a .cfignore file, whose rules apply to the paths below that directory and take precedence over the rules of its parents. A '#include PATH' line in a .cfignore file adds the rules of another file, such as .gitignore."`
+	relatedCommands interface{}                 `related_commands:"push"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  IgnoredFilesActor
+}
+
+func (cmd *IgnoredFilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd IgnoredFilesCommand) Execute(args []string) error {
+	appPath := string(cmd.AppPath)
+	if appPath == "" {
+		var err error
+		appPath, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayText("Listing files to upload from {{.Path}}...", map[string]interface{}{
+		"Path": appPath,
+	})
+	cmd.UI.DisplayNewline()
+
+	statuses, err := cmd.Actor.GetDirectoryIgnoreStatuses(appPath)
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("path"),
+		cmd.UI.TranslateText("status"),
+		cmd.UI.TranslateText("rule"),
+	}}
+	for _, status := range statuses {
+		path := status.Filename
+		if status.IsDir {
+			path += "/"
+		}
+
+		state := cmd.UI.TranslateText("upload")
+		if status.Ignored {
+			state = cmd.UI.TranslateText("ignore")
+		}
+
+		table = append(table, []string{path, state, status.Rule})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ignored-files Command", func() {
+	var (
+		cmd        IgnoredFilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeIgnoredFilesActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeIgnoredFilesActor)
+
+		cmd = IgnoredFilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no path is provided", func() {
+		It("lists the files of the current directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			pwd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetDirectoryIgnoreStatusesCallCount()).To(Equal(1))
+			Expect(fakeActor.GetDirectoryIgnoreStatusesArgsForCall(0)).To(Equal(pwd))
+			Expect(testUI.Out).To(Say("Listing files to upload from %s\\.\\.\\.", pwd))
+		})
+	})
+
+	Context("when a path is provided", func() {
+		BeforeEach(func() {
+			cmd.AppPath = "some-path"
+		})
+
+		Context("when listing the files succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetDirectoryIgnoreStatusesReturns([]sharedaction.IgnoreStatus{
+					{Filename: ".cfignore", Ignored: true, Rule: "(default) .cfignore"},
+					{Filename: "lib", IsDir: true},
+					{Filename: "lib/keep.log", Rule: "lib/.cfignore:1 !keep.log"},
+					{Filename: "logs", IsDir: true, Ignored: true, Rule: ".cfignore:2 logs/"},
+				}, nil)
+			})
+
+			It("displays whether each file is uploaded and the rule that decided it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetDirectoryIgnoreStatusesArgsForCall(0)).To(Equal("some-path"))
+				Expect(testUI.Out).To(Say("Listing files to upload from some-path\\.\\.\\."))
+				Expect(testUI.Out).To(Say("path\\s+status\\s+rule"))
+				Expect(testUI.Out).To(Say("\\.cfignore\\s+ignore\\s+\\(default\\) \\.cfignore"))
+				Expect(testUI.Out).To(Say("lib/\\s+upload"))
+				Expect(testUI.Out).To(Say("lib/keep\\.log\\s+upload\\s+lib/\\.cfignore:1 !keep\\.log"))
+				Expect(testUI.Out).To(Say("logs/\\s+ignore\\s+\\.cfignore:2 logs/"))
+			})
+		})
+
+		Context("when listing the files fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetDirectoryIgnoreStatusesReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
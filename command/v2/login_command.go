@@ -1,10 +1,23 @@
 package v2
 
 import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/browser"
 )
 
+//go:generate counterfeiter . LoginActor
+
+type LoginActor interface {
+	AuthenticateWithBrowser(openURL func(loginURL string) error) error
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
 	Organization      string      `short:"o" description:"Org"`
@@ -12,16 +25,146 @@ type LoginCommand struct {
 	Space             string      `short:"s" description:"Space"`
 	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
+	SSOBrowser        bool        `long:"sso-browser" description:"Log in through the login page opened in your web browser"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n   CF_NAME login --sso-browser [-o ORG] [-s SPACE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --sso-browser (CF_NAME will open the login page in your browser and wait for you to log in)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       LoginActor
+	OpenBrowser func(url string) error
 }
 
-func (LoginCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.SSOBrowser {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.OpenBrowser = browser.Open
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (LoginCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd LoginCommand) Execute(args []string) error {
+	if !cmd.SSOBrowser {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.validateSSOBrowserArguments()
+	if err != nil {
+		return err
+	}
+
+	err = command.WarnCLIVersionCheck(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"API endpoint: {{.Endpoint}}",
+		map[string]interface{}{
+			"Endpoint": cmd.Config.Target(),
+		})
+	cmd.UI.DisplayText("Authenticating...")
+
+	err = cmd.Actor.AuthenticateWithBrowser(cmd.openLoginPage)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if cmd.Organization != "" {
+		err = cmd.target()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Use '{{.Command}}' to view or set your target org and space.",
+		map[string]interface{}{
+			"Command": fmt.Sprintf("%s target", cmd.Config.BinaryName()),
+		})
+
+	return nil
+}
+
+func (cmd LoginCommand) validateSSOBrowserArguments() error {
+	conflictingArgs := []string{"--sso-browser"}
+	if cmd.APIEndpoint != "" {
+		conflictingArgs = append(conflictingArgs, "-a")
+	}
+	if cmd.Username != "" {
+		conflictingArgs = append(conflictingArgs, "-u")
+	}
+	if cmd.Password != "" {
+		conflictingArgs = append(conflictingArgs, "-p")
+	}
+	if cmd.SSO {
+		conflictingArgs = append(conflictingArgs, "--sso")
+	}
+	if cmd.SSOPasscode != "" {
+		conflictingArgs = append(conflictingArgs, "--sso-passcode")
+	}
+	if len(conflictingArgs) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflictingArgs}
+	}
+
+	if cmd.Space != "" && cmd.Organization == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "-s", Arg2: "-o"}
+	}
+
+	return nil
+}
+
+// openLoginPage displays the login URL and tries to open it in the user's
+// browser. Failing to open the browser is not fatal; the user can still visit
+// the URL manually.
+func (cmd LoginCommand) openLoginPage(loginURL string) error {
+	cmd.UI.DisplayText("Opening the login page in your browser: {{.URL}}", map[string]interface{}{
+		"URL": loginURL,
+	})
+
+	err := cmd.OpenBrowser(loginURL)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to open a browser. Visit the URL above to log in.")
+	}
+
+	cmd.UI.DisplayText("Waiting for the login to complete in your browser...")
+	return nil
+}
+
+// target targets the org and, when provided, the space given on the command
+// line.
+func (cmd LoginCommand) target() error {
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+
+	if cmd.Space == "" {
+		return nil
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+
+	return nil
 }
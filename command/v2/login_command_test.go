@@ -0,0 +1,193 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("login Command", func() {
+	var (
+		cmd        LoginCommand
+		testUI     *ui.UI
+		fakeActor  *v2fakes.FakeLoginActor
+		fakeConfig *commandfakes.FakeConfig
+		openedURLs []string
+		openErr    error
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v2fakes.FakeLoginActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		openedURLs = nil
+		openErr = nil
+
+		cmd = LoginCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+			OpenBrowser: func(url string) error {
+				openedURLs = append(openedURLs, url)
+				return openErr
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetReturns("some-api-target")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --sso-browser is not provided", func() {
+		It("falls back to the legacy login", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeActor.AuthenticateWithBrowserCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --sso-browser is provided", func() {
+		BeforeEach(func() {
+			cmd.SSOBrowser = true
+		})
+
+		Context("when it is combined with other credential flags", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+				cmd.SSO = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--sso-browser", "-u", "--sso"},
+				}))
+				Expect(fakeActor.AuthenticateWithBrowserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a space is provided without an org", func() {
+			BeforeEach(func() {
+				cmd.Space = "some-space"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-s", Arg2: "-o"}))
+				Expect(fakeActor.AuthenticateWithBrowserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the login succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithBrowserStub = func(openURL func(string) error) error {
+					return openURL("https://login.example.com/oauth/authorize?state=some-state")
+				}
+			})
+
+			It("opens the login page and displays the login progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(openedURLs).To(ConsistOf("https://login.example.com/oauth/authorize?state=some-state"))
+
+				Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+				Expect(testUI.Out).To(Say("Authenticating..."))
+				Expect(testUI.Out).To(Say(`Opening the login page in your browser: https://login\.example\.com/oauth/authorize\?state=some-state`))
+				Expect(testUI.Out).To(Say("Waiting for the login to complete in your browser..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Use 'faceman target' to view or set your target org and space."))
+
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			})
+
+			Context("when the browser cannot be opened", func() {
+				BeforeEach(func() {
+					openErr = errors.New("no browser")
+				})
+
+				It("tells the user to visit the URL and continues waiting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Unable to open a browser. Visit the URL above to log in."))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			Context("when an org and space are provided", func() {
+				BeforeEach(func() {
+					cmd.Organization = "some-org"
+					cmd.Space = "some-space"
+
+					fakeActor.GetOrganizationByNameReturns(
+						v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+						v2action.Warnings{"org-warning"},
+						nil)
+					fakeActor.GetSpaceByOrganizationAndNameReturns(
+						v2action.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true},
+						v2action.Warnings{"space-warning"},
+						nil)
+				})
+
+				It("targets the org and space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+					orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceName).To(Equal("some-space"))
+
+					Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+					guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+					Expect(guid).To(Equal("some-org-guid"))
+					Expect(name).To(Equal("some-org"))
+
+					Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+					guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+					Expect(guid).To(Equal("some-space-guid"))
+					Expect(name).To(Equal("some-space"))
+					Expect(allowSSH).To(BeTrue())
+
+					Expect(testUI.Err).To(Say("org-warning"))
+					Expect(testUI.Err).To(Say("space-warning"))
+				})
+			})
+
+			Context("when the org cannot be found", func() {
+				BeforeEach(func() {
+					cmd.Organization = "some-org"
+					fakeActor.GetOrganizationByNameReturns(
+						v2action.Organization{},
+						nil,
+						actionerror.OrganizationNotFoundError{Name: "some-org"})
+				})
+
+				It("returns the error without targeting", func() {
+					Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+					Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the login fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithBrowserReturns(errors.New("login failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("login failed"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
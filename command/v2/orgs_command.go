@@ -32,6 +32,9 @@ func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
 	if err != nil {
 		return err
 	}
+	if responseCache := command.NewResponseCache(config); responseCache != nil {
+		ccClient.WrapConnection(responseCache)
+	}
 	cmd.Actor = v2action.NewActor(ccClient, nil, config)
 
 	return nil
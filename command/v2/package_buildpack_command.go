@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . PackageBuildpackActor
+
+type PackageBuildpackActor interface {
+	PackageBuildpack(sourceDir string, outputPath string, includeDependencies bool) (sharedaction.BuildpackPackage, error)
+}
+
+type PackageBuildpackCommand struct {
+	RequiredArgs        flag.PackageBuildpackArgs `positional-args:"yes"`
+	IncludeDependencies bool                      `long:"include-dependencies" description:"Embed the dependencies listed in manifest.yml whose uri is a local path"`
+	Output              string                    `short:"o" description:"Path of the zip file to create (defaults to the directory name with a .zip extension in the current directory)"`
+	usage               interface{}               `usage:"CF_NAME package-buildpack PATH [-o OUTPUT_ZIP] [--include-dependencies]\n\n   Checks that a directory is a buildpack and zips it for create-buildpack or update-buildpack.\n   The directory must contain executable bin/detect, bin/compile or bin/supply, and bin/release files. A manifest.yml, if present, must set the buildpack's language and the name, version and uri of each dependency.\n\nEXAMPLES:\n   CF_NAME package-buildpack ./ruby-buildpack\n   CF_NAME package-buildpack ./ruby-buildpack -o /tmp/ruby_buildpack.zip --include-dependencies"`
+	relatedCommands     interface{}               `related_commands:"create-buildpack, update-buildpack"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  PackageBuildpackActor
+}
+
+func (cmd *PackageBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd PackageBuildpackCommand) Execute(args []string) error {
+	sourceDir, err := filepath.Abs(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	outputPath := cmd.Output
+	if outputPath == "" {
+		outputPath = filepath.Base(sourceDir) + ".zip"
+	}
+
+	cmd.UI.DisplayText("Packaging buildpack {{.Path}} into {{.Output}}...", map[string]interface{}{
+		"Path":   sourceDir,
+		"Output": outputPath,
+	})
+
+	pkg, err := cmd.Actor.PackageBuildpack(sourceDir, outputPath, cmd.IncludeDependencies)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range pkg.RemoteDependencies {
+		cmd.UI.DisplayWarning("Dependency {{.Name}} {{.Version}} was not embedded because {{.URI}} is not a local path.", map[string]interface{}{
+			"Name":    dependency.Name,
+			"Version": dependency.Version,
+			"URI":     dependency.URI,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	table := [][]string{
+		{cmd.UI.TranslateText("file:"), pkg.Path},
+		{cmd.UI.TranslateText("size:"), bytefmt.ByteSize(uint64(pkg.Size))},
+	}
+	if pkg.Language != "" {
+		table = append(table, []string{cmd.UI.TranslateText("language:"), pkg.Language})
+	}
+	if cmd.IncludeDependencies {
+		table = append(table, []string{cmd.UI.TranslateText("embedded dependencies:"), strconv.Itoa(len(pkg.EmbeddedDependencies))})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayOK()
+	return nil
+}
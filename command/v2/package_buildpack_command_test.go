@@ -0,0 +1,129 @@
+package v2_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("package-buildpack Command", func() {
+	var (
+		cmd        PackageBuildpackCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakePackageBuildpackActor
+		sourceDir  string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakePackageBuildpackActor)
+
+		cmd = PackageBuildpackCommand{
+			RequiredArgs: flag.PackageBuildpackArgs{Path: "ruby-buildpack"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+		}
+
+		pwd, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		sourceDir = filepath.Join(pwd, "ruby-buildpack")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when packaging succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.PackageBuildpackReturns(sharedaction.BuildpackPackage{
+				Path:     "ruby-buildpack.zip",
+				Size:     2048,
+				Language: "ruby",
+			}, nil)
+		})
+
+		It("zips the buildpack next to the current directory by default", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Packaging buildpack %s into ruby-buildpack\.zip\.\.\.`, regexp.QuoteMeta(sourceDir)))
+			Expect(testUI.Out).To(Say(`file:\s+ruby-buildpack\.zip`))
+			Expect(testUI.Out).To(Say(`size:\s+2K`))
+			Expect(testUI.Out).To(Say(`language:\s+ruby`))
+			Expect(testUI.Out).ToNot(Say("embedded dependencies"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			dir, output, includeDependencies := fakeActor.PackageBuildpackArgsForCall(0)
+			Expect(dir).To(Equal(sourceDir))
+			Expect(output).To(Equal("ruby-buildpack.zip"))
+			Expect(includeDependencies).To(BeFalse())
+		})
+
+		Context("when an output path is provided", func() {
+			BeforeEach(func() {
+				cmd.Output = "/tmp/some.zip"
+			})
+
+			It("zips the buildpack to the output path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, output, _ := fakeActor.PackageBuildpackArgsForCall(0)
+				Expect(output).To(Equal("/tmp/some.zip"))
+			})
+		})
+
+		Context("when dependencies are included", func() {
+			BeforeEach(func() {
+				cmd.IncludeDependencies = true
+				fakeActor.PackageBuildpackReturns(sharedaction.BuildpackPackage{
+					Path:                 "ruby-buildpack.zip",
+					EmbeddedDependencies: []sharedaction.BuildpackDependency{{Name: "ruby", Version: "2.4.1"}},
+					RemoteDependencies:   []sharedaction.BuildpackDependency{{Name: "bundler", Version: "1.15.1", URI: "https://example.com/bundler.tgz"}},
+				}, nil)
+			})
+
+			It("reports the embedded and remote dependencies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say(`Dependency bundler 1\.15\.1 was not embedded because https://example\.com/bundler\.tgz is not a local path\.`))
+				Expect(testUI.Out).To(Say(`embedded dependencies:\s+1`))
+
+				_, _, includeDependencies := fakeActor.PackageBuildpackArgsForCall(0)
+				Expect(includeDependencies).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when the buildpack is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.PackageBuildpackReturns(sharedaction.BuildpackPackage{}, actionerror.BuildpackExecutableMissingError{Executables: []string{"bin/detect"}})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.BuildpackExecutableMissingError{Executables: []string{"bin/detect"}}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when packaging fails", func() {
+		BeforeEach(func() {
+			fakeActor.PackageBuildpackReturns(sharedaction.BuildpackPackage{}, errors.New("package-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("package-error"))
+		})
+	})
+})
@@ -1,9 +1,17 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -15,9 +23,12 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/download"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
@@ -35,43 +46,62 @@ type ProgressBar interface {
 
 type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	CheckQuotaHeadroom(orgGUID string, spaceGUID string, configs []pushaction.ApplicationConfig) (pushaction.Warnings, error)
 	CloudControllerV2APIVersion() string
 	CloudControllerV3APIVersion() string
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	FetchRemoteAppSource(sourceURL string, checksum string, tmpDir string, downloader pushaction.Downloader) (string, error)
+	LoadBlueGreenState(stateDir string, spaceGUID string, appName string) (pushaction.BlueGreenState, bool, error)
+	MarkBlueGreenAppPushed(state pushaction.BlueGreenState, appGUID string) (pushaction.BlueGreenState, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	PrepareBlueGreenPush(config pushaction.ApplicationConfig, orgGUID string, stateDir string, keepOld bool) (pushaction.ApplicationConfig, pushaction.BlueGreenState, pushaction.Warnings, error)
 	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
+	RetireBlueGreenApp(state pushaction.BlueGreenState) (pushaction.Warnings, error)
+	RollbackBlueGreenPush(state pushaction.BlueGreenState) (pushaction.Warnings, error)
+	RunSmokeTest(state pushaction.BlueGreenState, smokeTest pushaction.SmokeTest) error
+	SetMatchedResources(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings)
+	SwapBlueGreenRoutes(state pushaction.BlueGreenState) (pushaction.BlueGreenState, pushaction.Warnings, error)
 }
 
 type PushCommand struct {
-	OptionalArgs        flag.OptionalAppName          `positional-args:"yes"`
-	Buildpacks          []string                      `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Command             flag.Command                  `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain              string                        `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage         flag.DockerImage              `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	DockerUsername      string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	DropletPath         flag.PathWithExistenceCheck   `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
-	PathToManifest      flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
-	HealthCheckType     flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	Hostname            string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	Instances           flag.Instances                `short:"i" description:"Number of instances"`
-	DiskQuota           flag.Megabytes                `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory              flag.Megabytes                `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoHostname          bool                          `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest          bool                          `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute             bool                          `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart             bool                          `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath             flag.PathWithExistenceCheck   `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute         bool                          `long:"random-route" description:"Create a random route for this app"`
-	RoutePath           flag.RoutePath                `long:"route-path" description:"Path for the route"`
-	StackName           string                        `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	VarsFilePaths       []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
-	Vars                []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
-	HealthCheckTimeout  int                           `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-
-	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	OptionalArgs        flag.OptionalAppName                   `positional-args:"yes"`
+	Buildpacks          []string                               `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Checksum            string                                 `long:"checksum" description:"SHA1 or SHA256 checksum (e.g. sha256:HEX) that the zip file downloaded from the -p URL must match"`
+	Command             flag.Command                           `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain              string                                 `short:"d" description:"Domain (e.g. example.com)"`
+	DockerImage         flag.DockerImage                       `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername      string                                 `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DropletPath         flag.PathWithExistenceCheck            `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun              bool                                   `long:"dry-run" description:"Show the changes push would make to the apps and the files it would upload, without changing anything"`
+	PathToManifest      flag.PathWithExistenceCheck            `short:"f" description:"Path to manifest"`
+	HealthCheckType     flag.HealthCheckType                   `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname            string                                 `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	Instances           flag.Instances                         `short:"i" description:"Number of instances"`
+	JSON                bool                                   `long:"json" description:"Print the --dry-run plan as JSON"`
+	KeepOld             bool                                   `long:"keep-old" description:"Stop and rename the old version of the app instead of deleting it after a blue-green push"`
+	DiskQuota           flag.Megabytes                         `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory              flag.Megabytes                         `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname          bool                                   `long:"no-hostname" description:"Map the root domain to this app"`
+	NoManifest          bool                                   `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute             bool                                   `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart             bool                                   `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath             flag.PathWithExistenceCheckOrSourceURL `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory, URL of such a zip file, or git repository (e.g. 'git+https://example.com/app.git#v1.2.3')"`
+	RandomRoute         bool                                   `long:"random-route" description:"Create a random route for this app"`
+	RoutePath           flag.RoutePath                         `long:"route-path" description:"Path for the route"`
+	SkipQuotaCheck      bool                                   `long:"skip-quota-check" description:"Do not check that the org and space quotas have room for the apps before starting them"`
+	SmokeTest           string                                 `long:"smoke-test" description:"Command to run against the new version of the app during a blue-green push; APP_URL and APP_NAME are set in its environment"`
+	SmokeTestPath       string                                 `long:"smoke-test-path" description:"Path on the new version of the app that must respond with a 2xx status during a blue-green push (Default: '/')"`
+	SmokeTestTimeout    int                                    `long:"smoke-test-timeout" default:"60" description:"Time (in seconds) allowed for the smoke test to pass during a blue-green push"`
+	StackName           string                                 `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	Strategy            flag.PushStrategy                      `long:"strategy" description:"Deployment strategy; 'blue-green' pushes the app under a temporary name and route, smoke tests it, then moves the routes over"`
+	VarsFilePaths       []flag.PathWithExistenceCheck          `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Vars                []template.VarKV                       `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	HealthCheckTimeout  int                                    `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingTimeout interface{}                            `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                            `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword      interface{}                            `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+
+	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--skip-quota-check] [--dry-run [--json]]\n   [--strategy blue-green [--smoke-test COMMAND | --smoke-test-path PATH] [--smoke-test-timeout SECONDS] [--keep-old]]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [--checksum CHECKSUM] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run [--json]]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run [--json]]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--dry-run [--json]]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI                      command.UI
@@ -88,6 +118,11 @@ type PushCommand struct {
 func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	if cmd.DryRun {
+		// A dry run must not record hashes and matches of files it has not
+		// pushed.
+		config = noResourceCacheConfig{Config: config}
+	}
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
 
@@ -109,12 +144,24 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ApplicationSummaryActor = v2v3action.NewActor(v2Actor, v3Actor)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	return nil
 }
 
+// noResourceCacheConfig is a config with the resource cache disabled.
+type noResourceCacheConfig struct {
+	command.Config
+}
+
+func (noResourceCacheConfig) ResourceCacheDir() string {
+	return ""
+}
+
 func (cmd PushCommand) Execute(args []string) error {
 	if cmd.DropletPath != "" {
 		if err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerV2APIVersion(), ccversion.MinVersionDropletUploadV2, "Option '--droplet'"); err != nil {
@@ -145,6 +192,20 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if pushaction.IsRemoteAppSource(cliSettings.ProvidedAppPath) {
+		tmpDirPath, tmpErr := ioutil.TempDir("", "cf-push-source-")
+		if tmpErr != nil {
+			return tmpErr
+		}
+		defer os.RemoveAll(tmpDirPath)
+
+		cliSettings.ProvidedAppPath, err = cmd.fetchRemoteAppSource(cliSettings.ProvidedAppPath, tmpDirPath)
+		if err != nil {
+			log.Errorln("fetching app source:", err)
+			return err
+		}
+	}
+
 	log.Info("checking manifest")
 	rawApps, err := cmd.findAndReadManifestWithFlavorText(cliSettings)
 	if err != nil {
@@ -167,7 +228,9 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.UI.DisplayText("Getting app info...")
+	if !cmd.JSON {
+		cmd.UI.DisplayText("Getting app info...")
+	}
 
 	log.Info("converting manifests to ApplicationConfigs")
 	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfigs(
@@ -182,6 +245,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.displayPushPlan(appConfigs)
+	}
+
 	for _, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayText("Creating app with these attributes...")
@@ -198,30 +265,31 @@ func (cmd PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
-	for appNumber, appConfig := range appConfigs {
-		if appConfig.CreatingApplication() {
-			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
-		} else {
-			cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
-		}
-
-		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
-		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+	if !cmd.NoStart && !cmd.SkipQuotaCheck {
+		err = cmd.checkQuotaHeadroom(appConfigs)
 		if err != nil {
-			log.Errorln("process apply stream:", err)
 			return err
 		}
+	}
 
-		if !cmd.NoStart {
-			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient)
-			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
-			if err != nil {
-				return err
+	for appNumber, appConfig := range appConfigs {
+		if cmd.Strategy.Strategy == "blue-green" {
+			err = cmd.blueGreenPush(user, appConfig)
+		} else {
+			if appConfig.CreatingApplication() {
+				cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
+					"AppName": appConfig.DesiredApplication.Name,
+				})
+			} else {
+				cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
+					"AppName": appConfig.DesiredApplication.Name,
+				})
 			}
+
+			_, err = cmd.applyAndStart(user, appConfig)
+		}
+		if err != nil {
+			return err
 		}
 
 		cmd.UI.DisplayNewline()
@@ -252,6 +320,185 @@ func (cmd PushCommand) Execute(args []string) error {
 	return nil
 }
 
+// checkQuotaHeadroom fails before anything is changed when starting the apps
+// would exceed the org or space quota. A blue-green push runs the new version
+// of an app next to the old one, so the old version's allocation is kept.
+func (cmd PushCommand) checkQuotaHeadroom(appConfigs []pushaction.ApplicationConfig) error {
+	configs := appConfigs
+	if cmd.Strategy.Strategy == "blue-green" {
+		configs = make([]pushaction.ApplicationConfig, len(appConfigs))
+		for i, appConfig := range appConfigs {
+			appConfig.CurrentApplication = pushaction.Application{}
+			configs[i] = appConfig
+		}
+	}
+
+	warnings, err := cmd.Actor.CheckQuotaHeadroom(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, configs)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+// applyAndStart applies the config and, unless --no-start is provided, starts
+// the app.
+func (cmd PushCommand) applyAndStart(user configv3.User, appConfig pushaction.ApplicationConfig) (pushaction.ApplicationConfig, error) {
+	configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
+	updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+	if err != nil {
+		log.Errorln("process apply stream:", err)
+		return updatedConfig, err
+	}
+	cmd.displayUploadSavings(updatedConfig)
+
+	if !cmd.NoStart {
+		messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient)
+		err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+		if err != nil {
+			return updatedConfig, err
+		}
+	}
+
+	return updatedConfig, nil
+}
+
+// blueGreenPush pushes the new version of the app next to the old one with a
+// temporary route, smoke tests it, moves the routes over and retires the old
+// version. Any failure before the routes are moved is rolled back.
+func (cmd PushCommand) blueGreenPush(user configv3.User, appConfig pushaction.ApplicationConfig) error {
+	appName := appConfig.DesiredApplication.Name
+	stateDir := cmd.Config.BlueGreenStateDir()
+
+	state, found, err := cmd.Actor.LoadBlueGreenState(stateDir, cmd.Config.TargetedSpace().GUID, appName)
+	if err != nil {
+		return err
+	}
+	if found {
+		if state.Resumable() {
+			cmd.UI.DisplayTextWithFlavor("Resuming interrupted blue-green push of app {{.AppName}}...", map[string]interface{}{
+				"AppName": appName,
+			})
+			err = cmd.finishBlueGreenPush(state)
+			if err != nil {
+				return err
+			}
+			cmd.UI.DisplayText("Run push again to push any newer changes.")
+			return nil
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Rolling back interrupted blue-green push of app {{.AppName}}...", map[string]interface{}{
+			"AppName": appName,
+		})
+		warnings, rollbackErr := cmd.Actor.RollbackBlueGreenPush(state)
+		cmd.UI.DisplayWarnings(warnings)
+		if rollbackErr != nil {
+			return rollbackErr
+		}
+	}
+
+	tempConfig, state, warnings, err := cmd.Actor.PrepareBlueGreenPush(appConfig, cmd.Config.TargetedOrganization().GUID, stateDir, cmd.KeepOld)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Pushing new version of app {{.AppName}} as {{.NewAppName}}...", map[string]interface{}{
+		"AppName":    appName,
+		"NewAppName": state.NewAppName,
+	})
+	updatedConfig, err := cmd.applyAndStart(user, tempConfig)
+	if updatedConfig.CurrentApplication.GUID != "" {
+		var markErr error
+		state, markErr = cmd.Actor.MarkBlueGreenAppPushed(state, updatedConfig.CurrentApplication.GUID)
+		if err == nil {
+			err = markErr
+		}
+	}
+	if err != nil {
+		return cmd.rollbackBlueGreenPush(state, err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Smoke testing app {{.NewAppName}} at {{.URL}}...", map[string]interface{}{
+		"NewAppName": state.NewAppName,
+		"URL":        state.TempURL,
+	})
+	err = cmd.Actor.RunSmokeTest(state, pushaction.SmokeTest{
+		Command:           cmd.SmokeTest,
+		HTTPPath:          cmd.SmokeTestPath,
+		Timeout:           time.Duration(cmd.SmokeTestTimeout) * time.Second,
+		SkipSSLValidation: cmd.Config.SkipSSLValidation(),
+		Output:            cmd.UI.GetOut(),
+	})
+	if err != nil {
+		return cmd.rollbackBlueGreenPush(state, err)
+	}
+	cmd.UI.DisplayOK()
+
+	return cmd.finishBlueGreenPush(state)
+}
+
+// finishBlueGreenPush moves the routes to the new version of the app and
+// retires the old version.
+func (cmd PushCommand) finishBlueGreenPush(state pushaction.BlueGreenState) error {
+	if state.Phase != pushaction.BlueGreenRetiring {
+		cmd.UI.DisplayTextWithFlavor("Moving routes from app {{.AppName}} to app {{.NewAppName}}...", map[string]interface{}{
+			"AppName":    state.AppName,
+			"NewAppName": state.NewAppName,
+		})
+		var (
+			warnings pushaction.Warnings
+			err      error
+		)
+		state, warnings, err = cmd.Actor.SwapBlueGreenRoutes(state)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.rollbackBlueGreenPush(state, err)
+		}
+	}
+
+	if state.OldAppGUID == "" {
+		cmd.UI.DisplayTextWithFlavor("Renaming app {{.NewAppName}} to {{.AppName}}...", map[string]interface{}{
+			"AppName":    state.AppName,
+			"NewAppName": state.NewAppName,
+		})
+	} else if state.KeepOld {
+		cmd.UI.DisplayTextWithFlavor("Stopping old version of app {{.AppName}} and renaming it to {{.OldAppName}}...", map[string]interface{}{
+			"AppName":    state.AppName,
+			"OldAppName": state.OldAppName,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Deleting old version of app {{.AppName}}...", map[string]interface{}{
+			"AppName": state.AppName,
+		})
+	}
+	warnings, err := cmd.Actor.RetireBlueGreenApp(state)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("The routes of app {{.AppName}} have been moved to the new version, but the old version could not be retired. Run push again to finish.", map[string]interface{}{
+			"AppName": state.AppName,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// rollbackBlueGreenPush undoes a failed blue-green push and returns the error
+// that caused it.
+func (cmd PushCommand) rollbackBlueGreenPush(state pushaction.BlueGreenState, pushErr error) error {
+	cmd.UI.DisplayWarning("Blue-green push of app {{.AppName}} failed; rolling back...", map[string]interface{}{
+		"AppName": state.AppName,
+	})
+	warnings, err := cmd.Actor.RollbackBlueGreenPush(state)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("rolling back blue-green push:", err)
+		cmd.UI.DisplayWarning("Rolling back failed: {{.Error}}. Run push again to retry the rollback.", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+	return pushErr
+}
+
 // GetCommandLineSettings generates a push CommandLineSettings object from the
 // command's command line flags. It also validates those settings, preventing
 // contradictory flags.
@@ -305,6 +552,26 @@ func (cmd PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings,
 	return config, nil
 }
 
+func (cmd PushCommand) fetchRemoteAppSource(sourceURL string, tmpDirPath string) (string, error) {
+	if !cmd.JSON {
+		cmd.UI.DisplayText("Fetching app source...")
+	}
+
+	downloader := download.NewDownloader(time.Second * 30)
+	appPath, err := cmd.Actor.FetchRemoteAppSource(sourceURL, cmd.Checksum, tmpDirPath, downloader)
+	if err != nil {
+		if httpErr, ok := err.(download.RawHTTPStatusError); ok {
+			return "", translatableerror.AppSourceHTTPStatusError{Status: httpErr.Status}
+		}
+		return "", err
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayNewline()
+	}
+	return appPath, nil
+}
+
 func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
 	var (
 		pathToManifest string
@@ -361,12 +628,14 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 	}
 
 	if pathToManifest == "" {
-		cmd.UI.DisplayTextWithFlavor("Pushing app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-			"AppName":   settings.Name,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
+		if !cmd.JSON {
+			cmd.UI.DisplayTextWithFlavor("Pushing app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+				"AppName":   settings.Name,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		}
 		return nil, nil
 	}
 
@@ -375,15 +644,19 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Pushing from manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Pushing from manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
 	log.WithField("pathToManifest", pathToManifest).Info("reading manifest")
-	cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
-		"Path": pathToManifest,
-	})
+	if !cmd.JSON {
+		cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
+			"Path": pathToManifest,
+		})
+	}
 
 	apps, warnings, err := cmd.Actor.ReadManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
 	cmd.UI.DisplayWarnings(warnings)
@@ -444,6 +717,199 @@ func (cmd PushCommand) processApplyStreams(
 	return updatedConfig, nil
 }
 
+// pushPlan is the JSON representation of the changes push would make to an
+// application.
+type pushPlan struct {
+	Name    string           `json:"name"`
+	Action  string           `json:"action"`
+	Changes []pushPlanChange `json:"changes"`
+	Upload  []pushPlanFile   `json:"upload"`
+	Matched []pushPlanFile   `json:"matched"`
+}
+
+type pushPlanChange struct {
+	Field   string      `json:"field"`
+	Current interface{} `json:"current"`
+	Desired interface{} `json:"desired"`
+	Changed bool        `json:"changed"`
+}
+
+type pushPlanFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// displayPushPlan displays the changes push would make to each application
+// and the files it would upload, without applying any of them.
+func (cmd PushCommand) displayPushPlan(appConfigs []pushaction.ApplicationConfig) error {
+	plans := []pushPlan{}
+	for _, appConfig := range appConfigs {
+		if len(appConfig.AllResources) > 0 {
+			var warnings pushaction.Warnings
+			appConfig, warnings = cmd.Actor.SetMatchedResources(appConfig)
+			cmd.UI.DisplayWarnings(warnings)
+		}
+
+		changes := shared.GetApplicationChanges(appConfig)
+		if len(appConfig.CurrentApplication.Buildpacks) > 0 || len(appConfig.DesiredApplication.Buildpacks) > 0 {
+			changes = append(changes, ui.Change{
+				Header:       "buildpacks:",
+				CurrentValue: appConfig.CurrentApplication.Buildpacks,
+				NewValue:     appConfig.DesiredApplication.Buildpacks,
+			})
+		}
+
+		if cmd.JSON {
+			plans = append(plans, newPushPlan(appConfig, changes))
+			continue
+		}
+
+		if appConfig.CreatingApplication() {
+			cmd.UI.DisplayText("App {{.AppName}} would be created with these attributes...", map[string]interface{}{
+				"AppName": appConfig.DesiredApplication.Name,
+			})
+		} else {
+			cmd.UI.DisplayText("App {{.AppName}} would be updated with these attributes...", map[string]interface{}{
+				"AppName": appConfig.DesiredApplication.Name,
+			})
+		}
+		err := cmd.UI.DisplayChangesForPush(changes)
+		if err != nil {
+			log.Errorln("display changes:", err)
+			return err
+		}
+		cmd.UI.DisplayNewline()
+		cmd.displayPlannedUploads(appConfig)
+	}
+
+	if cmd.JSON {
+		output, err := json.MarshalIndent(plans, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.UI.GetOut(), string(output))
+		return nil
+	}
+
+	cmd.UI.DisplayText("Dry run complete; no changes were made.")
+	return nil
+}
+
+func (cmd PushCommand) displayPlannedUploads(appConfig pushaction.ApplicationConfig) {
+	if len(appConfig.AllResources) == 0 {
+		return
+	}
+
+	upload := plannedFiles(appConfig.UnmatchedResources)
+	if len(upload) == 0 {
+		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
+	} else {
+		cmd.UI.DisplayText("Files to upload:")
+		table := [][]string{}
+		for _, file := range upload {
+			table = append(table, []string{file.Path, bytefmt.ByteSize(uint64(file.Size))})
+		}
+		cmd.UI.DisplayNonWrappingTable("  ", table, ui.DefaultTableSpacePadding)
+	}
+
+	if matched := plannedFiles(appConfig.MatchedResources); len(matched) > 0 {
+		cmd.UI.DisplayText("{{.Count}} files found in remote cache; would skip uploading.", map[string]interface{}{
+			"Count": len(matched),
+		})
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func newPushPlan(appConfig pushaction.ApplicationConfig, changes []ui.Change) pushPlan {
+	plan := pushPlan{
+		Name:    appConfig.DesiredApplication.Name,
+		Action:  "update",
+		Changes: []pushPlanChange{},
+		Upload:  plannedFiles(appConfig.UnmatchedResources),
+		Matched: plannedFiles(appConfig.MatchedResources),
+	}
+	if appConfig.CreatingApplication() {
+		plan.Action = "create"
+	}
+
+	for _, change := range changes {
+		planChange := pushPlanChange{
+			Field:   strings.TrimSuffix(change.Header, ":"),
+			Changed: planValueChanged(change.CurrentValue, change.NewValue),
+		}
+		if !change.HiddenValue {
+			planChange.Current = planValue(change.CurrentValue)
+			planChange.Desired = planValue(change.NewValue)
+		}
+		plan.Changes = append(plan.Changes, planChange)
+	}
+
+	return plan
+}
+
+func plannedFiles(resources []v2action.Resource) []pushPlanFile {
+	files := []pushPlanFile{}
+	for _, resource := range resources {
+		if resource.Mode.IsDir() {
+			continue
+		}
+		files = append(files, pushPlanFile{Path: resource.Filename, Size: resource.Size})
+	}
+	return files
+}
+
+// planValue converts a change value to its JSON representation. Only the
+// names of environment variables are included, as their values may be
+// secret.
+func planValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]string:
+		names := []string{}
+		for name := range typedValue {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	case []string:
+		sorted := append([]string{}, typedValue...)
+		sort.Strings(sorted)
+		return sorted
+	}
+	return value
+}
+
+func planValueChanged(currentValue interface{}, newValue interface{}) bool {
+	if currentMap, ok := currentValue.(map[string]string); ok {
+		newMap, _ := newValue.(map[string]string)
+		if len(currentMap) == 0 && len(newMap) == 0 {
+			return false
+		}
+		return !reflect.DeepEqual(currentMap, newMap)
+	}
+	return !reflect.DeepEqual(planValue(currentValue), planValue(newValue))
+}
+
+// displayUploadSavings displays how much of the application's content the
+// Cloud Controller already had and therefore did not have to be uploaded.
+func (cmd PushCommand) displayUploadSavings(config pushaction.ApplicationConfig) {
+	var saved, total int64
+	for _, resource := range config.MatchedResources {
+		saved += resource.Size
+	}
+	for _, resource := range config.AllResources {
+		total += resource.Size
+	}
+
+	if saved == 0 || total == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText("{{.Saved}} of {{.Total}} found in remote cache; skipped uploading.", map[string]interface{}{
+		"Saved": bytefmt.ByteSize(uint64(saved)),
+		"Total": bytefmt.ByteSize(uint64(total)),
+	})
+}
+
 func (cmd PushCommand) processEvent(user configv3.User, appConfig pushaction.ApplicationConfig, event pushaction.Event) bool {
 	log.Infoln("received apply event:", event)
 
@@ -511,6 +977,43 @@ func (cmd PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"-b", "--docker-image, -o"},
 		}
+	case cmd.Checksum != "" && !util.IsHTTPScheme(string(cmd.AppPath)):
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--checksum",
+			Arg2: "-p URL",
+		}
+	case cmd.Strategy.Strategy != "" && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--strategy", "--no-start"},
+		}
+	case cmd.Strategy.Strategy != "" && cmd.DryRun:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--strategy", "--dry-run"},
+		}
+	case cmd.SmokeTest != "" && cmd.SmokeTestPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--smoke-test", "--smoke-test-path"},
+		}
+	case cmd.SmokeTest != "" && cmd.Strategy.Strategy == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--smoke-test",
+			Arg2: "--strategy",
+		}
+	case cmd.SmokeTestPath != "" && cmd.Strategy.Strategy == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--smoke-test-path",
+			Arg2: "--strategy",
+		}
+	case cmd.KeepOld && cmd.Strategy.Strategy == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--keep-old",
+			Arg2: "--strategy",
+		}
+	case cmd.JSON && !cmd.DryRun:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--json",
+			Arg2: "--dry-run",
+		}
 	case cmd.DockerUsername != "" && cmd.DockerImage.Path == "":
 		return translatableerror.RequiredFlagsError{
 			Arg1: "--docker-image, -o",
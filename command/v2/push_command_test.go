@@ -1,6 +1,7 @@
 package v2_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/download"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -153,6 +155,67 @@ var _ = Describe("push Command", func() {
 					fakeActor.MergeAndValidateSettingsAndManifestsReturns(appManifests, nil)
 				})
 
+				Context("when -p is a remote app source", func() {
+					BeforeEach(func() {
+						cmd.AppPath = "git+https://example.com/app.git#v1.2.3"
+						fakeActor.FetchRemoteAppSourceStub = func(_ string, _ string, tmpDir string, _ pushaction.Downloader) (string, error) {
+							return filepath.Join(tmpDir, "source"), nil
+						}
+					})
+
+					It("fetches the app source and pushes it from a temporary directory", func() {
+						Expect(testUI.Out).To(Say("Fetching app source..."))
+
+						Expect(fakeActor.FetchRemoteAppSourceCallCount()).To(Equal(1))
+						sourceURL, checksum, tmpDir, downloader := fakeActor.FetchRemoteAppSourceArgsForCall(0)
+						Expect(sourceURL).To(Equal("git+https://example.com/app.git#v1.2.3"))
+						Expect(checksum).To(BeEmpty())
+						Expect(downloader).ToNot(BeNil())
+
+						Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+						settings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+						Expect(settings.ProvidedAppPath).To(Equal(filepath.Join(tmpDir, "source")))
+
+						_, err := os.Stat(tmpDir)
+						Expect(os.IsNotExist(err)).To(BeTrue())
+					})
+
+					Context("when a checksum is provided", func() {
+						BeforeEach(func() {
+							cmd.AppPath = "https://example.com/app.zip"
+							cmd.Checksum = "sha256:some-checksum"
+						})
+
+						It("passes the checksum", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							_, checksum, _, _ := fakeActor.FetchRemoteAppSourceArgsForCall(0)
+							Expect(checksum).To(Equal("sha256:some-checksum"))
+						})
+					})
+
+					Context("when fetching the app source fails", func() {
+						BeforeEach(func() {
+							fakeActor.FetchRemoteAppSourceReturns("", actionerror.GitCommandError{Command: "clone", Output: "some-output"})
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(actionerror.GitCommandError{Command: "clone", Output: "some-output"}))
+							Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when downloading the app source fails with an HTTP error", func() {
+						BeforeEach(func() {
+							cmd.AppPath = "https://example.com/app.zip"
+							fakeActor.FetchRemoteAppSourceReturns("", download.RawHTTPStatusError{Status: "404 Not Found"})
+						})
+
+						It("returns an AppSourceHTTPStatusError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.AppSourceHTTPStatusError{Status: "404 Not Found"}))
+						})
+					})
+				})
+
 				Context("when buildpacks (plural) is provided in the manifest and the API version is below the minimum", func() {
 					BeforeEach(func() {
 						appManifests = []manifest.Application{
@@ -199,10 +262,182 @@ var _ = Describe("push Command", func() {
 						fakeActor.ConvertToApplicationConfigsReturns(appConfigs, pushaction.Warnings{"some-config-warnings"}, nil)
 					})
 
+					Context("when --dry-run is provided", func() {
+						BeforeEach(func() {
+							cmd.DryRun = true
+							appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+							appConfigs[0].CurrentApplication.EnvironmentVariables = map[string]string{"SECRET": "old-value", "REMOVED": "some-value"}
+							appConfigs[0].DesiredApplication.EnvironmentVariables = map[string]string{"SECRET": "new-value"}
+							appConfigs[0].AllResources = []v2action.Resource{
+								{Filename: "some-dir", Mode: os.ModeDir | 0755},
+								{Filename: "some-dir/some-file", Size: 2048},
+								{Filename: "some-cached-file", Size: 1024},
+							}
+
+							fakeActor.SetMatchedResourcesStub = func(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings) {
+								config.MatchedResources = config.AllResources[2:]
+								config.UnmatchedResources = config.AllResources[:2]
+								return config, pushaction.Warnings{"some-match-warning"}
+							}
+						})
+
+						It("displays the changes and the files to upload without applying them", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Getting app info..."))
+							Expect(testUI.Out).To(Say("App %s would be updated with these attributes...", appName))
+							Expect(testUI.Out).To(Say(`\s+env:`))
+							Expect(testUI.Out).To(Say(`\-\s+REMOVED`))
+							Expect(testUI.Out).To(Say(`\-\s+SECRET`))
+							Expect(testUI.Out).To(Say(`\+\s+SECRET`))
+							Expect(testUI.Out).To(Say("Files to upload:"))
+							Expect(testUI.Out).To(Say(`some-dir/some-file\s+2K`))
+							Expect(testUI.Out).To(Say("1 files found in remote cache; would skip uploading."))
+							Expect(testUI.Out).To(Say("Dry run complete; no changes were made."))
+							Expect(testUI.Out).ToNot(Say("old-value|new-value"))
+							Expect(testUI.Err).To(Say("some-match-warning"))
+
+							Expect(fakeActor.SetMatchedResourcesCallCount()).To(Equal(1))
+							Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+						})
+
+						Context("when the app is docker based", func() {
+							BeforeEach(func() {
+								appConfigs[0].AllResources = nil
+								appConfigs[0].DesiredApplication.DockerImage = "some-image"
+							})
+
+							It("does not match resources", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(fakeActor.SetMatchedResourcesCallCount()).To(Equal(0))
+								Expect(testUI.Out).ToNot(Say("Files to upload:"))
+							})
+						})
+
+						Context("when --json is provided", func() {
+							BeforeEach(func() {
+								cmd.JSON = true
+								cmd.NoManifest = true
+								appConfigs[0].CurrentApplication.GUID = ""
+								appConfigs[0].CurrentApplication.Name = ""
+								appConfigs[0].DesiredApplication.Instances = types.NullInt{Value: 2, IsSet: true}
+							})
+
+							It("displays only the plan as JSON", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								var plans []map[string]interface{}
+								Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &plans)).To(Succeed())
+								Expect(plans).To(HaveLen(1))
+								Expect(plans[0]).To(HaveKeyWithValue("name", appName))
+								Expect(plans[0]).To(HaveKeyWithValue("action", "create"))
+								Expect(plans[0]["changes"]).To(ContainElement(map[string]interface{}{
+									"field": "name", "current": "", "desired": appName, "changed": true,
+								}))
+								Expect(plans[0]["changes"]).To(ContainElement(map[string]interface{}{
+									"field": "instances", "current": nil, "desired": float64(2), "changed": true,
+								}))
+								Expect(plans[0]["changes"]).To(ContainElement(map[string]interface{}{
+									"field": "env", "current": []interface{}{"REMOVED", "SECRET"}, "desired": []interface{}{"SECRET"}, "changed": true,
+								}))
+								Expect(plans[0]["upload"]).To(Equal([]interface{}{
+									map[string]interface{}{"path": "some-dir/some-file", "size": float64(2048)},
+								}))
+								Expect(plans[0]["matched"]).To(Equal([]interface{}{
+									map[string]interface{}{"path": "some-cached-file", "size": float64(1024)},
+								}))
+
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when checking the quota headroom", func() {
+						BeforeEach(func() {
+							appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+							fakeActor.CheckQuotaHeadroomReturns(pushaction.Warnings{"some-quota-warning"}, nil)
+							fakeActor.ApplyStub = func(_ pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+								errorStream := make(chan error, 1)
+								errorStream <- errors.New("some-apply-error")
+								return nil, nil, nil, errorStream
+							}
+						})
+
+						It("checks the quotas for the app configs before applying them", func() {
+							Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+							orgGUID, spaceGUID, configs := fakeActor.CheckQuotaHeadroomArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(configs).To(Equal(appConfigs))
+
+							Expect(testUI.Err).To(Say("some-quota-warning"))
+						})
+
+						Context("when the quota would be exceeded", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = actionerror.QuotaExceededError{Scope: "space", QuotaName: "some-quota", Resource: actionerror.QuotaResourceMemory, Requested: 2048, Available: 1024}
+								fakeActor.CheckQuotaHeadroomReturns(pushaction.Warnings{"some-quota-warning"}, expectedErr)
+							})
+
+							It("returns the error without applying the configs", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.Err).To(Say("some-quota-warning"))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when --strategy blue-green is provided", func() {
+							BeforeEach(func() {
+								cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+								fakeActor.CheckQuotaHeadroomReturns(nil, errors.New("stop here"))
+							})
+
+							It("counts the new version of the app on top of the current one", func() {
+								Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+								_, _, configs := fakeActor.CheckQuotaHeadroomArgsForCall(0)
+								Expect(configs).To(HaveLen(1))
+								Expect(configs[0].CurrentApplication).To(Equal(pushaction.Application{}))
+								Expect(configs[0].DesiredApplication).To(Equal(appConfigs[0].DesiredApplication))
+							})
+						})
+
+						Context("when --skip-quota-check is provided", func() {
+							BeforeEach(func() {
+								cmd.SkipQuotaCheck = true
+								fakeActor.CheckQuotaHeadroomReturns(nil, errors.New("should not be called"))
+							})
+
+							It("does not check the quotas", func() {
+								Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when --no-start is provided", func() {
+							BeforeEach(func() {
+								cmd.NoStart = true
+								fakeActor.CheckQuotaHeadroomReturns(nil, errors.New("should not be called"))
+							})
+
+							It("does not check the quotas", func() {
+								Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when the apply is successful", func() {
-						var updatedConfig pushaction.ApplicationConfig
+						var (
+							updatedConfig    pushaction.ApplicationConfig
+							allResources     []v2action.Resource
+							matchedResources []v2action.Resource
+						)
 
 						BeforeEach(func() {
+							allResources = nil
+							matchedResources = nil
+
 							fakeActor.ApplyStub = func(_ pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
 								configStream := make(chan pushaction.ApplicationConfig, 1)
 								eventStream := make(chan pushaction.Event)
@@ -212,6 +447,8 @@ var _ = Describe("push Command", func() {
 								updatedConfig = pushaction.ApplicationConfig{
 									CurrentApplication: pushaction.Application{Application: v2action.Application{Name: appName, GUID: "some-app-guid"}},
 									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: appName, GUID: "some-app-guid"}},
+									AllResources:       allResources,
+									MatchedResources:   matchedResources,
 									Path:               pwd,
 								}
 
@@ -307,6 +544,188 @@ var _ = Describe("push Command", func() {
 							fakeRestartActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
 						})
 
+						Context("when --strategy blue-green is provided", func() {
+							var state pushaction.BlueGreenState
+
+							BeforeEach(func() {
+								cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+								cmd.SmokeTestPath = "/health"
+								cmd.SmokeTestTimeout = 30
+								fakeConfig.BlueGreenStateDirReturns("some-state-dir")
+								fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+								fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+
+								state = pushaction.BlueGreenState{
+									Phase:      pushaction.BlueGreenPushing,
+									AppName:    appName,
+									OldAppGUID: "some-old-app-guid",
+									NewAppName: appName + "-new",
+									TempURL:    "http://some-app-new-temp.example.com",
+								}
+								tempConfig := appConfigs[0]
+								tempConfig.DesiredApplication.Name = appName + "-new"
+								fakeActor.PrepareBlueGreenPushReturns(tempConfig, state, pushaction.Warnings{"prepare-warning"}, nil)
+								fakeActor.MarkBlueGreenAppPushedStub = func(state pushaction.BlueGreenState, appGUID string) (pushaction.BlueGreenState, error) {
+									state.NewAppGUID = appGUID
+									state.Phase = pushaction.BlueGreenTesting
+									return state, nil
+								}
+								fakeActor.SwapBlueGreenRoutesStub = func(state pushaction.BlueGreenState) (pushaction.BlueGreenState, pushaction.Warnings, error) {
+									state.Phase = pushaction.BlueGreenSwapping
+									return state, pushaction.Warnings{"swap-warning"}, nil
+								}
+								fakeActor.RetireBlueGreenAppReturns(pushaction.Warnings{"retire-warning"}, nil)
+							})
+
+							It("pushes, smoke tests and swaps in the new version of the app", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								stateDir, spaceGUID, name := fakeActor.LoadBlueGreenStateArgsForCall(0)
+								Expect(stateDir).To(Equal("some-state-dir"))
+								Expect(spaceGUID).To(Equal("some-space-guid"))
+								Expect(name).To(Equal(appName))
+
+								config, orgGUID, stateDir, keepOld := fakeActor.PrepareBlueGreenPushArgsForCall(0)
+								Expect(config).To(Equal(appConfigs[0]))
+								Expect(orgGUID).To(Equal("some-org-guid"))
+								Expect(stateDir).To(Equal("some-state-dir"))
+								Expect(keepOld).To(BeFalse())
+
+								Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+								appliedConfig, _ := fakeActor.ApplyArgsForCall(0)
+								Expect(appliedConfig.DesiredApplication.Name).To(Equal(appName + "-new"))
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(1))
+
+								_, appGUID := fakeActor.MarkBlueGreenAppPushedArgsForCall(0)
+								Expect(appGUID).To(Equal("some-app-guid"))
+
+								testedState, smokeTest := fakeActor.RunSmokeTestArgsForCall(0)
+								Expect(testedState.NewAppGUID).To(Equal("some-app-guid"))
+								Expect(smokeTest.HTTPPath).To(Equal("/health"))
+								Expect(smokeTest.Timeout).To(Equal(30 * time.Second))
+
+								Expect(fakeActor.SwapBlueGreenRoutesCallCount()).To(Equal(1))
+								retiredState := fakeActor.RetireBlueGreenAppArgsForCall(0)
+								Expect(retiredState.Phase).To(Equal(pushaction.BlueGreenSwapping))
+								Expect(fakeActor.RollbackBlueGreenPushCallCount()).To(Equal(0))
+
+								Expect(testUI.Out).To(Say("Pushing new version of app some-app as some-app-new\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Smoke testing app some-app-new at http://some-app-new-temp.example.com\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Moving routes from app some-app to app some-app-new\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Deleting old version of app some-app\\.\\.\\."))
+								Expect(testUI.Err).To(Say("prepare-warning"))
+								Expect(testUI.Err).To(Say("swap-warning"))
+								Expect(testUI.Err).To(Say("retire-warning"))
+							})
+
+							Context("when the smoke test fails", func() {
+								BeforeEach(func() {
+									fakeActor.RunSmokeTestReturns(actionerror.SmokeTestFailedError{AppName: appName + "-new", Reason: "some-reason"})
+									fakeActor.RollbackBlueGreenPushReturns(pushaction.Warnings{"rollback-warning"}, nil)
+								})
+
+								It("rolls back and returns the error", func() {
+									Expect(executeErr).To(MatchError(actionerror.SmokeTestFailedError{AppName: appName + "-new", Reason: "some-reason"}))
+
+									Expect(fakeActor.RollbackBlueGreenPushCallCount()).To(Equal(1))
+									Expect(fakeActor.RollbackBlueGreenPushArgsForCall(0).NewAppGUID).To(Equal("some-app-guid"))
+									Expect(fakeActor.SwapBlueGreenRoutesCallCount()).To(Equal(0))
+									Expect(fakeActor.RetireBlueGreenAppCallCount()).To(Equal(0))
+
+									Expect(testUI.Err).To(Say("Blue-green push of app some-app failed; rolling back\\.\\.\\."))
+									Expect(testUI.Err).To(Say("rollback-warning"))
+								})
+
+								Context("when the rollback fails", func() {
+									BeforeEach(func() {
+										fakeActor.RollbackBlueGreenPushReturns(nil, errors.New("some-rollback-error"))
+									})
+
+									It("warns about the rollback and returns the original error", func() {
+										Expect(executeErr).To(MatchError(actionerror.SmokeTestFailedError{AppName: appName + "-new", Reason: "some-reason"}))
+										Expect(testUI.Err).To(Say("Rolling back failed: some-rollback-error\\. Run push again to retry the rollback\\."))
+									})
+								})
+							})
+
+							Context("when swapping the routes fails", func() {
+								BeforeEach(func() {
+									fakeActor.SwapBlueGreenRoutesStub = nil
+									fakeActor.SwapBlueGreenRoutesReturns(pushaction.BlueGreenState{Phase: pushaction.BlueGreenSwapping}, nil, errors.New("some-swap-error"))
+								})
+
+								It("rolls back the swapped routes", func() {
+									Expect(executeErr).To(MatchError("some-swap-error"))
+									Expect(fakeActor.RollbackBlueGreenPushArgsForCall(0).Phase).To(Equal(pushaction.BlueGreenSwapping))
+									Expect(fakeActor.RetireBlueGreenAppCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when retiring the old app fails", func() {
+								BeforeEach(func() {
+									fakeActor.RetireBlueGreenAppReturns(nil, errors.New("some-retire-error"))
+								})
+
+								It("does not roll back and tells the user to push again", func() {
+									Expect(executeErr).To(MatchError("some-retire-error"))
+									Expect(fakeActor.RollbackBlueGreenPushCallCount()).To(Equal(0))
+									Expect(testUI.Err).To(Say("Run push again to finish\\."))
+								})
+							})
+
+							Context("when an interrupted push can be resumed", func() {
+								BeforeEach(func() {
+									fakeActor.LoadBlueGreenStateReturns(pushaction.BlueGreenState{
+										Phase:      pushaction.BlueGreenRetiring,
+										AppName:    appName,
+										OldAppGUID: "some-old-app-guid",
+									}, true, nil)
+								})
+
+								It("finishes the interrupted push without pushing again", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.PrepareBlueGreenPushCallCount()).To(Equal(0))
+									Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+									Expect(fakeActor.SwapBlueGreenRoutesCallCount()).To(Equal(0))
+									Expect(fakeActor.RetireBlueGreenAppCallCount()).To(Equal(1))
+
+									Expect(testUI.Out).To(Say("Resuming interrupted blue-green push of app some-app\\.\\.\\."))
+									Expect(testUI.Out).To(Say("Run push again to push any newer changes\\."))
+								})
+							})
+
+							Context("when an interrupted push cannot be resumed", func() {
+								BeforeEach(func() {
+									fakeActor.LoadBlueGreenStateReturns(pushaction.BlueGreenState{
+										Phase:   pushaction.BlueGreenTesting,
+										AppName: appName,
+									}, true, nil)
+								})
+
+								It("rolls back the interrupted push before pushing", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.RollbackBlueGreenPushCallCount()).To(Equal(1))
+									Expect(fakeActor.RollbackBlueGreenPushArgsForCall(0).Phase).To(Equal(pushaction.BlueGreenTesting))
+									Expect(fakeActor.PrepareBlueGreenPushCallCount()).To(Equal(1))
+									Expect(testUI.Out).To(Say("Rolling back interrupted blue-green push of app some-app\\.\\.\\."))
+								})
+							})
+
+							Context("when preparing the push fails", func() {
+								BeforeEach(func() {
+									fakeActor.PrepareBlueGreenPushReturns(pushaction.ApplicationConfig{}, pushaction.BlueGreenState{}, nil, actionerror.BlueGreenAppNameTakenError{AppName: appName, Name: appName + "-new"})
+								})
+
+								It("returns the error without pushing", func() {
+									Expect(executeErr).To(MatchError(actionerror.BlueGreenAppNameTakenError{AppName: appName, Name: appName + "-new"}))
+									Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+									Expect(fakeActor.RollbackBlueGreenPushCallCount()).To(Equal(0))
+								})
+							})
+						})
+
 						Context("when no manifest is provided", func() {
 							It("passes through the command line flags", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
@@ -749,6 +1168,27 @@ var _ = Describe("push Command", func() {
 								Expect(testUI.Err).To(Say("apply-2"))
 							})
 
+							It("does not display upload savings when nothing was matched", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).ToNot(Say("found in remote cache; skipped uploading"))
+							})
+
+							Context("when some resources were already in the remote cache", func() {
+								BeforeEach(func() {
+									allResources = []v2action.Resource{
+										{Filename: "file-1", SHA1: "sha-1", Size: 3 * 1024 * 1024},
+										{Filename: "file-2", SHA1: "sha-2", Size: 1024 * 1024},
+									}
+									matchedResources = allResources[:1]
+								})
+
+								It("displays how much was not uploaded", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(testUI.Out).To(Say("Waiting for API to complete processing files\\.\\.\\."))
+									Expect(testUI.Out).To(Say("3M of 4M found in remote cache; skipped uploading\\."))
+								})
+							})
+
 							It("displays app staging logs", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
@@ -1208,6 +1648,65 @@ var _ = Describe("push Command", func() {
 				},
 				translatableerror.ArgumentCombinationError{Args: []string{"-b", "--docker-image, -o"}}),
 
+			Entry("--checksum without -p",
+				func() {
+					cmd.Checksum = "some-checksum"
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--checksum", Arg2: "-p URL"}),
+
+			Entry("--checksum and a local -p",
+				func() {
+					cmd.Checksum = "some-checksum"
+					cmd.AppPath = "some-directory-path"
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--checksum", Arg2: "-p URL"}),
+
+			Entry("--strategy and --no-start",
+				func() {
+					cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+					cmd.NoStart = true
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--strategy", "--no-start"}}),
+
+			Entry("--strategy and --dry-run",
+				func() {
+					cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+					cmd.DryRun = true
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--strategy", "--dry-run"}}),
+
+			Entry("--smoke-test and --smoke-test-path",
+				func() {
+					cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+					cmd.SmokeTest = "some-command"
+					cmd.SmokeTestPath = "/health"
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--smoke-test", "--smoke-test-path"}}),
+
+			Entry("--smoke-test without --strategy",
+				func() {
+					cmd.SmokeTest = "some-command"
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--smoke-test", Arg2: "--strategy"}),
+
+			Entry("--smoke-test-path without --strategy",
+				func() {
+					cmd.SmokeTestPath = "/health"
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--smoke-test-path", Arg2: "--strategy"}),
+
+			Entry("--keep-old without --strategy",
+				func() {
+					cmd.KeepOld = true
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--keep-old", Arg2: "--strategy"}),
+
+			Entry("--json without --dry-run",
+				func() {
+					cmd.JSON = true
+				},
+				translatableerror.RequiredFlagsError{Arg1: "--json", Arg2: "--dry-run"}),
+
 			Entry("--docker-username (without DOCKER_PASSWORD env set)",
 				func() {
 					cmd.DockerUsername = "some-docker-username"
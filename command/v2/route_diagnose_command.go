@@ -0,0 +1,205 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . RouteDiagnoseActor
+
+type RouteDiagnoseActor interface {
+	GetDomainsByNameAndOrganization(domainNames []string, orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	GetRouteByComponents(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	GetRouteDiagnosis(route v2action.Route) (v2action.RouteDiagnosis, v2action.Warnings, error)
+	ProbeRoute(route v2action.Route, timeout time.Duration) (v2action.RouteProbe, error)
+}
+
+type RouteDiagnoseCommand struct {
+	RequiredArgs    flag.Domain `positional-args:"yes"`
+	Hostname        string      `long:"hostname" short:"n" description:"Hostname for the HTTP route"`
+	Path            string      `long:"path" description:"Path for the HTTP route"`
+	Port            int         `long:"port" description:"Port for the TCP route"`
+	usage           interface{} `usage:"Diagnose an HTTP route:\n      CF_NAME route-diagnose DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Diagnose a TCP route:\n      CF_NAME route-diagnose DOMAIN --port PORT\n\n   Reports the apps and ports the route is mapped to, its route service and router group, and whether the mapped apps have running instances. The route is then requested from this machine.\n\nEXAMPLES:\n   CF_NAME route-diagnose example.com --hostname myhost            # myhost.example.com\n   CF_NAME route-diagnose example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME route-diagnose example.com --port 5000                  # example.com:5000"`
+	relatedCommands interface{} `related_commands:"check-route, map-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RouteDiagnoseActor
+}
+
+func (cmd *RouteDiagnoseCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	actor := v2action.NewActor(ccClient, uaaClient, config)
+
+	if ccClient.RoutingEndpoint() != "" {
+		actor.RouterClient, err = shared.NewRouterClient(ccClient.RoutingEndpoint(), config, uaaClient, ui)
+		if err != nil {
+			return err
+		}
+	}
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd RouteDiagnoseCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	domains, warnings, err := cmd.Actor.GetDomainsByNameAndOrganization([]string{cmd.RequiredArgs.Domain}, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	if len(domains) == 0 {
+		return actionerror.DomainNotFoundError{Name: cmd.RequiredArgs.Domain}
+	}
+
+	route := v2action.Route{
+		Domain: domains[0],
+		Host:   cmd.Hostname,
+		Path:   cmd.Path,
+	}
+	if route.Path != "" && !strings.HasPrefix(route.Path, "/") {
+		route.Path = "/" + route.Path
+	}
+	if cmd.Port != 0 {
+		route.Port = types.NullInt{IsSet: true, Value: cmd.Port}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Diagnosing route {{.Route}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Route":    route.String(),
+		"OrgName":  cmd.Config.TargetedOrganization().Name,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	route, warnings, err = cmd.Actor.GetRouteByComponents(route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	diagnosis, warnings, err := cmd.Actor.GetRouteDiagnosis(route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayDiagnosis(diagnosis)
+	cmd.UI.DisplayNewline()
+
+	probe, err := cmd.Actor.ProbeRoute(route, cmd.Config.DialTimeout())
+	cmd.displayProbe(probe, err)
+
+	return nil
+}
+
+func (cmd RouteDiagnoseCommand) displayDiagnosis(diagnosis v2action.RouteDiagnosis) {
+	none := cmd.UI.TranslateText("none")
+
+	routeService := none
+	if diagnosis.RouteService.Name != "" {
+		routeService = diagnosis.RouteService.Name
+	}
+
+	routerGroup := none
+	reservedPorts := none
+	if diagnosis.RouterGroup.Name != "" {
+		routerGroup = fmt.Sprintf("%s (%s)", diagnosis.RouterGroup.Name, diagnosis.RouterGroup.Type)
+	}
+	if diagnosis.RouterGroup.ReservedPorts != "" {
+		reservedPorts = diagnosis.RouterGroup.ReservedPorts
+	}
+
+	runningInstances := cmd.UI.TranslateText("no")
+	if diagnosis.HasRunningInstances() {
+		runningInstances = cmd.UI.TranslateText("yes")
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("route:"), diagnosis.Route.String()},
+		{cmd.UI.TranslateText("route service:"), routeService},
+		{cmd.UI.TranslateText("router group:"), routerGroup},
+	}
+	if diagnosis.Route.Domain.IsTCP() {
+		table = append(table, []string{cmd.UI.TranslateText("reserved ports:"), reservedPorts})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("running instances:"), runningInstances})
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(diagnosis.Destinations) == 0 {
+		cmd.UI.DisplayText("No apps are mapped to this route.")
+		return
+	}
+
+	destinationTable := [][]string{{
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("port"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("running"),
+	}}
+	for _, destination := range diagnosis.Destinations {
+		port := cmd.UI.TranslateText("default")
+		if destination.Port.IsSet {
+			port = fmt.Sprint(destination.Port.Value)
+		}
+
+		destinationTable = append(destinationTable, []string{
+			destination.Application.Name,
+			port,
+			cmd.UI.TranslateText(strings.ToLower(string(destination.Application.State))),
+			fmt.Sprintf("%d/%d", destination.RunningInstances, destination.Application.Instances.Value),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", destinationTable, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouteDiagnoseCommand) displayProbe(probe v2action.RouteProbe, err error) {
+	cmd.UI.DisplayText("Probing {{.Address}} from this machine...", map[string]interface{}{
+		"Address": probe.Address,
+	})
+
+	switch {
+	case err != nil:
+		cmd.UI.DisplayWarning("Probe failed after {{.Duration}}: {{.Error}}", map[string]interface{}{
+			"Duration": probe.Duration.Round(time.Millisecond),
+			"Error":    err.Error(),
+		})
+	case probe.StatusCode != 0:
+		cmd.UI.DisplayText("Received status {{.StatusCode}} in {{.Duration}}", map[string]interface{}{
+			"StatusCode": probe.StatusCode,
+			"Duration":   probe.Duration.Round(time.Millisecond),
+		})
+	default:
+		cmd.UI.DisplayText("Connected in {{.Duration}}", map[string]interface{}{
+			"Duration": probe.Duration.Round(time.Millisecond),
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("route-diagnose Command", func() {
+	var (
+		cmd             RouteDiagnoseCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRouteDiagnoseActor
+		binaryName      string
+		httpDomain      v2action.Domain
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRouteDiagnoseActor)
+
+		cmd = RouteDiagnoseCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Domain = "example.com"
+		cmd.Hostname = "myhost"
+		cmd.Path = "foo"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.DialTimeoutReturns(5 * time.Second)
+
+		httpDomain = v2action.Domain{GUID: "domain-guid", Name: "example.com", RouterGroupType: constant.HTTPRouterGroup}
+		fakeActor.GetDomainsByNameAndOrganizationReturns([]v2action.Domain{httpDomain}, v2action.Warnings{"domain-warning"}, nil)
+		fakeActor.GetRouteByComponentsStub = func(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
+			route.GUID = "route-guid"
+			return route, v2action.Warnings{"route-warning"}, nil
+		}
+		fakeActor.GetRouteDiagnosisStub = func(route v2action.Route) (v2action.RouteDiagnosis, v2action.Warnings, error) {
+			return v2action.RouteDiagnosis{
+				Route: route,
+				Destinations: []v2action.RouteDestination{
+					{
+						Application:      v2action.Application{Name: "app-1", State: constant.ApplicationStarted, Instances: types.NullInt{IsSet: true, Value: 2}},
+						Port:             types.NullInt{IsSet: true, Value: 9090},
+						RunningInstances: 1,
+					},
+					{
+						Application: v2action.Application{Name: "app-2", State: constant.ApplicationStopped, Instances: types.NullInt{IsSet: true, Value: 1}},
+					},
+				},
+				RouteService: v2action.ServiceInstance{Name: "some-route-service"},
+				RouterGroup:  v2action.RouterGroup{Name: "default-http", Type: "http"},
+			}, v2action.Warnings{"diagnosis-warning"}, nil
+		}
+		fakeActor.ProbeRouteReturns(v2action.RouteProbe{
+			Address:    "http://myhost.example.com/foo",
+			StatusCode: 502,
+			Duration:   42 * time.Millisecond,
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the domain does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetDomainsByNameAndOrganizationReturns(nil, v2action.Warnings{"domain-warning"}, nil)
+		})
+
+		It("returns a DomainNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+			Expect(testUI.Err).To(Say("domain-warning"))
+		})
+	})
+
+	Context("when the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByComponentsReturns(v2action.Route{}, v2action.Warnings{"route-warning"}, actionerror.RouteNotFoundError{Host: "myhost"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "myhost"}))
+			Expect(testUI.Err).To(Say("route-warning"))
+			Expect(fakeActor.GetRouteDiagnosisCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the route is an HTTP route", func() {
+		It("looks up the route with a leading slash on the path", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			domainNames, orgGUID := fakeActor.GetDomainsByNameAndOrganizationArgsForCall(0)
+			Expect(domainNames).To(ConsistOf("example.com"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeActor.GetRouteByComponentsArgsForCall(0)).To(Equal(v2action.Route{
+				Domain: httpDomain,
+				Host:   "myhost",
+				Path:   "/foo",
+			}))
+
+			route, timeout := fakeActor.ProbeRouteArgsForCall(0)
+			Expect(route.GUID).To(Equal("route-guid"))
+			Expect(timeout).To(Equal(5 * time.Second))
+		})
+
+		It("displays the diagnosis and the probe result", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Diagnosing route myhost.example.com/foo in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("route:\\s+myhost.example.com/foo"))
+			Expect(testUI.Out).To(Say("route service:\\s+some-route-service"))
+			Expect(testUI.Out).To(Say("router group:\\s+default-http \\(http\\)"))
+			Expect(testUI.Out).ToNot(Say("reserved ports:"))
+			Expect(testUI.Out).To(Say("running instances:\\s+yes"))
+			Expect(testUI.Out).To(Say("app\\s+port\\s+state\\s+running"))
+			Expect(testUI.Out).To(Say("app-1\\s+9090\\s+started\\s+1/2"))
+			Expect(testUI.Out).To(Say("app-2\\s+default\\s+stopped\\s+0/1"))
+			Expect(testUI.Out).To(Say("Probing http://myhost.example.com/foo from this machine\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Received status 502 in 42ms"))
+
+			Expect(testUI.Err).To(Say("domain-warning"))
+			Expect(testUI.Err).To(Say("route-warning"))
+			Expect(testUI.Err).To(Say("diagnosis-warning"))
+		})
+	})
+
+	Context("when the route is a TCP route", func() {
+		BeforeEach(func() {
+			cmd.Hostname = ""
+			cmd.Path = ""
+			cmd.Port = 1025
+
+			fakeActor.GetDomainsByNameAndOrganizationReturns([]v2action.Domain{
+				{GUID: "tcp-domain-guid", Name: "tcp.example.com", RouterGroupType: constant.TCPRouterGroup},
+			}, nil, nil)
+			fakeActor.GetRouteDiagnosisStub = func(route v2action.Route) (v2action.RouteDiagnosis, v2action.Warnings, error) {
+				return v2action.RouteDiagnosis{
+					Route:       route,
+					RouterGroup: v2action.RouterGroup{Name: "default-tcp", Type: "tcp", ReservedPorts: "1024-1033"},
+				}, nil, nil
+			}
+			fakeActor.ProbeRouteReturns(v2action.RouteProbe{Address: "tcp.example.com:1025", Duration: 3 * time.Millisecond}, errors.New("connection refused"))
+		})
+
+		It("displays the reserved ports and the probe failure", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			route := fakeActor.GetRouteByComponentsArgsForCall(0)
+			Expect(route.Port).To(Equal(types.NullInt{IsSet: true, Value: 1025}))
+
+			Expect(testUI.Out).To(Say("route:\\s+tcp.example.com:1025"))
+			Expect(testUI.Out).To(Say("route service:\\s+none"))
+			Expect(testUI.Out).To(Say("router group:\\s+default-tcp \\(tcp\\)"))
+			Expect(testUI.Out).To(Say("reserved ports:\\s+1024-1033"))
+			Expect(testUI.Out).To(Say("running instances:\\s+no"))
+			Expect(testUI.Out).To(Say("No apps are mapped to this route\\."))
+			Expect(testUI.Out).To(Say("Probing tcp.example.com:1025 from this machine\\.\\.\\."))
+			Expect(testUI.Err).To(Say("Probe failed after 3ms: connection refused"))
+		})
+	})
+
+	Context("when getting the diagnosis fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteDiagnosisReturns(v2action.RouteDiagnosis{}, v2action.Warnings{"diagnosis-warning"}, errors.New("diagnosis error"))
+		})
+
+		It("returns the error without probing the route", func() {
+			Expect(executeErr).To(MatchError("diagnosis error"))
+			Expect(testUI.Err).To(Say("diagnosis-warning"))
+			Expect(fakeActor.ProbeRouteCallCount()).To(Equal(0))
+		})
+	})
+})
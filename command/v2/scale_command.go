@@ -1,25 +1,107 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ScaleActor
+
+type ScaleActor interface {
+	CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
 type ScaleCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	ForceRestart    bool         `short:"f" description:"Force restart of app without prompt"`
-	NumInstances    int          `short:"i" description:"Number of instances"`
-	DiskLimit       string       `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit     string       `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	usage           interface{}  `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
-	relatedCommands interface{}  `related_commands:"push"`
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	ForceRestart    bool           `short:"f" description:"Force restart of app without prompt"`
+	NumInstances    flag.Instances `short:"i" description:"Number of instances"`
+	DiskLimit       string         `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit     string         `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	SkipQuotaCheck  bool           `long:"skip-quota-check" description:"Do not check that the org and space quotas have room for the new instances or memory before scaling"`
+	usage           interface{}    `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f] [--skip-quota-check]"`
+	relatedCommands interface{}    `related_commands:"push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScaleActor
 }
 
-func (ScaleCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ScaleCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.checksQuota() {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (ScaleCommand) Execute(args []string) error {
+// Execute checks that the org and space quotas have room for the new
+// instances or memory of a started app, then scales the app with the legacy
+// implementation.
+func (cmd ScaleCommand) Execute(args []string) error {
+	if !cmd.checksQuota() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !app.Started() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	request := v2action.QuotaRequest{
+		ApplicationGUID: app.GUID,
+		Instances:       app.Instances.Value,
+		MemoryInMB:      app.Memory.Value,
+	}
+	if cmd.NumInstances.IsSet {
+		request.Instances = cmd.NumInstances.Value
+	}
+	if cmd.MemoryLimit != "" {
+		// An invalid memory limit is reported by the legacy implementation.
+		memory, parseErr := bytefmt.ToMegabytes(cmd.MemoryLimit)
+		if parseErr != nil {
+			return translatableerror.UnrefactoredCommandError{}
+		}
+		request.MemoryInMB = memory
+	}
+
+	warnings, err = cmd.Actor.CheckQuotaHeadroom(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, []v2action.QuotaRequest{request})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
 	return translatableerror.UnrefactoredCommandError{}
 }
+
+// checksQuota returns true when the scale changes what the app allocates
+// against the org and space quotas and the check has not been skipped.
+func (cmd ScaleCommand) checksQuota() bool {
+	return !cmd.SkipQuotaCheck && (cmd.NumInstances.IsSet || cmd.MemoryLimit != "")
+}
@@ -0,0 +1,181 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scale Command", func() {
+	var (
+		cmd             ScaleCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeScaleActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeScaleActor)
+
+		cmd = ScaleCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{
+			GUID:      "some-app-guid",
+			Instances: types.NullInt{Value: 2, IsSet: true},
+			Memory:    types.NullByteSizeInMb{Value: 256, IsSet: true},
+			State:     constant.ApplicationStarted,
+		}, v2action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither the instances nor the memory are changed", func() {
+		BeforeEach(func() {
+			cmd.DiskLimit = "1G"
+		})
+
+		It("falls back to the legacy implementation without checking the quotas", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the instances or memory are changed", func() {
+		BeforeEach(func() {
+			cmd.NumInstances = flag.Instances{NullInt: types.NullInt{Value: 5, IsSet: true}}
+			cmd.MemoryLimit = "1G"
+		})
+
+		It("checks the quotas for the new instances and memory and falls back to the legacy implementation", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+			orgGUID, spaceGUID, requests := fakeActor.CheckQuotaHeadroomArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(requests).To(Equal([]v2action.QuotaRequest{{
+				ApplicationGUID: "some-app-guid",
+				Instances:       5,
+				MemoryInMB:      1024,
+			}}))
+		})
+
+		Context("when only the instances are changed", func() {
+			BeforeEach(func() {
+				cmd.MemoryLimit = ""
+			})
+
+			It("checks the quotas with the current memory of the app", func() {
+				_, _, requests := fakeActor.CheckQuotaHeadroomArgsForCall(0)
+				Expect(requests).To(Equal([]v2action.QuotaRequest{{
+					ApplicationGUID: "some-app-guid",
+					Instances:       5,
+					MemoryInMB:      256,
+				}}))
+			})
+		})
+
+		Context("when the quotas do not have room", func() {
+			BeforeEach(func() {
+				fakeActor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, actionerror.QuotaExceededError{Scope: "space", QuotaName: "some-quota"})
+			})
+
+			It("returns the error without scaling the app", func() {
+				Expect(executeErr).To(MatchError(actionerror.QuotaExceededError{Scope: "space", QuotaName: "some-quota"}))
+				Expect(testUI.Err).To(Say("quota-warning"))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", State: constant.ApplicationStopped}, nil, nil)
+			})
+
+			It("falls back to the legacy implementation without checking the quotas", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+				Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the memory limit is invalid", func() {
+			BeforeEach(func() {
+				cmd.MemoryLimit = "lots"
+			})
+
+			It("leaves reporting it to the legacy implementation", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+				Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, errors.New("get-app-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+			})
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --skip-quota-check is provided", func() {
+			BeforeEach(func() {
+				cmd.SkipQuotaCheck = true
+			})
+
+			It("falls back to the legacy implementation without checking the quotas", func() {
+				Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+				Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
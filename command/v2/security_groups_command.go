@@ -36,6 +36,9 @@ func (cmd *SecurityGroupsCommand) Setup(config command.Config, ui command.UI) er
 	if err != nil {
 		return err
 	}
+	if responseCache := command.NewResponseCache(config); responseCache != nil {
+		ccClient.WrapConnection(responseCache)
+	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
 	return nil
@@ -1,11 +1,13 @@
 package v2
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -16,14 +18,18 @@ import (
 
 type ServiceActor interface {
 	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
 	GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstanceSummary, v2action.Warnings, error)
+	GetServiceInstanceParameters(serviceInstanceGUID string) (v2action.ServiceParameters, v2action.Warnings, error)
+	GetServiceBindingsParametersByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceBindingParameters, v2action.Warnings, error)
 }
 
 type ServiceCommand struct {
-	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
-	GUID            bool                 `long:"guid" description:"Retrieve and display the given service's guid. All other output for the service is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
-	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+	RequiredArgs    flag.OptionalServiceInstance `positional-args:"yes"`
+	GUID            bool                         `long:"guid" description:"Retrieve and display the given service's guid. All other output for the service is suppressed."`
+	Params          bool                         `long:"params" description:"Retrieve and display the given service's parameters and the parameters of its bindings. All other output for the service is suppressed."`
+	usage           interface{}                  `usage:"CF_NAME service SERVICE_INSTANCE [--guid | --params]"`
+	relatedCommands interface{}                  `related_commands:"bind-service, rename-service, update-service"`
 
 	UI          command.UI
 	Config      command.Config
@@ -46,18 +52,42 @@ func (cmd *ServiceCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ServiceCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Params {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--guid", "--params"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
 
+	cmd.RequiredArgs.ServiceInstance, err = command.SelectRequiredArgument(cmd.UI, cmd.Config, cmd.RequiredArgs.ServiceInstance, "SERVICE_INSTANCE", "Service instance>", cmd.listServiceInstanceNames)
+	if err != nil {
+		return err
+	}
+
 	if cmd.GUID {
 		return cmd.displayServiceInstanceGUID()
 	}
 
+	if cmd.Params {
+		return cmd.displayServiceInstanceParameters()
+	}
+
 	return cmd.displayServiceInstanceSummary()
 }
 
+func (cmd ServiceCommand) listServiceInstanceNames() ([]string, []string, error) {
+	serviceInstances, warnings, err := cmd.Actor.GetServiceInstancesBySpace(cmd.Config.TargetedSpace().GUID)
+	var names []string
+	for _, serviceInstance := range serviceInstances {
+		names = append(names, serviceInstance.Name)
+	}
+	return names, warnings, err
+}
+
 func (cmd ServiceCommand) displayServiceInstanceGUID() error {
 	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -69,6 +99,88 @@ func (cmd ServiceCommand) displayServiceInstanceGUID() error {
 	return nil
 }
 
+func (cmd ServiceCommand) displayServiceInstanceParameters() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting parameters for service instance {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"UserName":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if serviceInstance.IsUserProvided() {
+		cmd.UI.DisplayText("User-provided service instances do not have parameters.")
+		return nil
+	}
+
+	parameters, warnings, err := cmd.Actor.GetServiceInstanceParameters(serviceInstance.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		err = cmd.displayParameters(parameters)
+		if err != nil {
+			return err
+		}
+	case ccerror.ServiceParametersFetchNotSupportedError:
+		cmd.UI.DisplayText("This service does not support fetching service instance parameters.")
+	default:
+		return err
+	}
+
+	bindingsParameters, warnings, err := cmd.Actor.GetServiceBindingsParametersByServiceInstance(serviceInstance.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, bindingParameters := range bindingsParameters {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Showing parameters of binding to app {{.AppName}}:", map[string]interface{}{
+			"AppName": bindingParameters.AppName,
+		})
+
+		if bindingParameters.FetchNotSupported {
+			cmd.UI.DisplayText("This service does not support fetching service binding parameters.")
+			continue
+		}
+
+		err = cmd.displayParameters(bindingParameters.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd ServiceCommand) displayParameters(parameters v2action.ServiceParameters) error {
+	if len(parameters) == 0 {
+		cmd.UI.DisplayText("No parameters are set.")
+		return nil
+	}
+
+	parametersJSON, err := json.MarshalIndent(parameters, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("{{.Parameters}}", map[string]interface{}{
+		"Parameters": string(parametersJSON),
+	})
+	return nil
+}
+
 func (cmd ServiceCommand) displayServiceInstanceSummary() error {
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
@@ -5,9 +5,11 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -77,6 +79,39 @@ var _ = Describe("service Command", func() {
 			})
 		})
 
+		Context("when no service instance name is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.ServiceInstance = ""
+				cmd.GUID = true
+			})
+
+			Context("when the terminal is interactive", func() {
+				BeforeEach(func() {
+					testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+					testUI.In.(*Buffer).Write([]byte("other\n"))
+					cmd.UI = testUI
+					fakeConfig.IsTTYReturns(true)
+					fakeActor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "some-service-instance"}, {Name: "other-service-instance"}}, v2action.Warnings{"get-instances-warning"}, nil)
+				})
+
+				It("lets the user pick the service instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(testUI.Err).To(Say("get-instances-warning"))
+
+					serviceInstanceNameArg, _ := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(serviceInstanceNameArg).To(Equal("other-service-instance"))
+				})
+			})
+
+			Context("when the terminal is not interactive", func() {
+				It("returns a RequiredArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}))
+					Expect(fakeActor.GetServiceInstancesBySpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when getting the current user fails", func() {
 			BeforeEach(func() {
 				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("get-user-error"))
@@ -180,6 +215,189 @@ var _ = Describe("service Command", func() {
 				})
 			})
 
+			Context("when the '--params' flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Params = true
+				})
+
+				Context("when the '--guid' flag is also provided", func() {
+					BeforeEach(func() {
+						cmd.GUID = true
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+							Args: []string{"--guid", "--params"},
+						}))
+						Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when an error is encountered getting the service instance", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("get-service-instance-error")
+						fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+							v2action.ServiceInstance{},
+							v2action.Warnings{"get-service-instance-warning"},
+							expectedErr,
+						)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("get-service-instance-warning"))
+						Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the service instance is user provided", func() {
+					BeforeEach(func() {
+						fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+							v2action.ServiceInstance{
+								GUID: "some-service-instance-guid",
+								Name: "some-service-instance",
+								Type: constant.ServiceInstanceTypeUserProvidedService,
+							},
+							v2action.Warnings{"get-service-instance-warning"},
+							nil,
+						)
+					})
+
+					It("displays that there are no parameters", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Getting parameters for service instance some-service-instance in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("User-provided service instances do not have parameters."))
+						Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+						Expect(fakeActor.GetServiceBindingsParametersByServiceInstanceCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the service instance is managed", func() {
+					BeforeEach(func() {
+						fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+							v2action.ServiceInstance{
+								GUID: "some-service-instance-guid",
+								Name: "some-service-instance",
+								Type: constant.ServiceInstanceTypeManagedService,
+							},
+							v2action.Warnings{"get-service-instance-warning"},
+							nil,
+						)
+					})
+
+					Context("when the parameters are retrieved", func() {
+						BeforeEach(func() {
+							fakeActor.GetServiceInstanceParametersReturns(
+								v2action.ServiceParameters{"ram_gb": 4},
+								v2action.Warnings{"get-parameters-warning"},
+								nil,
+							)
+							fakeActor.GetServiceBindingsParametersByServiceInstanceReturns(
+								[]v2action.ServiceBindingParameters{
+									{AppName: "app-1", Parameters: v2action.ServiceParameters{"permissions": "read-only"}},
+									{AppName: "app-2", Parameters: v2action.ServiceParameters{}},
+									{AppName: "app-3", FetchNotSupported: true},
+								},
+								v2action.Warnings{"get-binding-parameters-warning"},
+								nil,
+							)
+						})
+
+						It("displays the service instance and binding parameters, and all warnings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Getting parameters for service instance some-service-instance in org some-org / space some-space as some-user..."))
+							Expect(testUI.Out).To(Say(`\{`))
+							Expect(testUI.Out).To(Say(`"ram_gb": 4`))
+							Expect(testUI.Out).To(Say(`\}`))
+							Expect(testUI.Out).To(Say("Showing parameters of binding to app app-1:"))
+							Expect(testUI.Out).To(Say(`"permissions": "read-only"`))
+							Expect(testUI.Out).To(Say("Showing parameters of binding to app app-2:"))
+							Expect(testUI.Out).To(Say("No parameters are set."))
+							Expect(testUI.Out).To(Say("Showing parameters of binding to app app-3:"))
+							Expect(testUI.Out).To(Say("This service does not support fetching service binding parameters."))
+
+							Expect(testUI.Err).To(Say("get-service-instance-warning"))
+							Expect(testUI.Err).To(Say("get-parameters-warning"))
+							Expect(testUI.Err).To(Say("get-binding-parameters-warning"))
+
+							Expect(fakeActor.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+							Expect(fakeActor.GetServiceBindingsParametersByServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+							Expect(fakeActor.GetServiceInstanceSummaryByNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the parameters contain template syntax", func() {
+						BeforeEach(func() {
+							fakeActor.GetServiceInstanceParametersReturns(
+								v2action.ServiceParameters{"template": "{{.Name}}"},
+								nil,
+								nil)
+						})
+
+						It("displays them verbatim", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`"template": "\{\{\.Name\}\}"`))
+						})
+					})
+
+					Context("when the service broker does not support fetching parameters", func() {
+						BeforeEach(func() {
+							fakeActor.GetServiceInstanceParametersReturns(
+								nil,
+								v2action.Warnings{"get-parameters-warning"},
+								ccerror.ServiceParametersFetchNotSupportedError{Message: "not supported"},
+							)
+						})
+
+						It("displays that fetching parameters is not supported", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("This service does not support fetching service instance parameters."))
+							Expect(fakeActor.GetServiceBindingsParametersByServiceInstanceCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when getting the parameters fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("get-parameters-error")
+							fakeActor.GetServiceInstanceParametersReturns(
+								nil,
+								v2action.Warnings{"get-parameters-warning"},
+								expectedErr,
+							)
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("get-parameters-warning"))
+							Expect(fakeActor.GetServiceBindingsParametersByServiceInstanceCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when getting the binding parameters fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("get-binding-parameters-error")
+							fakeActor.GetServiceBindingsParametersByServiceInstanceReturns(
+								nil,
+								v2action.Warnings{"get-binding-parameters-warning"},
+								expectedErr,
+							)
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("get-binding-parameters-warning"))
+						})
+					})
+				})
+			})
+
 			Context("when the '--guid' flag is not provided", func() {
 				Context("when the service instance does not exist", func() {
 					BeforeEach(func() {
@@ -7,6 +7,7 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
@@ -14,6 +15,11 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
+	requestLimiter := command.RequestLimiter(config)
+	if requestLimiter != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRateLimiter(requestLimiter))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -29,11 +35,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:               config.BinaryName(),
+		AppVersion:            config.BinaryVersion(),
+		JobPollingTimeout:     config.OverallPollingTimeout(),
+		JobPollingInterval:    config.PollingInterval(),
+		PaginationConcurrency: config.PaginationConcurrency(),
+		Wrappers:              ccWrappers,
 	})
 
 	if !targetCF {
@@ -46,9 +53,15 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv2.TargetSettings{
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = ccClient.TargetCF(ccv2.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		TLSConfig:         tlsConfig,
 		DialTimeout:       config.DialTimeout(),
 	})
 	if err != nil {
@@ -63,8 +76,11 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		return nil, nil, translatableerror.AuthorizationEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
+	uaaClient := uaa.NewClient(config, tlsConfig)
 
+	if requestLimiter != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRateLimiter(requestLimiter))
+	}
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
@@ -0,0 +1,46 @@
+package shared
+
+import (
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
+)
+
+// NewRouterClient returns back a routing API client that authenticates,
+// retries and logs requests the same way as the Cloud Controller client.
+func NewRouterClient(routingEndpoint string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*router.Client, error) {
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
+	wrappers := []router.ConnectionWrapper{}
+
+	requestLimiter := command.RequestLimiter(config)
+	if requestLimiter != nil {
+		wrappers = append(wrappers, ccWrapper.NewRateLimiter(requestLimiter))
+	}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		wrappers = append(wrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		wrappers = append(wrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	wrappers = append(wrappers, ccWrapper.NewUAAAuthentication(uaaClient, config))
+	wrappers = append(wrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+
+	return router.NewClient(router.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		DialTimeout:       config.DialTimeout(),
+		RoutingEndpoint:   routingEndpoint,
+		SkipSSLValidation: config.SkipSSLValidation(),
+		TLSConfig:         tlsConfig,
+		Wrappers:          wrappers,
+	}), nil
+}
@@ -33,6 +33,9 @@ func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
 	if err != nil {
 		return err
 	}
+	if responseCache := command.NewResponseCache(config); responseCache != nil {
+		ccClient.WrapConnection(responseCache)
+	}
 	cmd.Actor = v2action.NewActor(ccClient, nil, config)
 
 	return nil
@@ -0,0 +1,114 @@
+package v2
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . StackAuditActor
+
+type StackAuditActor interface {
+	GetStackAudit() ([]v2action.StackAudit, v2action.Warnings, error)
+}
+
+type StackAuditCommand struct {
+	Stack           string      `long:"stack" description:"Only list the apps on this stack"`
+	usage           interface{} `usage:"CF_NAME stack-audit [--stack STACK]\n\n   Lists the apps on each stack across all the orgs and spaces you can see.\n\nEXAMPLES:\n   CF_NAME stack-audit\n   CF_NAME stack-audit --stack cflinuxfs2"`
+	relatedCommands interface{} `related_commands:"change-stack, stack, stacks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       StackAuditActor
+}
+
+func (cmd *StackAuditCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd StackAuditCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps by stack as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	audits, warnings, err := cmd.Actor.GetStackAudit()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, audit := range audits {
+		if cmd.Stack != "" && audit.Stack.Name != cmd.Stack {
+			continue
+		}
+		found = true
+		cmd.displayStack(audit)
+	}
+
+	if !found && cmd.Stack != "" {
+		return actionerror.StackNotFoundError{Name: cmd.Stack}
+	}
+
+	return nil
+}
+
+func (cmd StackAuditCommand) displayStack(audit v2action.StackAudit) {
+	stackName := audit.Stack.Name
+	if audit.Stack.GUID == "" {
+		stackName = cmd.UI.TranslateText("unknown/deleted stack")
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.StackName}} ({{.Count}} apps):", map[string]interface{}{
+		"StackName": stackName,
+		"Count":     len(audit.Applications),
+	})
+
+	if len(audit.Applications) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("state"),
+	}}
+	for _, app := range audit.Applications {
+		table = append(table, []string{
+			app.OrganizationName,
+			app.SpaceName,
+			app.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(app.State))),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
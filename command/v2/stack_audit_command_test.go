@@ -0,0 +1,134 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stack-audit Command", func() {
+	var (
+		cmd             StackAuditCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeStackAuditActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeStackAuditActor)
+
+		cmd = StackAuditCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetStackAuditReturns(
+			[]v2action.StackAudit{
+				{
+					Stack: v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"},
+					Applications: []v2action.StackApplication{
+						{Name: "app-1", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStarted},
+						{Name: "app-2", OrganizationName: "org-2", SpaceName: "space-2", State: constant.ApplicationStopped},
+					},
+				},
+				{
+					Stack: v2action.Stack{GUID: "new-stack-guid", Name: "new-stack"},
+				},
+				{
+					Applications: []v2action.StackApplication{
+						{Name: "app-3", OrganizationName: "org-1", SpaceName: "space-1", State: constant.ApplicationStarted},
+					},
+				},
+			},
+			v2action.Warnings{"audit-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the apps on each stack", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting apps by stack as some-user\\.\\.\\."))
+		Expect(testUI.Out).To(Say("old-stack \\(2 apps\\):"))
+		Expect(testUI.Out).To(Say("org\\s+space\\s+app\\s+state"))
+		Expect(testUI.Out).To(Say("org-1\\s+space-1\\s+app-1\\s+started"))
+		Expect(testUI.Out).To(Say("org-2\\s+space-2\\s+app-2\\s+stopped"))
+		Expect(testUI.Out).To(Say("new-stack \\(0 apps\\):"))
+		Expect(testUI.Out).To(Say("No apps found"))
+		Expect(testUI.Out).To(Say("unknown/deleted stack \\(1 apps\\):"))
+		Expect(testUI.Out).To(Say("org-1\\s+space-1\\s+app-3\\s+started"))
+		Expect(testUI.Err).To(Say("audit-warning"))
+	})
+
+	Context("when --stack is provided", func() {
+		BeforeEach(func() {
+			cmd.Stack = "new-stack"
+		})
+
+		It("only displays that stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("old-stack"))
+			Expect(testUI.Out).To(Say("new-stack \\(0 apps\\):"))
+		})
+
+		Context("when the stack does not exist", func() {
+			BeforeEach(func() {
+				cmd.Stack = "missing-stack"
+			})
+
+			It("returns a StackNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "missing-stack"}))
+			})
+		})
+	})
+
+	Context("when getting the audit fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackAuditReturns(nil, v2action.Warnings{"audit-warning"}, errors.New("audit error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("audit error"))
+			Expect(testUI.Err).To(Say("audit-warning"))
+		})
+	})
+})
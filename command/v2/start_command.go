@@ -14,12 +14,14 @@ import (
 
 type StartActor interface {
 	AppActor
+	CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
 	StartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
 }
 
 type StartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME start APP_NAME"`
+	SkipQuotaCheck      bool         `long:"skip-quota-check" description:"Do not check that the org and space quotas have room for the app before starting it"`
+	usage               interface{}  `usage:"CF_NAME start APP_NAME [--skip-quota-check]"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{}  `related_commands:"apps, logs, scale, ssh, stop, restart, run-task"`
@@ -42,7 +44,10 @@ func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -80,6 +85,18 @@ func (cmd StartCommand) Execute(args []string) error {
 		return nil
 	}
 
+	if !cmd.SkipQuotaCheck {
+		warnings, err = cmd.Actor.CheckQuotaHeadroom(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, []v2action.QuotaRequest{{
+			ApplicationGUID: app.GUID,
+			Instances:       app.Instances.Value,
+			MemoryInMB:      app.Memory.Value,
+		}})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.StartApplication(app, cmd.NOAAClient)
 	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 	if err != nil {
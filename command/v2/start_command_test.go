@@ -165,6 +165,58 @@ var _ = Describe("Start Command", func() {
 					Expect(app.GUID).To(Equal("app-guid"))
 				})
 
+				Context("when checking the quota headroom", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+						fakeActor.GetApplicationByNameAndSpaceReturns(
+							v2action.Application{
+								GUID:      "app-guid",
+								State:     constant.ApplicationStopped,
+								Instances: types.NullInt{Value: 3, IsSet: true},
+								Memory:    types.NullByteSizeInMb{Value: 256, IsSet: true},
+							},
+							nil,
+							nil,
+						)
+						fakeActor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, nil)
+					})
+
+					It("checks the app's instances and memory against the quotas", func() {
+						Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+						orgGUID, spaceGUID, requests := fakeActor.CheckQuotaHeadroomArgsForCall(0)
+						Expect(orgGUID).To(Equal("some-org-guid"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(requests).To(Equal([]v2action.QuotaRequest{{ApplicationGUID: "app-guid", Instances: 3, MemoryInMB: 256}}))
+
+						Expect(testUI.Err).To(Say("quota-warning"))
+					})
+
+					Context("when the quota would be exceeded", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = actionerror.QuotaExceededError{Scope: "org", QuotaName: "some-quota", Resource: actionerror.QuotaResourceAppInstances, Requested: 3, Available: 1}
+							fakeActor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, expectedErr)
+						})
+
+						It("returns the error without starting the app", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("quota-warning"))
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when --skip-quota-check is provided", func() {
+						BeforeEach(func() {
+							cmd.SkipQuotaCheck = true
+						})
+
+						It("does not check the quotas", func() {
+							Expect(fakeActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when passed an ApplicationStateStarting message", func() {
 					BeforeEach(func() {
 						fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
@@ -1,24 +1,237 @@
 package v2
 
 import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+//go:generate counterfeiter . UpdateServiceActor
+
+type UpdateServiceActor interface {
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstanceParameters(serviceInstanceGUID string) (v2action.ServiceParameters, v2action.Warnings, error)
+	GetServicePlan(servicePlanGUID string) (v2action.ServicePlan, v2action.Warnings, error)
+}
+
 type UpdateServiceCommand struct {
 	RequiredArgs     flag.ServiceInstance `positional-args:"yes"`
 	ParametersAsJSON flag.Path            `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
 	Plan             string               `short:"p" description:"Change service plan for a service instance"`
 	Tags             string               `short:"t" description:"User provided tags"`
-	usage            interface{}          `usage:"CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME update-service -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME update-service -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\n   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.\n\nEXAMPLES:\n   CF_NAME update-service mydb -p gold\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}'\n   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json\n   CF_NAME update-service mydb -t \"list, of, tags\""`
+	DryRun           bool                 `long:"dry-run" description:"Show the changes that would be made to the service instance without updating it"`
+	usage            interface{}          `usage:"CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--dry-run]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME update-service -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME update-service -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\n   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.\n\n   Optionally preview how the plan, tags and parameters of the service instance would change, without updating it.\n\nEXAMPLES:\n   CF_NAME update-service mydb -p gold\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}'\n   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json\n   CF_NAME update-service mydb -t \"list, of, tags\"\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}' --dry-run"`
 	relatedCommands  interface{}          `related_commands:"rename-service, services, update-user-provided-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateServiceActor
+}
+
+func (cmd *UpdateServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	if !cmd.DryRun {
+		return nil
+	}
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
 }
 
-func (UpdateServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd UpdateServiceCommand) Execute(args []string) error {
+	if !cmd.DryRun {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	var parameters flag.JSONOrFileWithValidation
+	if cmd.ParametersAsJSON != "" {
+		err := parameters.UnmarshalFlag(string(cmd.ParametersAsJSON))
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "-c",
+				ExpectedType: "a valid JSON object or path to a file containing a valid JSON object",
+			}
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing changes to service instance {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"UserName":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	changes := []ui.Change{
+		{
+			Header:       "name:",
+			CurrentValue: serviceInstance.Name,
+			NewValue:     serviceInstance.Name,
+		},
+	}
+
+	if serviceInstance.IsManaged() {
+		servicePlan, planWarnings, planErr := cmd.Actor.GetServicePlan(serviceInstance.ServicePlanGUID)
+		cmd.UI.DisplayWarnings(planWarnings)
+		if planErr != nil {
+			return planErr
+		}
+
+		newPlanName := servicePlan.Name
+		if cmd.Plan != "" {
+			newPlanName = cmd.Plan
+		}
+		changes = append(changes, ui.Change{
+			Header:       "plan:",
+			CurrentValue: servicePlan.Name,
+			NewValue:     newPlanName,
+		})
+	}
+
+	newTags := serviceInstance.Tags
+	if cmd.Tags != "" {
+		newTags = parseTags(cmd.Tags)
+	}
+	changes = append(changes, ui.Change{
+		Header:       "tags:",
+		CurrentValue: serviceInstance.Tags,
+		NewValue:     newTags,
+	})
+
+	err = cmd.UI.DisplayChangesForPush(changes)
+	if err != nil {
+		return err
+	}
+
+	if parameters != nil && serviceInstance.IsManaged() {
+		err = cmd.displayParameterChanges(serviceInstance.GUID, parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Service instance {{.ServiceInstanceName}} was not updated because --dry-run was provided.", map[string]interface{}{
+		"ServiceInstanceName": serviceInstance.Name,
+	})
+
 	return nil
 }
 
-func (UpdateServiceCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd UpdateServiceCommand) displayParameterChanges(serviceInstanceGUID string, newParameters map[string]interface{}) error {
+	currentParameters, warnings, err := cmd.Actor.GetServiceInstanceParameters(serviceInstanceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case ccerror.ServiceParametersFetchNotSupportedError:
+		cmd.UI.DisplayWarning("This service does not support fetching service instance parameters. All provided parameters are shown as new.")
+	default:
+		return err
+	}
+
+	parameterChanges, err := getParameterChanges(currentParameters, newParameters)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("parameters:")
+	return cmd.UI.DisplayChangesForPush(parameterChanges)
+}
+
+// getParameterChanges returns one change per top-level parameter key, with
+// the current and new values rendered as JSON. Brokers merge the provided
+// parameters into the existing ones, so keys omitted from the new parameters
+// keep their current value.
+func getParameterChanges(currentParameters map[string]interface{}, newParameters map[string]interface{}) ([]ui.Change, error) {
+	var keys []string
+	for key := range currentParameters {
+		keys = append(keys, key)
+	}
+	for key := range newParameters {
+		if _, ok := currentParameters[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []ui.Change
+	for _, key := range keys {
+		currentValue, err := parameterValueToString(currentParameters, key)
+		if err != nil {
+			return nil, err
+		}
+		newValue := currentValue
+		if _, ok := newParameters[key]; ok {
+			newValue, err = parameterValueToString(newParameters, key)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		changes = append(changes, ui.Change{
+			Header:       key + ":",
+			CurrentValue: currentValue,
+			NewValue:     newValue,
+		})
+	}
+
+	return changes, nil
+}
+
+func parameterValueToString(parameters map[string]interface{}, key string) (string, error) {
+	value, ok := parameters[key]
+	if !ok {
+		return "", nil
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(valueJSON), nil
+}
+
+func parseTags(tags string) []string {
+	var parsedTags []string
+	for _, tag := range strings.Split(tags, ",") {
+		if trimmedTag := strings.TrimSpace(tag); trimmedTag != "" {
+			parsedTags = append(parsedTags, trimmedTag)
+		}
+	}
+	return parsedTags
 }
@@ -0,0 +1,275 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-service Command", func() {
+	var (
+		cmd             UpdateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateServiceActor)
+
+		cmd = UpdateServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the --dry-run flag is not provided", func() {
+		It("returns an UnrefactoredCommandError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the --dry-run flag is provided", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		Context("when the provided parameters are not a valid JSON object", func() {
+			BeforeEach(func() {
+				cmd.ParametersAsJSON = "not-json"
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "-c",
+					ExpectedType: "a valid JSON object or path to a file containing a valid JSON object",
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an error is encountered checking if the environment is setup correctly", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrgArg, checkTargetedSpaceArg := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrgArg).To(BeTrue())
+				Expect(checkTargetedSpaceArg).To(BeTrue())
+			})
+		})
+
+		Context("when the user is logged in and an org and space are targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+				fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			})
+
+			Context("when getting the current user fails", func() {
+				BeforeEach(func() {
+					fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("get-user-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("get-user-error"))
+				})
+			})
+
+			Context("when getting the service instance fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"get-service-instance-warning"},
+						actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"},
+					)
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+					Expect(testUI.Err).To(Say("get-service-instance-warning"))
+
+					Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+					serviceInstanceName, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(serviceInstanceName).To(Equal("some-service-instance"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the service instance is managed", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{
+							GUID:            "some-service-instance-guid",
+							Name:            "some-service-instance",
+							ServicePlanGUID: "some-service-plan-guid",
+							Tags:            []string{"tag-1", "tag-2"},
+							Type:            constant.ServiceInstanceTypeManagedService,
+						},
+						v2action.Warnings{"get-service-instance-warning"},
+						nil,
+					)
+					fakeActor.GetServicePlanReturns(
+						v2action.ServicePlan{Name: "small"},
+						v2action.Warnings{"get-service-plan-warning"},
+						nil,
+					)
+				})
+
+				Context("when a new plan, tags and parameters are provided", func() {
+					BeforeEach(func() {
+						cmd.Plan = "large"
+						cmd.Tags = "tag-2, tag-3"
+						cmd.ParametersAsJSON = `{"ram_gb": 8, "backups": true}`
+						fakeActor.GetServiceInstanceParametersReturns(
+							v2action.ServiceParameters{"ram_gb": 4, "region": "eu"},
+							v2action.Warnings{"get-parameters-warning"},
+							nil,
+						)
+					})
+
+					It("displays the changes without updating the service instance", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Showing changes to service instance some-service-instance in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say(`\s+name:\s+some-service-instance`))
+						Expect(testUI.Out).To(Say(`-\s+plan:\s+small`))
+						Expect(testUI.Out).To(Say(`\+\s+plan:\s+large`))
+						Expect(testUI.Out).To(Say(`\s+tags:`))
+						Expect(testUI.Out).To(Say(`-\s+tag-1`))
+						Expect(testUI.Out).To(Say(`\s+tag-2`))
+						Expect(testUI.Out).To(Say(`\+\s+tag-3`))
+						Expect(testUI.Out).To(Say("parameters:"))
+						Expect(testUI.Out).To(Say(`\+\s+backups:\s+true`))
+						Expect(testUI.Out).To(Say(`-\s+ram_gb:\s+4`))
+						Expect(testUI.Out).To(Say(`\+\s+ram_gb:\s+8`))
+						Expect(testUI.Out).ToNot(Say(`[-+]\s+region:`))
+						Expect(testUI.Out).To(Say(`\s+region:\s+"eu"`))
+						Expect(testUI.Out).To(Say("Service instance some-service-instance was not updated because --dry-run was provided."))
+
+						Expect(testUI.Err).To(Say("get-service-instance-warning"))
+						Expect(testUI.Err).To(Say("get-service-plan-warning"))
+						Expect(testUI.Err).To(Say("get-parameters-warning"))
+
+						Expect(fakeActor.GetServicePlanArgsForCall(0)).To(Equal("some-service-plan-guid"))
+						Expect(fakeActor.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+					})
+				})
+
+				Context("when no parameters are provided", func() {
+					It("does not fetch the current parameters", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("parameters:"))
+						Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the service broker does not support fetching parameters", func() {
+					BeforeEach(func() {
+						cmd.ParametersAsJSON = `{"ram_gb": 8}`
+						fakeActor.GetServiceInstanceParametersReturns(
+							nil,
+							v2action.Warnings{"get-parameters-warning"},
+							ccerror.ServiceParametersFetchNotSupportedError{Message: "not supported"},
+						)
+					})
+
+					It("displays a warning and shows all provided parameters as new", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("This service does not support fetching service instance parameters. All provided parameters are shown as new."))
+						Expect(testUI.Out).To(Say("parameters:"))
+						Expect(testUI.Out).To(Say(`\+\s+ram_gb:\s+8`))
+					})
+				})
+
+				Context("when getting the current parameters fails", func() {
+					BeforeEach(func() {
+						cmd.ParametersAsJSON = `{"ram_gb": 8}`
+						fakeActor.GetServiceInstanceParametersReturns(
+							nil,
+							v2action.Warnings{"get-parameters-warning"},
+							errors.New("get-parameters-error"),
+						)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("get-parameters-error"))
+						Expect(testUI.Err).To(Say("get-parameters-warning"))
+					})
+				})
+
+				Context("when getting the service plan fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetServicePlanReturns(
+							v2action.ServicePlan{},
+							v2action.Warnings{"get-service-plan-warning"},
+							errors.New("get-service-plan-error"),
+						)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("get-service-plan-error"))
+						Expect(testUI.Err).To(Say("get-service-plan-warning"))
+					})
+				})
+			})
+
+			Context("when the service instance is user provided", func() {
+				BeforeEach(func() {
+					cmd.ParametersAsJSON = `{"ram_gb": 8}`
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{
+							GUID: "some-service-instance-guid",
+							Name: "some-service-instance",
+							Type: constant.ServiceInstanceTypeUserProvidedService,
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("does not display plan or parameter changes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("plan:"))
+					Expect(testUI.Out).ToNot(Say("parameters:"))
+					Expect(fakeActor.GetServicePlanCallCount()).To(Equal(0))
+					Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,312 @@
+package v2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UsageReportActor
+
+type UsageReportActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationUsageReport(orgGUID string, spaceName string) (v2action.OrganizationUsage, v2action.Warnings, error)
+}
+
+type UsageReportCommand struct {
+	Org             string            `short:"o" description:"Org to report on (defaults to the targeted org)"`
+	Space           string            `short:"s" description:"Only report on this space"`
+	Format          flag.ReportFormat `long:"format" description:"Output the report as csv or json instead of a table"`
+	usage           interface{}       `usage:"CF_NAME usage-report [-o ORG] [-s SPACE] [--format (csv | json)]\n\n   Reports the memory, disk and instances allocated to and used by each app in an org, and compares the allocations against the org and space quotas.\n   Memory and disk are allocated to every instance of a started app.\n\nEXAMPLES:\n   CF_NAME usage-report\n   CF_NAME usage-report -o my-org -s production --format csv > usage.csv"`
+	relatedCommands interface{}       `related_commands:"app, org, quota, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsageReportActor
+}
+
+func (cmd *UsageReportCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd UsageReportCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Org == "", false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Org != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		orgName = org.Name
+		orgGUID = org.GUID
+	}
+
+	if cmd.Format.Format == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting usage report for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	report, warnings, err := cmd.Actor.GetOrganizationUsageReport(orgGUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.Format.Format {
+	case "csv":
+		return cmd.displayCSV(report)
+	case "json":
+		return cmd.displayJSON(report)
+	default:
+		cmd.displayTables(report)
+		return nil
+	}
+}
+
+func (cmd UsageReportCommand) displayTables(report v2action.OrganizationUsage) {
+	table := [][]string{{
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("instances"),
+		cmd.UI.TranslateText("memory used"),
+		cmd.UI.TranslateText("memory allocated"),
+		cmd.UI.TranslateText("disk used"),
+		cmd.UI.TranslateText("disk allocated"),
+	}}
+	for _, space := range report.Spaces {
+		for _, app := range space.Applications {
+			table = append(table, []string{
+				space.Name,
+				app.Name,
+				cmd.UI.TranslateText(strings.ToLower(string(app.State))),
+				fmt.Sprintf("%d/%d", app.RunningInstances, app.Instances),
+				bytefmt.ByteSize(app.UsedMemory),
+				bytefmt.ByteSize(app.AllocatedMemory),
+				bytefmt.ByteSize(app.UsedDisk),
+				bytefmt.ByteSize(app.AllocatedDisk),
+			})
+		}
+	}
+
+	if len(table) == 1 {
+		cmd.UI.DisplayText("No apps found")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+
+	quotaTable := [][]string{{
+		"",
+		cmd.UI.TranslateText("quota"),
+		cmd.UI.TranslateText("memory allocated"),
+		cmd.UI.TranslateText("instances"),
+	}}
+	if report.Quota.Name != "" && cmd.Space == "" {
+		total := report.Total()
+		quotaTable = append(quotaTable, []string{
+			cmd.UI.TranslateText("org {{.OrgName}}", map[string]interface{}{"OrgName": report.Name}),
+			report.Quota.Name,
+			cmd.quotaMemoryUsage(total.AllocatedMemory, report.Quota.MemoryLimit),
+			cmd.quotaInstanceUsage(total.Instances, report.Quota.AppInstanceLimit),
+		})
+	}
+	for _, space := range report.Spaces {
+		if space.Quota.Name == "" {
+			continue
+		}
+		total := space.Total()
+		quotaTable = append(quotaTable, []string{
+			cmd.UI.TranslateText("space {{.SpaceName}}", map[string]interface{}{"SpaceName": space.Name}),
+			space.Quota.Name,
+			cmd.quotaMemoryUsage(total.AllocatedMemory, space.Quota.MemoryLimit),
+			cmd.quotaInstanceUsage(total.Instances, space.Quota.AppInstanceLimit),
+		})
+	}
+
+	if len(quotaTable) > 1 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTableWithHeader("", quotaTable, ui.DefaultTableSpacePadding)
+	}
+}
+
+func (cmd UsageReportCommand) quotaMemoryUsage(allocated uint64, limit types.NullByteSizeInMb) string {
+	if !limit.IsSet {
+		return cmd.UI.TranslateText("{{.Allocated}} (unlimited)", map[string]interface{}{
+			"Allocated": bytefmt.ByteSize(allocated),
+		})
+	}
+
+	limitInBytes := limit.Value * bytefmt.MEGABYTE
+	return cmd.UI.TranslateText("{{.Allocated}} of {{.Limit}} ({{.Percent}}%)", map[string]interface{}{
+		"Allocated": bytefmt.ByteSize(allocated),
+		"Limit":     bytefmt.ByteSize(limitInBytes),
+		"Percent":   percentOf(allocated, limitInBytes),
+	})
+}
+
+func (cmd UsageReportCommand) quotaInstanceUsage(instances int, limit types.NullInt) string {
+	if !limit.IsSet {
+		return cmd.UI.TranslateText("{{.Instances}} (unlimited)", map[string]interface{}{
+			"Instances": instances,
+		})
+	}
+
+	return cmd.UI.TranslateText("{{.Instances}} of {{.Limit}}", map[string]interface{}{
+		"Instances": instances,
+		"Limit":     limit.Value,
+	})
+}
+
+func (cmd UsageReportCommand) displayCSV(report v2action.OrganizationUsage) error {
+	writer := csv.NewWriter(cmd.UI.GetOut())
+	rows := [][]string{{
+		"org", "space", "app", "state", "instances", "running_instances",
+		"memory_allocated_bytes", "memory_used_bytes", "disk_allocated_bytes", "disk_used_bytes",
+	}}
+	for _, space := range report.Spaces {
+		for _, app := range space.Applications {
+			rows = append(rows, []string{
+				report.Name,
+				space.Name,
+				app.Name,
+				strings.ToLower(string(app.State)),
+				strconv.Itoa(app.Instances),
+				strconv.Itoa(app.RunningInstances),
+				strconv.FormatUint(app.AllocatedMemory, 10),
+				strconv.FormatUint(app.UsedMemory, 10),
+				strconv.FormatUint(app.AllocatedDisk, 10),
+				strconv.FormatUint(app.UsedDisk, 10),
+			})
+		}
+	}
+
+	return writer.WriteAll(rows)
+}
+
+type usageReportJSON struct {
+	Name   string                 `json:"name"`
+	Quota  *usageReportQuotaJSON  `json:"quota"`
+	Usage  usageReportUsageJSON   `json:"usage"`
+	Spaces []usageReportSpaceJSON `json:"spaces"`
+}
+
+type usageReportSpaceJSON struct {
+	Name  string                `json:"name"`
+	Quota *usageReportQuotaJSON `json:"quota"`
+	Usage usageReportUsageJSON  `json:"usage"`
+	Apps  []usageReportAppJSON  `json:"apps"`
+}
+
+type usageReportAppJSON struct {
+	Name  string               `json:"name"`
+	State string               `json:"state"`
+	Usage usageReportUsageJSON `json:"usage"`
+}
+
+type usageReportUsageJSON struct {
+	Instances        int    `json:"instances"`
+	RunningInstances int    `json:"running_instances"`
+	AllocatedMemory  uint64 `json:"memory_allocated_bytes"`
+	UsedMemory       uint64 `json:"memory_used_bytes"`
+	AllocatedDisk    uint64 `json:"disk_allocated_bytes"`
+	UsedDisk         uint64 `json:"disk_used_bytes"`
+}
+
+type usageReportQuotaJSON struct {
+	Name               string  `json:"name"`
+	MemoryLimitInBytes *uint64 `json:"memory_limit_bytes"`
+	AppInstanceLimit   *int    `json:"app_instance_limit"`
+}
+
+func newUsageReportQuotaJSON(name string, memoryLimit types.NullByteSizeInMb, appInstanceLimit types.NullInt) *usageReportQuotaJSON {
+	if name == "" {
+		return nil
+	}
+
+	quota := usageReportQuotaJSON{Name: name}
+	if memoryLimit.IsSet {
+		limit := memoryLimit.Value * bytefmt.MEGABYTE
+		quota.MemoryLimitInBytes = &limit
+	}
+	if appInstanceLimit.IsSet {
+		limit := appInstanceLimit.Value
+		quota.AppInstanceLimit = &limit
+	}
+	return &quota
+}
+
+func (cmd UsageReportCommand) displayJSON(report v2action.OrganizationUsage) error {
+	output := usageReportJSON{
+		Name:   report.Name,
+		Quota:  newUsageReportQuotaJSON(report.Quota.Name, report.Quota.MemoryLimit, report.Quota.AppInstanceLimit),
+		Usage:  usageReportUsageJSON(report.Total()),
+		Spaces: []usageReportSpaceJSON{},
+	}
+	for _, space := range report.Spaces {
+		spaceJSON := usageReportSpaceJSON{
+			Name:  space.Name,
+			Quota: newUsageReportQuotaJSON(space.Quota.Name, space.Quota.MemoryLimit, space.Quota.AppInstanceLimit),
+			Usage: usageReportUsageJSON(space.Total()),
+			Apps:  []usageReportAppJSON{},
+		}
+		for _, app := range space.Applications {
+			spaceJSON.Apps = append(spaceJSON.Apps, usageReportAppJSON{
+				Name:  app.Name,
+				State: strings.ToLower(string(app.State)),
+				Usage: usageReportUsageJSON(app.Usage),
+			})
+		}
+		output.Spaces = append(output.Spaces, spaceJSON)
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(cmd.UI.GetOut(), "%s\n", raw)
+	return err
+}
+
+func percentOf(value uint64, total uint64) uint64 {
+	if total == 0 {
+		return 0
+	}
+	return value * 100 / total
+}
@@ -0,0 +1,218 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage-report Command", func() {
+	var (
+		cmd             UsageReportCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUsageReportActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUsageReportActor)
+
+		cmd = UsageReportCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+
+		fakeActor.GetOrganizationUsageReportReturns(v2action.OrganizationUsage{
+			Name: "some-org",
+			Quota: v2action.OrganizationQuota{
+				Name:             "some-org-quota",
+				MemoryLimit:      types.NullByteSizeInMb{IsSet: true, Value: 2048},
+				AppInstanceLimit: types.NullInt{IsSet: true, Value: 10},
+			},
+			Spaces: []v2action.SpaceUsage{
+				{
+					Name:  "space-1",
+					Quota: v2action.SpaceQuota{Name: "some-space-quota"},
+					Applications: []v2action.ApplicationUsage{
+						{
+							Name:  "app-1",
+							State: constant.ApplicationStarted,
+							Usage: v2action.Usage{
+								Instances:        2,
+								RunningInstances: 1,
+								AllocatedMemory:  512 * bytefmt.MEGABYTE,
+								UsedMemory:       150 * bytefmt.MEGABYTE,
+								AllocatedDisk:    2048 * bytefmt.MEGABYTE,
+								UsedDisk:         400 * bytefmt.MEGABYTE,
+							},
+						},
+						{
+							Name:  "app-2",
+							State: constant.ApplicationStopped,
+						},
+					},
+				},
+			},
+		}, v2action.Warnings{"report-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no format is provided", func() {
+		It("displays the usage of every app and the quota usage", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			orgGUID, spaceName := fakeActor.GetOrganizationUsageReportArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(BeEmpty())
+
+			Expect(testUI.Out).To(Say("Getting usage report for org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("space\\s+app\\s+state\\s+instances\\s+memory used\\s+memory allocated\\s+disk used\\s+disk allocated"))
+			Expect(testUI.Out).To(Say("space-1\\s+app-1\\s+started\\s+1/2\\s+150M\\s+512M\\s+400M\\s+2G"))
+			Expect(testUI.Out).To(Say("space-1\\s+app-2\\s+stopped\\s+0/0\\s+0\\s+0\\s+0\\s+0"))
+			Expect(testUI.Out).To(Say("quota\\s+memory allocated\\s+instances"))
+			Expect(testUI.Out).To(Say("org some-org\\s+some-org-quota\\s+512M of 2G \\(25\\x25\\)\\s+2 of 10"))
+			Expect(testUI.Out).To(Say("space space-1\\s+some-space-quota\\s+512M \\(unlimited\\)\\s+2 \\(unlimited\\)"))
+			Expect(testUI.Err).To(Say("report-warning"))
+		})
+
+		Context("when a space is provided", func() {
+			BeforeEach(func() {
+				cmd.Space = "space-1"
+			})
+
+			It("reports the space without comparing against the org quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, spaceName := fakeActor.GetOrganizationUsageReportArgsForCall(0)
+				Expect(spaceName).To(Equal("space-1"))
+				Expect(testUI.Out).ToNot(Say("org some-org\\s+some-org-quota"))
+			})
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "other-org-guid", Name: "other-org"}, v2action.Warnings{"org-warning"}, nil)
+		})
+
+		It("only requires a login and reports on that org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			orgGUID, _ := fakeActor.GetOrganizationUsageReportArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+			Expect(testUI.Out).To(Say("Getting usage report for org other-org as some-user\\.\\.\\."))
+			Expect(testUI.Err).To(Say("org-warning"))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "other-org"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "other-org"}))
+				Expect(fakeActor.GetOrganizationUsageReportCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the csv format is provided", func() {
+		BeforeEach(func() {
+			cmd.Format = flag.ReportFormat{Format: "csv"}
+		})
+
+		It("outputs only the csv rows", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"org,space,app,state,instances,running_instances,memory_allocated_bytes,memory_used_bytes,disk_allocated_bytes,disk_used_bytes\n" +
+					"some-org,space-1,app-1,started,2,1,536870912,157286400,2147483648,419430400\n" +
+					"some-org,space-1,app-2,stopped,0,0,0,0,0,0\n"))
+		})
+	})
+
+	Context("when the json format is provided", func() {
+		BeforeEach(func() {
+			cmd.Format = flag.ReportFormat{Format: "json"}
+		})
+
+		It("outputs only the json report", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"name": "some-org",
+				"quota": {"name": "some-org-quota", "memory_limit_bytes": 2147483648, "app_instance_limit": 10},
+				"usage": {"instances": 2, "running_instances": 1, "memory_allocated_bytes": 536870912, "memory_used_bytes": 157286400, "disk_allocated_bytes": 2147483648, "disk_used_bytes": 419430400},
+				"spaces": [
+					{
+						"name": "space-1",
+						"quota": {"name": "some-space-quota", "memory_limit_bytes": null, "app_instance_limit": null},
+						"usage": {"instances": 2, "running_instances": 1, "memory_allocated_bytes": 536870912, "memory_used_bytes": 157286400, "disk_allocated_bytes": 2147483648, "disk_used_bytes": 419430400},
+						"apps": [
+							{"name": "app-1", "state": "started", "usage": {"instances": 2, "running_instances": 1, "memory_allocated_bytes": 536870912, "memory_used_bytes": 157286400, "disk_allocated_bytes": 2147483648, "disk_used_bytes": 419430400}},
+							{"name": "app-2", "state": "stopped", "usage": {"instances": 0, "running_instances": 0, "memory_allocated_bytes": 0, "memory_used_bytes": 0, "disk_allocated_bytes": 0, "disk_used_bytes": 0}}
+						]
+					}
+				]
+			}`))
+		})
+	})
+
+	Context("when getting the report fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationUsageReportReturns(v2action.OrganizationUsage{}, v2action.Warnings{"report-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("report-warning"))
+		})
+	})
+})
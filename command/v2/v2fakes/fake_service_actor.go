@@ -25,6 +25,21 @@ type FakeServiceActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstancesBySpaceMutex       sync.RWMutex
+	getServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetServiceInstanceSummaryByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ServiceInstanceSummary, v2action.Warnings, error)
 	getServiceInstanceSummaryByNameAndSpaceMutex       sync.RWMutex
 	getServiceInstanceSummaryByNameAndSpaceArgsForCall []struct {
@@ -41,6 +56,36 @@ type FakeServiceActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceInstanceParametersStub        func(serviceInstanceGUID string) (v2action.ServiceParameters, v2action.Warnings, error)
+	getServiceInstanceParametersMutex       sync.RWMutex
+	getServiceInstanceParametersArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceParametersReturns struct {
+		result1 v2action.ServiceParameters
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstanceParametersReturnsOnCall map[int]struct {
+		result1 v2action.ServiceParameters
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceBindingsParametersByServiceInstanceStub        func(serviceInstanceGUID string) ([]v2action.ServiceBindingParameters, v2action.Warnings, error)
+	getServiceBindingsParametersByServiceInstanceMutex       sync.RWMutex
+	getServiceBindingsParametersByServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceBindingsParametersByServiceInstanceReturns struct {
+		result1 []v2action.ServiceBindingParameters
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingsParametersByServiceInstanceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceBindingParameters
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -100,6 +145,60 @@ func (fake *FakeServiceActor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeServiceActor) GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesBySpaceReturnsOnCall[len(fake.getServiceInstancesBySpaceArgsForCall)]
+	fake.getServiceInstancesBySpaceArgsForCall = append(fake.getServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesBySpaceStub != nil {
+		return fake.GetServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesBySpaceReturns.result1, fake.getServiceInstancesBySpaceReturns.result2, fake.getServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeServiceActor) GetServiceInstancesBySpaceCallCount() int {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeServiceActor) GetServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeServiceActor) GetServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	fake.getServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	if fake.getServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstanceSummary, v2action.Warnings, error) {
 	fake.getServiceInstanceSummaryByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceSummaryByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceSummaryByNameAndSpaceArgsForCall)]
@@ -155,13 +254,127 @@ func (fake *FakeServiceActor) GetServiceInstanceSummaryByNameAndSpaceReturnsOnCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeServiceActor) GetServiceInstanceParameters(serviceInstanceGUID string) (v2action.ServiceParameters, v2action.Warnings, error) {
+	fake.getServiceInstanceParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceParametersReturnsOnCall[len(fake.getServiceInstanceParametersArgsForCall)]
+	fake.getServiceInstanceParametersArgsForCall = append(fake.getServiceInstanceParametersArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceParameters", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceParametersMutex.Unlock()
+	if fake.GetServiceInstanceParametersStub != nil {
+		return fake.GetServiceInstanceParametersStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceParametersReturns.result1, fake.getServiceInstanceParametersReturns.result2, fake.getServiceInstanceParametersReturns.result3
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceParametersCallCount() int {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return len(fake.getServiceInstanceParametersArgsForCall)
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceParametersArgsForCall(i int) string {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return fake.getServiceInstanceParametersArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceParametersReturns(result1 v2action.ServiceParameters, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	fake.getServiceInstanceParametersReturns = struct {
+		result1 v2action.ServiceParameters
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceInstanceParametersReturnsOnCall(i int, result1 v2action.ServiceParameters, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	if fake.getServiceInstanceParametersReturnsOnCall == nil {
+		fake.getServiceInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceParameters
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceParametersReturnsOnCall[i] = struct {
+		result1 v2action.ServiceParameters
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceBindingsParametersByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceBindingParameters, v2action.Warnings, error) {
+	fake.getServiceBindingsParametersByServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingsParametersByServiceInstanceReturnsOnCall[len(fake.getServiceBindingsParametersByServiceInstanceArgsForCall)]
+	fake.getServiceBindingsParametersByServiceInstanceArgsForCall = append(fake.getServiceBindingsParametersByServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceBindingsParametersByServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.getServiceBindingsParametersByServiceInstanceMutex.Unlock()
+	if fake.GetServiceBindingsParametersByServiceInstanceStub != nil {
+		return fake.GetServiceBindingsParametersByServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingsParametersByServiceInstanceReturns.result1, fake.getServiceBindingsParametersByServiceInstanceReturns.result2, fake.getServiceBindingsParametersByServiceInstanceReturns.result3
+}
+
+func (fake *FakeServiceActor) GetServiceBindingsParametersByServiceInstanceCallCount() int {
+	fake.getServiceBindingsParametersByServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingsParametersByServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceBindingsParametersByServiceInstanceArgsForCall)
+}
+
+func (fake *FakeServiceActor) GetServiceBindingsParametersByServiceInstanceArgsForCall(i int) string {
+	fake.getServiceBindingsParametersByServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingsParametersByServiceInstanceMutex.RUnlock()
+	return fake.getServiceBindingsParametersByServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeServiceActor) GetServiceBindingsParametersByServiceInstanceReturns(result1 []v2action.ServiceBindingParameters, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsParametersByServiceInstanceStub = nil
+	fake.getServiceBindingsParametersByServiceInstanceReturns = struct {
+		result1 []v2action.ServiceBindingParameters
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceActor) GetServiceBindingsParametersByServiceInstanceReturnsOnCall(i int, result1 []v2action.ServiceBindingParameters, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsParametersByServiceInstanceStub = nil
+	if fake.getServiceBindingsParametersByServiceInstanceReturnsOnCall == nil {
+		fake.getServiceBindingsParametersByServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceBindingParameters
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingsParametersByServiceInstanceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceBindingParameters
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
 	fake.getServiceInstanceSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	fake.getServiceBindingsParametersByServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingsParametersByServiceInstanceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
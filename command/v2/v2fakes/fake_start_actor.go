@@ -41,6 +41,21 @@ type FakeStartActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CheckQuotaHeadroomStub        func(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
+	checkQuotaHeadroomMutex       sync.RWMutex
+	checkQuotaHeadroomArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		requests  []v2action.QuotaRequest
+	}
+	checkQuotaHeadroomReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	checkQuotaHeadroomReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	StartApplicationStub        func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -175,6 +190,64 @@ func (fake *FakeStartActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeStartActor) CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error) {
+	var requestsCopy []v2action.QuotaRequest
+	if requests != nil {
+		requestsCopy = make([]v2action.QuotaRequest, len(requests))
+		copy(requestsCopy, requests)
+	}
+	fake.checkQuotaHeadroomMutex.Lock()
+	ret, specificReturn := fake.checkQuotaHeadroomReturnsOnCall[len(fake.checkQuotaHeadroomArgsForCall)]
+	fake.checkQuotaHeadroomArgsForCall = append(fake.checkQuotaHeadroomArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		requests  []v2action.QuotaRequest
+	}{orgGUID, spaceGUID, requestsCopy})
+	fake.recordInvocation("CheckQuotaHeadroom", []interface{}{orgGUID, spaceGUID, requestsCopy})
+	fake.checkQuotaHeadroomMutex.Unlock()
+	if fake.CheckQuotaHeadroomStub != nil {
+		return fake.CheckQuotaHeadroomStub(orgGUID, spaceGUID, requests)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.checkQuotaHeadroomReturns.result1, fake.checkQuotaHeadroomReturns.result2
+}
+
+func (fake *FakeStartActor) CheckQuotaHeadroomCallCount() int {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return len(fake.checkQuotaHeadroomArgsForCall)
+}
+
+func (fake *FakeStartActor) CheckQuotaHeadroomArgsForCall(i int) (string, string, []v2action.QuotaRequest) {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return fake.checkQuotaHeadroomArgsForCall[i].orgGUID, fake.checkQuotaHeadroomArgsForCall[i].spaceGUID, fake.checkQuotaHeadroomArgsForCall[i].requests
+}
+
+func (fake *FakeStartActor) CheckQuotaHeadroomReturns(result1 v2action.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	fake.checkQuotaHeadroomReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStartActor) CheckQuotaHeadroomReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	if fake.checkQuotaHeadroomReturnsOnCall == nil {
+		fake.checkQuotaHeadroomReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.checkQuotaHeadroomReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStartActor) StartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -243,6 +316,8 @@ func (fake *FakeStartActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
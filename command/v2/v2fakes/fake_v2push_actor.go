@@ -29,6 +29,21 @@ type FakeV2PushActor struct {
 		result3 <-chan pushaction.Warnings
 		result4 <-chan error
 	}
+	CheckQuotaHeadroomStub        func(orgGUID string, spaceGUID string, configs []pushaction.ApplicationConfig) (pushaction.Warnings, error)
+	checkQuotaHeadroomMutex       sync.RWMutex
+	checkQuotaHeadroomArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		configs   []pushaction.ApplicationConfig
+	}
+	checkQuotaHeadroomReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	checkQuotaHeadroomReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
 	CloudControllerV2APIVersionStub        func() string
 	cloudControllerV2APIVersionMutex       sync.RWMutex
 	cloudControllerV2APIVersionArgsForCall []struct{}
@@ -65,6 +80,53 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	FetchRemoteAppSourceStub        func(sourceURL string, checksum string, tmpDir string, downloader pushaction.Downloader) (string, error)
+	fetchRemoteAppSourceMutex       sync.RWMutex
+	fetchRemoteAppSourceArgsForCall []struct {
+		sourceURL  string
+		checksum   string
+		tmpDir     string
+		downloader pushaction.Downloader
+	}
+	fetchRemoteAppSourceReturns struct {
+		result1 string
+		result2 error
+	}
+	fetchRemoteAppSourceReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	LoadBlueGreenStateStub        func(stateDir string, spaceGUID string, appName string) (pushaction.BlueGreenState, bool, error)
+	loadBlueGreenStateMutex       sync.RWMutex
+	loadBlueGreenStateArgsForCall []struct {
+		stateDir  string
+		spaceGUID string
+		appName   string
+	}
+	loadBlueGreenStateReturns struct {
+		result1 pushaction.BlueGreenState
+		result2 bool
+		result3 error
+	}
+	loadBlueGreenStateReturnsOnCall map[int]struct {
+		result1 pushaction.BlueGreenState
+		result2 bool
+		result3 error
+	}
+	MarkBlueGreenAppPushedStub        func(state pushaction.BlueGreenState, appGUID string) (pushaction.BlueGreenState, error)
+	markBlueGreenAppPushedMutex       sync.RWMutex
+	markBlueGreenAppPushedArgsForCall []struct {
+		state   pushaction.BlueGreenState
+		appGUID string
+	}
+	markBlueGreenAppPushedReturns struct {
+		result1 pushaction.BlueGreenState
+		result2 error
+	}
+	markBlueGreenAppPushedReturnsOnCall map[int]struct {
+		result1 pushaction.BlueGreenState
+		result2 error
+	}
 	MergeAndValidateSettingsAndManifestsStub        func(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
 	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
@@ -79,6 +141,26 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PrepareBlueGreenPushStub        func(config pushaction.ApplicationConfig, orgGUID string, stateDir string, keepOld bool) (pushaction.ApplicationConfig, pushaction.BlueGreenState, pushaction.Warnings, error)
+	prepareBlueGreenPushMutex       sync.RWMutex
+	prepareBlueGreenPushArgsForCall []struct {
+		config   pushaction.ApplicationConfig
+		orgGUID  string
+		stateDir string
+		keepOld  bool
+	}
+	prepareBlueGreenPushReturns struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.BlueGreenState
+		result3 pushaction.Warnings
+		result4 error
+	}
+	prepareBlueGreenPushReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.BlueGreenState
+		result3 pushaction.Warnings
+		result4 error
+	}
 	ReadManifestStub        func(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
@@ -96,6 +178,72 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	RetireBlueGreenAppStub        func(state pushaction.BlueGreenState) (pushaction.Warnings, error)
+	retireBlueGreenAppMutex       sync.RWMutex
+	retireBlueGreenAppArgsForCall []struct {
+		state pushaction.BlueGreenState
+	}
+	retireBlueGreenAppReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	retireBlueGreenAppReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	RollbackBlueGreenPushStub        func(state pushaction.BlueGreenState) (pushaction.Warnings, error)
+	rollbackBlueGreenPushMutex       sync.RWMutex
+	rollbackBlueGreenPushArgsForCall []struct {
+		state pushaction.BlueGreenState
+	}
+	rollbackBlueGreenPushReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	rollbackBlueGreenPushReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	RunSmokeTestStub        func(state pushaction.BlueGreenState, smokeTest pushaction.SmokeTest) error
+	runSmokeTestMutex       sync.RWMutex
+	runSmokeTestArgsForCall []struct {
+		state     pushaction.BlueGreenState
+		smokeTest pushaction.SmokeTest
+	}
+	runSmokeTestReturns struct {
+		result1 error
+	}
+	runSmokeTestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetMatchedResourcesStub        func(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings)
+	setMatchedResourcesMutex       sync.RWMutex
+	setMatchedResourcesArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	setMatchedResourcesReturns struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}
+	setMatchedResourcesReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}
+	SwapBlueGreenRoutesStub        func(state pushaction.BlueGreenState) (pushaction.BlueGreenState, pushaction.Warnings, error)
+	swapBlueGreenRoutesMutex       sync.RWMutex
+	swapBlueGreenRoutesArgsForCall []struct {
+		state pushaction.BlueGreenState
+	}
+	swapBlueGreenRoutesReturns struct {
+		result1 pushaction.BlueGreenState
+		result2 pushaction.Warnings
+		result3 error
+	}
+	swapBlueGreenRoutesReturnsOnCall map[int]struct {
+		result1 pushaction.BlueGreenState
+		result2 pushaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -158,6 +306,64 @@ func (fake *FakeV2PushActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV2PushActor) CheckQuotaHeadroom(orgGUID string, spaceGUID string, configs []pushaction.ApplicationConfig) (pushaction.Warnings, error) {
+	var configsCopy []pushaction.ApplicationConfig
+	if configs != nil {
+		configsCopy = make([]pushaction.ApplicationConfig, len(configs))
+		copy(configsCopy, configs)
+	}
+	fake.checkQuotaHeadroomMutex.Lock()
+	ret, specificReturn := fake.checkQuotaHeadroomReturnsOnCall[len(fake.checkQuotaHeadroomArgsForCall)]
+	fake.checkQuotaHeadroomArgsForCall = append(fake.checkQuotaHeadroomArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		configs   []pushaction.ApplicationConfig
+	}{orgGUID, spaceGUID, configsCopy})
+	fake.recordInvocation("CheckQuotaHeadroom", []interface{}{orgGUID, spaceGUID, configsCopy})
+	fake.checkQuotaHeadroomMutex.Unlock()
+	if fake.CheckQuotaHeadroomStub != nil {
+		return fake.CheckQuotaHeadroomStub(orgGUID, spaceGUID, configs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.checkQuotaHeadroomReturns.result1, fake.checkQuotaHeadroomReturns.result2
+}
+
+func (fake *FakeV2PushActor) CheckQuotaHeadroomCallCount() int {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return len(fake.checkQuotaHeadroomArgsForCall)
+}
+
+func (fake *FakeV2PushActor) CheckQuotaHeadroomArgsForCall(i int) (string, string, []pushaction.ApplicationConfig) {
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
+	return fake.checkQuotaHeadroomArgsForCall[i].orgGUID, fake.checkQuotaHeadroomArgsForCall[i].spaceGUID, fake.checkQuotaHeadroomArgsForCall[i].configs
+}
+
+func (fake *FakeV2PushActor) CheckQuotaHeadroomReturns(result1 pushaction.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	fake.checkQuotaHeadroomReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) CheckQuotaHeadroomReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.CheckQuotaHeadroomStub = nil
+	if fake.checkQuotaHeadroomReturnsOnCall == nil {
+		fake.checkQuotaHeadroomReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.checkQuotaHeadroomReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) CloudControllerV2APIVersion() string {
 	fake.cloudControllerV2APIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerV2APIVersionReturnsOnCall[len(fake.cloudControllerV2APIVersionArgsForCall)]
@@ -300,6 +506,168 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) FetchRemoteAppSource(sourceURL string, checksum string, tmpDir string, downloader pushaction.Downloader) (string, error) {
+	fake.fetchRemoteAppSourceMutex.Lock()
+	ret, specificReturn := fake.fetchRemoteAppSourceReturnsOnCall[len(fake.fetchRemoteAppSourceArgsForCall)]
+	fake.fetchRemoteAppSourceArgsForCall = append(fake.fetchRemoteAppSourceArgsForCall, struct {
+		sourceURL  string
+		checksum   string
+		tmpDir     string
+		downloader pushaction.Downloader
+	}{sourceURL, checksum, tmpDir, downloader})
+	fake.recordInvocation("FetchRemoteAppSource", []interface{}{sourceURL, checksum, tmpDir, downloader})
+	fake.fetchRemoteAppSourceMutex.Unlock()
+	if fake.FetchRemoteAppSourceStub != nil {
+		return fake.FetchRemoteAppSourceStub(sourceURL, checksum, tmpDir, downloader)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.fetchRemoteAppSourceReturns.result1, fake.fetchRemoteAppSourceReturns.result2
+}
+
+func (fake *FakeV2PushActor) FetchRemoteAppSourceCallCount() int {
+	fake.fetchRemoteAppSourceMutex.RLock()
+	defer fake.fetchRemoteAppSourceMutex.RUnlock()
+	return len(fake.fetchRemoteAppSourceArgsForCall)
+}
+
+func (fake *FakeV2PushActor) FetchRemoteAppSourceArgsForCall(i int) (string, string, string, pushaction.Downloader) {
+	fake.fetchRemoteAppSourceMutex.RLock()
+	defer fake.fetchRemoteAppSourceMutex.RUnlock()
+	return fake.fetchRemoteAppSourceArgsForCall[i].sourceURL, fake.fetchRemoteAppSourceArgsForCall[i].checksum, fake.fetchRemoteAppSourceArgsForCall[i].tmpDir, fake.fetchRemoteAppSourceArgsForCall[i].downloader
+}
+
+func (fake *FakeV2PushActor) FetchRemoteAppSourceReturns(result1 string, result2 error) {
+	fake.FetchRemoteAppSourceStub = nil
+	fake.fetchRemoteAppSourceReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) FetchRemoteAppSourceReturnsOnCall(i int, result1 string, result2 error) {
+	fake.FetchRemoteAppSourceStub = nil
+	if fake.fetchRemoteAppSourceReturnsOnCall == nil {
+		fake.fetchRemoteAppSourceReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.fetchRemoteAppSourceReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) LoadBlueGreenState(stateDir string, spaceGUID string, appName string) (pushaction.BlueGreenState, bool, error) {
+	fake.loadBlueGreenStateMutex.Lock()
+	ret, specificReturn := fake.loadBlueGreenStateReturnsOnCall[len(fake.loadBlueGreenStateArgsForCall)]
+	fake.loadBlueGreenStateArgsForCall = append(fake.loadBlueGreenStateArgsForCall, struct {
+		stateDir  string
+		spaceGUID string
+		appName   string
+	}{stateDir, spaceGUID, appName})
+	fake.recordInvocation("LoadBlueGreenState", []interface{}{stateDir, spaceGUID, appName})
+	fake.loadBlueGreenStateMutex.Unlock()
+	if fake.LoadBlueGreenStateStub != nil {
+		return fake.LoadBlueGreenStateStub(stateDir, spaceGUID, appName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.loadBlueGreenStateReturns.result1, fake.loadBlueGreenStateReturns.result2, fake.loadBlueGreenStateReturns.result3
+}
+
+func (fake *FakeV2PushActor) LoadBlueGreenStateCallCount() int {
+	fake.loadBlueGreenStateMutex.RLock()
+	defer fake.loadBlueGreenStateMutex.RUnlock()
+	return len(fake.loadBlueGreenStateArgsForCall)
+}
+
+func (fake *FakeV2PushActor) LoadBlueGreenStateArgsForCall(i int) (string, string, string) {
+	fake.loadBlueGreenStateMutex.RLock()
+	defer fake.loadBlueGreenStateMutex.RUnlock()
+	return fake.loadBlueGreenStateArgsForCall[i].stateDir, fake.loadBlueGreenStateArgsForCall[i].spaceGUID, fake.loadBlueGreenStateArgsForCall[i].appName
+}
+
+func (fake *FakeV2PushActor) LoadBlueGreenStateReturns(result1 pushaction.BlueGreenState, result2 bool, result3 error) {
+	fake.LoadBlueGreenStateStub = nil
+	fake.loadBlueGreenStateReturns = struct {
+		result1 pushaction.BlueGreenState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) LoadBlueGreenStateReturnsOnCall(i int, result1 pushaction.BlueGreenState, result2 bool, result3 error) {
+	fake.LoadBlueGreenStateStub = nil
+	if fake.loadBlueGreenStateReturnsOnCall == nil {
+		fake.loadBlueGreenStateReturnsOnCall = make(map[int]struct {
+			result1 pushaction.BlueGreenState
+			result2 bool
+			result3 error
+		})
+	}
+	fake.loadBlueGreenStateReturnsOnCall[i] = struct {
+		result1 pushaction.BlueGreenState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) MarkBlueGreenAppPushed(state pushaction.BlueGreenState, appGUID string) (pushaction.BlueGreenState, error) {
+	fake.markBlueGreenAppPushedMutex.Lock()
+	ret, specificReturn := fake.markBlueGreenAppPushedReturnsOnCall[len(fake.markBlueGreenAppPushedArgsForCall)]
+	fake.markBlueGreenAppPushedArgsForCall = append(fake.markBlueGreenAppPushedArgsForCall, struct {
+		state   pushaction.BlueGreenState
+		appGUID string
+	}{state, appGUID})
+	fake.recordInvocation("MarkBlueGreenAppPushed", []interface{}{state, appGUID})
+	fake.markBlueGreenAppPushedMutex.Unlock()
+	if fake.MarkBlueGreenAppPushedStub != nil {
+		return fake.MarkBlueGreenAppPushedStub(state, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.markBlueGreenAppPushedReturns.result1, fake.markBlueGreenAppPushedReturns.result2
+}
+
+func (fake *FakeV2PushActor) MarkBlueGreenAppPushedCallCount() int {
+	fake.markBlueGreenAppPushedMutex.RLock()
+	defer fake.markBlueGreenAppPushedMutex.RUnlock()
+	return len(fake.markBlueGreenAppPushedArgsForCall)
+}
+
+func (fake *FakeV2PushActor) MarkBlueGreenAppPushedArgsForCall(i int) (pushaction.BlueGreenState, string) {
+	fake.markBlueGreenAppPushedMutex.RLock()
+	defer fake.markBlueGreenAppPushedMutex.RUnlock()
+	return fake.markBlueGreenAppPushedArgsForCall[i].state, fake.markBlueGreenAppPushedArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2PushActor) MarkBlueGreenAppPushedReturns(result1 pushaction.BlueGreenState, result2 error) {
+	fake.MarkBlueGreenAppPushedStub = nil
+	fake.markBlueGreenAppPushedReturns = struct {
+		result1 pushaction.BlueGreenState
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) MarkBlueGreenAppPushedReturnsOnCall(i int, result1 pushaction.BlueGreenState, result2 error) {
+	fake.MarkBlueGreenAppPushedStub = nil
+	if fake.markBlueGreenAppPushedReturnsOnCall == nil {
+		fake.markBlueGreenAppPushedReturnsOnCall = make(map[int]struct {
+			result1 pushaction.BlueGreenState
+			result2 error
+		})
+	}
+	fake.markBlueGreenAppPushedReturnsOnCall[i] = struct {
+		result1 pushaction.BlueGreenState
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -357,6 +725,66 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PrepareBlueGreenPush(config pushaction.ApplicationConfig, orgGUID string, stateDir string, keepOld bool) (pushaction.ApplicationConfig, pushaction.BlueGreenState, pushaction.Warnings, error) {
+	fake.prepareBlueGreenPushMutex.Lock()
+	ret, specificReturn := fake.prepareBlueGreenPushReturnsOnCall[len(fake.prepareBlueGreenPushArgsForCall)]
+	fake.prepareBlueGreenPushArgsForCall = append(fake.prepareBlueGreenPushArgsForCall, struct {
+		config   pushaction.ApplicationConfig
+		orgGUID  string
+		stateDir string
+		keepOld  bool
+	}{config, orgGUID, stateDir, keepOld})
+	fake.recordInvocation("PrepareBlueGreenPush", []interface{}{config, orgGUID, stateDir, keepOld})
+	fake.prepareBlueGreenPushMutex.Unlock()
+	if fake.PrepareBlueGreenPushStub != nil {
+		return fake.PrepareBlueGreenPushStub(config, orgGUID, stateDir, keepOld)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.prepareBlueGreenPushReturns.result1, fake.prepareBlueGreenPushReturns.result2, fake.prepareBlueGreenPushReturns.result3, fake.prepareBlueGreenPushReturns.result4
+}
+
+func (fake *FakeV2PushActor) PrepareBlueGreenPushCallCount() int {
+	fake.prepareBlueGreenPushMutex.RLock()
+	defer fake.prepareBlueGreenPushMutex.RUnlock()
+	return len(fake.prepareBlueGreenPushArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PrepareBlueGreenPushArgsForCall(i int) (pushaction.ApplicationConfig, string, string, bool) {
+	fake.prepareBlueGreenPushMutex.RLock()
+	defer fake.prepareBlueGreenPushMutex.RUnlock()
+	return fake.prepareBlueGreenPushArgsForCall[i].config, fake.prepareBlueGreenPushArgsForCall[i].orgGUID, fake.prepareBlueGreenPushArgsForCall[i].stateDir, fake.prepareBlueGreenPushArgsForCall[i].keepOld
+}
+
+func (fake *FakeV2PushActor) PrepareBlueGreenPushReturns(result1 pushaction.ApplicationConfig, result2 pushaction.BlueGreenState, result3 pushaction.Warnings, result4 error) {
+	fake.PrepareBlueGreenPushStub = nil
+	fake.prepareBlueGreenPushReturns = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.BlueGreenState
+		result3 pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV2PushActor) PrepareBlueGreenPushReturnsOnCall(i int, result1 pushaction.ApplicationConfig, result2 pushaction.BlueGreenState, result3 pushaction.Warnings, result4 error) {
+	fake.PrepareBlueGreenPushStub = nil
+	if fake.prepareBlueGreenPushReturnsOnCall == nil {
+		fake.prepareBlueGreenPushReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationConfig
+			result2 pushaction.BlueGreenState
+			result3 pushaction.Warnings
+			result4 error
+		})
+	}
+	fake.prepareBlueGreenPushReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.BlueGreenState
+		result3 pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error) {
 	var pathsToVarsFilesCopy []string
 	if pathsToVarsFiles != nil {
@@ -423,21 +851,297 @@ func (fake *FakeV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) RetireBlueGreenApp(state pushaction.BlueGreenState) (pushaction.Warnings, error) {
+	fake.retireBlueGreenAppMutex.Lock()
+	ret, specificReturn := fake.retireBlueGreenAppReturnsOnCall[len(fake.retireBlueGreenAppArgsForCall)]
+	fake.retireBlueGreenAppArgsForCall = append(fake.retireBlueGreenAppArgsForCall, struct {
+		state pushaction.BlueGreenState
+	}{state})
+	fake.recordInvocation("RetireBlueGreenApp", []interface{}{state})
+	fake.retireBlueGreenAppMutex.Unlock()
+	if fake.RetireBlueGreenAppStub != nil {
+		return fake.RetireBlueGreenAppStub(state)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retireBlueGreenAppReturns.result1, fake.retireBlueGreenAppReturns.result2
+}
+
+func (fake *FakeV2PushActor) RetireBlueGreenAppCallCount() int {
+	fake.retireBlueGreenAppMutex.RLock()
+	defer fake.retireBlueGreenAppMutex.RUnlock()
+	return len(fake.retireBlueGreenAppArgsForCall)
+}
+
+func (fake *FakeV2PushActor) RetireBlueGreenAppArgsForCall(i int) pushaction.BlueGreenState {
+	fake.retireBlueGreenAppMutex.RLock()
+	defer fake.retireBlueGreenAppMutex.RUnlock()
+	return fake.retireBlueGreenAppArgsForCall[i].state
+}
+
+func (fake *FakeV2PushActor) RetireBlueGreenAppReturns(result1 pushaction.Warnings, result2 error) {
+	fake.RetireBlueGreenAppStub = nil
+	fake.retireBlueGreenAppReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RetireBlueGreenAppReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.RetireBlueGreenAppStub = nil
+	if fake.retireBlueGreenAppReturnsOnCall == nil {
+		fake.retireBlueGreenAppReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.retireBlueGreenAppReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RollbackBlueGreenPush(state pushaction.BlueGreenState) (pushaction.Warnings, error) {
+	fake.rollbackBlueGreenPushMutex.Lock()
+	ret, specificReturn := fake.rollbackBlueGreenPushReturnsOnCall[len(fake.rollbackBlueGreenPushArgsForCall)]
+	fake.rollbackBlueGreenPushArgsForCall = append(fake.rollbackBlueGreenPushArgsForCall, struct {
+		state pushaction.BlueGreenState
+	}{state})
+	fake.recordInvocation("RollbackBlueGreenPush", []interface{}{state})
+	fake.rollbackBlueGreenPushMutex.Unlock()
+	if fake.RollbackBlueGreenPushStub != nil {
+		return fake.RollbackBlueGreenPushStub(state)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.rollbackBlueGreenPushReturns.result1, fake.rollbackBlueGreenPushReturns.result2
+}
+
+func (fake *FakeV2PushActor) RollbackBlueGreenPushCallCount() int {
+	fake.rollbackBlueGreenPushMutex.RLock()
+	defer fake.rollbackBlueGreenPushMutex.RUnlock()
+	return len(fake.rollbackBlueGreenPushArgsForCall)
+}
+
+func (fake *FakeV2PushActor) RollbackBlueGreenPushArgsForCall(i int) pushaction.BlueGreenState {
+	fake.rollbackBlueGreenPushMutex.RLock()
+	defer fake.rollbackBlueGreenPushMutex.RUnlock()
+	return fake.rollbackBlueGreenPushArgsForCall[i].state
+}
+
+func (fake *FakeV2PushActor) RollbackBlueGreenPushReturns(result1 pushaction.Warnings, result2 error) {
+	fake.RollbackBlueGreenPushStub = nil
+	fake.rollbackBlueGreenPushReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RollbackBlueGreenPushReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.RollbackBlueGreenPushStub = nil
+	if fake.rollbackBlueGreenPushReturnsOnCall == nil {
+		fake.rollbackBlueGreenPushReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.rollbackBlueGreenPushReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RunSmokeTest(state pushaction.BlueGreenState, smokeTest pushaction.SmokeTest) error {
+	fake.runSmokeTestMutex.Lock()
+	ret, specificReturn := fake.runSmokeTestReturnsOnCall[len(fake.runSmokeTestArgsForCall)]
+	fake.runSmokeTestArgsForCall = append(fake.runSmokeTestArgsForCall, struct {
+		state     pushaction.BlueGreenState
+		smokeTest pushaction.SmokeTest
+	}{state, smokeTest})
+	fake.recordInvocation("RunSmokeTest", []interface{}{state, smokeTest})
+	fake.runSmokeTestMutex.Unlock()
+	if fake.RunSmokeTestStub != nil {
+		return fake.RunSmokeTestStub(state, smokeTest)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runSmokeTestReturns.result1
+}
+
+func (fake *FakeV2PushActor) RunSmokeTestCallCount() int {
+	fake.runSmokeTestMutex.RLock()
+	defer fake.runSmokeTestMutex.RUnlock()
+	return len(fake.runSmokeTestArgsForCall)
+}
+
+func (fake *FakeV2PushActor) RunSmokeTestArgsForCall(i int) (pushaction.BlueGreenState, pushaction.SmokeTest) {
+	fake.runSmokeTestMutex.RLock()
+	defer fake.runSmokeTestMutex.RUnlock()
+	return fake.runSmokeTestArgsForCall[i].state, fake.runSmokeTestArgsForCall[i].smokeTest
+}
+
+func (fake *FakeV2PushActor) RunSmokeTestReturns(result1 error) {
+	fake.RunSmokeTestStub = nil
+	fake.runSmokeTestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) RunSmokeTestReturnsOnCall(i int, result1 error) {
+	fake.RunSmokeTestStub = nil
+	if fake.runSmokeTestReturnsOnCall == nil {
+		fake.runSmokeTestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runSmokeTestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) SetMatchedResources(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings) {
+	fake.setMatchedResourcesMutex.Lock()
+	ret, specificReturn := fake.setMatchedResourcesReturnsOnCall[len(fake.setMatchedResourcesArgsForCall)]
+	fake.setMatchedResourcesArgsForCall = append(fake.setMatchedResourcesArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("SetMatchedResources", []interface{}{config})
+	fake.setMatchedResourcesMutex.Unlock()
+	if fake.SetMatchedResourcesStub != nil {
+		return fake.SetMatchedResourcesStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setMatchedResourcesReturns.result1, fake.setMatchedResourcesReturns.result2
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesCallCount() int {
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
+	return len(fake.setMatchedResourcesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
+	return fake.setMatchedResourcesArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesReturns(result1 pushaction.ApplicationConfig, result2 pushaction.Warnings) {
+	fake.SetMatchedResourcesStub = nil
+	fake.setMatchedResourcesReturns = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesReturnsOnCall(i int, result1 pushaction.ApplicationConfig, result2 pushaction.Warnings) {
+	fake.SetMatchedResourcesStub = nil
+	if fake.setMatchedResourcesReturnsOnCall == nil {
+		fake.setMatchedResourcesReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+		})
+	}
+	fake.setMatchedResourcesReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) SwapBlueGreenRoutes(state pushaction.BlueGreenState) (pushaction.BlueGreenState, pushaction.Warnings, error) {
+	fake.swapBlueGreenRoutesMutex.Lock()
+	ret, specificReturn := fake.swapBlueGreenRoutesReturnsOnCall[len(fake.swapBlueGreenRoutesArgsForCall)]
+	fake.swapBlueGreenRoutesArgsForCall = append(fake.swapBlueGreenRoutesArgsForCall, struct {
+		state pushaction.BlueGreenState
+	}{state})
+	fake.recordInvocation("SwapBlueGreenRoutes", []interface{}{state})
+	fake.swapBlueGreenRoutesMutex.Unlock()
+	if fake.SwapBlueGreenRoutesStub != nil {
+		return fake.SwapBlueGreenRoutesStub(state)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.swapBlueGreenRoutesReturns.result1, fake.swapBlueGreenRoutesReturns.result2, fake.swapBlueGreenRoutesReturns.result3
+}
+
+func (fake *FakeV2PushActor) SwapBlueGreenRoutesCallCount() int {
+	fake.swapBlueGreenRoutesMutex.RLock()
+	defer fake.swapBlueGreenRoutesMutex.RUnlock()
+	return len(fake.swapBlueGreenRoutesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) SwapBlueGreenRoutesArgsForCall(i int) pushaction.BlueGreenState {
+	fake.swapBlueGreenRoutesMutex.RLock()
+	defer fake.swapBlueGreenRoutesMutex.RUnlock()
+	return fake.swapBlueGreenRoutesArgsForCall[i].state
+}
+
+func (fake *FakeV2PushActor) SwapBlueGreenRoutesReturns(result1 pushaction.BlueGreenState, result2 pushaction.Warnings, result3 error) {
+	fake.SwapBlueGreenRoutesStub = nil
+	fake.swapBlueGreenRoutesReturns = struct {
+		result1 pushaction.BlueGreenState
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) SwapBlueGreenRoutesReturnsOnCall(i int, result1 pushaction.BlueGreenState, result2 pushaction.Warnings, result3 error) {
+	fake.SwapBlueGreenRoutesStub = nil
+	if fake.swapBlueGreenRoutesReturnsOnCall == nil {
+		fake.swapBlueGreenRoutesReturnsOnCall = make(map[int]struct {
+			result1 pushaction.BlueGreenState
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.swapBlueGreenRoutesReturnsOnCall[i] = struct {
+		result1 pushaction.BlueGreenState
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyMutex.RLock()
 	defer fake.applyMutex.RUnlock()
+	fake.checkQuotaHeadroomMutex.RLock()
+	defer fake.checkQuotaHeadroomMutex.RUnlock()
 	fake.cloudControllerV2APIVersionMutex.RLock()
 	defer fake.cloudControllerV2APIVersionMutex.RUnlock()
 	fake.cloudControllerV3APIVersionMutex.RLock()
 	defer fake.cloudControllerV3APIVersionMutex.RUnlock()
 	fake.convertToApplicationConfigsMutex.RLock()
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.fetchRemoteAppSourceMutex.RLock()
+	defer fake.fetchRemoteAppSourceMutex.RUnlock()
+	fake.loadBlueGreenStateMutex.RLock()
+	defer fake.loadBlueGreenStateMutex.RUnlock()
+	fake.markBlueGreenAppPushedMutex.RLock()
+	defer fake.markBlueGreenAppPushedMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.prepareBlueGreenPushMutex.RLock()
+	defer fake.prepareBlueGreenPushMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
+	fake.retireBlueGreenAppMutex.RLock()
+	defer fake.retireBlueGreenAppMutex.RUnlock()
+	fake.rollbackBlueGreenPushMutex.RLock()
+	defer fake.rollbackBlueGreenPushMutex.RUnlock()
+	fake.runSmokeTestMutex.RLock()
+	defer fake.runSmokeTestMutex.RUnlock()
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
+	fake.swapBlueGreenRoutesMutex.RLock()
+	defer fake.swapBlueGreenRoutesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
@@ -29,7 +30,8 @@ type AppActor interface {
 type AppCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
 	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid]"`
+	Watch           bool         `long:"watch" description:"Refresh the app's health and status until Ctrl-C is pressed, highlighting instances that crashed or restarted"`
+	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid | --watch]"`
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI              command.UI
@@ -63,6 +65,12 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Watch {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--guid", "--watch"},
+		}
+	}
+
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
 	if err != nil {
 		return err
@@ -91,6 +99,10 @@ func (cmd AppCommand) Execute(args []string) error {
 	cmd.UI.DisplayNewline()
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer2(cmd.UI)
+	if cmd.Watch {
+		return cmd.watchAppSummary(appSummaryDisplayer)
+	}
+
 	summary, warnings, err := cmd.AppSummaryActor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -101,6 +113,39 @@ func (cmd AppCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AppCommand) watchAppSummary(appSummaryDisplayer *shared.AppSummaryDisplayer2) error {
+	var previous v3action.ProcessSummaries
+	return command.Watch(cmd.UI, cmd.Config, func(displayWarnings func([]string)) error {
+		summary, warnings, err := cmd.AppSummaryActor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
+		displayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		appSummaryDisplayer.AppDisplay(summary, false)
+
+		changes := summary.ProcessSummaries.InstanceChangesSince(previous)
+		previous = summary.ProcessSummaries
+		if len(changes) > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		for _, change := range changes {
+			templateValues := map[string]interface{}{
+				"ProcessType": change.ProcessType,
+				"Index":       change.Index,
+			}
+			switch change.Type {
+			case v3action.InstanceCrashed:
+				cmd.UI.DisplayTextWithBold("{{.ProcessType}} instance #{{.Index}} crashed", templateValues)
+			case v3action.InstanceRestarted:
+				cmd.UI.DisplayTextWithBold("{{.ProcessType}} instance #{{.Index}} restarted", templateValues)
+			}
+		}
+
+		return nil
+	})
+}
+
 func (cmd AppCommand) displayAppGUID() error {
 	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
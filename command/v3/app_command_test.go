@@ -206,7 +206,7 @@ var _ = Describe("app Command", func() {
 						},
 						CurrentDroplet: v3action.Droplet{
 							Stack: "cflinuxfs2",
-							Buildpacks: []v3action.Buildpack{
+							Buildpacks: []v3action.DropletBuildpack{
 								{
 									Name:         "ruby_buildpack",
 									DetectOutput: "some-detect-output",
@@ -255,4 +255,67 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	Context("when --guid and --watch are both provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			cmd.Watch = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--guid", "--watch"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the --watch flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Watch = true
+
+			summaryWithInstances := func(instances ...v3action.ProcessInstance) v2v3action.ApplicationSummary {
+				return v2v3action.ApplicationSummary{
+					ApplicationSummary: v3action.ApplicationSummary{
+						Application: v3action.Application{Name: "some-app", State: constant.ApplicationStarted},
+						ProcessSummaries: v3action.ProcessSummaries{
+							{
+								Process:         v3action.Process{Type: constant.ProcessTypeWeb},
+								InstanceDetails: instances,
+							},
+						},
+					},
+				}
+			}
+
+			fakeAppSummaryActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(0,
+				summaryWithInstances(
+					v3action.ProcessInstance{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 10},
+					v3action.ProcessInstance{Index: 1, State: constant.ProcessInstanceRunning, Uptime: 10},
+				), v2v3action.Warnings{"warning-1"}, nil)
+			fakeAppSummaryActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(1,
+				summaryWithInstances(
+					v3action.ProcessInstance{Index: 0, State: constant.ProcessInstanceRunning, Uptime: 1},
+					v3action.ProcessInstance{Index: 1, State: constant.ProcessInstanceCrashed},
+				), v2v3action.Warnings{"warning-1"}, nil)
+			fakeAppSummaryActor.GetApplicationSummaryByNameAndSpaceReturnsOnCall(2,
+				v2v3action.ApplicationSummary{}, nil, errors.New("some-error"))
+		})
+
+		It("refreshes the summary and highlights crashed and restarted instances", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(fakeAppSummaryActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(3))
+
+			Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+			Expect(testUI.Out).To(Say("name:\\s+some-app"))
+			Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+			Expect(testUI.Out).To(Say("name:\\s+some-app"))
+			Expect(testUI.Out).To(Say("web instance #0 restarted"))
+			Expect(testUI.Out).To(Say("web instance #1 crashed"))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).ToNot(Say("warning-1"))
+		})
+	})
 })
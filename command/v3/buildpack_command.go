@@ -0,0 +1,94 @@
+package v3
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . BuildpackActor
+
+type BuildpackActor interface {
+	CloudControllerAPIVersion() string
+	GetBuildpackByNameAndStack(buildpackName string, stack string) (v3action.Buildpack, v3action.Warnings, error)
+}
+
+type BuildpackCommand struct {
+	RequiredArgs    flag.BuildpackName `positional-args:"yes"`
+	Stack           string             `short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage           interface{}        `usage:"CF_NAME buildpack BUILDPACK [-s STACK]"`
+	relatedCommands interface{}        `related_commands:"buildpacks, update-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildpackActor
+}
+
+func (cmd *BuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionBuildpacksV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd BuildpackCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpacksV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting info for buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+		"Buildpack": cmd.RequiredArgs.Buildpack,
+		"Username":  user.Name,
+	})
+
+	buildpack, warnings, err := cmd.Actor.GetBuildpackByNameAndStack(cmd.RequiredArgs.Buildpack, cmd.Stack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), buildpack.Name},
+		{cmd.UI.TranslateText("stack:"), buildpack.Stack},
+		{cmd.UI.TranslateText("position:"), strconv.Itoa(buildpack.Position.Value)},
+		{cmd.UI.TranslateText("enabled:"), strconv.FormatBool(buildpack.Enabled.Value)},
+		{cmd.UI.TranslateText("locked:"), strconv.FormatBool(buildpack.Locked.Value)},
+		{cmd.UI.TranslateText("state:"), strings.ToLower(string(buildpack.State))},
+		{cmd.UI.TranslateText("filename:"), buildpack.Filename},
+	}, 3)
+
+	return nil
+}
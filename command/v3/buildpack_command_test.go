@@ -0,0 +1,127 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("buildpack Command", func() {
+	var (
+		cmd             v3.BuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeBuildpackActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeBuildpackActor)
+
+		cmd = v3.BuildpackCommand{
+			RequiredArgs: flag.BuildpackName{Buildpack: "ruby_buildpack"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionBuildpacksV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API is below the minimum version", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.0.0",
+				MinimumVersion: ccversion.MinVersionBuildpacksV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the buildpack exists", func() {
+		BeforeEach(func() {
+			cmd.Stack = "cflinuxfs2"
+			fakeActor.GetBuildpackByNameAndStackReturns(
+				v3action.Buildpack{
+					Name:     "ruby_buildpack",
+					Stack:    "cflinuxfs2",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: true},
+					Locked:   types.NullBool{IsSet: true, Value: true},
+					State:    constant.BuildpackReady,
+					Filename: "ruby_buildpack-v1.zip",
+				},
+				v3action.Warnings{"get-buildpack-warning"},
+				nil)
+		})
+
+		It("displays the buildpack details", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting info for buildpack ruby_buildpack as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`name:\s+ruby_buildpack`))
+			Expect(testUI.Out).To(Say(`stack:\s+cflinuxfs2`))
+			Expect(testUI.Out).To(Say(`position:\s+3`))
+			Expect(testUI.Out).To(Say(`enabled:\s+true`))
+			Expect(testUI.Out).To(Say(`locked:\s+true`))
+			Expect(testUI.Out).To(Say(`state:\s+ready`))
+			Expect(testUI.Out).To(Say(`filename:\s+ruby_buildpack-v1\.zip`))
+			Expect(testUI.Err).To(Say("get-buildpack-warning"))
+
+			name, stack := fakeActor.GetBuildpackByNameAndStackArgsForCall(0)
+			Expect(name).To(Equal("ruby_buildpack"))
+			Expect(stack).To(Equal("cflinuxfs2"))
+		})
+	})
+
+	Context("when the buildpack exists for multiple stacks", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackByNameAndStackReturns(
+				v3action.Buildpack{},
+				v3action.Warnings{"get-buildpack-warning"},
+				actionerror.MultipleBuildpacksFoundError{Name: "ruby_buildpack", Stacks: []string{"cflinuxfs2", "cflinuxfs3"}})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.MultipleBuildpacksFoundError{Name: "ruby_buildpack", Stacks: []string{"cflinuxfs2", "cflinuxfs3"}}))
+			Expect(testUI.Err).To(Say("get-buildpack-warning"))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package v3
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . BuildpacksActor
+
+type BuildpacksActor interface {
+	CloudControllerAPIVersion() string
+	GetBuildpacks(stack string) ([]v3action.Buildpack, v3action.Warnings, error)
+}
+
+type BuildpacksCommand struct {
+	Stack           string      `long:"stack" description:"Only list buildpacks for this stack"`
+	usage           interface{} `usage:"CF_NAME buildpacks [--stack STACK]"`
+	relatedCommands interface{} `related_commands:"buildpack, create-buildpack, push, stacks, update-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildpacksActor
+}
+
+func (cmd *BuildpacksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return cmd.legacyFallback(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--stack'",
+				MinimumVersion: ccversion.MinVersionBuildpacksV3,
+			})
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd BuildpacksCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpacksV3, "Option '--stack'")
+	if err != nil {
+		return cmd.legacyFallback(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Stack != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks for stack {{.Stack}} as {{.Username}}...", map[string]interface{}{
+			"Stack":    cmd.Stack,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+
+	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(cmd.Stack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(buildpacks) == 0 {
+		cmd.UI.DisplayText("No buildpacks found")
+		return nil
+	}
+
+	// The columns of the legacy implementation come first, so that scripts
+	// parsing them keep working.
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("buildpack"),
+			cmd.UI.TranslateText("position"),
+			cmd.UI.TranslateText("enabled"),
+			cmd.UI.TranslateText("locked"),
+			cmd.UI.TranslateText("filename"),
+			cmd.UI.TranslateText("stack"),
+		},
+	}
+
+	for _, buildpack := range buildpacks {
+		table = append(table, []string{
+			buildpack.Name,
+			strconv.Itoa(buildpack.Position.Value),
+			strconv.FormatBool(buildpack.Enabled.Value),
+			strconv.FormatBool(buildpack.Locked.Value),
+			buildpack.Filename,
+			buildpack.Stack,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+// legacyFallback runs the legacy implementation when the targeted API is too
+// old for the V3 buildpacks endpoints, unless --stack requires them.
+func (cmd BuildpacksCommand) legacyFallback(versionErr error) error {
+	if _, ok := versionErr.(translatableerror.MinimumAPIVersionNotMetError); !ok || cmd.Stack != "" {
+		return versionErr
+	}
+	return translatableerror.UnrefactoredCommandError{}
+}
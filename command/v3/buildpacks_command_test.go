@@ -0,0 +1,153 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("buildpacks Command", func() {
+	var (
+		cmd             v3.BuildpacksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeBuildpacksActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeBuildpacksActor)
+
+		cmd = v3.BuildpacksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionBuildpacksV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API is below the minimum version", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("falls back to the legacy implementation", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeActor.GetBuildpacksCallCount()).To(Equal(0))
+		})
+
+		Context("when a stack is provided", func() {
+			BeforeEach(func() {
+				cmd.Stack = "cflinuxfs2"
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--stack'",
+					CurrentVersion: "3.0.0",
+					MinimumVersion: ccversion.MinVersionBuildpacksV3,
+				}))
+			})
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when buildpacks exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpacksReturns(
+				[]v3action.Buildpack{
+					{
+						Name:     "ruby_buildpack",
+						Stack:    "cflinuxfs2",
+						Position: types.NullInt{IsSet: true, Value: 1},
+						Enabled:  types.NullBool{IsSet: true, Value: true},
+						Locked:   types.NullBool{IsSet: true, Value: false},
+						Filename: "ruby_buildpack-v1.zip",
+					},
+					{
+						Name:     "go_buildpack",
+						Position: types.NullInt{IsSet: true, Value: 2},
+						Enabled:  types.NullBool{IsSet: true, Value: false},
+						Locked:   types.NullBool{IsSet: true, Value: true},
+					},
+				},
+				v3action.Warnings{"get-buildpacks-warning"},
+				nil)
+		})
+
+		It("lists the buildpacks in position order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting buildpacks as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`buildpack\s+position\s+enabled\s+locked\s+filename\s+stack`))
+			Expect(testUI.Out).To(Say(`ruby_buildpack\s+1\s+true\s+false\s+ruby_buildpack-v1\.zip\s+cflinuxfs2`))
+			Expect(testUI.Out).To(Say(`go_buildpack\s+2\s+false\s+true`))
+			Expect(testUI.Err).To(Say("get-buildpacks-warning"))
+			Expect(fakeActor.GetBuildpacksArgsForCall(0)).To(BeEmpty())
+		})
+
+		Context("when a stack is provided", func() {
+			BeforeEach(func() {
+				cmd.Stack = "cflinuxfs2"
+			})
+
+			It("lists the buildpacks for the stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Getting buildpacks for stack cflinuxfs2 as admin\.\.\.`))
+				Expect(fakeActor.GetBuildpacksArgsForCall(0)).To(Equal("cflinuxfs2"))
+			})
+		})
+	})
+
+	Context("when no buildpacks exist", func() {
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No buildpacks found"))
+		})
+	})
+
+	Context("when getting the buildpacks fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpacksReturns(nil, v3action.Warnings{"get-buildpacks-warning"}, errors.New("get-buildpacks-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("get-buildpacks-error"))
+			Expect(testUI.Err).To(Say("get-buildpacks-warning"))
+		})
+	})
+})
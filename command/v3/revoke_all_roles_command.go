@@ -0,0 +1,120 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RevokeAllRolesActor
+
+type RevokeAllRolesActor interface {
+	CloudControllerAPIVersion() string
+	GetUserByNameAndOrigin(username string, origin string) (v3action.User, v3action.Warnings, error)
+	RevokeAllRoles(userGUID string) ([]v3action.Role, v3action.Warnings, error)
+}
+
+type RevokeAllRolesCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, required when the username exists in more than one identity provider"`
+	Force           bool          `short:"f" description:"Force revocation without confirmation"`
+	usage           interface{}   `usage:"CF_NAME revoke-all-roles USERNAME [--origin ORIGIN] [-f]\n\n   Removes every org and space role of the user across the foundation. The user account is not deleted."`
+	relatedCommands interface{}   `related_commands:"delete-user, unset-org-role, unset-space-role, user"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RevokeAllRolesActor
+}
+
+func (cmd *RevokeAllRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRolesV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, uaaClient)
+
+	return nil
+}
+
+func (cmd RevokeAllRolesCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRolesV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		revoke, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really revoke all org and space roles of user {{.Username}}?", map[string]interface{}{
+			"Username": cmd.RequiredArgs.Username,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !revoke {
+			cmd.UI.DisplayText("Revoke cancelled")
+			return nil
+		}
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Revoking all roles of user {{.Username}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Username":    cmd.RequiredArgs.Username,
+		"CurrentUser": currentUser.Name,
+	})
+
+	user, warnings, err := cmd.Actor.GetUserByNameAndOrigin(cmd.RequiredArgs.Username, cmd.Origin)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	revoked, warnings, err := cmd.Actor.RevokeAllRoles(user.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if len(revoked) > 0 {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("The following roles were revoked before the error occurred:")
+			displayRolesTable(cmd.UI, revoked)
+			cmd.UI.DisplayNewline()
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(revoked) == 0 {
+		cmd.UI.DisplayText("User {{.Username}} has no roles.", map[string]interface{}{
+			"Username": cmd.RequiredArgs.Username,
+		})
+		return nil
+	}
+
+	displayRolesTable(cmd.UI, revoked)
+	return nil
+}
@@ -0,0 +1,164 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("revoke-all-roles Command", func() {
+	var (
+		cmd             v3.RevokeAllRolesCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRevokeAllRolesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRevokeAllRolesActor)
+
+		cmd = v3.RevokeAllRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-user"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRolesV3)
+		fakeActor.GetUserByNameAndOriginReturns(v3action.User{GUID: "some-user-guid", Username: "some-user"}, v3action.Warnings{"get-user-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not revoke anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really revoke all org and space roles of user some-user\?`))
+				Expect(testUI.Out).To(Say("Revoke cancelled"))
+				Expect(fakeActor.RevokeAllRolesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("revokes the roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RevokeAllRolesCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Origin = "ldap"
+		})
+
+		Context("when the user has roles", func() {
+			BeforeEach(func() {
+				fakeActor.RevokeAllRolesReturns(
+					[]v3action.Role{
+						{Type: constant.RoleSpaceDeveloper, OrganizationName: "org-1", SpaceGUID: "space-guid", SpaceName: "space-1"},
+						{Type: constant.RoleOrganizationUser, OrganizationName: "org-1"},
+					},
+					v3action.Warnings{"revoke-warning"},
+					nil)
+			})
+
+			It("revokes the roles and displays them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Revoking all roles of user some-user as admin\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`org-1\s+space-1\s+space developer`))
+				Expect(testUI.Out).To(Say(`org-1\s+org user`))
+				Expect(testUI.Err).To(Say("get-user-warning"))
+				Expect(testUI.Err).To(Say("revoke-warning"))
+
+				username, origin := fakeActor.GetUserByNameAndOriginArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(origin).To(Equal("ldap"))
+				Expect(fakeActor.RevokeAllRolesArgsForCall(0)).To(Equal("some-user-guid"))
+			})
+		})
+
+		Context("when the user has no roles", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("User some-user has no roles."))
+			})
+		})
+
+		Context("when revoking fails part way", func() {
+			BeforeEach(func() {
+				fakeActor.RevokeAllRolesReturns(
+					[]v3action.Role{{Type: constant.RoleSpaceDeveloper, OrganizationName: "org-1", SpaceGUID: "space-guid", SpaceName: "space-1"}},
+					v3action.Warnings{"revoke-warning"},
+					errors.New("revoke-error"))
+			})
+
+			It("displays the revoked roles and returns the error", func() {
+				Expect(executeErr).To(MatchError("revoke-error"))
+				Expect(testUI.Out).To(Say("The following roles were revoked before the error occurred:"))
+				Expect(testUI.Out).To(Say(`org-1\s+space-1\s+space developer`))
+				Expect(testUI.Err).To(Say("revoke-warning"))
+			})
+		})
+
+		Context("when the user cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetUserByNameAndOriginReturns(v3action.User{}, nil, actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"}))
+				Expect(fakeActor.RevokeAllRolesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
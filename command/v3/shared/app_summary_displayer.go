@@ -191,7 +191,7 @@ func (AppSummaryDisplayer) usageSummary(processSummaries v3action.ProcessSummari
 	return strings.Join(usageStrings, ", ")
 }
 
-func (AppSummaryDisplayer) buildpackNames(buildpacks []v3action.Buildpack) string {
+func (AppSummaryDisplayer) buildpackNames(buildpacks []v3action.DropletBuildpack) string {
 	var names []string
 	for _, buildpack := range buildpacks {
 		if buildpack.DetectOutput != "" {
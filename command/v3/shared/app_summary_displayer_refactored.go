@@ -129,7 +129,7 @@ func (AppSummaryDisplayer2) usageSummary(processSummaries v3action.ProcessSummar
 	return strings.Join(usageStrings, ", ")
 }
 
-func (AppSummaryDisplayer2) buildpackNames(buildpacks []v3action.Buildpack) string {
+func (AppSummaryDisplayer2) buildpackNames(buildpacks []v3action.DropletBuildpack) string {
 	var names []string
 	for _, buildpack := range buildpacks {
 		if buildpack.DetectOutput != "" {
@@ -440,7 +440,7 @@ var _ = Describe("app summary displayer", func() {
 					ApplicationSummary: v3action.ApplicationSummary{
 						CurrentDroplet: v3action.Droplet{
 							Stack: "cflinuxfs2",
-							Buildpacks: []v3action.Buildpack{
+							Buildpacks: []v3action.DropletBuildpack{
 								{
 									Name:         "ruby_buildpack",
 									DetectOutput: "some-detect-output",
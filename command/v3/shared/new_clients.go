@@ -7,6 +7,7 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
@@ -14,6 +15,11 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV3 string) (*ccv3.Client, *uaa.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
 
+	requestLimiter := command.RequestLimiter(config)
+	if requestLimiter != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRateLimiter(requestLimiter))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -28,11 +34,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:               config.BinaryName(),
+		AppVersion:            config.BinaryVersion(),
+		JobPollingTimeout:     config.OverallPollingTimeout(),
+		JobPollingInterval:    config.PollingInterval(),
+		PaginationConcurrency: config.PaginationConcurrency(),
+		Wrappers:              ccWrappers,
 	})
 
 	if !targetCF {
@@ -45,9 +52,15 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv3.TargetSettings{
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = ccClient.TargetCF(ccv3.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		TLSConfig:         tlsConfig,
 		DialTimeout:       config.DialTimeout(),
 	})
 	if err != nil {
@@ -68,8 +81,11 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 		return nil, nil, translatableerror.UAAEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
+	uaaClient := uaa.NewClient(config, tlsConfig)
 
+	if requestLimiter != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRateLimiter(requestLimiter))
+	}
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
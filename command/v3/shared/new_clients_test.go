@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -115,6 +116,18 @@ var _ = Describe("New Clients", func() {
 		})
 	})
 
+	Context("when the CA certificate file cannot be loaded", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://potato.bananapants11122.co.uk")
+			fakeConfig.CACertFileReturns("/some/missing/ca.crt")
+		})
+
+		It("returns a CACertFileError", func() {
+			_, _, err := NewClients(fakeConfig, testUI, true, "")
+			Expect(err).To(BeAssignableToTypeOf(tlsconfig.CACertFileError{}))
+		})
+	})
+
 	Context("when the DialTimeout is set", func() {
 		BeforeEach(func() {
 			if runtime.GOOS == "windows" {
@@ -1,11 +1,17 @@
 package shared
 
 import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking"
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // NewNetworkingClient creates a new cfnetworking client.
@@ -14,8 +20,21 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 		return nil, translatableerror.CFNetworkingEndpointNotFoundError{}
 	}
 
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
 	wrappers := []cfnetv1.ConnectionWrapper{}
 
+	// This must be the first wrapper since it replaces the connection it wraps.
+	if tlsConfig != nil {
+		wrappers = append(wrappers, &tlsConnection{
+			dialTimeout: config.DialTimeout(),
+			tlsConfig:   tlsconfig.WithSkipSSLValidation(tlsConfig, config.SkipSSLValidation()),
+		})
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -38,3 +57,26 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 		Wrappers:          wrappers,
 	}), nil
 }
+
+// tlsConnection replaces the cfnetworking client's default connection, which
+// only supports skipping SSL validation, with one that uses the configured CA
+// certificates and client certificate.
+type tlsConnection struct {
+	connection  cfnetworking.Connection
+	dialTimeout time.Duration
+	tlsConfig   *tls.Config
+}
+
+func (wrapper *tlsConnection) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	return wrapper.connection.Make(request, passedResponse)
+}
+
+func (wrapper *tlsConnection) Wrap(_ cfnetworking.Connection) cfnetworking.Connection {
+	connection := cfnetworking.NewConnection(cfnetworking.Config{
+		DialTimeout: wrapper.dialTimeout,
+	})
+	connection.HTTPClient.Transport.(*http.Transport).TLSClientConfig = wrapper.tlsConfig
+
+	wrapper.connection = cfnetworking.NewErrorWrapper().Wrap(connection)
+	return wrapper
+}
@@ -1,8 +1,14 @@
 package shared_test
 
 import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"os"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -10,6 +16,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("New Clients", func() {
@@ -40,4 +47,58 @@ var _ = Describe("New Clients", func() {
 			Expect(err).To(MatchError("This command requires Network Policy API V1. Your targeted endpoint does not expose it."))
 		})
 	})
+
+	Context("when a CA certificate file is configured", func() {
+		var (
+			server     *Server
+			caCertFile *os.File
+		)
+
+		BeforeEach(func() {
+			server = NewTLSServer()
+
+			var err error
+			caCertFile, err = ioutil.TempFile("", "ca-cert")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pem.Encode(caCertFile, &pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: server.HTTPTestServer.Certificate().Raw,
+			})).To(Succeed())
+			Expect(caCertFile.Close()).To(Succeed())
+
+			fakeConfig.CACertFileReturns(caCertFile.Name())
+		})
+
+		AfterEach(func() {
+			server.Close()
+			Expect(os.Remove(caCertFile.Name())).To(Succeed())
+		})
+
+		It("trusts servers signed by the CA", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/policies"),
+					RespondWith(http.StatusOK, `{"policies": []}`),
+				),
+			)
+
+			client, err := NewNetworkingClient(server.URL(), fakeConfig, fakeUAAClient, testUI)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.ListPolicies()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the file cannot be loaded", func() {
+			BeforeEach(func() {
+				fakeConfig.CACertFileReturns("/some/missing/ca.crt")
+			})
+
+			It("returns a CACertFileError", func() {
+				_, err := NewNetworkingClient(server.URL(), fakeConfig, fakeUAAClient, testUI)
+				Expect(err).To(BeAssignableToTypeOf(tlsconfig.CACertFileError{}))
+			})
+		})
+	})
 })
@@ -1,13 +1,13 @@
 package shared
 
 import (
-	"crypto/tls"
 	"net/http"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -38,12 +38,15 @@ func (p DebugPrinter) Print(title string, dump string) {
 }
 
 // NewNOAAClient returns back a configured NOAA Client.
-func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *consumer.Consumer {
+func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*consumer.Consumer, error) {
+	tlsConfig, err := tlsconfig.New(config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
 	client := consumer.New(
 		apiURL,
-		&tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation(),
-		},
+		tlsconfig.WithSkipSSLValidation(tlsConfig, config.SkipSSLValidation()),
 		http.ProxyFromEnvironment,
 	)
 	client.RefreshTokenFrom(noaabridge.NewTokenRefresher(uaaClient, config))
@@ -63,5 +66,5 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
 	}
 
-	return client
+	return client, nil
 }
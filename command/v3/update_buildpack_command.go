@@ -0,0 +1,263 @@
+package v3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . UpdateBuildpackActor
+
+type UpdateBuildpackActor interface {
+	CloudControllerAPIVersion() string
+	GetBuildpackByNameAndStack(buildpackName string, stack string) (v3action.Buildpack, v3action.Warnings, error)
+	UpdateBuildpack(buildpack v3action.Buildpack) (v3action.Buildpack, v3action.Warnings, error)
+	UploadBuildpack(buildpackGUID string, pathToBuildpackBits string, checksum string, progressBar v3action.ProgressBar) (string, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . UpdateBuildpackBitsActor
+
+type UpdateBuildpackBitsActor interface {
+	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v2action.Downloader) (string, error)
+}
+
+type UpdateBuildpackCommand struct {
+	RequiredArgs    flag.BuildpackName               `positional-args:"yes"`
+	Checksum        string                           `long:"checksum" description:"SHA256 (or SHA1) checksum the buildpack zip file or URL must match before it is uploaded"`
+	Disable         bool                             `long:"disable" description:"Disable the buildpack from being used for staging"`
+	Enable          bool                             `long:"enable" description:"Enable the buildpack to be used for staging"`
+	Order           int                              `short:"i" description:"The order in which the buildpacks are checked during buildpack auto-detection"`
+	Lock            bool                             `long:"lock" description:"Lock the buildpack to prevent updates"`
+	Path            flag.PathWithExistenceCheckOrURL `short:"p" description:"Path to directory or zip file"`
+	Stack           string                           `short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	Unlock          bool                             `long:"unlock" description:"Unlock the buildpack to enable updates"`
+	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH [--checksum CHECKSUM]] [-s STACK] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
+	relatedCommands interface{}                      `related_commands:"buildpack, buildpacks, rename-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateBuildpackActor
+	BitsActor   UpdateBuildpackBitsActor
+	ProgressBar ProgressBar
+}
+
+func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return cmd.legacyFallback(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        cmd.v3OnlyOption(),
+				MinimumVersion: ccversion.MinVersionBuildpacksV3,
+			})
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.BitsActor = v2action.NewActor(ccClientV2, uaaClientV2, config)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd UpdateBuildpackCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpacksV3, cmd.v3OnlyOption())
+	if err != nil {
+		return cmd.legacyFallback(err)
+	}
+
+	err = cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Stack != "" {
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} with stack {{.Stack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": cmd.RequiredArgs.Buildpack,
+			"Stack":     cmd.Stack,
+			"Username":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": cmd.RequiredArgs.Buildpack,
+			"Username":  user.Name,
+		})
+	}
+
+	buildpack, warnings, err := cmd.Actor.GetBuildpackByNameAndStack(cmd.RequiredArgs.Buildpack, cmd.Stack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if update, changed := cmd.buildpackChanges(buildpack.GUID); changed {
+		_, warnings, err = cmd.Actor.UpdateBuildpack(update)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+	cmd.UI.DisplayOK()
+
+	if cmd.Path == "" {
+		return nil
+	}
+	cmd.UI.DisplayNewline()
+
+	return cmd.uploadBits(buildpack.GUID, user.Name)
+}
+
+func (cmd UpdateBuildpackCommand) uploadBits(buildpackGUID string, username string) error {
+	tmpDirPath, err := ioutil.TempDir("", "buildpack-dir-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	downloader := download.NewDownloader(time.Second * 30)
+	pathToBuildpackBits, err := cmd.BitsActor.PrepareBuildpackBits(string(cmd.Path), tmpDirPath, downloader)
+	if err != nil {
+		if httpErr, ok := err.(download.RawHTTPStatusError); ok {
+			return translatableerror.HTTPStatusError{Status: httpErr.Status}
+		}
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Uploading buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+		"Buildpack": cmd.RequiredArgs.Buildpack,
+		"Username":  username,
+	})
+
+	cmd.ProgressBar.Ready()
+	sha, warnings, err := cmd.Actor.UploadBuildpack(buildpackGUID, pathToBuildpackBits, cmd.Checksum, cmd.ProgressBar)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.ProgressBar.Complete()
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Done uploading")
+	cmd.UI.DisplayText("sha256: {{.SHA}}", map[string]interface{}{
+		"SHA": sha,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// buildpackChanges returns the settings to update on the buildpack and
+// whether any of them were requested.
+func (cmd UpdateBuildpackCommand) buildpackChanges(buildpackGUID string) (v3action.Buildpack, bool) {
+	update := v3action.Buildpack{GUID: buildpackGUID}
+	changed := false
+
+	if cmd.Order != 0 {
+		update.Position = types.NullInt{IsSet: true, Value: cmd.Order}
+		changed = true
+	}
+	if cmd.Enable || cmd.Disable {
+		update.Enabled = types.NullBool{IsSet: true, Value: cmd.Enable}
+		changed = true
+	}
+	if cmd.Lock || cmd.Unlock {
+		update.Locked = types.NullBool{IsSet: true, Value: cmd.Lock}
+		changed = true
+	}
+
+	return update, changed
+}
+
+func (cmd UpdateBuildpackCommand) validateFlags() error {
+	switch {
+	case cmd.Enable && cmd.Disable:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--enable", "--disable"},
+		}
+	case cmd.Lock && cmd.Unlock:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--lock", "--unlock"},
+		}
+	case cmd.Path != "" && cmd.Lock:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"-p", "--lock"},
+		}
+	case cmd.Path != "" && cmd.Unlock:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"-p", "--unlock"},
+		}
+	case cmd.Checksum != "" && cmd.Path == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--checksum",
+			Arg2: "-p",
+		}
+	case cmd.Checksum != "" && isDirectory(string(cmd.Path)):
+		// A directory is zipped before it is uploaded, so there is no
+		// archive whose checksum the user could know in advance.
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--checksum", "-p DIRECTORY"},
+		}
+	}
+	return nil
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// v3OnlyOption returns the first provided option that the legacy
+// implementation does not support.
+func (cmd UpdateBuildpackCommand) v3OnlyOption() string {
+	switch {
+	case cmd.Stack != "":
+		return "Option '-s'"
+	case cmd.Checksum != "":
+		return "Option '--checksum'"
+	}
+	return ""
+}
+
+// legacyFallback runs the legacy implementation when the targeted API is too
+// old for the V3 buildpacks endpoints, unless a V3 only option was provided.
+func (cmd UpdateBuildpackCommand) legacyFallback(versionErr error) error {
+	if _, ok := versionErr.(translatableerror.MinimumAPIVersionNotMetError); !ok || cmd.v3OnlyOption() != "" {
+		return versionErr
+	}
+	return translatableerror.UnrefactoredCommandError{}
+}
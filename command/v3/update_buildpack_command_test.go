@@ -0,0 +1,255 @@
+package v3_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-buildpack Command", func() {
+	var (
+		cmd             v3.UpdateBuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUpdateBuildpackActor
+		fakeBitsActor   *v3fakes.FakeUpdateBuildpackBitsActor
+		fakeProgressBar *v3fakes.FakeProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUpdateBuildpackActor)
+		fakeBitsActor = new(v3fakes.FakeUpdateBuildpackBitsActor)
+		fakeProgressBar = new(v3fakes.FakeProgressBar)
+
+		cmd = v3.UpdateBuildpackCommand{
+			RequiredArgs: flag.BuildpackName{Buildpack: "ruby_buildpack"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			BitsActor:    fakeBitsActor,
+			ProgressBar:  fakeProgressBar,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionBuildpacksV3)
+		fakeActor.GetBuildpackByNameAndStackReturns(
+			v3action.Buildpack{GUID: "buildpack-guid", Name: "ruby_buildpack"},
+			v3action.Warnings{"get-buildpack-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API is below the minimum version", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("falls back to the legacy implementation", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+
+		Context("when a stack is provided", func() {
+			BeforeEach(func() {
+				cmd.Stack = "cflinuxfs2"
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '-s'",
+					CurrentVersion: "3.0.0",
+					MinimumVersion: ccversion.MinVersionBuildpacksV3,
+				}))
+			})
+		})
+
+		Context("when a checksum is provided", func() {
+			BeforeEach(func() {
+				cmd.Path = "some-path"
+				cmd.Checksum = "some-checksum"
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--checksum'",
+					CurrentVersion: "3.0.0",
+					MinimumVersion: ccversion.MinVersionBuildpacksV3,
+				}))
+			})
+		})
+	})
+
+	DescribeTable("invalid flag combinations",
+		func(setup func(), expectedErr error) {
+			setup()
+			Expect(cmd.Execute(nil)).To(MatchError(expectedErr))
+		},
+		Entry("--enable and --disable",
+			func() { cmd.Enable, cmd.Disable = true, true },
+			translatableerror.ArgumentCombinationError{Args: []string{"--enable", "--disable"}}),
+		Entry("--lock and --unlock",
+			func() { cmd.Lock, cmd.Unlock = true, true },
+			translatableerror.ArgumentCombinationError{Args: []string{"--lock", "--unlock"}}),
+		Entry("-p and --lock",
+			func() { cmd.Path, cmd.Lock = "some-path", true },
+			translatableerror.ArgumentCombinationError{Args: []string{"-p", "--lock"}}),
+		Entry("-p and --unlock",
+			func() { cmd.Path, cmd.Unlock = "some-path", true },
+			translatableerror.ArgumentCombinationError{Args: []string{"-p", "--unlock"}}),
+		Entry("--checksum without -p",
+			func() { cmd.Checksum = "some-checksum" },
+			translatableerror.RequiredFlagsError{Arg1: "--checksum", Arg2: "-p"}),
+		Entry("--checksum with a directory -p",
+			func() { cmd.Path, cmd.Checksum = flag.PathWithExistenceCheckOrURL(os.TempDir()), "some-checksum" },
+			translatableerror.ArgumentCombinationError{Args: []string{"--checksum", "-p DIRECTORY"}}),
+	)
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the buildpack cannot be found", func() {
+		BeforeEach(func() {
+			cmd.Stack = "cflinuxfs2"
+			fakeActor.GetBuildpackByNameAndStackReturns(
+				v3action.Buildpack{},
+				v3action.Warnings{"get-buildpack-warning"},
+				actionerror.BuildpackNotFoundError{Name: "ruby_buildpack", Stack: "cflinuxfs2"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{Name: "ruby_buildpack", Stack: "cflinuxfs2"}))
+			Expect(testUI.Out).To(Say(`Updating buildpack ruby_buildpack with stack cflinuxfs2 as admin\.\.\.`))
+			Expect(testUI.Err).To(Say("get-buildpack-warning"))
+		})
+	})
+
+	Context("when only settings are provided", func() {
+		BeforeEach(func() {
+			cmd.Order = 3
+			cmd.Disable = true
+			cmd.Lock = true
+			fakeActor.UpdateBuildpackReturns(v3action.Buildpack{}, v3action.Warnings{"update-buildpack-warning"}, nil)
+		})
+
+		It("updates the settings without uploading bits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Updating buildpack ruby_buildpack as admin\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-buildpack-warning"))
+			Expect(testUI.Err).To(Say("update-buildpack-warning"))
+
+			Expect(fakeActor.UpdateBuildpackArgsForCall(0)).To(Equal(v3action.Buildpack{
+				GUID:     "buildpack-guid",
+				Position: types.NullInt{IsSet: true, Value: 3},
+				Enabled:  types.NullBool{IsSet: true, Value: false},
+				Locked:   types.NullBool{IsSet: true, Value: true},
+			}))
+			Expect(fakeBitsActor.PrepareBuildpackBitsCallCount()).To(Equal(0))
+			Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+		})
+
+		Context("when updating the buildpack fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateBuildpackReturns(v3action.Buildpack{}, v3action.Warnings{"update-buildpack-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-buildpack-warning"))
+			})
+		})
+	})
+
+	Context("when no settings are provided", func() {
+		It("does not update the buildpack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.UpdateBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a path is provided", func() {
+		BeforeEach(func() {
+			cmd.Path = "some-path"
+			cmd.Checksum = "some-checksum"
+			fakeBitsActor.PrepareBuildpackBitsReturns("some-path.zip", nil)
+			fakeActor.UploadBuildpackReturns("some-sha", v3action.Warnings{"upload-buildpack-warning"}, nil)
+		})
+
+		It("uploads the bits with a progress bar and displays the SHA256", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Uploading buildpack ruby_buildpack as admin\.\.\.`))
+			Expect(testUI.Out).To(Say("Done uploading"))
+			Expect(testUI.Out).To(Say("sha256: some-sha"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("upload-buildpack-warning"))
+
+			inputPath, _, _ := fakeBitsActor.PrepareBuildpackBitsArgsForCall(0)
+			Expect(inputPath).To(Equal("some-path"))
+
+			guid, path, checksum, progressBar := fakeActor.UploadBuildpackArgsForCall(0)
+			Expect(guid).To(Equal("buildpack-guid"))
+			Expect(path).To(Equal("some-path.zip"))
+			Expect(checksum).To(Equal("some-checksum"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+
+			Expect(fakeProgressBar.ReadyCallCount()).To(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+
+		Context("when preparing the bits fails", func() {
+			BeforeEach(func() {
+				fakeBitsActor.PrepareBuildpackBitsReturns("", errors.New("prepare-error"))
+			})
+
+			It("returns the error without uploading", func() {
+				Expect(executeErr).To(MatchError("prepare-error"))
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the upload fails", func() {
+			BeforeEach(func() {
+				fakeActor.UploadBuildpackReturns("", v3action.Warnings{"upload-buildpack-warning"}, actionerror.ChecksumMismatchError{Source: "some-path.zip"})
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ChecksumMismatchError{Source: "some-path.zip"}))
+				Expect(testUI.Err).To(Say("upload-buildpack-warning"))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,148 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UserActor
+
+type UserActor interface {
+	CloudControllerAPIVersion() string
+	GetUserByNameAndOrigin(username string, origin string) (v3action.User, v3action.Warnings, error)
+	GetUserRoles(userGUID string) ([]v3action.Role, v3action.Warnings, error)
+}
+
+type UserCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, required when the username exists in more than one identity provider"`
+	usage           interface{}   `usage:"CF_NAME user USERNAME [--origin ORIGIN]"`
+	relatedCommands interface{}   `related_commands:"org-users, revoke-all-roles, space-users, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UserActor
+}
+
+func (cmd *UserCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRolesV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, uaaClient)
+
+	return nil
+}
+
+func (cmd UserCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRolesV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting roles of user {{.Username}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Username":    cmd.RequiredArgs.Username,
+		"CurrentUser": currentUser.Name,
+	})
+
+	user, warnings, err := cmd.Actor.GetUserByNameAndOrigin(cmd.RequiredArgs.Username, cmd.Origin)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	roles, warnings, err := cmd.Actor.GetUserRoles(user.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("username:"), user.Username},
+		{cmd.UI.TranslateText("origin:"), user.Origin},
+		{cmd.UI.TranslateText("guid:"), user.GUID},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(roles) == 0 {
+		cmd.UI.DisplayText("No roles found.")
+		return nil
+	}
+
+	displayRolesTable(cmd.UI, roles)
+	return nil
+}
+
+// displayRolesTable displays one row per role with its organization and
+// space.
+func displayRolesTable(display command.UI, roles []v3action.Role) {
+	table := [][]string{
+		{
+			display.TranslateText("org"),
+			display.TranslateText("space"),
+			display.TranslateText("role"),
+		},
+	}
+
+	for _, role := range roles {
+		table = append(table, []string{
+			role.OrganizationName,
+			role.SpaceName,
+			display.TranslateText(roleTypeDisplayName(role.Type)),
+		})
+	}
+
+	display.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// roleTypeDisplayName returns the human readable name of the role type.
+func roleTypeDisplayName(roleType constant.RoleType) string {
+	switch roleType {
+	case constant.RoleOrganizationUser:
+		return "org user"
+	case constant.RoleOrganizationManager:
+		return "org manager"
+	case constant.RoleOrganizationBillingManager:
+		return "billing manager"
+	case constant.RoleOrganizationAuditor:
+		return "org auditor"
+	case constant.RoleSpaceManager:
+		return "space manager"
+	case constant.RoleSpaceDeveloper:
+		return "space developer"
+	case constant.RoleSpaceAuditor:
+		return "space auditor"
+	default:
+		return string(roleType)
+	}
+}
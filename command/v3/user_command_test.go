@@ -0,0 +1,149 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user Command", func() {
+	var (
+		cmd             v3.UserCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUserActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUserActor)
+
+		cmd = v3.UserCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-user"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRolesV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.0.0",
+				MinimumVersion: ccversion.MinVersionRolesV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user cannot be found", func() {
+		BeforeEach(func() {
+			cmd.Origin = "ldap"
+			fakeActor.GetUserByNameAndOriginReturns(v3action.User{}, v3action.Warnings{"get-user-warning"}, actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.UserNotFoundError{Username: "some-user", Origin: "ldap"}))
+			Expect(testUI.Err).To(Say("get-user-warning"))
+
+			username, origin := fakeActor.GetUserByNameAndOriginArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(Equal("ldap"))
+		})
+	})
+
+	Context("when the user exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetUserByNameAndOriginReturns(v3action.User{GUID: "some-user-guid", Username: "some-user", Origin: "uaa"}, v3action.Warnings{"get-user-warning"}, nil)
+			fakeActor.GetUserRolesReturns(
+				[]v3action.Role{
+					{Type: constant.RoleOrganizationManager, OrganizationName: "org-1"},
+					{Type: constant.RoleSpaceDeveloper, OrganizationName: "org-1", SpaceGUID: "space-guid", SpaceName: "space-1"},
+				},
+				v3action.Warnings{"get-roles-warning"},
+				nil)
+		})
+
+		It("displays the user and their role matrix", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting roles of user some-user as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`username:\s+some-user`))
+			Expect(testUI.Out).To(Say(`origin:\s+uaa`))
+			Expect(testUI.Out).To(Say(`guid:\s+some-user-guid`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+role`))
+			Expect(testUI.Out).To(Say(`org-1\s+org manager`))
+			Expect(testUI.Out).To(Say(`org-1\s+space-1\s+space developer`))
+
+			Expect(testUI.Err).To(Say("get-user-warning"))
+			Expect(testUI.Err).To(Say("get-roles-warning"))
+			Expect(fakeActor.GetUserRolesArgsForCall(0)).To(Equal("some-user-guid"))
+		})
+
+		Context("when the user has no roles", func() {
+			BeforeEach(func() {
+				fakeActor.GetUserRolesReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No roles found."))
+			})
+		})
+
+		Context("when getting the roles fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetUserRolesReturns(nil, v3action.Warnings{"get-roles-warning"}, errors.New("get-roles-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get-roles-error"))
+				Expect(testUI.Err).To(Say("get-roles-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UsersActor
+
+type UsersActor interface {
+	CloudControllerAPIVersion() string
+	GetUsersWithRoles(search string) ([]v3action.UserWithRoles, v3action.Warnings, error)
+}
+
+type UsersCommand struct {
+	Search          string      `long:"search" description:"Only list users whose username contains this text"`
+	usage           interface{} `usage:"CF_NAME users [--search TEXT]"`
+	relatedCommands interface{} `related_commands:"org-users, space-users, user"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsersActor
+}
+
+func (cmd *UsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRolesV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd UsersCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRolesV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Search != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting users matching {{.Search}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Search":      cmd.Search,
+			"CurrentUser": currentUser.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting users as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": currentUser.Name,
+		})
+	}
+
+	users, warnings, err := cmd.Actor.GetUsersWithRoles(cmd.Search)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(users) == 0 {
+		cmd.UI.DisplayText("No users found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("username"),
+			cmd.UI.TranslateText("origin"),
+			cmd.UI.TranslateText("roles"),
+		},
+	}
+
+	for _, user := range users {
+		var roles []string
+		for _, role := range user.Roles {
+			target := role.OrganizationName
+			if role.IsSpaceRole() {
+				target = fmt.Sprintf("%s/%s", role.OrganizationName, role.SpaceName)
+			}
+			roles = append(roles, fmt.Sprintf("%s (%s)", target, cmd.UI.TranslateText(roleTypeDisplayName(role.Type))))
+		}
+
+		username := user.Username
+		if username == "" {
+			username = user.PresentationName
+		}
+
+		table = append(table, []string{
+			username,
+			user.Origin,
+			strings.Join(roles, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
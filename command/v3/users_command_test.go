@@ -0,0 +1,123 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("users Command", func() {
+	var (
+		cmd             v3.UsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUsersActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUsersActor)
+
+		cmd = v3.UsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRolesV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when users exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetUsersWithRolesReturns(
+				[]v3action.UserWithRoles{
+					{
+						User: v3action.User{Username: "user-1", Origin: "uaa"},
+						Roles: []v3action.Role{
+							{Type: constant.RoleOrganizationManager, OrganizationName: "org-1"},
+							{Type: constant.RoleSpaceAuditor, OrganizationName: "org-1", SpaceGUID: "space-guid", SpaceName: "space-1"},
+						},
+					},
+					{
+						User: v3action.User{PresentationName: "some-client", Origin: "uaa"},
+					},
+				},
+				v3action.Warnings{"get-users-warning"},
+				nil)
+		})
+
+		It("lists the users with their roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting users as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`username\s+origin\s+roles`))
+			Expect(testUI.Out).To(Say(`user-1\s+uaa\s+org-1 \(org manager\), org-1/space-1 \(space auditor\)`))
+			Expect(testUI.Out).To(Say(`some-client\s+uaa`))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+			Expect(fakeActor.GetUsersWithRolesArgsForCall(0)).To(BeEmpty())
+		})
+
+		Context("when a search is provided", func() {
+			BeforeEach(func() {
+				cmd.Search = "user"
+			})
+
+			It("passes the search to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Getting users matching user as admin\.\.\.`))
+				Expect(fakeActor.GetUsersWithRolesArgsForCall(0)).To(Equal("user"))
+			})
+		})
+	})
+
+	Context("when no users are found", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No users found."))
+		})
+	})
+
+	Context("when getting the users fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUsersWithRolesReturns(nil, v3action.Warnings{"get-users-warning"}, errors.New("get-users-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-users-error"))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+		})
+	})
+})
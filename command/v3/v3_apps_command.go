@@ -46,12 +46,18 @@ func (cmd *V3AppsCommand) Setup(config command.Config, ui command.UI) error {
 
 		return err
 	}
+	if responseCache := command.NewResponseCache(config); responseCache != nil {
+		ccClient.WrapConnection(responseCache)
+	}
 	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
 		return err
 	}
+	if responseCache := command.NewResponseCache(config); responseCache != nil {
+		ccClientV2.WrapConnection(responseCache)
+	}
 
 	cmd.V2AppActor = v2action.NewActor(ccClientV2, uaaClientV2, config)
 
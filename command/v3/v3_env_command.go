@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . V3EnvActor
@@ -20,12 +21,15 @@ import (
 type V3EnvActor interface {
 	CloudControllerAPIVersion() string
 	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.EnvironmentVariableGroups, v3action.Warnings, error)
+	WriteEnvironmentVariablesFile(path string, envVars map[string]interface{}) error
 }
 
 type V3EnvCommand struct {
 	RequiredArgs    flag.EnvironmentArgs `positional-args:"yes"`
-	usage           interface{}          `usage:"CF_NAME v3-env APP_NAME"`
-	relatedCommands interface{}          `related_commands:"v3-app, v3-apps, v3-set-env, v3-unset-env, running-environment-variable-group, staging-environment-variable-group"`
+	Output          flag.Path            `long:"output" description:"Write the user-provided env variables to a file instead of displaying them. Files with a .json extension are written as JSON, all other files as dotenv files"`
+	Unmask          bool                 `long:"unmask" description:"Display the values of secret looking env variables, which are hidden by default"`
+	usage           interface{}          `usage:"CF_NAME v3-env APP_NAME [--unmask] [--output FILE]"`
+	relatedCommands interface{}          `related_commands:"v3-app, v3-apps, v3-set-env, v3-unset-env, v3-env-group, running-environment-variable-group, staging-environment-variable-group"`
 
 	UI          command.UI
 	Config      command.Config
@@ -70,13 +74,23 @@ func (cmd V3EnvCommand) Execute(args []string) error {
 	}
 
 	appName := cmd.RequiredArgs.AppName
-	cmd.UI.DisplayTextWithFlavor("Getting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   appName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if cmd.Output != "" {
+		cmd.UI.DisplayTextWithFlavor("Writing user-provided env variables of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} to {{.Path}}...", map[string]interface{}{
+			"AppName":   appName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+			"Path":      cmd.Output.String(),
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   appName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(
 		appName,
@@ -87,6 +101,24 @@ func (cmd V3EnvCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Output != "" {
+		err = cmd.Actor.WriteEnvironmentVariablesFile(cmd.Output.String(), envGroups.EnvironmentVariables)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if !cmd.Unmask {
+		envGroups.System = ui.RedactEnvironmentVariables(envGroups.System)
+		envGroups.Application = ui.RedactEnvironmentVariables(envGroups.Application)
+		envGroups.EnvironmentVariables = ui.RedactEnvironmentVariables(envGroups.EnvironmentVariables)
+		envGroups.Running = ui.RedactEnvironmentVariables(envGroups.Running)
+		envGroups.Staging = ui.RedactEnvironmentVariables(envGroups.Staging)
+	}
+
 	if len(envGroups.System) > 0 || len(envGroups.Application) > 0 {
 		cmd.UI.DisplayHeader("System-Provided:")
 		cmd.displayEnvGroup(envGroups.System)
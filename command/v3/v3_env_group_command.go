@@ -0,0 +1,113 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . V3EnvGroupActor
+
+type V3EnvGroupActor interface {
+	CloudControllerAPIVersion() string
+	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) ([]v3action.EnvironmentVariablePair, v3action.Warnings, error)
+}
+
+type V3EnvGroupCommand struct {
+	RequiredArgs    flag.EnvironmentVariableGroupArgs `positional-args:"yes"`
+	Unmask          bool                              `long:"unmask" description:"Display the values of secret looking env variables, which are hidden by default"`
+	usage           interface{}                       `usage:"CF_NAME v3-env-group GROUP [--unmask]\n\n   GROUP is either running or staging."`
+	relatedCommands interface{}                       `related_commands:"v3-env, v3-set-env-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3EnvGroupActor
+}
+
+func (cmd *V3EnvGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionEnvironmentVariableGroupsV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd V3EnvGroupCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionEnvironmentVariableGroupsV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	group := cmd.RequiredArgs.Group.Group
+	cmd.UI.DisplayTextWithFlavor("Getting the {{.Group}} environment variable group as {{.Username}}...", map[string]interface{}{
+		"Group":    group,
+		"Username": user.Name,
+	})
+
+	envPairs, warnings, err := cmd.Actor.GetEnvironmentVariableGroup(constant.EnvironmentVariableGroupName(group))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(envPairs) == 0 {
+		cmd.UI.DisplayText("No {{.Group}} env variables have been set", map[string]interface{}{
+			"Group": group,
+		})
+		return nil
+	}
+
+	values := map[string]interface{}{}
+	for _, envPair := range envPairs {
+		values[envPair.Key] = envPair.Value
+	}
+	if !cmd.Unmask {
+		values = ui.RedactEnvironmentVariables(values)
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("variable name"),
+			cmd.UI.TranslateText("assigned value"),
+		},
+	}
+	for _, envPair := range envPairs {
+		table = append(table, []string{envPair.Key, values[envPair.Key].(string)})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,141 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-env-group Command", func() {
+	var (
+		cmd             v3.V3EnvGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3EnvGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3EnvGroupActor)
+
+		cmd = v3.V3EnvGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Group.Group = "running"
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionEnvironmentVariableGroupsV3)
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionEnvironmentVariableGroupsV3,
+			}))
+		})
+
+		It("displays the experimental warning", func() {
+			Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the group has variables", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariableGroupReturns([]v3action.EnvironmentVariablePair{
+				{Key: "API_TOKEN", Value: "hunter2"},
+				{Key: "HTTP_PROXY", Value: "http://proxy.example.com"},
+			}, v3action.Warnings{"group-warning"}, nil)
+		})
+
+		It("displays the variables, hiding secret looking values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting the running environment variable group as banana\\.\\.\\."))
+			Expect(testUI.Out).To(Say("variable name\\s+assigned value"))
+			Expect(testUI.Out).To(Say("API_TOKEN\\s+\\[PRIVATE DATA HIDDEN\\]"))
+			Expect(testUI.Out).To(Say("HTTP_PROXY\\s+http://proxy\\.example\\.com"))
+			Expect(testUI.Err).To(Say("group-warning"))
+
+			Expect(fakeActor.GetEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.RunningEnvironmentVariableGroup))
+		})
+
+		Context("when --unmask is provided", func() {
+			BeforeEach(func() {
+				cmd.Unmask = true
+			})
+
+			It("displays the secret looking values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("API_TOKEN\\s+hunter2"))
+			})
+		})
+	})
+
+	Context("when the group has no variables", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Group.Group = "staging"
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No staging env variables have been set"))
+			Expect(fakeActor.GetEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.StagingEnvironmentVariableGroup))
+		})
+	})
+
+	Context("when getting the group fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariableGroupReturns(nil, v3action.Warnings{"group-warning"}, errors.New("group-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("group-error"))
+			Expect(testUI.Err).To(Say("group-warning"))
+		})
+	})
+})
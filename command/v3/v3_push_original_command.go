@@ -68,7 +68,10 @@ func (cmd *V3PushCommand) OriginalSetup(config command.Config, ui command.UI) er
 	cmd.OriginalV2PushActor = pushaction.NewActor(v2Actor, v3actor, sharedActor)
 
 	v2AppActor := v2action.NewActor(ccClientV2, uaaClientV2, config)
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	cmd.AppSummaryDisplayer = shared.AppSummaryDisplayer{
 		UI:         cmd.UI,
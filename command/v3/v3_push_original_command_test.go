@@ -700,7 +700,7 @@ var _ = Describe("v3-push Command", func() {
 													},
 													CurrentDroplet: v3action.Droplet{
 														Stack: "cflinuxfs2",
-														Buildpacks: []v3action.Buildpack{
+														Buildpacks: []v3action.DropletBuildpack{
 															{
 																Name:         "ruby_buildpack",
 																DetectOutput: "some-detect-output",
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -23,12 +24,19 @@ type V3ScaleActor interface {
 
 	CloudControllerAPIVersion() string
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessByTypeAndApplication(processType string, appGUID string) (v3action.Process, v3action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
 }
 
+//go:generate counterfeiter . V3ScaleQuotaActor
+
+type V3ScaleQuotaActor interface {
+	CheckQuotaHeadroom(orgGUID string, spaceGUID string, requests []v2action.QuotaRequest) (v2action.Warnings, error)
+}
+
 type V3ScaleCommand struct {
 	RequiredArgs        flag.AppName   `positional-args:"yes"`
 	Force               bool           `short:"f" description:"Force restart of app without prompt"`
@@ -36,13 +44,15 @@ type V3ScaleCommand struct {
 	DiskLimit           flag.Megabytes `short:"k" required:"false" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	MemoryLimit         flag.Megabytes `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	ProcessType         string         `long:"process" default:"web" description:"App process to scale"`
-	usage               interface{}    `usage:"CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY]"`
+	SkipQuotaCheck      bool           `long:"skip-quota-check" description:"Do not check that the org and space quotas have room for the new instances or memory before scaling"`
+	usage               interface{}    `usage:"CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [--skip-quota-check]"`
 	relatedCommands     interface{}    `related_commands:"v3-push"`
 	envCFStartupTimeout interface{}    `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI                  command.UI
 	Config              command.Config
 	Actor               V3ScaleActor
+	QuotaActor          V3ScaleQuotaActor
 	SharedActor         command.SharedActor
 	AppSummaryDisplayer shared.AppSummaryDisplayer
 }
@@ -67,6 +77,7 @@ func (cmd *V3ScaleCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
+	cmd.QuotaActor = v2Actor
 
 	cmd.AppSummaryDisplayer = shared.AppSummaryDisplayer{
 		UI:         ui,
@@ -107,7 +118,7 @@ func (cmd V3ScaleCommand) Execute(args []string) error {
 		return cmd.showCurrentScale(user.Name)
 	}
 
-	scalled, err := cmd.scaleProcess(app.GUID, user.Name)
+	scalled, err := cmd.scaleProcess(app, user.Name)
 	if err != nil {
 		return err
 	}
@@ -145,7 +156,7 @@ func (cmd V3ScaleCommand) Execute(args []string) error {
 	return cmd.showCurrentScale(user.Name)
 }
 
-func (cmd V3ScaleCommand) scaleProcess(appGUID string, username string) (bool, error) {
+func (cmd V3ScaleCommand) scaleProcess(app v3action.Application, username string) (bool, error) {
 	cmd.UI.DisplayTextWithFlavor("Scaling app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -154,6 +165,13 @@ func (cmd V3ScaleCommand) scaleProcess(appGUID string, username string) (bool, e
 	})
 	cmd.UI.DisplayNewline()
 
+	if app.Started() && !cmd.SkipQuotaCheck && (cmd.Instances.IsSet || cmd.MemoryLimit.IsSet) {
+		err := cmd.checkQuotaHeadroom(app.GUID)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	shouldRestart := cmd.DiskLimit.IsSet || cmd.MemoryLimit.IsSet
 	if shouldRestart && !cmd.Force {
 		shouldScale, err := cmd.UI.DisplayBoolPrompt(
@@ -171,7 +189,7 @@ func (cmd V3ScaleCommand) scaleProcess(appGUID string, username string) (bool, e
 		cmd.UI.DisplayNewline()
 	}
 
-	warnings, err := cmd.Actor.ScaleProcessByApplication(appGUID, v3action.Process{
+	warnings, err := cmd.Actor.ScaleProcessByApplication(app.GUID, v3action.Process{
 		Type:       cmd.ProcessType,
 		Instances:  cmd.Instances.NullInt,
 		MemoryInMB: cmd.MemoryLimit.NullUint64,
@@ -183,7 +201,7 @@ func (cmd V3ScaleCommand) scaleProcess(appGUID string, username string) (bool, e
 	}
 
 	if shouldRestart {
-		err := cmd.restartApplication(appGUID, username)
+		err := cmd.restartApplication(app.GUID, username)
 		if err != nil {
 			return false, err
 		}
@@ -192,6 +210,36 @@ func (cmd V3ScaleCommand) scaleProcess(appGUID string, username string) (bool, e
 	return true, nil
 }
 
+// checkQuotaHeadroom fails before the process is scaled when its new instance
+// count and memory would exceed the org or space quota. The v2 API reports an
+// app's web process as the app itself, so only the web process's current
+// allocation is replaced by the new one.
+func (cmd V3ScaleCommand) checkQuotaHeadroom(appGUID string) error {
+	process, warnings, err := cmd.Actor.GetProcessByTypeAndApplication(cmd.ProcessType, appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	request := v2action.QuotaRequest{
+		Instances:  process.Instances.Value,
+		MemoryInMB: process.MemoryInMB.Value,
+	}
+	if cmd.Instances.IsSet {
+		request.Instances = cmd.Instances.Value
+	}
+	if cmd.MemoryLimit.IsSet {
+		request.MemoryInMB = cmd.MemoryLimit.Value
+	}
+	if cmd.ProcessType == constant.ProcessTypeWeb {
+		request.ApplicationGUID = appGUID
+	}
+
+	quotaWarnings, err := cmd.QuotaActor.CheckQuotaHeadroom(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, []v2action.QuotaRequest{request})
+	cmd.UI.DisplayWarnings(quotaWarnings)
+	return err
+}
+
 func (cmd V3ScaleCommand) restartApplication(appGUID string, username string) error {
 	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
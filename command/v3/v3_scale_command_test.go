@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
@@ -33,6 +34,7 @@ var _ = Describe("v3-scale Command", func() {
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeV3ScaleActor
 		fakeV2Actor     *sharedfakes.FakeV2AppActor
+		fakeQuotaActor  *v3fakes.FakeV3ScaleQuotaActor
 		appName         string
 		binaryName      string
 		executeErr      error
@@ -46,6 +48,7 @@ var _ = Describe("v3-scale Command", func() {
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3ScaleActor)
 		fakeV2Actor = new(sharedfakes.FakeV2AppActor)
+		fakeQuotaActor = new(v3fakes.FakeV3ScaleQuotaActor)
 		appName = "some-app"
 
 		cmd = v3.V3ScaleCommand{
@@ -53,6 +56,7 @@ var _ = Describe("v3-scale Command", func() {
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			QuotaActor:  fakeQuotaActor,
 			AppSummaryDisplayer: shared.AppSummaryDisplayer{
 				UI:         testUI,
 				Config:     fakeConfig,
@@ -697,6 +701,115 @@ var _ = Describe("v3-scale Command", func() {
 				})
 			})
 
+			Context("when the application is started", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted},
+						v3action.Warnings{"get-app-warning"},
+						nil)
+					fakeActor.GetProcessByTypeAndApplicationReturns(
+						v3action.Process{
+							Type:       constant.ProcessTypeWeb,
+							Instances:  types.NullInt{Value: 2, IsSet: true},
+							MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+						},
+						v3action.Warnings{"get-process-warning"},
+						nil)
+					fakeQuotaActor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, nil)
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(appSummary, nil, nil)
+
+					cmd.Instances.Value = 3
+					cmd.Instances.IsSet = true
+				})
+
+				It("checks the new instances against the quotas before scaling", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("get-process-warning"))
+					Expect(testUI.Err).To(Say("quota-warning"))
+
+					Expect(fakeActor.GetProcessByTypeAndApplicationCallCount()).To(Equal(1))
+					processTypeArg, appGUIDArg := fakeActor.GetProcessByTypeAndApplicationArgsForCall(0)
+					Expect(processTypeArg).To(Equal(constant.ProcessTypeWeb))
+					Expect(appGUIDArg).To(Equal("some-app-guid"))
+
+					Expect(fakeQuotaActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+					orgGUIDArg, spaceGUIDArg, requests := fakeQuotaActor.CheckQuotaHeadroomArgsForCall(0)
+					Expect(orgGUIDArg).To(Equal("some-org-guid"))
+					Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+					Expect(requests).To(Equal([]v2action.QuotaRequest{{ApplicationGUID: "some-app-guid", Instances: 3, MemoryInMB: 32}}))
+
+					Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+				})
+
+				Context("when scaling a process other than web", func() {
+					BeforeEach(func() {
+						cmd.ProcessType = "worker"
+						cmd.MemoryLimit.Value = 64
+						cmd.MemoryLimit.IsSet = true
+						cmd.Force = true
+					})
+
+					It("adds the process's instances on top of the app's current usage", func() {
+						Expect(fakeQuotaActor.CheckQuotaHeadroomCallCount()).To(Equal(1))
+						_, _, requests := fakeQuotaActor.CheckQuotaHeadroomArgsForCall(0)
+						Expect(requests).To(Equal([]v2action.QuotaRequest{{Instances: 3, MemoryInMB: 64}}))
+					})
+				})
+
+				Context("when the quota would be exceeded", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = actionerror.QuotaExceededError{Scope: "space", QuotaName: "some-quota", Resource: actionerror.QuotaResourceAppInstances, Requested: 3, Available: 2}
+						fakeQuotaActor.CheckQuotaHeadroomReturns(v2action.Warnings{"quota-warning"}, expectedErr)
+					})
+
+					It("returns the error without scaling the app", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("quota-warning"))
+						Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when getting the process fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetProcessByTypeAndApplicationReturns(v3action.Process{}, nil, actionerror.ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb})
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb}))
+						Expect(fakeQuotaActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when only the disk flag option is provided", func() {
+					BeforeEach(func() {
+						cmd.Instances.IsSet = false
+						cmd.DiskLimit.Value = 512
+						cmd.DiskLimit.IsSet = true
+						cmd.Force = true
+					})
+
+					It("does not check the quotas", func() {
+						Expect(fakeQuotaActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --skip-quota-check is provided", func() {
+					BeforeEach(func() {
+						cmd.SkipQuotaCheck = true
+					})
+
+					It("does not check the quotas", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.GetProcessByTypeAndApplicationCallCount()).To(Equal(0))
+						Expect(fakeQuotaActor.CheckQuotaHeadroomCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when an error is encountered scaling the application", func() {
 				var expectedErr error
 
@@ -17,13 +17,16 @@ import (
 
 type V3SetEnvActor interface {
 	CloudControllerAPIVersion() string
+	ReadEnvironmentVariablesFile(path string) ([]v3action.EnvironmentVariablePair, error)
 	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+	SetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string, envPairs []v3action.EnvironmentVariablePair) (v3action.Warnings, error)
 }
 
 type V3SetEnvCommand struct {
-	RequiredArgs    flag.SetEnvironmentArgs `positional-args:"yes"`
-	usage           interface{}             `usage:"CF_NAME v3-set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE"`
-	relatedCommands interface{}             `related_commands:"v3-apps, v3-env, v3-restart, set-running-environment-variable-group, set-staging-environment-variable-group, v3-stage, v3-unset-env"`
+	RequiredArgs    flag.SetEnvironmentOrFileArgs `positional-args:"yes"`
+	FromFile        flag.PathWithExistenceCheck   `long:"from-file" description:"Set all env variables of a dotenv or JSON file"`
+	usage           interface{}                   `usage:"CF_NAME v3-set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE\n   CF_NAME v3-set-env APP_NAME --from-file FILE\n\n   Files with a .json extension, or starting with '{', contain a JSON object of names to values. All other files contain one NAME=VALUE line per variable; '#' starts a comment line and values may be quoted.\n\nEXAMPLES:\n   CF_NAME v3-set-env my-app LOG_LEVEL debug\n   CF_NAME v3-set-env my-app --from-file .env"`
+	relatedCommands interface{}                   `related_commands:"v3-apps, v3-env, v3-restart, set-running-environment-variable-group, set-staging-environment-variable-group, v3-set-env-group, v3-stage, v3-unset-env"`
 
 	UI          command.UI
 	Config      command.Config
@@ -52,7 +55,12 @@ func (cmd *V3SetEnvCommand) Setup(config command.Config, ui command.UI) error {
 func (cmd V3SetEnvCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	err := checkEnvironmentVariableArgs(cmd.RequiredArgs.EnvironmentVariableName, cmd.RequiredArgs.EnvironmentVariableValue, cmd.FromFile)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
 	if err != nil {
 		return err
 	}
@@ -68,6 +76,10 @@ func (cmd V3SetEnvCommand) Execute(args []string) error {
 	}
 
 	appName := cmd.RequiredArgs.AppName
+	if cmd.FromFile != "" {
+		return cmd.setFromFile(appName, user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Setting env variable {{.EnvVarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":    appName,
 		"EnvVarName": cmd.RequiredArgs.EnvironmentVariableName,
@@ -81,7 +93,7 @@ func (cmd V3SetEnvCommand) Execute(args []string) error {
 		cmd.Config.TargetedSpace().GUID,
 		v3action.EnvironmentVariablePair{
 			Key:   cmd.RequiredArgs.EnvironmentVariableName,
-			Value: string(cmd.RequiredArgs.EnvironmentVariableValue),
+			Value: string(cmd.RequiredArgs.EnvironmentVariableValue.EnvironmentVariable),
 		})
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -95,3 +107,57 @@ func (cmd V3SetEnvCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd V3SetEnvCommand) setFromFile(appName string, username string) error {
+	envPairs, err := cmd.Actor.ReadEnvironmentVariablesFile(string(cmd.FromFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting env variables from {{.Path}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"Path":      string(cmd.FromFile),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+
+	warnings, err := cmd.Actor.SetEnvironmentVariablesByApplicationNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, envPairs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, envPair := range envPairs {
+		cmd.UI.DisplayText("  {{.EnvVarName}}", map[string]interface{}{
+			"EnvVarName": envPair.Key,
+		})
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf v3-stage {{.AppName}}' to ensure your env variable changes take effect.", map[string]interface{}{
+		"AppName": appName,
+	})
+
+	return nil
+}
+
+// checkEnvironmentVariableArgs returns an error unless either a variable name
+// and value, or a file of variables, were provided.
+func checkEnvironmentVariableArgs(name string, value flag.OptionalEnvironmentVariable, fromFile flag.PathWithExistenceCheck) error {
+	if fromFile != "" {
+		if name != "" || value.IsSet {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"ENV_VAR_NAME", "--from-file"},
+			}
+		}
+		return nil
+	}
+
+	if name == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "ENV_VAR_NAME"}
+	}
+	if !value.IsSet {
+		return translatableerror.RequiredArgumentError{ArgumentName: "ENV_VAR_VALUE"}
+	}
+	return nil
+}
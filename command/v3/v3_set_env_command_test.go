@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
@@ -49,7 +50,7 @@ var _ = Describe("v3-set-env Command", func() {
 
 		cmd.RequiredArgs.AppName = appName
 		cmd.RequiredArgs.EnvironmentVariableName = "some-key"
-		cmd.RequiredArgs.EnvironmentVariableValue = "some-value"
+		cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{EnvironmentVariable: "some-value", IsSet: true}
 	})
 
 	JustBeforeEach(func() {
@@ -73,6 +74,56 @@ var _ = Describe("v3-set-env Command", func() {
 		})
 	})
 
+	Context("when the variable name is not provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EnvironmentVariableName = ""
+			cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ENV_VAR_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the variable value is not provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ENV_VAR_VALUE"}))
+		})
+	})
+
+	Context("when the variable value is empty", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{IsSet: true}
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		})
+
+		It("sets the variable to the empty string", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(1))
+			_, _, envVariablePair := fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+			Expect(envVariablePair.Key).To(Equal("some-key"))
+			Expect(envVariablePair.Value).To(BeEmpty())
+		})
+	})
+
+	Context("when both a variable and --from-file are provided", func() {
+		BeforeEach(func() {
+			cmd.FromFile = "some-file"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"ENV_VAR_NAME", "--from-file"},
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -133,6 +184,64 @@ var _ = Describe("v3-set-env Command", func() {
 				})
 			})
 
+			Context("when --from-file is provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.EnvironmentVariableName = ""
+					cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{}
+					cmd.FromFile = "some-dir/.env"
+
+					fakeActor.ReadEnvironmentVariablesFileReturns([]v3action.EnvironmentVariablePair{
+						{Key: "A_VAR", Value: "a"},
+						{Key: "B_VAR", Value: "b"},
+					}, nil)
+					fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceReturns(v3action.Warnings{"set-warning"}, nil)
+				})
+
+				It("sets all the variables of the file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Setting env variables from some-dir/\\.env for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+					Expect(testUI.Out).To(Say("A_VAR"))
+					Expect(testUI.Out).To(Say("B_VAR"))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("TIP: Use 'cf v3-stage some-app' to ensure your env variable changes take effect\\."))
+					Expect(testUI.Err).To(Say("set-warning"))
+
+					Expect(fakeActor.ReadEnvironmentVariablesFileArgsForCall(0)).To(Equal("some-dir/.env"))
+					Expect(fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(0))
+					appName, spaceGUID, envPairs := fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(envPairs).To(Equal([]v3action.EnvironmentVariablePair{
+						{Key: "A_VAR", Value: "a"},
+						{Key: "B_VAR", Value: "b"},
+					}))
+				})
+
+				Context("when reading the file fails", func() {
+					BeforeEach(func() {
+						fakeActor.ReadEnvironmentVariablesFileReturns(nil, errors.New("read-error"))
+					})
+
+					It("returns the error without setting anything", func() {
+						Expect(executeErr).To(MatchError("read-error"))
+						Expect(fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when setting the variables fails", func() {
+					BeforeEach(func() {
+						fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceReturns(v3action.Warnings{"set-warning"}, errors.New("set-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executeErr).To(MatchError("set-error"))
+						Expect(testUI.Err).To(Say("set-warning"))
+						Expect(testUI.Out).ToNot(Say("OK"))
+					})
+				})
+			})
+
 			Context("when the set environment variable returns an unknown error", func() {
 				var expectedErr error
 				BeforeEach(func() {
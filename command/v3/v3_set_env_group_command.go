@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3SetEnvGroupActor
+
+type V3SetEnvGroupActor interface {
+	CloudControllerAPIVersion() string
+	ReadEnvironmentVariablesFile(path string) ([]v3action.EnvironmentVariablePair, error)
+	SetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envPairs []v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+}
+
+type V3SetEnvGroupCommand struct {
+	RequiredArgs    flag.SetEnvironmentVariableGroupArgs `positional-args:"yes"`
+	FromFile        flag.PathWithExistenceCheck          `long:"from-file" description:"Set all env variables of a dotenv or JSON file"`
+	usage           interface{}                          `usage:"CF_NAME v3-set-env-group GROUP ENV_VAR_NAME ENV_VAR_VALUE\n   CF_NAME v3-set-env-group GROUP --from-file FILE\n\n   GROUP is either running or staging. Variables of the group that are not provided are left unchanged.\n\nEXAMPLES:\n   CF_NAME v3-set-env-group running HTTP_PROXY http://proxy.example.com\n   CF_NAME v3-set-env-group staging --from-file staging.json"`
+	relatedCommands interface{}                          `related_commands:"v3-env-group, v3-set-env, v3-restart, v3-stage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SetEnvGroupActor
+}
+
+func (cmd *V3SetEnvGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionEnvironmentVariableGroupsV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd V3SetEnvGroupCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := checkEnvironmentVariableArgs(cmd.RequiredArgs.EnvironmentVariableName, cmd.RequiredArgs.EnvironmentVariableValue, cmd.FromFile)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionEnvironmentVariableGroupsV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	group := cmd.RequiredArgs.Group.Group
+	var envPairs []v3action.EnvironmentVariablePair
+	if cmd.FromFile != "" {
+		envPairs, err = cmd.Actor.ReadEnvironmentVariablesFile(string(cmd.FromFile))
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Setting env variables from {{.Path}} in the {{.Group}} environment variable group as {{.Username}}...", map[string]interface{}{
+			"Path":     string(cmd.FromFile),
+			"Group":    group,
+			"Username": user.Name,
+		})
+	} else {
+		envPairs = []v3action.EnvironmentVariablePair{{
+			Key:   cmd.RequiredArgs.EnvironmentVariableName,
+			Value: string(cmd.RequiredArgs.EnvironmentVariableValue.EnvironmentVariable),
+		}}
+
+		cmd.UI.DisplayTextWithFlavor("Setting env variable {{.EnvVarName}} in the {{.Group}} environment variable group as {{.Username}}...", map[string]interface{}{
+			"EnvVarName": cmd.RequiredArgs.EnvironmentVariableName,
+			"Group":      group,
+			"Username":   user.Name,
+		})
+	}
+
+	warnings, err := cmd.Actor.SetEnvironmentVariableGroup(constant.EnvironmentVariableGroupName(group), envPairs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Changes require an app restart (for running) or restage (for staging) to apply to existing applications.")
+
+	return nil
+}
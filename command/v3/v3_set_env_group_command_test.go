@@ -0,0 +1,165 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-set-env-group Command", func() {
+	var (
+		cmd             v3.V3SetEnvGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3SetEnvGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SetEnvGroupActor)
+
+		cmd = v3.V3SetEnvGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Group.Group = "staging"
+		cmd.RequiredArgs.EnvironmentVariableName = "some-key"
+		cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{EnvironmentVariable: "some-value", IsSet: true}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionEnvironmentVariableGroupsV3)
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both a variable and --from-file are provided", func() {
+		BeforeEach(func() {
+			cmd.FromFile = "some-file"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"ENV_VAR_NAME", "--from-file"},
+			}))
+			Expect(fakeActor.SetEnvironmentVariableGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionEnvironmentVariableGroupsV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when a single variable is provided", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableGroupReturns(v3action.Warnings{"set-warning"}, nil)
+		})
+
+		It("sets the variable in the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting env variable some-key in the staging environment variable group as banana\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Changes require an app restart \\(for running\\) or restage \\(for staging\\) to apply to existing applications\\."))
+			Expect(testUI.Err).To(Say("set-warning"))
+
+			group, envPairs := fakeActor.SetEnvironmentVariableGroupArgsForCall(0)
+			Expect(group).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(envPairs).To(Equal([]v3action.EnvironmentVariablePair{{Key: "some-key", Value: "some-value"}}))
+		})
+	})
+
+	Context("when --from-file is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Group.Group = "running"
+			cmd.RequiredArgs.EnvironmentVariableName = ""
+			cmd.RequiredArgs.EnvironmentVariableValue = flag.OptionalEnvironmentVariable{}
+			cmd.FromFile = "running.json"
+
+			fakeActor.ReadEnvironmentVariablesFileReturns([]v3action.EnvironmentVariablePair{
+				{Key: "A_VAR", Value: "a"},
+			}, nil)
+		})
+
+		It("sets the variables of the file in the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting env variables from running\\.json in the running environment variable group as banana\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.ReadEnvironmentVariablesFileArgsForCall(0)).To(Equal("running.json"))
+			group, envPairs := fakeActor.SetEnvironmentVariableGroupArgsForCall(0)
+			Expect(group).To(Equal(constant.RunningEnvironmentVariableGroup))
+			Expect(envPairs).To(Equal([]v3action.EnvironmentVariablePair{{Key: "A_VAR", Value: "a"}}))
+		})
+
+		Context("when reading the file fails", func() {
+			BeforeEach(func() {
+				fakeActor.ReadEnvironmentVariablesFileReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("read-error"))
+				Expect(fakeActor.SetEnvironmentVariableGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when setting the group fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableGroupReturns(v3action.Warnings{"set-warning"}, errors.New("set-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("set-error"))
+			Expect(testUI.Err).To(Say("set-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -24,11 +24,12 @@ type SSHActor interface {
 
 type V3SSHActor interface {
 	CloudControllerAPIVersion() string
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
 }
 
 type V3SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
+	RequiredArgs          flag.OptionalAppName     `positional-args:"yes"`
 	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
 	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
 	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
@@ -87,6 +88,11 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	cmd.RequiredArgs.AppName, err = command.SelectRequiredArgument(cmd.UI, cmd.Config, cmd.RequiredArgs.AppName, "APP_NAME", "App>", cmd.listAppNames)
+	if err != nil {
+		return err
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -128,6 +134,15 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd V3SSHCommand) listAppNames() ([]string, []string, error) {
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	var names []string
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	return names, warnings, err
+}
+
 func (cmd V3SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
 	return nil, nil
 }
@@ -41,7 +41,7 @@ var _ = Describe("v3-ssh Command", func() {
 
 		appName = "some-app"
 		cmd = v3.V3SSHCommand{
-			RequiredArgs: flag.AppName{AppName: appName},
+			RequiredArgs: flag.OptionalAppName{AppName: appName},
 
 			ProcessType:         "some-process-type",
 			ProcessIndex:        1,
@@ -101,6 +101,38 @@ var _ = Describe("v3-ssh Command", func() {
 				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
 			})
 
+			Context("when no app name is provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.AppName = ""
+				})
+
+				Context("when the terminal is interactive", func() {
+					BeforeEach(func() {
+						testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+						testUI.In.(*Buffer).Write([]byte("2\n"))
+						cmd.UI = testUI
+						fakeConfig.IsTTYReturns(true)
+						fakeActor.GetApplicationsBySpaceReturns([]v3action.Application{{Name: "some-app"}, {Name: "other-app"}}, v3action.Warnings{"get-apps-warning"}, nil)
+					})
+
+					It("lets the user pick the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+						Expect(testUI.Err).To(Say("get-apps-warning"))
+
+						appNameArg, _, _, _ := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+						Expect(appNameArg).To(Equal("some-app"))
+					})
+				})
+
+				Context("when the terminal is not interactive", func() {
+					It("returns a RequiredArgumentError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+						Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when getting the secure shell authentication information succeeds", func() {
 				var sshAuth v3action.SSHAuthentication
 
@@ -34,6 +34,18 @@ type FakeV3EnvActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	WriteEnvironmentVariablesFileStub        func(path string, envVars map[string]interface{}) error
+	writeEnvironmentVariablesFileMutex       sync.RWMutex
+	writeEnvironmentVariablesFileArgsForCall []struct {
+		path    string
+		envVars map[string]interface{}
+	}
+	writeEnvironmentVariablesFileReturns struct {
+		result1 error
+	}
+	writeEnvironmentVariablesFileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -133,6 +145,55 @@ func (fake *FakeV3EnvActor) GetEnvironmentVariablesByApplicationNameAndSpaceRetu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3EnvActor) WriteEnvironmentVariablesFile(path string, envVars map[string]interface{}) error {
+	fake.writeEnvironmentVariablesFileMutex.Lock()
+	ret, specificReturn := fake.writeEnvironmentVariablesFileReturnsOnCall[len(fake.writeEnvironmentVariablesFileArgsForCall)]
+	fake.writeEnvironmentVariablesFileArgsForCall = append(fake.writeEnvironmentVariablesFileArgsForCall, struct {
+		path    string
+		envVars map[string]interface{}
+	}{path, envVars})
+	fake.recordInvocation("WriteEnvironmentVariablesFile", []interface{}{path, envVars})
+	fake.writeEnvironmentVariablesFileMutex.Unlock()
+	if fake.WriteEnvironmentVariablesFileStub != nil {
+		return fake.WriteEnvironmentVariablesFileStub(path, envVars)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeEnvironmentVariablesFileReturns.result1
+}
+
+func (fake *FakeV3EnvActor) WriteEnvironmentVariablesFileCallCount() int {
+	fake.writeEnvironmentVariablesFileMutex.RLock()
+	defer fake.writeEnvironmentVariablesFileMutex.RUnlock()
+	return len(fake.writeEnvironmentVariablesFileArgsForCall)
+}
+
+func (fake *FakeV3EnvActor) WriteEnvironmentVariablesFileArgsForCall(i int) (string, map[string]interface{}) {
+	fake.writeEnvironmentVariablesFileMutex.RLock()
+	defer fake.writeEnvironmentVariablesFileMutex.RUnlock()
+	return fake.writeEnvironmentVariablesFileArgsForCall[i].path, fake.writeEnvironmentVariablesFileArgsForCall[i].envVars
+}
+
+func (fake *FakeV3EnvActor) WriteEnvironmentVariablesFileReturns(result1 error) {
+	fake.WriteEnvironmentVariablesFileStub = nil
+	fake.writeEnvironmentVariablesFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3EnvActor) WriteEnvironmentVariablesFileReturnsOnCall(i int, result1 error) {
+	fake.WriteEnvironmentVariablesFileStub = nil
+	if fake.writeEnvironmentVariablesFileReturnsOnCall == nil {
+		fake.writeEnvironmentVariablesFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeEnvironmentVariablesFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3EnvActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -140,6 +201,8 @@ func (fake *FakeV3EnvActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.writeEnvironmentVariablesFileMutex.RLock()
+	defer fake.writeEnvironmentVariablesFileMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
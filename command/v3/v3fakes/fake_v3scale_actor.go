@@ -51,6 +51,22 @@ type FakeV3ScaleActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetProcessByTypeAndApplicationStub        func(processType string, appGUID string) (v3action.Process, v3action.Warnings, error)
+	getProcessByTypeAndApplicationMutex       sync.RWMutex
+	getProcessByTypeAndApplicationArgsForCall []struct {
+		processType string
+		appGUID     string
+	}
+	getProcessByTypeAndApplicationReturns struct {
+		result1 v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
 	ScaleProcessByApplicationStub        func(appGUID string, process v3action.Process) (v3action.Warnings, error)
 	scaleProcessByApplicationMutex       sync.RWMutex
 	scaleProcessByApplicationArgsForCall []struct {
@@ -260,6 +276,61 @@ func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3ScaleActor) GetProcessByTypeAndApplication(processType string, appGUID string) (v3action.Process, v3action.Warnings, error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
+	fake.getProcessByTypeAndApplicationArgsForCall = append(fake.getProcessByTypeAndApplicationArgsForCall, struct {
+		processType string
+		appGUID     string
+	}{processType, appGUID})
+	fake.recordInvocation("GetProcessByTypeAndApplication", []interface{}{processType, appGUID})
+	fake.getProcessByTypeAndApplicationMutex.Unlock()
+	if fake.GetProcessByTypeAndApplicationStub != nil {
+		return fake.GetProcessByTypeAndApplicationStub(processType, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessByTypeAndApplicationReturns.result1, fake.getProcessByTypeAndApplicationReturns.result2, fake.getProcessByTypeAndApplicationReturns.result3
+}
+
+func (fake *FakeV3ScaleActor) GetProcessByTypeAndApplicationCallCount() int {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.getProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) GetProcessByTypeAndApplicationArgsForCall(i int) (string, string) {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return fake.getProcessByTypeAndApplicationArgsForCall[i].processType, fake.getProcessByTypeAndApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3ScaleActor) GetProcessByTypeAndApplicationReturns(result1 v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessByTypeAndApplicationStub = nil
+	fake.getProcessByTypeAndApplicationReturns = struct {
+		result1 v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result1 v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessByTypeAndApplicationStub = nil
+	if fake.getProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.getProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Process
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3ScaleActor) ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error) {
 	fake.scaleProcessByApplicationMutex.Lock()
 	ret, specificReturn := fake.scaleProcessByApplicationReturnsOnCall[len(fake.scaleProcessByApplicationArgsForCall)]
@@ -475,6 +546,8 @@ func (fake *FakeV3ScaleActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.scaleProcessByApplicationMutex.RLock()
 	defer fake.scaleProcessByApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
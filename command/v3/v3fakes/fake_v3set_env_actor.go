@@ -18,6 +18,19 @@ type FakeV3SetEnvActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ReadEnvironmentVariablesFileStub        func(path string) ([]v3action.EnvironmentVariablePair, error)
+	readEnvironmentVariablesFileMutex       sync.RWMutex
+	readEnvironmentVariablesFileArgsForCall []struct {
+		path string
+	}
+	readEnvironmentVariablesFileReturns struct {
+		result1 []v3action.EnvironmentVariablePair
+		result2 error
+	}
+	readEnvironmentVariablesFileReturnsOnCall map[int]struct {
+		result1 []v3action.EnvironmentVariablePair
+		result2 error
+	}
 	SetEnvironmentVariableByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
 	setEnvironmentVariableByApplicationNameAndSpaceMutex       sync.RWMutex
 	setEnvironmentVariableByApplicationNameAndSpaceArgsForCall []struct {
@@ -33,6 +46,21 @@ type FakeV3SetEnvActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	SetEnvironmentVariablesByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, envPairs []v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+	setEnvironmentVariablesByApplicationNameAndSpaceMutex       sync.RWMutex
+	setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+		envPairs  []v3action.EnvironmentVariablePair
+	}
+	setEnvironmentVariablesByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -77,6 +105,57 @@ func (fake *FakeV3SetEnvActor) CloudControllerAPIVersionReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeV3SetEnvActor) ReadEnvironmentVariablesFile(path string) ([]v3action.EnvironmentVariablePair, error) {
+	fake.readEnvironmentVariablesFileMutex.Lock()
+	ret, specificReturn := fake.readEnvironmentVariablesFileReturnsOnCall[len(fake.readEnvironmentVariablesFileArgsForCall)]
+	fake.readEnvironmentVariablesFileArgsForCall = append(fake.readEnvironmentVariablesFileArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("ReadEnvironmentVariablesFile", []interface{}{path})
+	fake.readEnvironmentVariablesFileMutex.Unlock()
+	if fake.ReadEnvironmentVariablesFileStub != nil {
+		return fake.ReadEnvironmentVariablesFileStub(path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readEnvironmentVariablesFileReturns.result1, fake.readEnvironmentVariablesFileReturns.result2
+}
+
+func (fake *FakeV3SetEnvActor) ReadEnvironmentVariablesFileCallCount() int {
+	fake.readEnvironmentVariablesFileMutex.RLock()
+	defer fake.readEnvironmentVariablesFileMutex.RUnlock()
+	return len(fake.readEnvironmentVariablesFileArgsForCall)
+}
+
+func (fake *FakeV3SetEnvActor) ReadEnvironmentVariablesFileArgsForCall(i int) string {
+	fake.readEnvironmentVariablesFileMutex.RLock()
+	defer fake.readEnvironmentVariablesFileMutex.RUnlock()
+	return fake.readEnvironmentVariablesFileArgsForCall[i].path
+}
+
+func (fake *FakeV3SetEnvActor) ReadEnvironmentVariablesFileReturns(result1 []v3action.EnvironmentVariablePair, result2 error) {
+	fake.ReadEnvironmentVariablesFileStub = nil
+	fake.readEnvironmentVariablesFileReturns = struct {
+		result1 []v3action.EnvironmentVariablePair
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3SetEnvActor) ReadEnvironmentVariablesFileReturnsOnCall(i int, result1 []v3action.EnvironmentVariablePair, result2 error) {
+	fake.ReadEnvironmentVariablesFileStub = nil
+	if fake.readEnvironmentVariablesFileReturnsOnCall == nil {
+		fake.readEnvironmentVariablesFileReturnsOnCall = make(map[int]struct {
+			result1 []v3action.EnvironmentVariablePair
+			result2 error
+		})
+	}
+	fake.readEnvironmentVariablesFileReturnsOnCall[i] = struct {
+		result1 []v3action.EnvironmentVariablePair
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3SetEnvActor) SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error) {
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall)]
@@ -130,13 +209,75 @@ func (fake *FakeV3SetEnvActor) SetEnvironmentVariableByApplicationNameAndSpaceRe
 	}{result1, result2}
 }
 
+func (fake *FakeV3SetEnvActor) SetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string, envPairs []v3action.EnvironmentVariablePair) (v3action.Warnings, error) {
+	var envPairsCopy []v3action.EnvironmentVariablePair
+	if envPairs != nil {
+		envPairsCopy = make([]v3action.EnvironmentVariablePair, len(envPairs))
+		copy(envPairsCopy, envPairs)
+	}
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)]
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall = append(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+		envPairs  []v3action.EnvironmentVariablePair
+	}{appName, spaceGUID, envPairsCopy})
+	fake.recordInvocation("SetEnvironmentVariablesByApplicationNameAndSpace", []interface{}{appName, spaceGUID, envPairsCopy})
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	if fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub != nil {
+		return fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub(appName, spaceGUID, envPairs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setEnvironmentVariablesByApplicationNameAndSpaceReturns.result1, fake.setEnvironmentVariablesByApplicationNameAndSpaceReturns.result2
+}
+
+func (fake *FakeV3SetEnvActor) SetEnvironmentVariablesByApplicationNameAndSpaceCallCount() int {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3SetEnvActor) SetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string, []v3action.EnvironmentVariablePair) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i].appName, fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i].envPairs
+}
+
+func (fake *FakeV3SetEnvActor) SetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3SetEnvActor) SetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	if fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3SetEnvActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.readEnvironmentVariablesFileMutex.RLock()
+	defer fake.readEnvironmentVariablesFileMutex.RUnlock()
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
 	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
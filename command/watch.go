@@ -0,0 +1,49 @@
+package command
+
+import (
+	"os"
+	"os/signal"
+	"time"
+)
+
+// Watch redraws the output of display in place every polling interval until
+// the user presses Ctrl-C or display returns an error. Warnings passed to
+// the returned function are only displayed the first time they are seen.
+func Watch(ui UI, config Config, display func(displayWarnings func([]string)) error) error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	seenWarnings := map[string]bool{}
+	displayWarnings := func(warnings []string) {
+		var newWarnings []string
+		for _, warning := range warnings {
+			if !seenWarnings[warning] {
+				seenWarnings[warning] = true
+				newWarnings = append(newWarnings, warning)
+			}
+		}
+		ui.DisplayWarnings(newWarnings)
+	}
+
+	for {
+		var err error
+		ui.DisplayLiveUpdate(func() {
+			ui.DisplayText("Updated {{.Time}}; press Ctrl-C to stop.", map[string]interface{}{
+				"Time": ui.UserFriendlyDate(time.Now()),
+			})
+			ui.DisplayNewline()
+			err = display(displayWarnings)
+		})
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-interrupt:
+			ui.DisplayNewline()
+			return nil
+		case <-time.After(config.PollingInterval()):
+		}
+	}
+}
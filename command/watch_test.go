@@ -0,0 +1,56 @@
+package command_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		calls      int
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		calls = 0
+	})
+
+	JustBeforeEach(func() {
+		executeErr = Watch(testUI, fakeConfig, func(displayWarnings func([]string)) error {
+			calls++
+			displayWarnings([]string{"some-warning", fmt.Sprintf("warning-%d", calls)})
+			if calls == 3 {
+				return errors.New("some-error")
+			}
+			testUI.DisplayText("snapshot {{.Count}}", map[string]interface{}{"Count": calls})
+			return nil
+		})
+	})
+
+	It("redraws the display every polling interval until it fails", func() {
+		Expect(executeErr).To(MatchError("some-error"))
+		Expect(calls).To(Equal(3))
+
+		Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+		Expect(testUI.Out).To(Say("snapshot 1"))
+		Expect(testUI.Out).To(Say("Updated .*; press Ctrl-C to stop\\."))
+		Expect(testUI.Out).To(Say("snapshot 2"))
+		Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+	})
+
+	It("only displays each warning once", func() {
+		Expect(testUI.Err).To(Say("some-warning\nwarning-1\n\nwarning-2\n\nwarning-3\n"))
+	})
+})
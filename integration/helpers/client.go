@@ -42,7 +42,7 @@ func CreateCCV2Client() (*ccv2.Client, error) {
 		return nil, translatableerror.AuthorizationEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
+	uaaClient := uaa.NewClient(config, nil)
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// NullBool is a wrapper around boolean values that can be null or a boolean.
+// Use IsSet to check if the value is provided, instead of checking against
+// false.
+type NullBool struct {
+	IsSet bool
+	Value bool
+}
+
+// ParseBoolValue is used to parse a user provided *bool argument.
+func (n *NullBool) ParseBoolValue(val *bool) {
+	if val == nil {
+		n.IsSet = false
+		n.Value = false
+		return
+	}
+
+	n.Value = *val
+	n.IsSet = true
+}
+
+func (n *NullBool) UnmarshalJSON(rawJSON []byte) error {
+	var value *bool
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	n.ParseBoolValue(value)
+	return nil
+}
+
+func (n NullBool) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return []byte(strconv.FormatBool(n.Value)), nil
+	}
+	return []byte("null"), nil
+}
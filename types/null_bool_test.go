@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullBool", func() {
+	var nullBool NullBool
+
+	BeforeEach(func() {
+		nullBool = NullBool{}
+	})
+
+	Describe("ParseBoolValue", func() {
+		Context("when nil is provided", func() {
+			It("sets IsSet to false", func() {
+				nullBool.ParseBoolValue(nil)
+				Expect(nullBool).To(Equal(NullBool{Value: false, IsSet: false}))
+			})
+		})
+
+		Context("when non-nil pointer is provided", func() {
+			It("sets IsSet to true and Value to provided value", func() {
+				b := true
+				nullBool.ParseBoolValue(&b)
+				Expect(nullBool).To(Equal(NullBool{Value: true, IsSet: true}))
+			})
+		})
+	})
+
+	DescribeTable("UnmarshalJSON",
+		func(rawJSON string, expectedNullBool NullBool) {
+			err := nullBool.UnmarshalJSON([]byte(rawJSON))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nullBool).To(Equal(expectedNullBool))
+		},
+		Entry("true", "true", NullBool{Value: true, IsSet: true}),
+		Entry("false", "false", NullBool{Value: false, IsSet: true}),
+		Entry("null", "null", NullBool{Value: false, IsSet: false}),
+	)
+
+	Context("when the JSON is not a boolean", func() {
+		It("returns an error", func() {
+			err := nullBool.UnmarshalJSON([]byte(`"yes"`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("MarshalJSON",
+		func(nullBool NullBool, expectedBytes []byte) {
+			bytes, err := nullBool.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(expectedBytes))
+		},
+		Entry("true", NullBool{Value: true, IsSet: true}, []byte("true")),
+		Entry("false", NullBool{Value: false, IsSet: true}, []byte("false")),
+		Entry("unset", NullBool{}, []byte("null")),
+	)
+})
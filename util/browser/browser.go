@@ -0,0 +1,8 @@
+// Package browser opens URLs in the user's default web browser.
+package browser
+
+// Open opens the provided URL in the user's default web browser. It does not
+// wait for the browser to exit.
+func Open(url string) error {
+	return openCommand(url).Start()
+}
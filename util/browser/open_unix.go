@@ -0,0 +1,9 @@
+// +build !windows,!darwin
+
+package browser
+
+import "os/exec"
+
+func openCommand(url string) *exec.Cmd {
+	return exec.Command("xdg-open", url)
+}
@@ -0,0 +1,264 @@
+// Package cfignore matches application paths against .cfignore rules.
+//
+// Rules follow .gitignore semantics: later rules take precedence over earlier
+// ones, rules from a nested ignore file only apply below its directory and
+// take precedence over the rules of its parents, '!' re-includes a path and a
+// path inside an ignored directory is always ignored. In addition, a
+// '#include PATH' line reads the rules of another file, such as .gitignore,
+// as if they were written in place of the directive. Included files that do
+// not exist are skipped.
+package cfignore
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// IncludeDirective is the prefix of lines that include the rules of another
+// file.
+const IncludeDirective = "#include "
+
+// ReadFileFunc reads the ignore file at the provided slash separated path,
+// relative to the application root. It returns an error satisfying
+// os.IsNotExist if the file does not exist.
+type ReadFileFunc func(path string) ([]byte, error)
+
+// Rule is a single pattern of an ignore file.
+type Rule struct {
+	// Source is the path of the file that defined the rule, relative to the
+	// application root. It is empty for default rules.
+	Source  string
+	Line    int
+	Pattern string
+
+	base    string
+	negated bool
+	dirOnly bool
+	regexp  *regexp.Regexp
+}
+
+// Negated returns true if the rule re-includes the paths it matches.
+func (rule Rule) Negated() bool {
+	return rule.negated
+}
+
+func (rule Rule) String() string {
+	if rule.Source == "" {
+		return fmt.Sprintf("(default) %s", rule.Pattern)
+	}
+	return fmt.Sprintf("%s:%d %s", rule.Source, rule.Line, rule.Pattern)
+}
+
+func (rule Rule) matches(slashPath string, isDir bool) bool {
+	if rule.dirOnly && !isDir {
+		return false
+	}
+	return rule.regexp.MatchString(slashPath)
+}
+
+// Matcher decides which application paths are ignored.
+type Matcher struct {
+	rules []Rule
+}
+
+// NewMatcher returns a Matcher with the provided default rules, which apply
+// to the whole application.
+func NewMatcher(defaultLines ...string) (*Matcher, error) {
+	matcher := new(Matcher)
+	for i, line := range defaultLines {
+		err := matcher.addLine("", i+1, "", line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return matcher, nil
+}
+
+// AddIgnoreFile adds the rules of the ignore file at the provided slash
+// separated path, relative to the application root. The rules apply to the
+// paths below the directory of the file. Files must be added from the
+// outermost directory inwards.
+func (matcher *Matcher) AddIgnoreFile(filePath string, readFile ReadFileFunc) error {
+	filePath = cleanPath(filePath)
+	return matcher.addIgnoreFile(filePath, relativeDir(filePath), readFile, map[string]bool{})
+}
+
+func (matcher *Matcher) addIgnoreFile(filePath string, base string, readFile ReadFileFunc, seen map[string]bool) error {
+	if seen[filePath] {
+		return IncludeCycleError{Path: filePath}
+	}
+	seen[filePath] = true
+	defer delete(seen, filePath)
+
+	raw, err := readFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	for i, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		if strings.HasPrefix(line, IncludeDirective) {
+			includePath := strings.TrimSpace(strings.TrimPrefix(line, IncludeDirective))
+			includePath = cleanPath(path.Join(relativeDir(filePath), includePath))
+			err = matcher.addIgnoreFile(includePath, base, readFile, seen)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+
+		err = matcher.addLine(filePath, i+1, base, line)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Match returns true if the provided slash separated path, relative to the
+// application root, is ignored. It also returns the rule that decided the
+// outcome, or nil if no rule matched the path or its parent directories.
+func (matcher *Matcher) Match(slashPath string, isDir bool) (bool, *Rule) {
+	slashPath = cleanPath(slashPath)
+	if slashPath == "" {
+		return false, nil
+	}
+
+	var decidingRule *Rule
+	parts := strings.Split(slashPath, "/")
+	for i := range parts {
+		current := strings.Join(parts[:i+1], "/")
+		currentIsDir := isDir || i < len(parts)-1
+
+		rule := matcher.lastMatchingRule(current, currentIsDir)
+		if rule == nil {
+			continue
+		}
+
+		decidingRule = rule
+		if !rule.negated {
+			return true, rule
+		}
+	}
+
+	return false, decidingRule
+}
+
+func (matcher *Matcher) lastMatchingRule(slashPath string, isDir bool) *Rule {
+	for i := len(matcher.rules) - 1; i >= 0; i-- {
+		if matcher.rules[i].matches(slashPath, isDir) {
+			return &matcher.rules[i]
+		}
+	}
+	return nil
+}
+
+func (matcher *Matcher) addLine(source string, lineNumber int, base string, line string) error {
+	pattern := strings.TrimRight(line, " \t")
+	if strings.HasSuffix(pattern, "\\") && len(pattern) < len(line) {
+		pattern += " "
+	}
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return nil
+	}
+
+	rule := Rule{
+		Source:  source,
+		Line:    lineNumber,
+		Pattern: pattern,
+		base:    base,
+	}
+
+	if strings.HasPrefix(pattern, "!") {
+		rule.negated = true
+		pattern = pattern[1:]
+	} else if strings.HasPrefix(pattern, "\\!") || strings.HasPrefix(pattern, "\\#") {
+		pattern = pattern[1:]
+	}
+
+	if strings.HasSuffix(pattern, "/") {
+		rule.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if pattern == "" {
+		return nil
+	}
+
+	expression := "^"
+	if base != "" {
+		expression += regexp.QuoteMeta(base) + "/"
+	}
+	if !anchored {
+		expression += "(?:.*/)?"
+	}
+	expression += globToRegexp(pattern) + "$"
+
+	var err error
+	rule.regexp, err = regexp.Compile(expression)
+	if err != nil {
+		return InvalidPatternError{Source: source, Line: lineNumber, Pattern: rule.Pattern}
+	}
+
+	matcher.rules = append(matcher.rules, rule)
+	return nil
+}
+
+// globToRegexp converts a glob pattern to a regular expression. '*' and '?'
+// never match a '/', while '**' matches across directories.
+func globToRegexp(glob string) string {
+	expression := ""
+	for i := 0; i < len(glob); i++ {
+		switch char := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			expression += "(?:.*/)?"
+			i += 2
+		case strings.HasPrefix(glob[i:], "**") && i+2 == len(glob):
+			expression += ".*"
+			i++
+		case char == '*':
+			expression += "[^/]*"
+		case char == '?':
+			expression += "[^/]"
+		case char == '\\' && i+1 < len(glob):
+			i++
+			expression += regexp.QuoteMeta(string(glob[i]))
+		case char == '[':
+			end := strings.Index(glob[i+1:], "]")
+			if end < 0 {
+				expression += regexp.QuoteMeta(string(char))
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expression += "[" + strings.Replace(class, "/", "", -1) + "]"
+			i += end + 1
+		default:
+			expression += regexp.QuoteMeta(string(char))
+		}
+	}
+	return expression
+}
+
+// cleanPath returns the path relative to the application root, without
+// leading or trailing slashes.
+func cleanPath(slashPath string) string {
+	return strings.TrimPrefix(path.Clean("/"+slashPath), "/")
+}
+
+func relativeDir(slashPath string) string {
+	dir := path.Dir(slashPath)
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
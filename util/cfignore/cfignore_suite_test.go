@@ -0,0 +1,13 @@
+package cfignore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCFIgnore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CF Ignore Suite")
+}
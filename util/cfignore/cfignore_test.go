@@ -0,0 +1,168 @@
+package cfignore_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/cfignore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Matcher", func() {
+	var (
+		files   map[string]string
+		matcher *Matcher
+	)
+
+	readFile := func(path string) ([]byte, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+		}
+		return []byte(content), nil
+	}
+
+	BeforeEach(func() {
+		files = map[string]string{}
+
+		var err error
+		matcher, err = NewMatcher(".git", "manifest.yml")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("patterns",
+		func(pattern string, path string, isDir bool, ignored bool) {
+			files[".cfignore"] = pattern
+			Expect(matcher.AddIgnoreFile(".cfignore", readFile)).To(Succeed())
+
+			matched, _ := matcher.Match(path, isDir)
+			Expect(matched).To(Equal(ignored))
+		},
+
+		Entry("names match at any depth", "*.log", "a/b/debug.log", false, true),
+		Entry("names match directories and their contents", "tmp", "a/tmp/file", false, true),
+		Entry("leading slashes anchor the pattern", "/tmp", "a/tmp", true, false),
+		Entry("inner slashes anchor the pattern", "a/tmp", "a/tmp/file", false, true),
+		Entry("inner slashes anchor the pattern to the root", "a/tmp", "b/a/tmp", true, false),
+		Entry("trailing slashes only match directories", "build/", "build", false, false),
+		Entry("trailing slashes match directories", "build/", "src/build", true, true),
+		Entry("stars do not match slashes", "/src/*.go", "src/a/main.go", false, false),
+		Entry("leading double stars match any directory", "**/cache", "a/b/cache", true, true),
+		Entry("inner double stars match zero directories", "a/**/b", "a/b", true, true),
+		Entry("inner double stars match nested directories", "a/**/b", "a/x/y/b", true, true),
+		Entry("trailing double stars match contents", "a/**", "a/x/y", false, true),
+		Entry("question marks match a single character", "file?.txt", "file1.txt", false, true),
+		Entry("character classes", "file[0-9].txt", "filea.txt", false, false),
+		Entry("negated character classes", "file[!0-9].txt", "filea.txt", false, true),
+		Entry("escaped exclamation marks", `\!important`, "!important", false, true),
+		Entry("comments", "# tmp", "tmp", false, false),
+	)
+
+	Describe("negation", func() {
+		BeforeEach(func() {
+			files[".cfignore"] = "*.log\n!keep.log\nlogs/\n!logs/keep.log\n"
+			Expect(matcher.AddIgnoreFile(".cfignore", readFile)).To(Succeed())
+		})
+
+		It("re-includes paths matched by earlier rules", func() {
+			ignored, rule := matcher.Match("keep.log", false)
+			Expect(ignored).To(BeFalse())
+			Expect(rule.String()).To(Equal(".cfignore:2 !keep.log"))
+			Expect(rule.Negated()).To(BeTrue())
+		})
+
+		It("does not re-include paths inside ignored directories", func() {
+			ignored, rule := matcher.Match("logs/keep.log", false)
+			Expect(ignored).To(BeTrue())
+			Expect(rule.String()).To(Equal(".cfignore:3 logs/"))
+		})
+	})
+
+	Describe("default rules", func() {
+		It("applies them to the whole application", func() {
+			ignored, rule := matcher.Match("a/.git/config", false)
+			Expect(ignored).To(BeTrue())
+			Expect(rule.String()).To(Equal("(default) .git"))
+		})
+
+		It("returns no rule for paths that match nothing", func() {
+			ignored, rule := matcher.Match("a/main.go", false)
+			Expect(ignored).To(BeFalse())
+			Expect(rule).To(BeNil())
+		})
+	})
+
+	Describe("nested ignore files", func() {
+		BeforeEach(func() {
+			files[".cfignore"] = "*.tmp\n"
+			files["a/.cfignore"] = "/data\n!keep.tmp\n"
+			Expect(matcher.AddIgnoreFile(".cfignore", readFile)).To(Succeed())
+			Expect(matcher.AddIgnoreFile("a/.cfignore", readFile)).To(Succeed())
+		})
+
+		It("applies their rules relative to their directory", func() {
+			ignored, rule := matcher.Match("a/data", true)
+			Expect(ignored).To(BeTrue())
+			Expect(rule.String()).To(Equal("a/.cfignore:1 /data"))
+
+			ignored, _ = matcher.Match("data", true)
+			Expect(ignored).To(BeFalse())
+
+			ignored, _ = matcher.Match("a/b/data", true)
+			Expect(ignored).To(BeFalse())
+		})
+
+		It("gives their rules precedence over the rules of parent directories", func() {
+			ignored, _ := matcher.Match("a/keep.tmp", false)
+			Expect(ignored).To(BeFalse())
+
+			ignored, _ = matcher.Match("keep.tmp", false)
+			Expect(ignored).To(BeTrue())
+		})
+	})
+
+	Describe("includes", func() {
+		Context("when the included file exists", func() {
+			BeforeEach(func() {
+				files["a/.cfignore"] = "#include ../.gitignore\n!vendor/keep\n"
+				files[".gitignore"] = "vendor/*\n"
+				Expect(matcher.AddIgnoreFile("a/.cfignore", readFile)).To(Succeed())
+			})
+
+			It("adds the included rules in place, relative to the including file", func() {
+				ignored, rule := matcher.Match("a/vendor/lib", true)
+				Expect(ignored).To(BeTrue())
+				Expect(rule.String()).To(Equal(".gitignore:1 vendor/*"))
+
+				ignored, _ = matcher.Match("vendor/lib", true)
+				Expect(ignored).To(BeFalse())
+
+				ignored, _ = matcher.Match("a/vendor/keep", true)
+				Expect(ignored).To(BeFalse())
+			})
+		})
+
+		Context("when the included file does not exist", func() {
+			It("skips it", func() {
+				files[".cfignore"] = "#include .gitignore\n"
+				Expect(matcher.AddIgnoreFile(".cfignore", readFile)).To(Succeed())
+			})
+		})
+
+		Context("when files include each other", func() {
+			It("returns an IncludeCycleError", func() {
+				files[".cfignore"] = "#include .gitignore\n"
+				files[".gitignore"] = "#include .cfignore\n"
+				Expect(matcher.AddIgnoreFile(".cfignore", readFile)).To(MatchError(IncludeCycleError{Path: ".cfignore"}))
+			})
+		})
+	})
+
+	Context("when the ignore file cannot be read", func() {
+		It("returns the error", func() {
+			err := matcher.AddIgnoreFile(".cfignore", readFile)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package cfignore
+
+import "fmt"
+
+// InvalidPatternError is returned when an ignore file contains a pattern
+// that cannot be compiled.
+type InvalidPatternError struct {
+	Source  string
+	Line    int
+	Pattern string
+}
+
+func (e InvalidPatternError) Error() string {
+	return fmt.Sprintf("invalid pattern %q at %s:%d", e.Pattern, e.Source, e.Line)
+}
+
+// IncludeCycleError is returned when ignore files include each other.
+type IncludeCycleError struct {
+	Path string
+}
+
+func (e IncludeCycleError) Error() string {
+	return fmt.Sprintf("ignore file %s includes itself", e.Path)
+}
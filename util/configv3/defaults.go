@@ -47,6 +47,10 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultPaginationConcurrency is the default number of pages of a list
+	// that are requested at the same time.
+	DefaultPaginationConcurrency = 4
 )
 
 // NOAARequestRetryCount returns the number of request retries.
@@ -54,6 +58,12 @@ func (*Config) NOAARequestRetryCount() int {
 	return DefaultNOAARetryCount
 }
 
+// PaginationConcurrency returns the number of pages of a list that are
+// requested at the same time.
+func (*Config) PaginationConcurrency() int {
+	return DefaultPaginationConcurrency
+}
+
 // PollingInterval returns the time between polls.
 func (config *Config) PollingInterval() time.Duration {
 	return DefaultPollingInterval
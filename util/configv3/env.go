@@ -1,6 +1,7 @@
 package configv3
 
 import (
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -8,23 +9,29 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName         string
+	CFCACertFile       string
+	CFClientCertFile   string
+	CFClientKeyFile    string
+	CFColor            string
+	CFDialTimeout      string
+	CFHome             string
+	CFLogLevel         string
+	CFPassword         string
+	CFPluginHome       string
+	CFRequestRateLimit string
+	CFResourceCache    string
+	CFResponseCacheTTL string
+	CFStagingTimeout   string
+	CFStartupTimeout   string
+	CFTrace            string
+	CFUsername         string
+	DockerPassword     string
+	Experimental       string
+	ForceTTY           string
+	HTTPSProxy         string
+	Lang               string
+	LCAll              string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -116,6 +123,51 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// BlueGreenStateDir returns the directory the progress of blue-green pushes
+// is recorded in, so that interrupted pushes can be resumed.
+func (*Config) BlueGreenStateDir() string {
+	return filepath.Join(configDirectory(), "blue-green")
+}
+
+// ResourceCacheDir returns the directory the SHA1s of pushed files and the
+// resources already uploaded to each Cloud Controller are cached in. This is
+// based off of:
+//   1. The empty string, disabling the cache, if $CF_RESOURCE_CACHE is false
+//   2. Defaults to cache/resources in the config directory
+func (config *Config) ResourceCacheDir() string {
+	if config.ENV.CFResourceCache != "" {
+		enabled, err := strconv.ParseBool(config.ENV.CFResourceCache)
+		if err == nil && !enabled {
+			return ""
+		}
+	}
+
+	return filepath.Join(configDirectory(), "cache", "resources")
+}
+
+// ResponseCacheDir returns the directory responses of read-only commands are
+// cached in.
+func (*Config) ResponseCacheDir() string {
+	return filepath.Join(configDirectory(), "cache", "responses")
+}
+
+// ResponseCacheTTL returns how long responses of read-only commands are
+// cached on disk. Cached responses are not invalidated when resources change,
+// so commands may display stale data for up to this long. The time is based
+// off of:
+//   1. The $CF_RESPONSE_CACHE_TTL environment variable (in seconds) if set
+//   2. Defaults to 0, which disables the cache
+func (config *Config) ResponseCacheTTL() time.Duration {
+	if config.ENV.CFResponseCacheTTL != "" {
+		val, err := strconv.ParseInt(config.ENV.CFResponseCacheTTL, 10, 64)
+		if err == nil && val > 0 {
+			return time.Duration(val) * time.Second
+		}
+	}
+
+	return 0
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
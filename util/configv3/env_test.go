@@ -2,6 +2,7 @@ package configv3_test
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
@@ -30,6 +31,7 @@ var _ = Describe("Config", func() {
 			Expect(os.Setenv("CF_DIAL_TIMEOUT", "1234")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_DOCKER_PASSWORD", "banana")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_PASSWORD", "I am password.")).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_RESPONSE_CACHE_TTL", "30")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STAGING_TIMEOUT", "8675")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STARTUP_TIMEOUT", "309")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_USERNAME", "i-R-user")).ToNot(HaveOccurred())
@@ -45,6 +47,7 @@ var _ = Describe("Config", func() {
 			Expect(os.Unsetenv("CF_DIAL_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_DOCKER_PASSWORD")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_PASSWORD")).ToNot(HaveOccurred())
+			Expect(os.Unsetenv("CF_RESPONSE_CACHE_TTL")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STAGING_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STARTUP_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_USERNAME")).ToNot(HaveOccurred())
@@ -57,11 +60,52 @@ var _ = Describe("Config", func() {
 			Expect(config.DialTimeout()).To(Equal(1234 * time.Second))
 			Expect(config.DockerPassword()).To(Equal("banana"))
 			Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
+			Expect(config.ResponseCacheTTL()).To(Equal(30 * time.Second))
 			Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 			Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 		})
 	})
 
+	Describe("BlueGreenStateDir", func() {
+		It("is stored in the config directory", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.BlueGreenStateDir()).To(Equal(filepath.Join(homeDir, ".cf", "blue-green")))
+		})
+	})
+
+	Describe("ResourceCacheDir", func() {
+		It("is stored in the config directory", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ResourceCacheDir()).To(Equal(filepath.Join(homeDir, ".cf", "cache", "resources")))
+		})
+
+		Context("when CF_RESOURCE_CACHE is false", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_RESOURCE_CACHE", "false")).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_RESOURCE_CACHE")).ToNot(HaveOccurred())
+			})
+
+			It("disables the cache", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.ResourceCacheDir()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("ResponseCacheTTL", func() {
+		It("disables the cache by default", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ResponseCacheTTL()).To(BeZero())
+		})
+	})
+
 	Describe("BinaryName", func() {
 		It("returns the name used to invoke", func() {
 			config, err := LoadConfig()
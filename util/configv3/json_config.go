@@ -1,6 +1,7 @@
 package configv3
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -24,7 +25,11 @@ type JSONConfig struct {
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
+	CACertFile               string             `json:"CACertFile"`
+	ClientCertFile           string             `json:"ClientCertFile"`
+	ClientKeyFile            string             `json:"ClientKeyFile"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
+	RequestRateLimit         float64            `json:"RequestRateLimit"`
 	Trace                    string             `json:"Trace"`
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
@@ -63,6 +68,10 @@ type Space struct {
 // User represents the user information provided by the JWT access token.
 type User struct {
 	Name string
+
+	// GUID is the subject of the access token: the user's GUID, or the
+	// client ID for client credentials.
+	GUID string
 }
 
 // AccessToken returns the access token for making authenticated API calls.
@@ -75,6 +84,39 @@ func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
 }
 
+// CACertFile returns the path to a PEM encoded bundle of additional CA
+// certificates to trust. This is based off of:
+//   1. The $CF_CA_CERT_FILE environment variable if set
+//   2. The config file's CACertFile value
+func (config *Config) CACertFile() string {
+	if config.ENV.CFCACertFile != "" {
+		return config.ENV.CFCACertFile
+	}
+	return config.ConfigFile.CACertFile
+}
+
+// ClientCertFile returns the path to the PEM encoded client certificate to
+// present to servers that request one. This is based off of:
+//   1. The $CF_CLIENT_CERT_FILE environment variable if set
+//   2. The config file's ClientCertFile value
+func (config *Config) ClientCertFile() string {
+	if config.ENV.CFClientCertFile != "" {
+		return config.ENV.CFClientCertFile
+	}
+	return config.ConfigFile.ClientCertFile
+}
+
+// ClientKeyFile returns the path to the PEM encoded private key of the client
+// certificate. This is based off of:
+//   1. The $CF_CLIENT_KEY_FILE environment variable if set
+//   2. The config file's ClientKeyFile value
+func (config *Config) ClientKeyFile() string {
+	if config.ENV.CFClientKeyFile != "" {
+		return config.ENV.CFClientKeyFile
+	}
+	return config.ConfigFile.ClientKeyFile
+}
+
 // CurrentUser returns user information decoded from the JWT access token in
 // .cf/config.json.
 func (config *Config) CurrentUser() (User, error) {
@@ -112,6 +154,23 @@ func (config *Config) RefreshToken() string {
 	return config.ConfigFile.RefreshToken
 }
 
+// RequestRateLimit returns the maximum number of API requests per second the
+// CLI makes, or 0 when requests are not limited. This is based off of:
+//   1. The $CF_REQUEST_RATE_LIMIT environment variable if set
+//   2. The config file's RequestRateLimit value
+func (config *Config) RequestRateLimit() float64 {
+	if config.ENV.CFRequestRateLimit != "" {
+		envVal, err := strconv.ParseFloat(config.ENV.CFRequestRateLimit, 64)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+	if config.ConfigFile.RequestRateLimit < 0 {
+		return 0
+	}
+	return config.ConfigFile.RequestRateLimit
+}
+
 // SetAccessToken sets the current access token.
 func (config *Config) SetAccessToken(accessToken string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -136,6 +195,21 @@ func (config *Config) SetSpaceInformation(guid string, name string, allowSSH boo
 	config.ConfigFile.TargetedSpace.AllowSSH = allowSSH
 }
 
+// SetTLSCertificateFiles sets the CA bundle, client certificate and client key
+// files used when connecting to the targeted API endpoints.
+func (config *Config) SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) {
+	config.ConfigFile.CACertFile = caCertFile
+	config.ConfigFile.ClientCertFile = clientCertFile
+	config.ConfigFile.ClientKeyFile = clientKeyFile
+}
+
+// TLSCertificateFiles returns the CA bundle, client certificate and client key
+// files stored in the config file, ignoring the environment variables that
+// override them.
+func (config *Config) TLSCertificateFiles() (string, string, string) {
+	return config.ConfigFile.CACertFile, config.ConfigFile.ClientCertFile, config.ConfigFile.ClientKeyFile
+}
+
 // SetTargetInformation sets the currently targeted CC API and related other
 // related API URLs.
 func (config *Config) SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool) {
@@ -259,7 +333,9 @@ func decodeUserFromJWT(accessToken string) (User, error) {
 	} else {
 		ID = claims.Get("client_id").(string)
 	}
+	subject, _ := claims.Get("sub").(string)
 	return User{
 		Name: ID,
+		GUID: subject,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package configv3_test
 
 import (
+	"os"
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
@@ -62,6 +63,7 @@ var _ = Describe("JSONConfig", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(user).To(Equal(User{
 					Name: "potato-face",
+					GUID: "potato-face",
 				}))
 			})
 		})
@@ -78,6 +80,7 @@ var _ = Describe("JSONConfig", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(user).To(Equal(User{
 					Name: "admin",
+					GUID: "9519be3e-44d9-40d0-ab9a-f4ace11df159",
 				}))
 			})
 		})
@@ -286,6 +289,139 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("TLS certificate files", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"CACertFile":"/some/ca.crt",
+				"ClientCertFile":"/some/client.crt",
+				"ClientKeyFile":"/some/client.key"
+			}`
+			setConfig(homeDir, rawConfig)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+		})
+
+		It("returns fields directly from config", func() {
+			Expect(config.CACertFile()).To(Equal("/some/ca.crt"))
+			Expect(config.ClientCertFile()).To(Equal("/some/client.crt"))
+			Expect(config.ClientKeyFile()).To(Equal("/some/client.key"))
+		})
+
+		Context("when the environment variables are set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CA_CERT_FILE", "/env/ca.crt")).To(Succeed())
+				Expect(os.Setenv("CF_CLIENT_CERT_FILE", "/env/client.crt")).To(Succeed())
+				Expect(os.Setenv("CF_CLIENT_KEY_FILE", "/env/client.key")).To(Succeed())
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CA_CERT_FILE")).To(Succeed())
+				Expect(os.Unsetenv("CF_CLIENT_CERT_FILE")).To(Succeed())
+				Expect(os.Unsetenv("CF_CLIENT_KEY_FILE")).To(Succeed())
+			})
+
+			It("prefers the environment variables", func() {
+				Expect(config.CACertFile()).To(Equal("/env/ca.crt"))
+				Expect(config.ClientCertFile()).To(Equal("/env/client.crt"))
+				Expect(config.ClientKeyFile()).To(Equal("/env/client.key"))
+			})
+		})
+	})
+
+	Describe("RequestRateLimit", func() {
+		BeforeEach(func() {
+			rawConfig := `{ "RequestRateLimit":2.5 }`
+			setConfig(homeDir, rawConfig)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+		})
+
+		It("returns fields directly from config", func() {
+			Expect(config.RequestRateLimit()).To(Equal(2.5))
+		})
+
+		Context("when the CF_REQUEST_RATE_LIMIT environment variable is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_REQUEST_RATE_LIMIT", "10")).To(Succeed())
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_REQUEST_RATE_LIMIT")).To(Succeed())
+			})
+
+			It("prefers the environment variable", func() {
+				Expect(config.RequestRateLimit()).To(Equal(10.0))
+			})
+		})
+
+		Context("when the CF_REQUEST_RATE_LIMIT environment variable is invalid", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_REQUEST_RATE_LIMIT", "fast")).To(Succeed())
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_REQUEST_RATE_LIMIT")).To(Succeed())
+			})
+
+			It("falls back to the config", func() {
+				Expect(config.RequestRateLimit()).To(Equal(2.5))
+			})
+		})
+	})
+
+	Describe("SetTLSCertificateFiles", func() {
+		It("sets the TLS certificate files", func() {
+			config = new(Config)
+			config.SetTLSCertificateFiles("some-ca", "some-cert", "some-key")
+			Expect(config.ConfigFile.CACertFile).To(Equal("some-ca"))
+			Expect(config.ConfigFile.ClientCertFile).To(Equal("some-cert"))
+			Expect(config.ConfigFile.ClientKeyFile).To(Equal("some-key"))
+		})
+	})
+
+	Describe("TLSCertificateFiles", func() {
+		BeforeEach(func() {
+			rawConfig := `{ "CACertFile":"some-ca", "ClientCertFile":"some-cert", "ClientKeyFile":"some-key" }`
+			setConfig(homeDir, rawConfig)
+
+			Expect(os.Setenv("CF_CA_CERT_FILE", "env-ca")).ToNot(HaveOccurred())
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CA_CERT_FILE")).ToNot(HaveOccurred())
+		})
+
+		It("returns the files stored in the config file", func() {
+			caCertFile, clientCertFile, clientKeyFile := config.TLSCertificateFiles()
+			Expect(caCertFile).To(Equal("some-ca"))
+			Expect(clientCertFile).To(Equal("some-cert"))
+			Expect(clientKeyFile).To(Equal("some-key"))
+			Expect(config.CACertFile()).To(Equal("env-ca"))
+		})
+	})
+
 	Describe("SkipSSLValidation", func() {
 		BeforeEach(func() {
 			rawConfig := `{ "SSLDisabled":true }`
@@ -18,14 +18,14 @@ import (
 //
 // The '.cf' directory will be read in one of the following locations on UNIX
 // Systems:
-//   1. $CF_HOME/.cf if $CF_HOME is set
-//   2. $HOME/.cf as the default
+//  1. $CF_HOME/.cf if $CF_HOME is set
+//  2. $HOME/.cf as the default
 //
 // The '.cf' directory will be read in one of the following locations on
 // Windows Systems:
-//   1. CF_HOME\.cf if CF_HOME is set
-//   2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
-//   3. USERPROFILE\.cf as the default
+//  1. CF_HOME\.cf if CF_HOME is set
+//  2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
+//  3. USERPROFILE\.cf as the default
 func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	err := removeOldTempConfigFiles()
 	if err != nil {
@@ -78,22 +78,28 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:         filepath.Base(os.Args[0]),
+		CFCACertFile:       os.Getenv("CF_CA_CERT_FILE"),
+		CFClientCertFile:   os.Getenv("CF_CLIENT_CERT_FILE"),
+		CFClientKeyFile:    os.Getenv("CF_CLIENT_KEY_FILE"),
+		CFColor:            os.Getenv("CF_COLOR"),
+		CFDialTimeout:      os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:         os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:         os.Getenv("CF_PASSWORD"),
+		CFPluginHome:       os.Getenv("CF_PLUGIN_HOME"),
+		CFRequestRateLimit: os.Getenv("CF_REQUEST_RATE_LIMIT"),
+		CFResourceCache:    os.Getenv("CF_RESOURCE_CACHE"),
+		CFResponseCacheTTL: os.Getenv("CF_RESPONSE_CACHE_TTL"),
+		CFStagingTimeout:   os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:   os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:            os.Getenv("CF_TRACE"),
+		CFUsername:         os.Getenv("CF_USERNAME"),
+		DockerPassword:     os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:       os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:           os.Getenv("FORCE_TTY"),
+		HTTPSProxy:         os.Getenv("https_proxy"),
+		Lang:               os.Getenv("LANG"),
+		LCAll:              os.Getenv("LC_ALL"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -0,0 +1,250 @@
+// Package envfile reads and writes files of environment variables.
+//
+// Two formats are supported. Files with a .json extension, or whose content
+// starts with '{', contain a single JSON object of variable names to string,
+// number or boolean values. All other files are dotenv files: one NAME=VALUE
+// assignment per line, optionally prefixed with 'export'. Blank lines and
+// lines starting with '#' are skipped. Values may be wrapped in double
+// quotes, which support the escapes \n, \" and \\, or single quotes, which
+// are taken literally; a comment may follow the closing quote. Unquoted values
+// end at the first ' #'. When a variable is assigned more than once the last
+// value wins.
+package envfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	validName     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+	unquotedValue = regexp.MustCompile(`^[A-Za-z0-9_./:@,+%-]*$`)
+	quoteEscaper  = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+// ReadFile parses the environment variables in the file at path.
+func ReadFile(path string) (map[string]string, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(path, raw)
+}
+
+// Parse parses raw as the content of the file at source.
+func Parse(source string, raw []byte) (map[string]string, error) {
+	if isJSON(source, raw) {
+		return parseJSON(source, raw)
+	}
+	return parseDotenv(source, raw)
+}
+
+// WriteFile writes vars to the file at path, as JSON if path has a .json
+// extension and as a dotenv file otherwise. The file is only readable by the
+// current user because the values are often secrets.
+func WriteFile(path string, vars map[string]string) error {
+	raw, err := Marshal(path, vars)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// WriteValues is like WriteFile, but keeps numbers and booleans as JSON
+// values in JSON files. Other values that are not strings are written as
+// their JSON encoding.
+func WriteValues(path string, values map[string]interface{}) error {
+	raw, err := MarshalValues(path, values)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// Marshal returns vars in the format of the file at path, sorted by name.
+// Marshalled content can be read back with Parse.
+func Marshal(path string, vars map[string]string) ([]byte, error) {
+	values := make(map[string]interface{}, len(vars))
+	for name, value := range vars {
+		values[name] = value
+	}
+	return MarshalValues(path, values)
+}
+
+// MarshalValues is like Marshal, but keeps numbers and booleans as JSON values
+// in JSON files. Other values that are not strings are written as their JSON
+// encoding.
+func MarshalValues(path string, values map[string]interface{}) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	isJSONPath := strings.EqualFold(filepath.Ext(path), ".json")
+
+	scalars := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		switch value.(type) {
+		case string:
+			scalars[name] = value
+			continue
+		case bool, float64, json.Number:
+			if isJSONPath {
+				scalars[name] = value
+				continue
+			}
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		scalars[name] = string(raw)
+	}
+
+	if isJSONPath {
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(scalars)
+		return buffer.Bytes(), err
+	}
+
+	names := make([]string, 0, len(scalars))
+	for name := range scalars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := scalars[name].(string)
+		if !unquotedValue.MatchString(value) {
+			value = `"` + quoteEscaper.Replace(value) + `"`
+		}
+		fmt.Fprintf(buffer, "%s=%s\n", name, value)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func isJSON(source string, raw []byte) bool {
+	return strings.EqualFold(filepath.Ext(source), ".json") ||
+		bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{"))
+}
+
+func parseJSON(source string, raw []byte) (map[string]string, error) {
+	var values map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	err := decoder.Decode(&values)
+	if err != nil {
+		return nil, InvalidJSONError{Source: source, Reason: err.Error()}
+	}
+
+	vars := map[string]string{}
+	for name, value := range values {
+		switch v := value.(type) {
+		case string:
+			vars[name] = v
+		case json.Number:
+			vars[name] = v.String()
+		case bool:
+			vars[name] = strconv.FormatBool(v)
+		default:
+			return nil, InvalidValueError{Source: source, Name: name}
+		}
+	}
+
+	return vars, nil
+}
+
+func parseDotenv(source string, raw []byte) (map[string]string, error) {
+	vars := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "export ") {
+			line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		name := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || !validName.MatchString(name) {
+			return nil, InvalidLineError{Source: source, Line: lineNumber}
+		}
+
+		value, ok := parseDotenvValue(strings.TrimSpace(parts[1]))
+		if !ok {
+			return nil, InvalidLineError{Source: source, Line: lineNumber}
+		}
+		vars[name] = value
+	}
+
+	return vars, scanner.Err()
+}
+
+func parseDotenvValue(value string) (string, bool) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		return parseDoubleQuotedValue(value[1:])
+	case strings.HasPrefix(value, "'"):
+		end := strings.Index(value[1:], "'")
+		if end == -1 {
+			return "", false
+		}
+		return value[1 : end+1], isComment(value[end+2:])
+	}
+
+	if i := strings.Index(value, " #"); i != -1 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return value, true
+}
+
+// parseDoubleQuotedValue unescapes \n, \" and \\ in value up to the closing
+// double quote. Other backslashes are taken literally.
+func parseDoubleQuotedValue(value string) (string, bool) {
+	unquoted := new(bytes.Buffer)
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '"':
+			return unquoted.String(), isComment(value[i+1:])
+		case '\\':
+			if i+1 < len(value) {
+				switch value[i+1] {
+				case 'n':
+					unquoted.WriteByte('\n')
+					i++
+					continue
+				case '"', '\\':
+					unquoted.WriteByte(value[i+1])
+					i++
+					continue
+				}
+			}
+		}
+		unquoted.WriteByte(value[i])
+	}
+	return "", false
+}
+
+// isComment returns true if the text following a closing quote is empty or a
+// comment.
+func isComment(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	return rest == "" || strings.HasPrefix(rest, "#")
+}
@@ -0,0 +1,13 @@
+package envfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvFile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Env File Suite")
+}
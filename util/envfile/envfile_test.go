@@ -0,0 +1,181 @@
+package envfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/util/envfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("envfile", func() {
+	Describe("Parse", func() {
+		DescribeTable("dotenv files",
+			func(content string, expected map[string]string) {
+				vars, err := Parse(".env", []byte(content))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vars).To(Equal(expected))
+			},
+
+			Entry("assignments", "A=1\nB=two", map[string]string{"A": "1", "B": "two"}),
+			Entry("blank lines and comments", "\n# comment\nA=1\n\n", map[string]string{"A": "1"}),
+			Entry("export prefixes", "export A=1", map[string]string{"A": "1"}),
+			Entry("surrounding whitespace", "  A = 1  ", map[string]string{"A": "1"}),
+			Entry("empty values", "A=", map[string]string{"A": ""}),
+			Entry("equals signs in values", "A=b=c", map[string]string{"A": "b=c"}),
+			Entry("inline comments", "A=1 # comment", map[string]string{"A": "1"}),
+			Entry("double quoted values", `A="one # two\nthree"`, map[string]string{"A": "one # two\nthree"}),
+			Entry("single quoted values", `A='one\n "two"'`, map[string]string{"A": `one\n "two"`}),
+			Entry("comments after double quotes", `A="one two" # comment`, map[string]string{"A": "one two"}),
+			Entry("comments after single quotes", `A='one two' # comment`, map[string]string{"A": "one two"}),
+			Entry("escaped quotes and backslashes", `A="\"one\" \\ two"`, map[string]string{"A": `"one" \ two`}),
+			Entry("other backslashes in double quotes", `A="C:\temp\x41"`, map[string]string{"A": `C:\temp\x41`}),
+			Entry("repeated names", "A=1\nA=2", map[string]string{"A": "2"}),
+		)
+
+		DescribeTable("invalid dotenv lines",
+			func(content string, line int) {
+				_, err := Parse(".env", []byte(content))
+				Expect(err).To(MatchError(InvalidLineError{Source: ".env", Line: line}))
+			},
+
+			Entry("missing equals sign", "A=1\nB", 2),
+			Entry("missing name", "=1", 1),
+			Entry("invalid name", "A B=1", 1),
+			Entry("unterminated double quote", `A="1`, 1),
+			Entry("unterminated single quote", `A='1`, 1),
+			Entry("text after a double quote", `A="1" 2`, 1),
+			Entry("text after a single quote", `A='1' 2`, 1),
+		)
+
+		It("parses JSON objects by extension", func() {
+			vars, err := Parse("vars.json", []byte(`{"A": "1", "B": 2.50, "C": true}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(map[string]string{"A": "1", "B": "2.50", "C": "true"}))
+		})
+
+		It("parses JSON objects by content", func() {
+			vars, err := Parse("vars", []byte(`  {"A": "1"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(map[string]string{"A": "1"}))
+		})
+
+		It("returns an InvalidValueError for JSON values that are not scalars", func() {
+			_, err := Parse("vars.json", []byte(`{"A": {"b": "c"}}`))
+			Expect(err).To(MatchError(InvalidValueError{Source: "vars.json", Name: "A"}))
+		})
+
+		It("returns an InvalidJSONError for invalid JSON", func() {
+			_, err := Parse("vars.json", []byte(`["A"]`))
+			Expect(err).To(BeAssignableToTypeOf(InvalidJSONError{}))
+			Expect(err.(InvalidJSONError).Source).To(Equal("vars.json"))
+		})
+	})
+
+	Describe("Marshal", func() {
+		var vars map[string]string
+
+		BeforeEach(func() {
+			vars = map[string]string{
+				"B":     "plain-value",
+				"A":     "has spaces and \"quotes\"",
+				"EMPTY": "",
+			}
+		})
+
+		It("writes sorted dotenv assignments, quoting values when needed", func() {
+			raw, err := Marshal(".env", vars)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal("A=\"has spaces and \\\"quotes\\\"\"\nB=plain-value\nEMPTY=\n"))
+		})
+
+		It("writes JSON for .json paths", func() {
+			raw, err := Marshal("vars.json", vars)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{"A": "has spaces and \"quotes\"", "B": "plain-value", "EMPTY": ""}`))
+		})
+
+		DescribeTable("round trips through Parse",
+			func(path string) {
+				vars["MULTILINE"] = "line one\nline two # not a comment"
+				vars["BACKSLASHES"] = `C:\temp\n "quoted" \`
+				raw, err := Marshal(path, vars)
+				Expect(err).ToNot(HaveOccurred())
+
+				parsed, err := Parse(path, raw)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed).To(Equal(vars))
+			},
+
+			Entry("dotenv", ".env"),
+			Entry("JSON", "vars.json"),
+		)
+	})
+
+	Describe("MarshalValues", func() {
+		var values map[string]interface{}
+
+		BeforeEach(func() {
+			values = map[string]interface{}{
+				"STRING": "some-value",
+				"NUMBER": float64(1.5),
+				"BOOL":   false,
+				"OBJECT": map[string]interface{}{"a": "b"},
+			}
+		})
+
+		It("keeps numbers and booleans as JSON values for .json paths", func() {
+			raw, err := MarshalValues("vars.json", values)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{"STRING": "some-value", "NUMBER": 1.5, "BOOL": false, "OBJECT": "{\"a\":\"b\"}"}`))
+
+			parsed, err := Parse("vars.json", raw)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(map[string]string{"STRING": "some-value", "NUMBER": "1.5", "BOOL": "false", "OBJECT": `{"a":"b"}`}))
+		})
+
+		It("writes values that are not strings as JSON for dotenv paths", func() {
+			raw, err := MarshalValues(".env", values)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal("BOOL=false\nNUMBER=1.5\nOBJECT=\"{\\\"a\\\":\\\"b\\\"}\"\nSTRING=some-value\n"))
+		})
+	})
+
+	Describe("WriteFile and ReadFile", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "envfile")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes a file only the user can read and reads it back", func() {
+			path := filepath.Join(dir, ".env")
+			Expect(WriteFile(path, map[string]string{"A": "1"})).To(Succeed())
+
+			if runtime.GOOS != "windows" {
+				info, err := os.Stat(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			}
+
+			vars, err := ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(map[string]string{"A": "1"}))
+		})
+
+		It("returns an error when the file does not exist", func() {
+			_, err := ReadFile(filepath.Join(dir, "missing"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package envfile
+
+import "fmt"
+
+// InvalidLineError is returned when a line of a dotenv file is not a
+// NAME=VALUE assignment.
+type InvalidLineError struct {
+	Source string
+	Line   int
+}
+
+func (e InvalidLineError) Error() string {
+	return fmt.Sprintf("invalid environment variable assignment at %s:%d", e.Source, e.Line)
+}
+
+// InvalidValueError is returned when the value of a variable in a JSON file is
+// not a string, number or boolean.
+type InvalidValueError struct {
+	Source string
+	Name   string
+}
+
+func (e InvalidValueError) Error() string {
+	return fmt.Sprintf("value of %s in %s must be a string, number or boolean", e.Name, e.Source)
+}
+
+// InvalidJSONError is returned when a JSON file is not a single object.
+type InvalidJSONError struct {
+	Source string
+	Reason string
+}
+
+func (e InvalidJSONError) Error() string {
+	return fmt.Sprintf("invalid JSON in %s: %s", e.Source, e.Reason)
+}
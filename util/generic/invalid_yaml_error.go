@@ -0,0 +1,15 @@
+package generic
+
+import "fmt"
+
+// InvalidYAMLError is returned when a declarative configuration file cannot
+// be parsed as YAML or JSON. File describes the kind of file, for example
+// "org config file".
+type InvalidYAMLError struct {
+	File string
+	Err  error
+}
+
+func (e InvalidYAMLError) Error() string {
+	return fmt.Sprintf("The %s must be valid YAML or JSON. %s", e.File, e.Err)
+}
@@ -0,0 +1,23 @@
+package jsonfilter
+
+import "fmt"
+
+// InvalidFilterError is returned when a filter expression cannot be parsed.
+type InvalidFilterError struct {
+	Filter string
+	Reason string
+}
+
+func (e InvalidFilterError) Error() string {
+	return fmt.Sprintf("invalid filter '%s': %s", e.Filter, e.Reason)
+}
+
+// EvaluationError is returned when a filter cannot be applied to a document.
+type EvaluationError struct {
+	Filter string
+	Reason string
+}
+
+func (e EvaluationError) Error() string {
+	return fmt.Sprintf("cannot apply filter '%s': %s", e.Filter, e.Reason)
+}
@@ -0,0 +1,329 @@
+// Package jsonfilter extracts values from JSON documents using a subset of
+// the jq path syntax.
+//
+// Supported expressions are the identity filter '.', object fields ('.name',
+// '.["name"]'), array indices ('.[0]', '.[-1]'), iteration over arrays and
+// objects ('.[]') and pipes between paths ('.resources[] | .name').
+package jsonfilter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	fieldStep stepKind = iota
+	indexStep
+	iterateStep
+)
+
+type step struct {
+	kind  stepKind
+	field string
+	index int
+}
+
+// Filter is a parsed filter expression.
+type Filter struct {
+	expression string
+	steps      []step
+}
+
+// Parse parses the provided filter expression.
+func Parse(expression string) (Filter, error) {
+	p := parser{expression: expression}
+	steps, err := p.parse()
+	if err != nil {
+		return Filter{}, err
+	}
+	return Filter{expression: expression, steps: steps}, nil
+}
+
+// String returns the original filter expression.
+func (f Filter) String() string {
+	return f.expression
+}
+
+// ApplyJSON decodes the raw JSON document and applies the filter to it.
+// Numbers are decoded as json.Number to preserve their precision.
+func (f Filter) ApplyJSON(raw []byte) ([]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, EvaluationError{Filter: f.expression, Reason: "input is not valid JSON"}
+	}
+
+	return f.Apply(document)
+}
+
+// Apply applies the filter to a decoded JSON document and returns every
+// value it produces.
+func (f Filter) Apply(document interface{}) ([]interface{}, error) {
+	values := []interface{}{document}
+	for _, s := range f.steps {
+		var next []interface{}
+		for _, value := range values {
+			results, err := f.applyStep(s, value)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, results...)
+		}
+		values = next
+	}
+	return values, nil
+}
+
+func (f Filter) applyStep(s step, value interface{}) ([]interface{}, error) {
+	switch s.kind {
+	case fieldStep:
+		switch typed := value.(type) {
+		case nil:
+			return []interface{}{nil}, nil
+		case map[string]interface{}:
+			return []interface{}{typed[s.field]}, nil
+		default:
+			return nil, f.evaluationError("cannot index %s with \"%s\"", typeName(value), s.field)
+		}
+	case indexStep:
+		switch typed := value.(type) {
+		case nil:
+			return []interface{}{nil}, nil
+		case []interface{}:
+			i := s.index
+			if i < 0 {
+				i += len(typed)
+			}
+			if i < 0 || i >= len(typed) {
+				return []interface{}{nil}, nil
+			}
+			return []interface{}{typed[i]}, nil
+		default:
+			return nil, f.evaluationError("cannot index %s with number", typeName(value))
+		}
+	default:
+		switch typed := value.(type) {
+		case []interface{}:
+			return typed, nil
+		case map[string]interface{}:
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			results := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				results = append(results, typed[key])
+			}
+			return results, nil
+		default:
+			return nil, f.evaluationError("cannot iterate over %s", typeName(value))
+		}
+	}
+}
+
+func (f Filter) evaluationError(format string, args ...interface{}) error {
+	return EvaluationError{Filter: f.expression, Reason: fmt.Sprintf(format, args...)}
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number, float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+type parser struct {
+	expression string
+	pos        int
+}
+
+func (p *parser) parse() ([]step, error) {
+	var steps []step
+
+	p.skipSpaces()
+	if p.done() {
+		return nil, p.errorf("filter is empty")
+	}
+
+	for {
+		p.skipSpaces()
+		if p.peek() != '.' {
+			return nil, p.errorf("expected '.' at position %d", p.pos+1)
+		}
+		p.pos++
+
+		pathSteps, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, pathSteps...)
+
+		p.skipSpaces()
+		if p.done() {
+			return steps, nil
+		}
+		if p.peek() != '|' {
+			return nil, p.errorf("unexpected '%c' at position %d", p.peek(), p.pos+1)
+		}
+		p.pos++
+	}
+}
+
+// parsePath parses the remainder of a path after its leading '.'.
+func (p *parser) parsePath() ([]step, error) {
+	var steps []step
+
+	if isIdentifierStart(p.peek()) {
+		steps = append(steps, step{kind: fieldStep, field: p.parseIdentifier()})
+	} else if p.peek() == '"' {
+		field, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step{kind: fieldStep, field: field})
+	}
+
+	for !p.done() {
+		switch p.peek() {
+		case '.':
+			p.pos++
+			if isIdentifierStart(p.peek()) {
+				steps = append(steps, step{kind: fieldStep, field: p.parseIdentifier()})
+			} else if p.peek() == '"' {
+				field, err := p.parseString()
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, step{kind: fieldStep, field: field})
+			} else if p.peek() != '[' {
+				return nil, p.errorf("expected a field name at position %d", p.pos+1)
+			}
+		case '[':
+			bracketStep, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, bracketStep)
+		default:
+			return steps, nil
+		}
+	}
+
+	return steps, nil
+}
+
+func (p *parser) parseBracket() (step, error) {
+	start := p.pos
+	p.pos++
+	p.skipSpaces()
+
+	var s step
+	switch {
+	case p.peek() == ']':
+		s = step{kind: iterateStep}
+	case p.peek() == '"':
+		field, err := p.parseString()
+		if err != nil {
+			return step{}, err
+		}
+		s = step{kind: fieldStep, field: field}
+	default:
+		numberStart := p.pos
+		if p.peek() == '-' {
+			p.pos++
+		}
+		for !p.done() && p.peek() >= '0' && p.peek() <= '9' {
+			p.pos++
+		}
+		index, err := strconv.Atoi(p.expression[numberStart:p.pos])
+		if err != nil {
+			return step{}, p.errorf("expected an index, a quoted field name or ']' at position %d", numberStart+1)
+		}
+		s = step{kind: indexStep, index: index}
+	}
+
+	p.skipSpaces()
+	if p.peek() != ']' {
+		return step{}, p.errorf("unterminated '[' at position %d", start+1)
+	}
+	p.pos++
+	return s, nil
+}
+
+func (p *parser) parseIdentifier() string {
+	start := p.pos
+	for !p.done() && isIdentifierPart(p.peek()) {
+		p.pos++
+	}
+	return p.expression[start:p.pos]
+}
+
+func (p *parser) parseString() (string, error) {
+	start := p.pos
+	p.pos++
+	for !p.done() {
+		switch p.peek() {
+		case '\\':
+			p.pos += 2
+		case '"':
+			p.pos++
+			value, err := strconv.Unquote(p.expression[start:p.pos])
+			if err != nil {
+				return "", p.errorf("invalid string at position %d", start+1)
+			}
+			return value, nil
+		default:
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated string at position %d", start+1)
+}
+
+func (p *parser) skipSpaces() {
+	for !p.done() && strings.ContainsRune(" \t\n", rune(p.peek())) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.expression[p.pos]
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.expression)
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return InvalidFilterError{Filter: p.expression, Reason: fmt.Sprintf(format, args...)}
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
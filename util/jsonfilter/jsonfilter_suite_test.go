@@ -0,0 +1,13 @@
+package jsonfilter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestJSONFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "JSON Filter Suite")
+}
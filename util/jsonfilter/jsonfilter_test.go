@@ -0,0 +1,92 @@
+package jsonfilter_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/jsonfilter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filter", func() {
+	const document = `{
+		"pagination": {"total_results": 2, "next": null},
+		"resources": [
+			{"guid": "guid-1", "name": "app-1", "lifecycle": {"type": "buildpack"}},
+			{"guid": "guid-2", "name": "app-2", "lifecycle": {"type": "docker"}}
+		],
+		"some key": "some-value"
+	}`
+
+	DescribeTable("ApplyJSON",
+		func(expression string, expectedValues []interface{}) {
+			filter, err := Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+
+			values, err := filter.ApplyJSON([]byte(document))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal(expectedValues))
+		},
+
+		Entry("identity", ".", []interface{}{map[string]interface{}{
+			"pagination": map[string]interface{}{"total_results": json.Number("2"), "next": nil},
+			"resources": []interface{}{
+				map[string]interface{}{"guid": "guid-1", "name": "app-1", "lifecycle": map[string]interface{}{"type": "buildpack"}},
+				map[string]interface{}{"guid": "guid-2", "name": "app-2", "lifecycle": map[string]interface{}{"type": "docker"}},
+			},
+			"some key": "some-value",
+		}}),
+		Entry("nested fields", ".pagination.total_results", []interface{}{json.Number("2")}),
+		Entry("null fields", ".pagination.next", []interface{}{nil}),
+		Entry("missing fields", ".missing.field", []interface{}{nil}),
+		Entry("quoted fields", `.["some key"]`, []interface{}{"some-value"}),
+		Entry("array index", ".resources[1].name", []interface{}{"app-2"}),
+		Entry("negative array index", ".resources[-1].guid", []interface{}{"guid-2"}),
+		Entry("out of range array index", ".resources[5]", []interface{}{nil}),
+		Entry("iteration", ".resources[].name", []interface{}{"app-1", "app-2"}),
+		Entry("iteration followed by nested fields", ".resources[].lifecycle.type", []interface{}{"buildpack", "docker"}),
+		Entry("object iteration", ".resources[0].lifecycle[]", []interface{}{"buildpack"}),
+		Entry("pipes", ".resources[] | .guid", []interface{}{"guid-1", "guid-2"}),
+	)
+
+	DescribeTable("Parse errors",
+		func(expression string, reason string) {
+			_, err := Parse(expression)
+			Expect(err).To(MatchError(InvalidFilterError{Filter: expression, Reason: reason}))
+		},
+
+		Entry("empty filter", "", "filter is empty"),
+		Entry("missing leading dot", "resources", "expected '.' at position 1"),
+		Entry("trailing dot", ".resources.", "expected a field name at position 12"),
+		Entry("unterminated bracket", ".resources[0", "unterminated '[' at position 11"),
+		Entry("invalid index", ".resources[a]", "expected an index, a quoted field name or ']' at position 12"),
+		Entry("unterminated string", `.["name`, "unterminated string at position 3"),
+		Entry("unexpected characters", ".resources name", "unexpected 'n' at position 12"),
+		Entry("pipe without a path", ".resources |", "expected '.' at position 13"),
+	)
+
+	DescribeTable("evaluation errors",
+		func(expression string, reason string) {
+			filter, err := Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = filter.ApplyJSON([]byte(document))
+			Expect(err).To(MatchError(EvaluationError{Filter: expression, Reason: reason}))
+		},
+
+		Entry("field of an array", ".resources.name", `cannot index array with "name"`),
+		Entry("index of an object", ".pagination[0]", "cannot index object with number"),
+		Entry("iteration over a string", ".resources[0].name[]", "cannot iterate over string"),
+	)
+
+	Context("when the input is not JSON", func() {
+		It("returns an EvaluationError", func() {
+			filter, err := Parse(".name")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = filter.ApplyJSON([]byte("<html></html>"))
+			Expect(err).To(MatchError(EvaluationError{Filter: ".name", Reason: "input is not valid JSON"}))
+		})
+	})
+})
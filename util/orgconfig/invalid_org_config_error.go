@@ -0,0 +1,11 @@
+package orgconfig
+
+import "fmt"
+
+type InvalidOrgConfigError struct {
+	Message string
+}
+
+func (e InvalidOrgConfigError) Error() string {
+	return fmt.Sprintf("Invalid org config file: %s", e.Message)
+}
@@ -0,0 +1,104 @@
+// Package orgconfig reads declarative descriptions of organizations, their
+// spaces, quotas, isolation segments and user roles.
+package orgconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Organization is the desired state of a single organization.
+type Organization struct {
+	Name                    string   `yaml:"name"`
+	Quota                   string   `yaml:"quota"`
+	IsolationSegments       []string `yaml:"isolation_segments"`
+	DefaultIsolationSegment string   `yaml:"default_isolation_segment"`
+	Managers                []string `yaml:"managers"`
+	BillingManagers         []string `yaml:"billing_managers"`
+	Auditors                []string `yaml:"auditors"`
+	Spaces                  []Space  `yaml:"spaces"`
+}
+
+// Space is the desired state of a single space of an organization.
+type Space struct {
+	Name             string   `yaml:"name"`
+	Quota            string   `yaml:"quota"`
+	IsolationSegment string   `yaml:"isolation_segment"`
+	Managers         []string `yaml:"managers"`
+	Developers       []string `yaml:"developers"`
+	Auditors         []string `yaml:"auditors"`
+}
+
+// ReadOrganizations reads and validates the YAML or JSON document at the
+// provided path. The document must contain a top level 'orgs' list.
+func ReadOrganizations(pathToFile string) ([]Organization, error) {
+	raw, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var document struct {
+		Organizations []Organization `yaml:"orgs"`
+	}
+	err = yaml.Unmarshal(raw, &document)
+	if err != nil {
+		return nil, generic.InvalidYAMLError{File: "org config file", Err: err}
+	}
+
+	err = validate(document.Organizations)
+	if err != nil {
+		return nil, err
+	}
+
+	return document.Organizations, nil
+}
+
+func validate(orgs []Organization) error {
+	if len(orgs) == 0 {
+		return InvalidOrgConfigError{Message: "must have at least one org"}
+	}
+
+	orgNames := map[string]bool{}
+	for i, org := range orgs {
+		if org.Name == "" {
+			return InvalidOrgConfigError{Message: fmt.Sprintf("org %d has no name", i+1)}
+		}
+		if orgNames[org.Name] {
+			return InvalidOrgConfigError{Message: fmt.Sprintf("org %s is defined more than once", org.Name)}
+		}
+		orgNames[org.Name] = true
+
+		if org.DefaultIsolationSegment != "" && !contains(org.IsolationSegments, org.DefaultIsolationSegment) {
+			return InvalidOrgConfigError{Message: fmt.Sprintf("default isolation segment %s of org %s must be listed in its isolation segments", org.DefaultIsolationSegment, org.Name)}
+		}
+
+		spaceNames := map[string]bool{}
+		for j, space := range org.Spaces {
+			if space.Name == "" {
+				return InvalidOrgConfigError{Message: fmt.Sprintf("space %d of org %s has no name", j+1, org.Name)}
+			}
+			if spaceNames[space.Name] {
+				return InvalidOrgConfigError{Message: fmt.Sprintf("space %s of org %s is defined more than once", space.Name, org.Name)}
+			}
+			spaceNames[space.Name] = true
+
+			if space.IsolationSegment != "" && !contains(org.IsolationSegments, space.IsolationSegment) {
+				return InvalidOrgConfigError{Message: fmt.Sprintf("isolation segment %s of space %s must be listed in the isolation segments of org %s", space.IsolationSegment, space.Name, org.Name)}
+			}
+		}
+	}
+
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package orgconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	. "code.cloudfoundry.org/cli/util/orgconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadOrganizations", func() {
+	var (
+		pathToFile string
+		document   string
+		orgs       []Organization
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		tempFile, err := ioutil.TempFile("", "org-config-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToFile = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToFile)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(pathToFile, []byte(document), 0666)).To(Succeed())
+		orgs, executeErr = ReadOrganizations(pathToFile)
+	})
+
+	Context("when the document is valid YAML", func() {
+		BeforeEach(func() {
+			document = `---
+orgs:
+- name: org-1
+  quota: default
+  isolation_segments: [iso-1, iso-2]
+  default_isolation_segment: iso-1
+  managers: [alice]
+  billing_managers: [bob]
+  auditors: [carol]
+  spaces:
+  - name: space-1
+    quota: small
+    isolation_segment: iso-2
+    managers: [alice]
+    developers: [dave, erin]
+    auditors: [carol]
+- name: org-2
+`
+		})
+
+		It("returns the orgs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(orgs).To(Equal([]Organization{
+				{
+					Name:                    "org-1",
+					Quota:                   "default",
+					IsolationSegments:       []string{"iso-1", "iso-2"},
+					DefaultIsolationSegment: "iso-1",
+					Managers:                []string{"alice"},
+					BillingManagers:         []string{"bob"},
+					Auditors:                []string{"carol"},
+					Spaces: []Space{
+						{
+							Name:             "space-1",
+							Quota:            "small",
+							IsolationSegment: "iso-2",
+							Managers:         []string{"alice"},
+							Developers:       []string{"dave", "erin"},
+							Auditors:         []string{"carol"},
+						},
+					},
+				},
+				{Name: "org-2"},
+			}))
+		})
+	})
+
+	Context("when the document is valid JSON", func() {
+		BeforeEach(func() {
+			document = `{"orgs": [{"name": "org-1", "spaces": [{"name": "space-1", "developers": ["dave"]}]}]}`
+		})
+
+		It("returns the orgs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(orgs).To(Equal([]Organization{
+				{
+					Name:   "org-1",
+					Spaces: []Space{{Name: "space-1", Developers: []string{"dave"}}},
+				},
+			}))
+		})
+	})
+
+	Context("when the document is not valid YAML", func() {
+		BeforeEach(func() {
+			document = "orgs: [}"
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(generic.InvalidYAMLError{}))
+			Expect(executeErr.Error()).To(HavePrefix("The org config file must be valid YAML or JSON."))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		JustBeforeEach(func() {
+			Expect(os.RemoveAll(pathToFile)).ToNot(HaveOccurred())
+			orgs, executeErr = ReadOrganizations(pathToFile)
+		})
+
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	DescribeInvalidDocument := func(description string, invalidDocument string, message string) {
+		Context(description, func() {
+			BeforeEach(func() {
+				document = invalidDocument
+			})
+
+			It("returns an InvalidOrgConfigError", func() {
+				Expect(executeErr).To(MatchError(InvalidOrgConfigError{Message: message}))
+			})
+		})
+	}
+
+	DescribeInvalidDocument("when there are no orgs",
+		"orgs: []",
+		"must have at least one org")
+
+	DescribeInvalidDocument("when an org has no name",
+		"orgs:\n- quota: default",
+		"org 1 has no name")
+
+	DescribeInvalidDocument("when an org is defined twice",
+		"orgs:\n- name: org-1\n- name: org-1",
+		"org org-1 is defined more than once")
+
+	DescribeInvalidDocument("when the default isolation segment is not listed",
+		"orgs:\n- name: org-1\n  default_isolation_segment: iso-1",
+		"default isolation segment iso-1 of org org-1 must be listed in its isolation segments")
+
+	DescribeInvalidDocument("when a space has no name",
+		"orgs:\n- name: org-1\n  spaces:\n  - quota: small",
+		"space 1 of org org-1 has no name")
+
+	DescribeInvalidDocument("when a space is defined twice",
+		"orgs:\n- name: org-1\n  spaces:\n  - name: space-1\n  - name: space-1",
+		"space space-1 of org org-1 is defined more than once")
+
+	DescribeInvalidDocument("when the isolation segment of a space is not listed",
+		"orgs:\n- name: org-1\n  isolation_segments: [iso-1]\n  spaces:\n  - name: space-1\n    isolation_segment: iso-2",
+		"isolation segment iso-2 of space space-1 must be listed in the isolation segments of org org-1")
+})
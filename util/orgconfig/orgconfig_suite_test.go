@@ -0,0 +1,13 @@
+package orgconfig_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestOrgConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Org Config Suite")
+}
@@ -15,7 +15,9 @@ type ProgressBar struct {
 
 func NewProgressBar() *ProgressBar {
 	return &ProgressBar{
-		ready: make(chan bool),
+		// Buffered so Ready can be called before the upload that waits on it
+		// starts.
+		ready: make(chan bool, 1),
 	}
 }
 
@@ -0,0 +1,83 @@
+package resourcecache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileHashExpiration is how long an unused file hash is kept.
+const FileHashExpiration = 30 * 24 * time.Hour
+
+type fileHashEntry struct {
+	SHA1     string    `json:"sha1"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// FileHashes caches the SHA1s of local files. Entries are keyed by the
+// file's absolute path, modification time and size, so a file is rehashed as
+// soon as any of them change.
+type FileHashes struct {
+	path    string
+	entries map[string]fileHashEntry
+	hits    int
+}
+
+// LoadFileHashes loads the file hash cache stored in dir.
+func LoadFileHashes(dir string) *FileHashes {
+	cache := &FileHashes{
+		path:    filepath.Join(dir, "file_hashes.json"),
+		entries: map[string]fileHashEntry{},
+	}
+	load(cache.path, &cache.entries)
+	return cache
+}
+
+// Get returns the cached SHA1 of the file at path, if the file has not
+// changed since it was cached.
+func (cache *FileHashes) Get(path string, info os.FileInfo) (string, bool) {
+	key := fileHashKey(path, info)
+	entry, ok := cache.entries[key]
+	if !ok {
+		return "", false
+	}
+
+	entry.LastUsed = time.Now()
+	cache.entries[key] = entry
+	cache.hits++
+	return entry.SHA1, true
+}
+
+// Set caches the SHA1 of the file at path.
+func (cache *FileHashes) Set(path string, info os.FileInfo, sha1 string) {
+	cache.entries[fileHashKey(path, info)] = fileHashEntry{
+		SHA1:     sha1,
+		LastUsed: time.Now(),
+	}
+}
+
+// Hits returns the number of hashes served from the cache since it was
+// loaded.
+func (cache *FileHashes) Hits() int {
+	return cache.hits
+}
+
+// Save drops expired entries and writes the cache to disk.
+func (cache *FileHashes) Save() error {
+	now := time.Now()
+	for key, entry := range cache.entries {
+		if expired(entry.LastUsed, now, FileHashExpiration) {
+			delete(cache.entries, key)
+		}
+	}
+
+	return save(cache.path, cache.entries)
+}
+
+func fileHashKey(path string, info os.FileInfo) string {
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+	return fmt.Sprintf("%s|%d|%d", path, info.ModTime().UnixNano(), info.Size())
+}
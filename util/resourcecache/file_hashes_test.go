@@ -0,0 +1,104 @@
+package resourcecache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/resourcecache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileHashes", func() {
+	var (
+		cacheDir string
+		filePath string
+		info     os.FileInfo
+	)
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		filePath = filepath.Join(cacheDir, "some-file")
+		Expect(ioutil.WriteFile(filePath, []byte("some-content"), 0600)).To(Succeed())
+		info, err = os.Stat(filePath)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("returns cached hashes across loads", func() {
+		cache := LoadFileHashes(cacheDir)
+		_, ok := cache.Get(filePath, info)
+		Expect(ok).To(BeFalse())
+
+		cache.Set(filePath, info, "some-sha")
+		Expect(cache.Save()).To(Succeed())
+
+		cache = LoadFileHashes(cacheDir)
+		sha1, ok := cache.Get(filePath, info)
+		Expect(ok).To(BeTrue())
+		Expect(sha1).To(Equal("some-sha"))
+		Expect(cache.Hits()).To(Equal(1))
+	})
+
+	Context("when the file's modification time changes", func() {
+		It("misses", func() {
+			cache := LoadFileHashes(cacheDir)
+			cache.Set(filePath, info, "some-sha")
+
+			later := info.ModTime().Add(time.Minute)
+			Expect(os.Chtimes(filePath, later, later)).To(Succeed())
+			newInfo, err := os.Stat(filePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, ok := cache.Get(filePath, newInfo)
+			Expect(ok).To(BeFalse())
+			Expect(cache.Hits()).To(BeZero())
+		})
+	})
+
+	Context("when the file's size changes", func() {
+		It("misses", func() {
+			cache := LoadFileHashes(cacheDir)
+			cache.Set(filePath, info, "some-sha")
+
+			Expect(ioutil.WriteFile(filePath, []byte("some-other-content"), 0600)).To(Succeed())
+			Expect(os.Chtimes(filePath, info.ModTime(), info.ModTime())).To(Succeed())
+			newInfo, err := os.Stat(filePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, ok := cache.Get(filePath, newInfo)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when the cache file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filepath.Join(cacheDir, "file_hashes.json"), []byte("not-json"), 0600)).To(Succeed())
+		})
+
+		It("treats the cache as empty", func() {
+			cache := LoadFileHashes(cacheDir)
+			_, ok := cache.Get(filePath, info)
+			Expect(ok).To(BeFalse())
+			Expect(cache.Save()).To(Succeed())
+		})
+	})
+
+	Context("when the cache directory does not exist", func() {
+		It("creates it when saving", func() {
+			dir := filepath.Join(cacheDir, "some", "dir")
+			cache := LoadFileHashes(dir)
+			cache.Set(filePath, info, "some-sha")
+			Expect(cache.Save()).To(Succeed())
+			Expect(filepath.Join(dir, "file_hashes.json")).To(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package resourcecache
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// RemoteResourceExpiration is how long a resource is assumed to remain in a
+// Cloud Controller's resource pool after it was last matched or uploaded.
+const RemoteResourceExpiration = 7 * 24 * time.Hour
+
+// RemoteResources caches the SHA1s of the resources a single Cloud
+// Controller is known to have, so they do not need to be matched again.
+type RemoteResources struct {
+	path    string
+	entries map[string]time.Time
+}
+
+// LoadRemoteResources loads the cache of resources known to the Cloud
+// Controller at apiURL from dir.
+func LoadRemoteResources(dir string, apiURL string) *RemoteResources {
+	cache := &RemoteResources{
+		path:    filepath.Join(dir, fmt.Sprintf("remote_%x.json", sha256.Sum256([]byte(apiURL)))),
+		entries: map[string]time.Time{},
+	}
+	load(cache.path, &cache.entries)
+	return cache
+}
+
+// Contains returns true if the Cloud Controller is known to have the resource
+// with the provided SHA1.
+func (cache *RemoteResources) Contains(sha1 string) bool {
+	lastSeen, ok := cache.entries[sha1]
+	return ok && !expired(lastSeen, time.Now(), RemoteResourceExpiration)
+}
+
+// Add records that the Cloud Controller has the resources with the provided
+// SHA1s.
+func (cache *RemoteResources) Add(sha1s ...string) {
+	now := time.Now()
+	for _, sha1 := range sha1s {
+		cache.entries[sha1] = now
+	}
+}
+
+// Clear forgets every resource.
+func (cache *RemoteResources) Clear() {
+	cache.entries = map[string]time.Time{}
+}
+
+// Save drops expired entries and writes the cache to disk.
+func (cache *RemoteResources) Save() error {
+	now := time.Now()
+	for sha1, lastSeen := range cache.entries {
+		if expired(lastSeen, now, RemoteResourceExpiration) {
+			delete(cache.entries, sha1)
+		}
+	}
+
+	return save(cache.path, cache.entries)
+}
@@ -0,0 +1,59 @@
+package resourcecache_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/resourcecache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RemoteResources", func() {
+	var cacheDir string
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("remembers added resources across loads", func() {
+		cache := LoadRemoteResources(cacheDir, "https://api.example.com")
+		Expect(cache.Contains("sha-1")).To(BeFalse())
+
+		cache.Add("sha-1", "sha-2")
+		Expect(cache.Save()).To(Succeed())
+
+		cache = LoadRemoteResources(cacheDir, "https://api.example.com")
+		Expect(cache.Contains("sha-1")).To(BeTrue())
+		Expect(cache.Contains("sha-2")).To(BeTrue())
+		Expect(cache.Contains("sha-3")).To(BeFalse())
+	})
+
+	It("keeps the resources of each API separately", func() {
+		cache := LoadRemoteResources(cacheDir, "https://api.example.com")
+		cache.Add("sha-1")
+		Expect(cache.Save()).To(Succeed())
+
+		otherCache := LoadRemoteResources(cacheDir, "https://api.other.example.com")
+		Expect(otherCache.Contains("sha-1")).To(BeFalse())
+	})
+
+	Describe("Clear", func() {
+		It("forgets every resource", func() {
+			cache := LoadRemoteResources(cacheDir, "https://api.example.com")
+			cache.Add("sha-1")
+			cache.Clear()
+			Expect(cache.Contains("sha-1")).To(BeFalse())
+
+			Expect(cache.Save()).To(Succeed())
+			cache = LoadRemoteResources(cacheDir, "https://api.example.com")
+			Expect(cache.Contains("sha-1")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,59 @@
+// Package resourcecache persists information about pushed files between
+// pushes: the SHA1s of local files and the resources each Cloud Controller is
+// known to already have.
+//
+// Caches are best effort. A cache file that cannot be read is treated as
+// empty, and entries that have not been used for a while are dropped when the
+// cache is saved.
+package resourcecache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func load(path string, entries interface{}) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(raw, entries)
+}
+
+// save writes the entries to a temporary file and renames it, so concurrent
+// pushes never read a partially written cache.
+func save(path string, entries interface{}) error {
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+func expired(lastUsed time.Time, now time.Time, expiration time.Duration) bool {
+	return now.Sub(lastUsed) > expiration
+}
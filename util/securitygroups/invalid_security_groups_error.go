@@ -0,0 +1,11 @@
+package securitygroups
+
+import "fmt"
+
+type InvalidSecurityGroupsError struct {
+	Message string
+}
+
+func (e InvalidSecurityGroupsError) Error() string {
+	return fmt.Sprintf("Invalid security groups file: %s", e.Message)
+}
@@ -0,0 +1,181 @@
+package securitygroups
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Protocols supported by security group rules.
+const (
+	ProtocolAll  = "all"
+	ProtocolICMP = "icmp"
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+)
+
+// IPRange is an inclusive range of IPv4 addresses.
+type IPRange struct {
+	Start uint32
+	End   uint32
+}
+
+// Contains returns true when the provided IPv4 address is in the range.
+func (r IPRange) Contains(ip net.IP) bool {
+	ipv4 := ip.To4()
+	if ipv4 == nil {
+		return false
+	}
+	value := binary.BigEndian.Uint32(ipv4)
+	return r.Start <= value && value <= r.End
+}
+
+// Overlaps returns true when the two ranges share at least one address.
+func (r IPRange) Overlaps(other IPRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// IsEverything returns true when the range covers all IPv4 addresses.
+func (r IPRange) IsEverything() bool {
+	return r.Start == 0 && r.End == ^uint32(0)
+}
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Contains returns true when the provided port is in the range.
+func (r PortRange) Contains(port int) bool {
+	return r.Start <= port && port <= r.End
+}
+
+// Overlaps returns true when the two ranges share at least one port.
+func (r PortRange) Overlaps(other PortRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// ParseDestination parses a rule destination, which is either a single IPv4
+// address, a CIDR or a range of addresses separated by a dash.
+func ParseDestination(destination string) (IPRange, error) {
+	destination = strings.TrimSpace(destination)
+
+	if strings.Contains(destination, "/") {
+		_, network, err := net.ParseCIDR(destination)
+		if err != nil || network.IP.To4() == nil {
+			return IPRange{}, fmt.Errorf("destination %q is not a valid IPv4 CIDR", destination)
+		}
+		start := binary.BigEndian.Uint32(network.IP.To4())
+		ones, _ := network.Mask.Size()
+		return IPRange{Start: start, End: start | (^uint32(0) >> uint(ones))}, nil
+	}
+
+	if parts := strings.Split(destination, "-"); len(parts) == 2 {
+		start, err := parseIPv4(parts[0])
+		if err != nil {
+			return IPRange{}, err
+		}
+		end, err := parseIPv4(parts[1])
+		if err != nil {
+			return IPRange{}, err
+		}
+		if start > end {
+			return IPRange{}, fmt.Errorf("destination %q starts after it ends", destination)
+		}
+		return IPRange{Start: start, End: end}, nil
+	}
+
+	ip, err := parseIPv4(destination)
+	if err != nil {
+		return IPRange{}, err
+	}
+	return IPRange{Start: ip, End: ip}, nil
+}
+
+// ParsePorts parses a rule's ports, which is a comma separated list of single
+// ports and port ranges separated by a dash.
+func ParsePorts(ports string) ([]PortRange, error) {
+	var ranges []PortRange
+	for _, portOrRange := range strings.Split(ports, ",") {
+		portOrRange = strings.TrimSpace(portOrRange)
+		bounds := strings.Split(portOrRange, "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("ports %q are not valid", ports)
+		}
+
+		start, err := parsePort(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = parsePort(bounds[1])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if start > end {
+			return nil, fmt.Errorf("port range %q starts after it ends", portOrRange)
+		}
+
+		ranges = append(ranges, PortRange{Start: start, End: end})
+	}
+	return ranges, nil
+}
+
+// Allows returns true when the rule allows traffic of the provided protocol
+// to the provided IP address and port. Invalid rules never allow traffic, and
+// icmp rules never allow tcp or udp traffic.
+func (rule Rule) Allows(protocol string, ip net.IP, port int) bool {
+	switch rule.Protocol {
+	case ProtocolAll:
+	case ProtocolICMP:
+		// icmp rules have no ports, so they only ever match icmp traffic.
+		if protocol != ProtocolICMP {
+			return false
+		}
+	default:
+		if rule.Protocol != protocol {
+			return false
+		}
+	}
+
+	destination, err := ParseDestination(rule.Destination)
+	if err != nil || !destination.Contains(ip) {
+		return false
+	}
+
+	if rule.Protocol != ProtocolTCP && rule.Protocol != ProtocolUDP {
+		return true
+	}
+
+	ports, err := ParsePorts(rule.Ports)
+	if err != nil {
+		return false
+	}
+	for _, portRange := range ports {
+		if portRange.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseIPv4(address string) (uint32, error) {
+	ip := net.ParseIP(strings.TrimSpace(address)).To4()
+	if ip == nil {
+		return 0, fmt.Errorf("%q is not a valid IPv4 address", address)
+	}
+	return binary.BigEndian.Uint32(ip), nil
+}
+
+func parsePort(port string) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(port))
+	if err != nil || value < 1 || value > 65535 {
+		return 0, fmt.Errorf("port %q must be a number between 1 and 65535", port)
+	}
+	return value, nil
+}
@@ -0,0 +1,74 @@
+package securitygroups_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/util/securitygroups"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rules", func() {
+	Describe("ParseDestination", func() {
+		DescribeTable("valid destinations",
+			func(destination string, expected IPRange) {
+				ipRange, err := ParseDestination(destination)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ipRange).To(Equal(expected))
+			},
+			Entry("single address", "10.0.0.1", IPRange{Start: 0x0A000001, End: 0x0A000001}),
+			Entry("CIDR", "10.0.0.0/8", IPRange{Start: 0x0A000000, End: 0x0AFFFFFF}),
+			Entry("everything", "0.0.0.0/0", IPRange{Start: 0, End: 0xFFFFFFFF}),
+			Entry("range", "10.0.0.1-10.0.0.9", IPRange{Start: 0x0A000001, End: 0x0A000009}),
+		)
+
+		DescribeTable("invalid destinations",
+			func(destination string) {
+				_, err := ParseDestination(destination)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("not an address", "example.com"),
+			Entry("bad CIDR", "10.0.0.0/33"),
+			Entry("IPv6", "::1"),
+			Entry("reversed range", "10.0.0.9-10.0.0.1"),
+		)
+	})
+
+	Describe("ParsePorts", func() {
+		It("parses lists of ports and port ranges", func() {
+			ports, err := ParsePorts("80, 443,8000-9000")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ports).To(Equal([]PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}, {Start: 8000, End: 9000}}))
+		})
+
+		DescribeTable("invalid ports",
+			func(ports string) {
+				_, err := ParsePorts(ports)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("not a number", "http"),
+			Entry("zero", "0"),
+			Entry("too large", "65536"),
+			Entry("reversed range", "9000-8000"),
+			Entry("too many dashes", "1-2-3"),
+		)
+	})
+
+	Describe("Allows", func() {
+		DescribeTable("matching traffic",
+			func(rule Rule, protocol string, ip string, port int, expected bool) {
+				Expect(rule.Allows(protocol, net.ParseIP(ip), port)).To(Equal(expected))
+			},
+			Entry("matching tcp rule", Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}, "tcp", "10.1.2.3", 443, true),
+			Entry("wrong port", Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}, "tcp", "10.1.2.3", 80, false),
+			Entry("wrong protocol", Rule{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "443"}, "tcp", "10.1.2.3", 443, false),
+			Entry("outside destination", Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"}, "tcp", "11.0.0.1", 443, false),
+			Entry("all protocols", Rule{Protocol: "all", Destination: "10.0.0.0-10.0.0.255"}, "udp", "10.0.0.42", 53, true),
+			Entry("invalid rule", Rule{Protocol: "tcp", Destination: "nowhere", Ports: "443"}, "tcp", "10.1.2.3", 443, false),
+			Entry("icmp rule and tcp traffic", Rule{Protocol: "icmp", Destination: "10.0.0.0/8"}, "tcp", "10.1.2.3", 443, false),
+			Entry("icmp rule and udp traffic", Rule{Protocol: "icmp", Destination: "10.0.0.0/8"}, "udp", "10.1.2.3", 53, false),
+		)
+	})
+})
@@ -0,0 +1,90 @@
+// Package securitygroups reads declarative descriptions of security groups,
+// their rules and the spaces they are bound to.
+package securitygroups
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SecurityGroup is the desired state of a single security group.
+type SecurityGroup struct {
+	Name          string  `yaml:"name"`
+	Rules         []Rule  `yaml:"rules"`
+	RunningSpaces []Space `yaml:"running_spaces"`
+	StagingSpaces []Space `yaml:"staging_spaces"`
+}
+
+// Rule is a single egress rule of a security group.
+type Rule struct {
+	Protocol    string `yaml:"protocol"`
+	Destination string `yaml:"destination"`
+	Ports       string `yaml:"ports"`
+	Type        *int   `yaml:"type"`
+	Code        *int   `yaml:"code"`
+	Log         bool   `yaml:"log"`
+	Description string `yaml:"description"`
+}
+
+// Space identifies a space that a security group is bound to.
+type Space struct {
+	Org   string `yaml:"org"`
+	Space string `yaml:"space"`
+}
+
+// ReadSecurityGroups reads and validates the YAML or JSON document at the
+// provided path. The document must contain a top level 'security_groups'
+// list.
+func ReadSecurityGroups(pathToFile string) ([]SecurityGroup, error) {
+	raw, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var document struct {
+		SecurityGroups []SecurityGroup `yaml:"security_groups"`
+	}
+	err = yaml.Unmarshal(raw, &document)
+	if err != nil {
+		return nil, generic.InvalidYAMLError{File: "security groups file", Err: err}
+	}
+
+	err = validate(document.SecurityGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return document.SecurityGroups, nil
+}
+
+func validate(securityGroups []SecurityGroup) error {
+	if len(securityGroups) == 0 {
+		return InvalidSecurityGroupsError{Message: "must have at least one security group"}
+	}
+
+	names := map[string]bool{}
+	for i, securityGroup := range securityGroups {
+		if securityGroup.Name == "" {
+			return InvalidSecurityGroupsError{Message: fmt.Sprintf("security group %d has no name", i+1)}
+		}
+		if names[securityGroup.Name] {
+			return InvalidSecurityGroupsError{Message: fmt.Sprintf("security group %s is defined more than once", securityGroup.Name)}
+		}
+		names[securityGroup.Name] = true
+
+		if ruleErrors := RuleErrors(ValidateRules(securityGroup.Rules)); len(ruleErrors) > 0 {
+			return InvalidSecurityGroupsError{Message: fmt.Sprintf("security group %s has an invalid %s", securityGroup.Name, ruleErrors[0])}
+		}
+
+		for _, space := range append(securityGroup.RunningSpaces, securityGroup.StagingSpaces...) {
+			if space.Org == "" || space.Space == "" {
+				return InvalidSecurityGroupsError{Message: fmt.Sprintf("every space of security group %s must have an org and a space", securityGroup.Name)}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package securitygroups_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	. "code.cloudfoundry.org/cli/util/securitygroups"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadSecurityGroups", func() {
+	var (
+		pathToFile     string
+		document       string
+		securityGroups []SecurityGroup
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		tempFile, err := ioutil.TempFile("", "security-groups-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToFile = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToFile)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(pathToFile, []byte(document), 0666)).To(Succeed())
+		securityGroups, executeErr = ReadSecurityGroups(pathToFile)
+	})
+
+	Context("when the document is valid YAML", func() {
+		BeforeEach(func() {
+			document = `---
+security_groups:
+- name: public-networks
+  rules:
+  - protocol: tcp
+    destination: 10.0.0.0/8
+    ports: "443"
+    description: https
+  running_spaces:
+  - org: org-1
+    space: space-1
+  staging_spaces:
+  - org: org-1
+    space: space-2
+- name: dns
+  rules:
+  - protocol: udp
+    destination: 0.0.0.0/0
+    ports: "53"
+  - protocol: icmp
+    destination: 10.0.0.0/8
+    type: 0
+    code: 0
+    log: true
+`
+		})
+
+		It("returns the security groups", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			icmpType, icmpCode := 0, 0
+			Expect(securityGroups).To(Equal([]SecurityGroup{
+				{
+					Name: "public-networks",
+					Rules: []Rule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https"},
+					},
+					RunningSpaces: []Space{{Org: "org-1", Space: "space-1"}},
+					StagingSpaces: []Space{{Org: "org-1", Space: "space-2"}},
+				},
+				{
+					Name: "dns",
+					Rules: []Rule{
+						{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: &icmpType, Code: &icmpCode, Log: true},
+					},
+				},
+			}))
+		})
+	})
+
+	Context("when the document is valid JSON", func() {
+		BeforeEach(func() {
+			document = `{
+				"security_groups": [
+					{
+						"name": "dns",
+						"rules": [{"protocol": "udp", "destination": "0.0.0.0/0", "ports": "53"}],
+						"running_spaces": [{"org": "org-1", "space": "space-1"}]
+					}
+				]
+			}`
+		})
+
+		It("returns the security groups", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(securityGroups).To(Equal([]SecurityGroup{
+				{
+					Name:          "dns",
+					Rules:         []Rule{{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"}},
+					RunningSpaces: []Space{{Org: "org-1", Space: "space-1"}},
+				},
+			}))
+		})
+	})
+
+	Context("when the document is not valid YAML", func() {
+		BeforeEach(func() {
+			document = "security_groups: [}"
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(generic.InvalidYAMLError{}))
+			Expect(executeErr.Error()).To(HavePrefix("The security groups file must be valid YAML or JSON."))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		JustBeforeEach(func() {
+			Expect(os.RemoveAll(pathToFile)).ToNot(HaveOccurred())
+			securityGroups, executeErr = ReadSecurityGroups(pathToFile)
+		})
+
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	DescribeInvalidDocument := func(description string, invalidDocument string, message string) {
+		Context(description, func() {
+			BeforeEach(func() {
+				document = invalidDocument
+			})
+
+			It("returns an InvalidSecurityGroupsError", func() {
+				Expect(executeErr).To(MatchError(InvalidSecurityGroupsError{Message: message}))
+			})
+		})
+	}
+
+	DescribeInvalidDocument("when there are no security groups",
+		"security_groups: []",
+		"must have at least one security group")
+
+	DescribeInvalidDocument("when a security group has no name",
+		"security_groups:\n- rules: []",
+		"security group 1 has no name")
+
+	DescribeInvalidDocument("when a security group is defined twice",
+		"security_groups:\n- name: dns\n- name: dns",
+		"security group dns is defined more than once")
+
+	DescribeInvalidDocument("when a rule has no destination",
+		"security_groups:\n- name: dns\n  rules:\n  - protocol: udp",
+		"security group dns has an invalid rule 1: destination is required")
+
+	DescribeInvalidDocument("when a space has no org",
+		"security_groups:\n- name: dns\n  staging_spaces:\n  - space: space-1",
+		"every space of security group dns must have an org and a space")
+})
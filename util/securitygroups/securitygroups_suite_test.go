@@ -0,0 +1,13 @@
+package securitygroups_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSecurityGroups(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Security Groups Suite")
+}
@@ -0,0 +1,169 @@
+package securitygroups
+
+import "fmt"
+
+// RuleProblem is an issue found in a security group rule.
+type RuleProblem struct {
+	// Rule is the position of the rule, starting at 1.
+	Rule int
+
+	Message string
+
+	// IsError is true when the Cloud Controller would reject the rule or the
+	// rule could never match. Otherwise the problem is a warning.
+	IsError bool
+}
+
+func (problem RuleProblem) String() string {
+	return fmt.Sprintf("rule %d: %s", problem.Rule, problem.Message)
+}
+
+type parsedRule struct {
+	position    int
+	protocol    string
+	destination IPRange
+	ports       []PortRange
+
+	// icmpType and icmpCode are only set for icmp rules, where -1 matches
+	// every type or code.
+	icmpType int
+	icmpCode int
+}
+
+// ValidateRules checks the protocol, destination and ports of every rule,
+// and warns about rules that allow traffic to every destination or that
+// overlap with an earlier rule.
+func ValidateRules(rules []Rule) []RuleProblem {
+	var (
+		problems    []RuleProblem
+		parsedRules []parsedRule
+	)
+
+	for i, rule := range rules {
+		parsed, ruleProblems := validateRule(i+1, rule)
+		problems = append(problems, ruleProblems...)
+		if parsed == nil {
+			continue
+		}
+
+		if parsed.destination.IsEverything() {
+			problems = append(problems, RuleProblem{
+				Rule:    parsed.position,
+				Message: fmt.Sprintf("destination %s allows traffic to all IP addresses", rule.Destination),
+			})
+		}
+
+		for _, earlier := range parsedRules {
+			if parsed.overlaps(earlier) {
+				problems = append(problems, RuleProblem{
+					Rule:    parsed.position,
+					Message: fmt.Sprintf("overlaps with rule %d", earlier.position),
+				})
+			}
+		}
+
+		parsedRules = append(parsedRules, *parsed)
+	}
+
+	return problems
+}
+
+// RuleErrors returns only the problems that are errors.
+func RuleErrors(problems []RuleProblem) []RuleProblem {
+	var errs []RuleProblem
+	for _, problem := range problems {
+		if problem.IsError {
+			errs = append(errs, problem)
+		}
+	}
+	return errs
+}
+
+func validateRule(position int, rule Rule) (*parsedRule, []RuleProblem) {
+	newError := func(message string) []RuleProblem {
+		return []RuleProblem{{Rule: position, Message: message, IsError: true}}
+	}
+
+	switch rule.Protocol {
+	case ProtocolAll, ProtocolICMP, ProtocolTCP, ProtocolUDP:
+	case "":
+		return nil, newError("protocol is required")
+	default:
+		return nil, newError(fmt.Sprintf("protocol %q must be one of tcp, udp, icmp or all", rule.Protocol))
+	}
+
+	if rule.Destination == "" {
+		return nil, newError("destination is required")
+	}
+	destination, err := ParseDestination(rule.Destination)
+	if err != nil {
+		return nil, newError(err.Error())
+	}
+
+	parsed := parsedRule{
+		position:    position,
+		protocol:    rule.Protocol,
+		destination: destination,
+	}
+
+	if rule.Protocol == ProtocolTCP || rule.Protocol == ProtocolUDP {
+		if rule.Ports == "" {
+			return nil, newError(fmt.Sprintf("ports are required for %s rules", rule.Protocol))
+		}
+		parsed.ports, err = ParsePorts(rule.Ports)
+		if err != nil {
+			return nil, newError(err.Error())
+		}
+	} else if rule.Ports != "" {
+		return nil, newError("ports are only supported for tcp and udp rules")
+	}
+
+	if rule.Protocol == ProtocolICMP {
+		if rule.Type == nil || rule.Code == nil {
+			return nil, newError("type and code are required for icmp rules")
+		}
+		if *rule.Type < -1 || *rule.Type > 255 {
+			return nil, newError(fmt.Sprintf("icmp type %d must be -1 or a number between 0 and 255", *rule.Type))
+		}
+		if *rule.Code < -1 || *rule.Code > 255 {
+			return nil, newError(fmt.Sprintf("icmp code %d must be -1 or a number between 0 and 255", *rule.Code))
+		}
+		parsed.icmpType = *rule.Type
+		parsed.icmpCode = *rule.Code
+	} else if rule.Type != nil || rule.Code != nil {
+		return nil, newError("type and code are only supported for icmp rules")
+	}
+
+	return &parsed, nil
+}
+
+func (rule parsedRule) overlaps(other parsedRule) bool {
+	if rule.protocol != other.protocol && rule.protocol != ProtocolAll && other.protocol != ProtocolAll {
+		return false
+	}
+
+	if !rule.destination.Overlaps(other.destination) {
+		return false
+	}
+
+	if rule.protocol == ProtocolICMP && other.protocol == ProtocolICMP {
+		return icmpValuesOverlap(rule.icmpType, other.icmpType) && icmpValuesOverlap(rule.icmpCode, other.icmpCode)
+	}
+
+	if rule.ports == nil || other.ports == nil {
+		return true
+	}
+
+	for _, ports := range rule.ports {
+		for _, otherPorts := range other.ports {
+			if ports.Overlaps(otherPorts) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func icmpValuesOverlap(value int, other int) bool {
+	return value == -1 || other == -1 || value == other
+}
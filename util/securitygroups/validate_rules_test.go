@@ -0,0 +1,78 @@
+package securitygroups_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/securitygroups"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateRules", func() {
+	It("returns no problems for valid, disjoint rules", func() {
+		Expect(ValidateRules([]Rule{
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+			{Protocol: "udp", Destination: "10.0.0.0/24", Ports: "443"},
+			{Protocol: "icmp", Destination: "10.0.1.1", Type: intPtr(0), Code: intPtr(0)},
+			{Protocol: "icmp", Destination: "10.0.1.1", Type: intPtr(8), Code: intPtr(-1)},
+		})).To(BeEmpty())
+	})
+
+	DescribeTable("invalid rules",
+		func(rule Rule, message string) {
+			Expect(ValidateRules([]Rule{rule})).To(ConsistOf(RuleProblem{Rule: 1, Message: message, IsError: true}))
+		},
+		Entry("missing protocol", Rule{Destination: "10.0.0.1"}, "protocol is required"),
+		Entry("unknown protocol", Rule{Protocol: "sctp", Destination: "10.0.0.1"}, `protocol "sctp" must be one of tcp, udp, icmp or all`),
+		Entry("missing destination", Rule{Protocol: "all"}, "destination is required"),
+		Entry("invalid destination", Rule{Protocol: "all", Destination: "10.0.0.0/40"}, `destination "10.0.0.0/40" is not a valid IPv4 CIDR`),
+		Entry("missing ports", Rule{Protocol: "tcp", Destination: "10.0.0.1"}, "ports are required for tcp rules"),
+		Entry("invalid ports", Rule{Protocol: "udp", Destination: "10.0.0.1", Ports: "70000"}, `port "70000" must be a number between 1 and 65535`),
+		Entry("ports on an icmp rule", Rule{Protocol: "icmp", Destination: "10.0.0.1", Ports: "80"}, "ports are only supported for tcp and udp rules"),
+		Entry("missing icmp type", Rule{Protocol: "icmp", Destination: "10.0.0.1", Code: intPtr(0)}, "type and code are required for icmp rules"),
+		Entry("missing icmp code", Rule{Protocol: "icmp", Destination: "10.0.0.1", Type: intPtr(0)}, "type and code are required for icmp rules"),
+		Entry("invalid icmp type", Rule{Protocol: "icmp", Destination: "10.0.0.1", Type: intPtr(256), Code: intPtr(0)}, "icmp type 256 must be -1 or a number between 0 and 255"),
+		Entry("invalid icmp code", Rule{Protocol: "icmp", Destination: "10.0.0.1", Type: intPtr(0), Code: intPtr(-2)}, "icmp code -2 must be -1 or a number between 0 and 255"),
+		Entry("type and code on a tcp rule", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80", Type: intPtr(0), Code: intPtr(0)}, "type and code are only supported for icmp rules"),
+	)
+
+	It("warns about rules that allow traffic to every address", func() {
+		Expect(ValidateRules([]Rule{{Protocol: "all", Destination: "0.0.0.0/0"}})).To(ConsistOf(
+			RuleProblem{Rule: 1, Message: "destination 0.0.0.0/0 allows traffic to all IP addresses"},
+		))
+	})
+
+	It("warns about overlapping rules", func() {
+		problems := ValidateRules([]Rule{
+			{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "8000-9000"},
+			{Protocol: "tcp", Destination: "10.1.0.0-10.1.0.255", Ports: "8080"},
+			{Protocol: "all", Destination: "10.1.0.1"},
+			{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "8080"},
+		})
+		Expect(problems).To(ConsistOf(
+			RuleProblem{Rule: 2, Message: "overlaps with rule 1"},
+			RuleProblem{Rule: 3, Message: "overlaps with rule 1"},
+			RuleProblem{Rule: 3, Message: "overlaps with rule 2"},
+			RuleProblem{Rule: 4, Message: "overlaps with rule 3"},
+		))
+		Expect(RuleErrors(problems)).To(BeEmpty())
+	})
+
+	It("warns about icmp rules that match the same type and code", func() {
+		Expect(ValidateRules([]Rule{
+			{Protocol: "icmp", Destination: "10.0.0.0/8", Type: intPtr(-1), Code: intPtr(-1)},
+			{Protocol: "icmp", Destination: "10.0.0.1", Type: intPtr(8), Code: intPtr(0)},
+		})).To(ConsistOf(
+			RuleProblem{Rule: 2, Message: "overlaps with rule 1"},
+		))
+	})
+
+	It("formats problems with the rule position", func() {
+		Expect(RuleProblem{Rule: 2, Message: "some problem"}.String()).To(Equal("rule 2: some problem"))
+	})
+})
+
+func intPtr(value int) *int {
+	return &value
+}
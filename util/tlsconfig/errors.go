@@ -0,0 +1,26 @@
+package tlsconfig
+
+import "fmt"
+
+// CACertFileError is returned when the CA certificate bundle cannot be read
+// or does not contain any certificates.
+type CACertFileError struct {
+	Path string
+	Err  error
+}
+
+func (e CACertFileError) Error() string {
+	return fmt.Sprintf("unable to load CA certificate file %s: %s", e.Path, e.Err)
+}
+
+// ClientCertificateError is returned when the client certificate and key
+// cannot be loaded.
+type ClientCertificateError struct {
+	CertFile string
+	KeyFile  string
+	Err      error
+}
+
+func (e ClientCertificateError) Error() string {
+	return fmt.Sprintf("unable to load client certificate %s and key %s: %s", e.CertFile, e.KeyFile, e.Err)
+}
@@ -0,0 +1,81 @@
+// Package tlsconfig builds the TLS configuration used by the CLI's HTTP
+// connections from a user provided CA bundle and client certificate.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+)
+
+// New returns a TLS configuration that trusts the certificates in caCertFile,
+// in addition to the system's trusted certificates, and presents the
+// certificate and key in clientCertFile and clientKeyFile to servers that
+// request one. It returns nil when none of the files are provided.
+func New(caCertFile string, clientCertFile string, clientKeyFile string) (*tls.Config, error) {
+	if caCertFile == "" && clientCertFile == "" && clientKeyFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{}
+
+	if caCertFile != "" {
+		certPool, err := loadCACertPool(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = certPool
+	}
+
+	if clientCertFile != "" || clientKeyFile != "" {
+		if clientCertFile == "" || clientKeyFile == "" {
+			return nil, ClientCertificateError{
+				CertFile: clientCertFile,
+				KeyFile:  clientKeyFile,
+				Err:      errors.New("both a client certificate and a client key are required"),
+			}
+		}
+
+		certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, ClientCertificateError{
+				CertFile: clientCertFile,
+				KeyFile:  clientKeyFile,
+				Err:      err,
+			}
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// WithSkipSSLValidation returns a copy of config, or an empty configuration
+// when config is nil, with InsecureSkipVerify set to skipSSLValidation.
+func WithSkipSSLValidation(config *tls.Config, skipSSLValidation bool) *tls.Config {
+	result := &tls.Config{}
+	if config != nil {
+		result = config.Clone()
+	}
+	result.InsecureSkipVerify = skipSSLValidation
+	return result
+}
+
+func loadCACertPool(caCertFile string) (*x509.CertPool, error) {
+	contents, err := ioutil.ReadFile(caCertFile)
+	if err != nil {
+		return nil, CACertFileError{Path: caCertFile, Err: err}
+	}
+
+	certPool, err := x509.SystemCertPool()
+	if err != nil || certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+
+	if !certPool.AppendCertsFromPEM(contents) {
+		return nil, CACertFileError{Path: caCertFile, Err: errors.New("no PEM encoded certificates found")}
+	}
+
+	return certPool, nil
+}
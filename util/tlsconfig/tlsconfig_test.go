@@ -0,0 +1,132 @@
+package tlsconfig_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/tlsconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS Config", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "tlsconfig")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("New", func() {
+		Context("when no files are provided", func() {
+			It("returns nil", func() {
+				config, err := New("", "", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).To(BeNil())
+			})
+		})
+
+		Context("when a CA bundle is provided", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				caCertFile, _ = writeCertificate(dir, "some-ca")
+			})
+
+			It("trusts the certificates in the bundle", func() {
+				config, err := New(caCertFile, "", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Certificates).To(BeEmpty())
+
+				contents, err := ioutil.ReadFile(caCertFile)
+				Expect(err).ToNot(HaveOccurred())
+				block, _ := pem.Decode(contents)
+				cert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = cert.Verify(x509.VerifyOptions{Roots: config.RootCAs})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the bundle does not exist", func() {
+				It("returns a CACertFileError", func() {
+					_, err := New(filepath.Join(dir, "missing.crt"), "", "")
+					Expect(err).To(BeAssignableToTypeOf(CACertFileError{}))
+					Expect(err.(CACertFileError).Path).To(Equal(filepath.Join(dir, "missing.crt")))
+				})
+			})
+
+			Context("when the bundle does not contain any certificates", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(caCertFile, []byte("not a certificate"), 0600)).To(Succeed())
+				})
+
+				It("returns a CACertFileError", func() {
+					_, err := New(caCertFile, "", "")
+					Expect(err).To(BeAssignableToTypeOf(CACertFileError{}))
+					Expect(err).To(MatchError(ContainSubstring("no PEM encoded certificates found")))
+				})
+			})
+		})
+
+		Context("when a client certificate and key are provided", func() {
+			var clientCertFile, clientKeyFile string
+
+			BeforeEach(func() {
+				clientCertFile, clientKeyFile = writeCertificate(dir, "some-client")
+			})
+
+			It("presents the client certificate", func() {
+				config, err := New("", clientCertFile, clientKeyFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.RootCAs).To(BeNil())
+				Expect(config.Certificates).To(HaveLen(1))
+			})
+
+			Context("when the key does not match the certificate", func() {
+				BeforeEach(func() {
+					_, clientKeyFile = writeCertificate(dir, "some-other-client")
+				})
+
+				It("returns a ClientCertificateError", func() {
+					_, err := New("", clientCertFile, clientKeyFile)
+					Expect(err).To(BeAssignableToTypeOf(ClientCertificateError{}))
+				})
+			})
+
+			Context("when only the certificate is provided", func() {
+				It("returns a ClientCertificateError", func() {
+					_, err := New("", clientCertFile, "")
+					Expect(err).To(BeAssignableToTypeOf(ClientCertificateError{}))
+					Expect(err).To(MatchError(ContainSubstring("both a client certificate and a client key are required")))
+				})
+			})
+		})
+	})
+
+	Describe("WithSkipSSLValidation", func() {
+		It("sets InsecureSkipVerify on a copy of the config", func() {
+			original := &tls.Config{ServerName: "some-server"}
+
+			config := WithSkipSSLValidation(original, true)
+			Expect(config.InsecureSkipVerify).To(BeTrue())
+			Expect(config.ServerName).To(Equal("some-server"))
+			Expect(original.InsecureSkipVerify).To(BeFalse())
+		})
+
+		It("returns a new config when none is provided", func() {
+			config := WithSkipSSLValidation(nil, false)
+			Expect(config).ToNot(BeNil())
+			Expect(config.InsecureSkipVerify).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// DisplayLiveUpdate outputs everything display writes to ui.Out. When attached
+// to a TTY, the output of the previous call is erased first so that the
+// display is redrawn in place; otherwise each call is appended.
+func (ui *UI) DisplayLiveUpdate(display func()) {
+	if ui.IsTTY && ui.liveUpdateLines > 0 {
+		ui.terminalLock.Lock()
+		fmt.Fprintf(ui.Out, "\033[%dA\033[J", ui.liveUpdateLines)
+		ui.terminalLock.Unlock()
+	}
+
+	counter := &lineCountingWriter{writer: ui.Out, width: ui.TerminalWidth}
+	out := ui.Out
+	ui.Out = counter
+	defer func() {
+		ui.Out = out
+		ui.liveUpdateLines = counter.lines
+	}()
+
+	display()
+}
+
+// lineCountingWriter counts the terminal lines written through it, including
+// lines wrapped at width. ANSI escape sequences take up no columns.
+type lineCountingWriter struct {
+	writer io.Writer
+	width  int
+
+	lines    int
+	column   int
+	inEscape bool
+}
+
+func (w *lineCountingWriter) Write(p []byte) (int, error) {
+	for s := string(p); len(s) > 0; {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+
+		switch {
+		case w.inEscape:
+			w.inEscape = !(r >= '@' && r <= '~' && r != '[')
+		case r == '\033':
+			w.inEscape = true
+		case r == '\n':
+			w.lines++
+			w.column = 0
+		default:
+			w.column += runewidth.RuneWidth(r)
+			if w.width > 0 && w.column > w.width {
+				w.lines++
+				w.column = runewidth.RuneWidth(r)
+			}
+		}
+	}
+
+	return w.writer.Write(p)
+}
@@ -0,0 +1,58 @@
+package ui_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayLiveUpdate", func() {
+	var (
+		ui  *UI
+		out *Buffer
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+	})
+
+	Context("when attached to a TTY", func() {
+		BeforeEach(func() {
+			ui.IsTTY = true
+			ui.TerminalWidth = 10
+		})
+
+		It("erases the previous output before redrawing", func() {
+			ui.DisplayLiveUpdate(func() {
+				ui.DisplayText("first")
+				ui.DisplayText("\x1b[1mbold\x1b[0m")
+				ui.DisplayText("a line that wraps")
+			})
+			Expect(out).To(Say("first\n\x1b\\[1mbold\x1b\\[0m\na line that wraps\n"))
+
+			ui.DisplayLiveUpdate(func() {
+				ui.DisplayText("second")
+			})
+			Expect(out).To(Say("\x1b\\[4A\x1b\\[Jsecond\n"))
+
+			ui.DisplayLiveUpdate(func() {
+				ui.DisplayText("third")
+			})
+			Expect(out).To(Say("\x1b\\[1A\x1b\\[Jthird\n"))
+		})
+	})
+
+	Context("when not attached to a TTY", func() {
+		It("appends each update", func() {
+			ui.DisplayLiveUpdate(func() {
+				ui.DisplayText("first")
+			})
+			ui.DisplayLiveUpdate(func() {
+				ui.DisplayText("second")
+			})
+			Expect(string(out.Contents())).To(Equal("first\nsecond\n"))
+		})
+	})
+})
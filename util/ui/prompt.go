@@ -1,6 +1,16 @@
 package ui
 
-import "github.com/vito/go-interact/interact"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vito/go-interact/interact"
+)
+
+// selectionPromptLimit is the maximum number of options DisplaySelectionPrompt
+// lists at once. Longer lists have to be narrowed down by filtering.
+const selectionPromptLimit = 20
 
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
@@ -30,3 +40,91 @@ func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[strin
 	err := interactivePrompt.Resolve(interact.Required(&password))
 	return string(password), err
 }
+
+// DisplaySelectionPrompt lists the options and waits for the user to pick one,
+// either by entering its name or its number. A name takes precedence, so an
+// option named "2" is selected by entering 2. Entering anything else filters
+// the list down to the options that contain the entered characters in order,
+// ignoring case; when only one option matches it is selected.
+func (ui *UI) DisplaySelectionPrompt(options []string, template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	matches := options
+	for {
+		ui.displaySelectionOptions(matches)
+
+		var response string
+		interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+		interactivePrompt.Input = ui.In
+		interactivePrompt.Output = ui.OutForInteration
+		err := interactivePrompt.Resolve(interact.Required(&response))
+		if err != nil {
+			return "", err
+		}
+		response = strings.TrimSpace(response)
+
+		for _, option := range options {
+			if option == response {
+				return option, nil
+			}
+		}
+
+		if number, convErr := strconv.Atoi(response); convErr == nil {
+			if number >= 1 && number <= len(matches) && number <= selectionPromptLimit {
+				return matches[number-1], nil
+			}
+			fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText("Invalid selection '{{.Selection}}'.", map[string]interface{}{
+				"Selection": response,
+			}))
+			continue
+		}
+
+		filtered := filterSelectionOptions(options, response)
+		switch len(filtered) {
+		case 0:
+			fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText("No matches for '{{.Filter}}'.", map[string]interface{}{
+				"Filter": response,
+			}))
+		case 1:
+			return filtered[0], nil
+		default:
+			matches = filtered
+		}
+	}
+}
+
+func (ui *UI) displaySelectionOptions(options []string) {
+	for i, option := range options {
+		if i == selectionPromptLimit {
+			fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText("...and {{.Count}} more; type part of a name to filter.", map[string]interface{}{
+				"Count": len(options) - selectionPromptLimit,
+			}))
+			break
+		}
+		fmt.Fprintf(ui.Out, "%d. %s\n", i+1, option)
+	}
+}
+
+// filterSelectionOptions returns the options that contain the characters of
+// filter in order, ignoring case.
+func filterSelectionOptions(options []string, filter string) []string {
+	filter = strings.ToLower(filter)
+
+	var matches []string
+	for _, option := range options {
+		remaining := filter
+		for _, r := range strings.ToLower(option) {
+			if remaining == "" {
+				break
+			}
+			if strings.HasPrefix(remaining, string(r)) {
+				remaining = remaining[len(string(r)):]
+			}
+		}
+		if remaining == "" {
+			matches = append(matches, option)
+		}
+	}
+	return matches
+}
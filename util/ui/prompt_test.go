@@ -1,6 +1,8 @@
 package ui_test
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/util/ui/uifakes"
@@ -143,4 +145,137 @@ var _ = Describe("Prompts", func() {
 			})
 		})
 	})
+
+	Describe("DisplaySelectionPrompt", func() {
+		var (
+			options  []string
+			selected string
+			err      error
+		)
+
+		BeforeEach(func() {
+			options = []string{"some-app", "some-other-app", "different-app"}
+		})
+
+		JustBeforeEach(func() {
+			selected, err = ui.DisplaySelectionPrompt(options, "Select an {{.Thing}}", map[string]interface{}{
+				"Thing": "app",
+			})
+		})
+
+		Context("when the user enters a number", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("2\n"))
+			})
+
+			It("lists the options and returns the chosen one", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("some-other-app"))
+
+				Expect(out).To(Say("1\\. some-app\n"))
+				Expect(out).To(Say("2\\. some-other-app\n"))
+				Expect(out).To(Say("3\\. different-app\n"))
+				Expect(out).To(Say("Select an app"))
+			})
+		})
+
+		Context("when the user enters a name", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("some-app\n"))
+			})
+
+			It("returns the option with that name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("some-app"))
+			})
+		})
+
+		Context("when the user enters a number that is also the name of an option", func() {
+			BeforeEach(func() {
+				options = []string{"some-app", "1", "2"}
+				inBuffer.Write([]byte("2\n"))
+			})
+
+			It("returns the option with that name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("2"))
+			})
+		})
+
+		Context("when the user enters a filter that matches one option", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("DIFF\n"))
+			})
+
+			It("returns the matching option", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("different-app"))
+			})
+		})
+
+		Context("when the user enters a filter that matches several options", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("soa\n2\n"))
+			})
+
+			It("lists the matching options and returns the one chosen from them", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("some-other-app"))
+
+				Expect(out).To(Say("3\\. different-app\n"))
+				Expect(out).To(Say("1\\. some-app\n"))
+				Expect(out).To(Say("2\\. some-other-app\n"))
+				Expect(out).ToNot(Say("3\\."))
+			})
+		})
+
+		Context("when the user enters a filter that matches nothing", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("xyz\n1\n"))
+			})
+
+			It("says so and prompts again", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("some-app"))
+				Expect(out).To(Say("No matches for 'xyz'\\."))
+			})
+		})
+
+		Context("when the user enters a number that is out of range", func() {
+			BeforeEach(func() {
+				inBuffer.Write([]byte("4\n3\n"))
+			})
+
+			It("says so and prompts again", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("different-app"))
+				Expect(out).To(Say("Invalid selection '4'\\."))
+			})
+		})
+
+		Context("when there are more options than can be listed", func() {
+			BeforeEach(func() {
+				options = nil
+				for i := 0; i < 25; i++ {
+					options = append(options, fmt.Sprintf("app-%02d", i))
+				}
+				inBuffer.Write([]byte("21\napp-22\n"))
+			})
+
+			It("lists the first options and asks the user to filter", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selected).To(Equal("app-22"))
+
+				Expect(out).To(Say("20\\. app-19\n"))
+				Expect(out).To(Say("\\.\\.\\.and 5 more; type part of a name to filter\\."))
+				Expect(out).To(Say("Invalid selection '21'\\."))
+			})
+		})
+
+		Context("when the input ends before a selection is made", func() {
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
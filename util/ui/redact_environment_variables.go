@@ -0,0 +1,36 @@
+package ui
+
+import "regexp"
+
+var secretEnvironmentVariableNames = regexp.MustCompile("(?i)passw(or)?d|passphrase|secret|token|credential|private_?key|api_?key|access_?key")
+
+// RedactEnvironmentVariables returns a copy of vars where the values of
+// secret looking names, at any depth, and passwords embedded in URLs are
+// replaced with RedactedValue. vars is not modified.
+func RedactEnvironmentVariables(vars map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(vars))
+	for name, value := range vars {
+		redacted[name] = redactEnvironmentVariable(name, value)
+	}
+	return redacted
+}
+
+func redactEnvironmentVariable(name string, value interface{}) interface{} {
+	if name != tokenEndpoint && secretEnvironmentVariableNames.MatchString(name) {
+		return RedactedValue
+	}
+
+	switch v := value.(type) {
+	case string:
+		return sanitizeURL(v)
+	case []interface{}:
+		list := make([]interface{}, 0, len(v))
+		for _, val := range v {
+			list = append(list, redactEnvironmentVariable("", val))
+		}
+		return list
+	case map[string]interface{}:
+		return RedactEnvironmentVariables(v)
+	}
+	return value
+}
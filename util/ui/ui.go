@@ -41,6 +41,8 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	liveUpdateLines int
+
 	TimezoneLocation *time.Location
 }
 
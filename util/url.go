@@ -9,3 +9,9 @@ func IsHTTPScheme(path string) bool {
 func IsUnsupportedURLScheme(path string) bool {
 	return strings.Contains(path, "://") && !IsHTTPScheme(path)
 }
+
+// IsGitScheme returns true if the path is a git repository URL of the form
+// git+URL[#REF].
+func IsGitScheme(path string) bool {
+	return strings.HasPrefix(path, "git+") && strings.Contains(path, "://")
+}
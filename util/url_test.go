@@ -37,4 +37,16 @@ var _ = Describe("util", func() {
 		Entry("UNIX path", "/some/path", false),
 		Entry("Windows path", "C:\\some\\path", false),
 	)
+
+	DescribeTable("IsGitScheme",
+		func(path string, isGitScheme bool) {
+			Expect(IsGitScheme(path)).To(Equal(isGitScheme))
+		},
+
+		Entry("git+file URL", "git+file:///some/repo#v1.2.3", true),
+		Entry("git+https URL", "git+https://example.com/repo.git", true),
+		Entry("git+ssh URL", "git+ssh://git@example.com/repo.git#main", true),
+		Entry("plain HTTPS URL", "https://example.com/repo.git", false),
+		Entry("local file name", "git+some-path", false),
+	)
 })